@@ -1,5 +1,10 @@
 package n
 
+import (
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/phR0ze/n/pkg/enc/msgpack"
+)
+
 // Char wraps the Go rune providing a way to distinguish it from an int32
 // where as a rune is indistinguishable from an int32. Provides convenience
 // methods on par with rapid development languages.
@@ -54,6 +59,21 @@ func (p *Char) Less(obj interface{}) bool {
 	return p.A() < other.A()
 }
 
+// MarshalJSON implements the json.Marshaler interface encoding this Char as a json string.
+func (p *Char) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.A())
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface encoding this Char as a msgpack string.
+func (p *Char) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(p.A())
+}
+
+// MarshalYAML implements the yaml.Marshaler interface encoding this Char as a yaml string.
+func (p *Char) MarshalYAML() (interface{}, error) {
+	return p.A(), nil
+}
+
 // O returns the underlying data structure as is
 func (p *Char) O() interface{} {
 	if p == nil {
@@ -85,3 +105,33 @@ func (p *Char) String() string {
 	}
 	return string(*p)
 }
+
+// UnmarshalJSON implements the json.Unmarshaler interface decoding a json string into this Char.
+func (p *Char) UnmarshalJSON(data []byte) (err error) {
+	var x string
+	if err = json.Unmarshal(data, &x); err != nil {
+		return
+	}
+	*p = *ToChar(x)
+	return
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface decoding a msgpack string into this Char.
+func (p *Char) UnmarshalMsgpack(data []byte) (err error) {
+	var x string
+	if err = msgpack.Unmarshal(data, &x); err != nil {
+		return
+	}
+	*p = *ToChar(x)
+	return
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface decoding a yaml string into this Char.
+func (p *Char) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	var x string
+	if err = unmarshal(&x); err != nil {
+		return
+	}
+	*p = *ToChar(x)
+	return
+}
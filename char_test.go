@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/phR0ze/n/pkg/enc/json"
+	yaml "github.com/phR0ze/yaml/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -95,3 +97,77 @@ func TestChar_String(t *testing.T) {
 		assert.Equal(t, "1", NewChar("1").String())
 	}
 }
+
+func TestChar_MarshalMsgpack(t *testing.T) {
+	data, err := NewChar('a').MarshalMsgpack()
+	assert.Nil(t, err)
+
+	char := NewCharV()
+	assert.Nil(t, char.UnmarshalMsgpack(data))
+	assert.Equal(t, "a", char.A())
+}
+
+// MarshalJSON
+//--------------------------------------------------------------------------------------------------
+func TestChar_MarshalJSON(t *testing.T) {
+
+	// round trip
+	{
+		data, err := NewChar('a').MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, `"a"`, string(data))
+
+		x := NewChar(0)
+		assert.Nil(t, x.UnmarshalJSON(data))
+		assert.Equal(t, NewChar('a'), x)
+	}
+
+	// as a field in a struct
+	{
+		type config struct {
+			Data *Char `json:"data"`
+		}
+		data, err := json.Marshal(config{Data: NewChar('a')})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"data":"a"}`, string(data))
+
+		var c config
+		assert.Nil(t, json.Unmarshal(data, &c))
+		assert.Equal(t, NewChar('a'), c.Data)
+	}
+
+	// invalid
+	assert.NotNil(t, NewChar(0).UnmarshalJSON([]byte(`[1]`)))
+}
+
+// MarshalYAML
+//--------------------------------------------------------------------------------------------------
+func TestChar_MarshalYAML(t *testing.T) {
+
+	// round trip
+	{
+		data, err := yaml.Marshal(NewChar('a'))
+		assert.Nil(t, err)
+		assert.Equal(t, "a\n", string(data))
+
+		x := NewChar(0)
+		assert.Nil(t, yaml.Unmarshal(data, x))
+		assert.Equal(t, NewChar('a'), x)
+	}
+
+	// as a field in a struct
+	{
+		type config struct {
+			Data *Char `yaml:"data"`
+		}
+		data, err := yaml.Marshal(config{Data: NewChar('a')})
+		assert.Nil(t, err)
+
+		var c config
+		assert.Nil(t, yaml.Unmarshal(data, &c))
+		assert.Equal(t, NewChar('a'), c.Data)
+	}
+
+	// invalid
+	assert.NotNil(t, yaml.Unmarshal([]byte("[1]"), NewChar(0)))
+}
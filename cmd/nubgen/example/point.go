@@ -0,0 +1,9 @@
+// Package example demonstrates a reflection free Slice generated by nubgen
+package example
+
+//go:generate go run github.com/phR0ze/n/cmd/nubgen -type Point -value "Point{1, 2}" -value "Point{2, 1}" -value "Point{3, 0}"
+
+// Point is a simple user defined type to generate a Slice for
+type Point struct {
+	X, Y int
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"go/format"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	//go:embed slice.go.tmpl
+	sliceTemplate string
+
+	//go:embed slice_test.go.tmpl
+	sliceTestTemplate string
+)
+
+// Config describes the Slice to generate
+type Config struct {
+	Type    string   // element type e.g. Point or time.Duration
+	Slice   string   // name of the generated Slice type, defaults to the type name + Slice
+	Package string   // package of the generated files
+	Deep    bool     // compare elements with reflect.DeepEqual instead of ==
+	Convert string   // function converting values of other types into an element e.g. ToString
+	NoTo    bool     // To<Slice> and To<Slice>E are provided elsewhere so aren't generated
+	Values  []string // element expressions in ascending order for the generated tests
+}
+
+// data is the template data for the Slice templates
+type data struct {
+	Config
+	N         string // qualifier for the n package or empty when generating into it
+	Qualified bool   // the n package needs to be imported
+}
+
+// Generate returns the formatted source of the Slice for the given config and the source of its
+// tests if test values were given.
+func Generate(config Config) (src, test []byte, err error) {
+	if config.Type == "" {
+		err = errors.Errorf("type is required")
+		return
+	}
+	if config.Package == "" {
+		err = errors.Errorf("package is required when not run from go generate")
+		return
+	}
+	if len(config.Values) != 0 && len(config.Values) != 3 {
+		err = errors.Errorf("exactly three test values are required but got %d", len(config.Values))
+		return
+	}
+	config.Slice = Name(config)
+
+	d := data{Config: config, N: "n.", Qualified: true}
+	if config.Package == "n" {
+		d.N, d.Qualified = "", false
+	}
+	if src, err = execute("slice", sliceTemplate, d); err != nil {
+		return
+	}
+	if len(config.Values) != 0 {
+		test, err = execute("slice_test", sliceTestTemplate, d)
+	}
+	return
+}
+
+// Name returns the name of the Slice type to generate for the given config which defaults to the
+// type name without any package qualifier or pointer e.g. *geo.Point becomes PointSlice.
+func Name(config Config) string {
+	if config.Slice != "" {
+		return config.Slice
+	}
+	name := strings.TrimLeft(config.Type, "*")
+	if i := strings.LastIndex(name, "."); i != -1 {
+		name = name[i+1:]
+	}
+	runes := []rune(name)
+	if len(runes) > 0 {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+	return string(runes) + "Slice"
+}
+
+// execute runs the given template with the given data and formats the result
+func execute(name, text string, d data) (src []byte, err error) {
+	var tpl *template.Template
+	if tpl, err = template.New(name).Parse(text); err != nil {
+		err = errors.Wrapf(err, "failed to parse %s template", name)
+		return
+	}
+	buf := &bytes.Buffer{}
+	if err = tpl.Execute(buf, d); err != nil {
+		err = errors.Wrapf(err, "failed to execute %s template", name)
+		return
+	}
+	if src, err = format.Source(buf.Bytes()); err != nil {
+		err = errors.Wrapf(err, "failed to format generated %s for type %s", name, d.Type)
+	}
+	return
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/phR0ze/n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+
+	// invalid config
+	{
+		_, _, err := Generate(Config{Package: "foo"})
+		assert.Equal(t, "type is required", err.Error())
+		_, _, err = Generate(Config{Type: "Point"})
+		assert.Equal(t, "package is required when not run from go generate", err.Error())
+		_, _, err = Generate(Config{Type: "Point", Package: "foo", Values: []string{"Point{}"}})
+		assert.Equal(t, "exactly three test values are required but got 1", err.Error())
+	}
+
+	// without tests
+	{
+		src, test, err := Generate(Config{Type: "Point", Package: "foo"})
+		assert.Nil(t, err)
+		assert.NotEmpty(t, src)
+		assert.Nil(t, test)
+	}
+
+	// generated Slice implements every ISlice method
+	{
+		src, test, err := Generate(Config{Type: "Point", Package: "foo", Values: []string{"Point{1}", "Point{2}", "Point{3}"}})
+		assert.Nil(t, err)
+		assert.NotEmpty(t, test)
+		methods := parseMethods(t, src, "PointSlice")
+		iface := reflect.TypeOf((*n.ISlice)(nil)).Elem()
+		for i := 0; i < iface.NumMethod(); i++ {
+			assert.True(t, methods[iface.Method(i).Name], iface.Method(i).Name)
+		}
+	}
+
+	// generating into the n package doesn't qualify its types
+	{
+		src, _, err := Generate(Config{Type: "uint8", Package: "n", Slice: "ByteSlice"})
+		assert.Nil(t, err)
+		assert.NotContains(t, string(src), "n.ISlice")
+		assert.NotContains(t, string(src), `"github.com/phR0ze/n"`)
+		assert.Contains(t, string(src), "func (p *ByteSlice) Append(elem interface{}) ISlice {")
+	}
+
+	// conversion of other types and conversions provided elsewhere
+	{
+		src, _, err := Generate(Config{Type: "string", Package: "n", Slice: "StringSlice", Convert: "ToString", NoTo: true})
+		assert.Nil(t, err)
+		assert.Contains(t, string(src), "return ToString(obj), true")
+		assert.NotContains(t, string(src), "func ToStringSlice(")
+		assert.NotContains(t, string(src), "func ToStringSliceE(")
+
+		src, _, err = Generate(Config{Type: "Point", Package: "foo"})
+		assert.Nil(t, err)
+		assert.Contains(t, string(src), "func ToPointSliceE(")
+		assert.NotContains(t, string(src), "default:\n\t\treturn")
+	}
+
+	// deep equality for types that don't support ==
+	{
+		src, _, err := Generate(Config{Type: "Tags", Package: "foo", Deep: true})
+		assert.Nil(t, err)
+		assert.Contains(t, string(src), "reflect.DeepEqual(a, b)")
+		assert.NotContains(t, string(src), "map[Tags]bool")
+	}
+}
+
+func TestStringSlice(t *testing.T) {
+	src, test, err := Generate(Config{Type: "string", Package: "n", Slice: "StringSlice", Convert: "ToString", NoTo: true})
+	assert.Nil(t, err)
+	assert.Nil(t, test)
+
+	// regenerate with go generate in the repo root when the templates change
+	data, err := os.ReadFile("../../slice_string.go")
+	assert.Nil(t, err)
+	assert.Equal(t, string(data), string(src))
+}
+
+func TestExample(t *testing.T) {
+	src, test, err := Generate(Config{Type: "Point", Package: "example",
+		Values: []string{"Point{1, 2}", "Point{2, 1}", "Point{3, 0}"}})
+	assert.Nil(t, err)
+
+	// regenerate with go generate ./cmd/nubgen/example when the templates change
+	data, err := os.ReadFile("example/point_slice.go")
+	assert.Nil(t, err)
+	assert.Equal(t, string(data), string(src))
+	data, err = os.ReadFile("example/point_slice_test.go")
+	assert.Nil(t, err)
+	assert.Equal(t, string(data), string(test))
+}
+
+func TestName(t *testing.T) {
+	assert.Equal(t, "PointSlice", Name(Config{Type: "Point"}))
+	assert.Equal(t, "PointSlice", Name(Config{Type: "*geo.Point"}))
+	assert.Equal(t, "DurationSlice", Name(Config{Type: "time.Duration"}))
+	assert.Equal(t, "Uint8Slice", Name(Config{Type: "uint8"}))
+	assert.Equal(t, "Points", Name(Config{Type: "Point", Slice: "Points"}))
+}
+
+func TestSnakeCase(t *testing.T) {
+	assert.Equal(t, "point_slice", SnakeCase("PointSlice"))
+	assert.Equal(t, "http_server_slice", SnakeCase("HTTPServerSlice"))
+	assert.Equal(t, "uint8_slice", SnakeCase("Uint8Slice"))
+}
+
+// parseMethods returns the names of the methods declared on the given type in the given source
+func parseMethods(t *testing.T, src []byte, typ string) (methods map[string]bool) {
+	methods = map[string]bool{}
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	assert.Nil(t, err)
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil {
+			if star, ok := fn.Recv.List[0].Type.(*ast.StarExpr); ok && star.X.(*ast.Ident).Name == typ {
+				methods[fn.Name.Name] = true
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,103 @@
+// Nubgen generates reflection free Slice implementations for user defined types.
+//
+// Slices of custom types otherwise fall back on the reflection based RefSlice. Nubgen emits an
+// optimized FooSlice for the given type implementing the full ISlice interface, templated from the
+// library's hand written StringSlice, and optionally tests for it. Passing -package n generates
+// the Slice unqualified for use within the n package itself.
+//
+// The templates are the single source of truth for the library's own StringSlice as well which
+// is generated into slice_string.go by go generate with its string specific extras e.g. Grep and
+// Closest hand written in slice_string_ext.go. TestStringSlice and TestExample check the committed
+// files are still what the templates generate.
+//
+// Usage:
+//
+//	//go:generate go run github.com/phR0ze/n/cmd/nubgen -type Point -value "Point{1, 2}" -value "Point{2, 1}" -value "Point{3, 0}"
+//
+// Flags:
+//
+//	-type     element type to generate the Slice for e.g. Point or time.Duration (required)
+//	-name     name of the generated Slice type (default: type name + Slice e.g. PointSlice)
+//	-package  package of the generated files (default: $GOPACKAGE set by go generate)
+//	-output   generated file name (default: snake case of the name e.g. point_slice.go)
+//	-deep     compare elements with reflect.DeepEqual for types that don't support ==
+//	-convert  function converting values of other types into an element e.g. ToString
+//	-noto     don't generate To<Slice> and To<Slice>E as they are provided elsewhere
+//	-value    element expression used by the generated tests, given three times in ascending
+//	          order. Tests are only generated when values are given.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// values collects the repeated -value flags
+type values []string
+
+func (p *values) String() string {
+	return strings.Join(*p, ", ")
+}
+
+func (p *values) Set(value string) error {
+	*p = append(*p, value)
+	return nil
+}
+
+func main() {
+	var vals values
+	config := Config{}
+	flag.StringVar(&config.Type, "type", "", "element type to generate the Slice for e.g. Point")
+	flag.StringVar(&config.Slice, "name", "", "name of the generated Slice type (default: type name + Slice)")
+	flag.StringVar(&config.Package, "package", os.Getenv("GOPACKAGE"), "package of the generated files")
+	output := flag.String("output", "", "generated file name (default: snake case of the name)")
+	flag.BoolVar(&config.Deep, "deep", false, "compare elements with reflect.DeepEqual instead of ==")
+	flag.StringVar(&config.Convert, "convert", "", "function converting values of other types into an element e.g. ToString")
+	flag.BoolVar(&config.NoTo, "noto", false, "don't generate To<Slice> and To<Slice>E as they are provided elsewhere")
+	flag.Var(&vals, "value", "element expression for the generated tests, given three times in ascending order")
+	flag.Parse()
+	config.Values = vals
+
+	if err := run(config, *output); err != nil {
+		fmt.Fprintf(os.Stderr, "nubgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run generates the Slice and optionally its tests writing them to the given output file
+func run(config Config, output string) (err error) {
+	var src, test []byte
+	if src, test, err = Generate(config); err != nil {
+		return
+	}
+	if output == "" {
+		output = SnakeCase(Name(config)) + ".go"
+	}
+	if err = os.WriteFile(output, src, 0644); err != nil {
+		return
+	}
+	if test != nil {
+		err = os.WriteFile(strings.TrimSuffix(output, filepath.Ext(output))+"_test.go", test, 0644)
+	}
+	return
+}
+
+// SnakeCase converts the given CamelCase name to snake_case e.g. PointSlice to point_slice
+func SnakeCase(name string) string {
+	var builder strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				builder.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
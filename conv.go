@@ -9,6 +9,7 @@ import (
 	"time"
 	"unicode/utf8"
 
+	ntime "github.com/phR0ze/n/pkg/time"
 	yaml "github.com/phR0ze/yaml/v2"
 	"github.com/pkg/errors"
 )
@@ -1876,6 +1877,12 @@ func ToBoolE(obj interface{}) (val bool, err error) {
 	case uint64:
 		val = x != 0
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToBoolE(v)
+			}
+			return
+		}
 		err = errors.Errorf("unable to convert type %T to bool", x)
 	}
 	return
@@ -1887,7 +1894,9 @@ func ToDuration(obj interface{}) (val time.Duration) {
 	return x
 }
 
-// ToDurationE converts an interface to a time.Duration type.
+// ToDurationE converts an interface to a time.Duration type. Base 10 integer strings are treated
+// as nanoseconds like ints while other strings are parsed with ntime.ParseDuration supporting Go
+// durations e.g. "1h30m", extended units e.g. "1d", "2w", "3 days" and ISO 8601 e.g. "P1DT2H".
 func ToDurationE(obj interface{}) (val time.Duration, err error) {
 	o := DeReference(obj)
 
@@ -1895,11 +1904,22 @@ func ToDurationE(obj interface{}) (val time.Duration, err error) {
 	case nil:
 	case time.Duration:
 		val = x
+	case string:
+		if v, e := strconv.ParseInt(x, 10, 64); e == nil {
+			return time.Duration(v), nil
+		}
+		val, err = ntime.ParseDuration(x)
 	case int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8:
 		val = time.Duration(ToInt64(x))
 	case float32, float64:
 		val = time.Duration(ToFloat64(x))
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToDurationE(v)
+			}
+			return
+		}
 		err = errors.Errorf("failed to convert type %T to time.Duration", obj)
 	}
 	return
@@ -2059,6 +2079,13 @@ func ToChar(obj interface{}) *Char {
 		if x != nil {
 			val = Char(([]rune(strconv.FormatInt(int64(*x), 10)))[0])
 		}
+
+	// registered converters
+	//----------------------------------------------------------------------------------------------
+	default:
+		if v, ok, e := convert(x, val); ok && e == nil {
+			return ToChar(v)
+		}
 	}
 	return &val
 }
@@ -2125,6 +2152,12 @@ func ToFloat32E(obj interface{}) (val float32, err error) {
 			val = float32(v)
 		}
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToFloat32E(v)
+			}
+			return
+		}
 		err = errors.Errorf("unable to convert type %T to int", x)
 	}
 	return
@@ -2189,6 +2222,12 @@ func ToFloat64E(obj interface{}) (val float64, err error) {
 			}
 		}
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToFloat64E(v)
+			}
+			return
+		}
 		err = errors.Errorf("unable to convert type %T to int", x)
 	}
 	return
@@ -2541,6 +2580,12 @@ func ToFloatSliceE(obj interface{}) (val *FloatSlice, err error) {
 	// fall back on reflection
 	//----------------------------------------------------------------------------------------------
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToFloatSliceE(v)
+			}
+			return
+		}
 		v := reflect.ValueOf(x)
 		k := v.Kind()
 
@@ -2628,6 +2673,12 @@ func ToIntE(obj interface{}) (val int, err error) {
 			val = int(v)
 		}
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToIntE(v)
+			}
+			return
+		}
 		err = errors.Errorf("unable to convert type %T to int", x)
 	}
 	return
@@ -2695,6 +2746,12 @@ func ToInt8E(obj interface{}) (val int8, err error) {
 			val = int8(v)
 		}
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToInt8E(v)
+			}
+			return
+		}
 		err = errors.Errorf("unable to convert type %T to int8", x)
 	}
 	return
@@ -2762,6 +2819,12 @@ func ToInt16E(obj interface{}) (val int16, err error) {
 			val = int16(v)
 		}
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToInt16E(v)
+			}
+			return
+		}
 		err = errors.Errorf("unable to convert type %T to int16", x)
 	}
 	return
@@ -2829,6 +2892,12 @@ func ToInt32E(obj interface{}) (val int32, err error) {
 			val = int32(v)
 		}
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToInt32E(v)
+			}
+			return
+		}
 		err = errors.Errorf("unable to convert type %T to int32", x)
 	}
 	return
@@ -2896,6 +2965,12 @@ func ToInt64E(obj interface{}) (val int64, err error) {
 			val = int64(v)
 		}
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToInt64E(v)
+			}
+			return
+		}
 		err = errors.Errorf("unable to convert type %T to int64", x)
 	}
 	return
@@ -3260,6 +3335,12 @@ func ToIntSliceE(obj interface{}) (val *IntSlice, err error) {
 	// fall back on reflection
 	//----------------------------------------------------------------------------------------------
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToIntSliceE(v)
+			}
+			return
+		}
 		v := reflect.ValueOf(x)
 		k := v.Kind()
 
@@ -3611,6 +3692,8 @@ func ToInterSlice(obj interface{}) (slice *InterSlice) {
 	//----------------------------------------------------------------------------------------------
 	case InterSlice:
 		slice = &x
+	case *PersistentVector:
+		slice = NewInterSlice(x.ToInterSlice())
 	case *InterSlice:
 		if x != nil {
 			slice = x
@@ -4106,6 +4189,12 @@ func ToSliceOfMapE(obj interface{}) (val *SliceOfMap, err error) {
 			}
 		}
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToSliceOfMapE(v)
+			}
+			return
+		}
 		var m *StringMap
 		if m, err = ToStringMapE(x); err != nil {
 			err = errors.Errorf("failed to convert type %T to a SliceOfMap", x)
@@ -4574,6 +4663,9 @@ func ToStr(obj interface{}) *Str {
 	// fall back on reflection
 	//----------------------------------------------------------------------------------------------
 	default:
+		if v, ok, e := convertRegistered(x, val); ok && e == nil {
+			return ToStr(v)
+		}
 		v := reflect.ValueOf(x)
 		k := v.Kind()
 
@@ -4588,6 +4680,9 @@ func ToStr(obj interface{}) *Str {
 		default:
 			if y, ok := x.(fmt.Stringer); ok && y != nil {
 				val = Str(y.String())
+			} else if y, ok := textMarshaler(x); ok {
+				text, _ := y.MarshalText()
+				val = Str(string(text))
 			} else {
 				val = Str(fmt.Sprintf("%v", x))
 			}
@@ -4761,10 +4856,18 @@ func ToStringMapE(obj interface{}) (val *StringMap, err error) {
 		if x != nil {
 			val = x
 		}
+	case *PersistentMap:
+		val = x.ToStringMap()
 
 	// fall back on reflection
 	//----------------------------------------------------------------------------------------------
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToStringMapE(v)
+			}
+			return
+		}
 		v := reflect.ValueOf(DeReference(obj))
 		k := v.Kind()
 
@@ -5133,6 +5236,12 @@ func ToStringSliceE(obj interface{}) (val *StringSlice, err error) {
 	// fall back on reflection
 	//----------------------------------------------------------------------------------------------
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToStringSliceE(v)
+			}
+			return
+		}
 		v := reflect.ValueOf(x)
 		k := v.Kind()
 
@@ -5431,6 +5540,12 @@ func ToStrsE(obj interface{}) (val []string, err error) {
 	// fall back on reflection
 	//----------------------------------------------------------------------------------------------
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToStrsE(v)
+			}
+			return
+		}
 		v := reflect.ValueOf(x)
 		k := v.Kind()
 
@@ -5456,7 +5571,11 @@ func ToTime(obj interface{}) time.Time {
 	return x
 }
 
-// ToTimeE converts an interface to a time.Time type.
+// ToTimeE converts an interface to a time.Time type. Integers are treated as a Unix epoch in
+// seconds, milliseconds, microseconds or nanoseconds based on their magnitude. Strings are
+// parsed with TimeLayouts then with ntime.ParseAt supporting the layouts registered with
+// ntime.AddLayouts, a trailing zone e.g. "PST" or "Europe/Berlin" registered with ntime.AddZone
+// and relative expressions e.g. "yesterday", "3 days ago", "next monday 9am".
 func ToTimeE(obj interface{}) (val time.Time, err error) {
 	o := DeReference(obj)
 
@@ -5484,26 +5603,40 @@ func ToTimeE(obj interface{}) (val time.Time, err error) {
 				return val, nil
 			}
 		}
-		err = errors.Errorf("failed to parse time %s", x)
+
+		// Parse human friendly formats relative to now
+		now := time.Now()
+		if !gUseLocalTime {
+			now = now.UTC()
+		}
+		if val, err = ntime.ParseAt(x, now); err != nil {
+			err = errors.Errorf("failed to parse time %s", x)
+		}
 
 	// int
 	//----------------------------------------------------------------------------------------------
 	case int:
-		val = time.Unix(int64(x), 0)
+		val = ntime.FromEpoch(int64(x))
 	case int32:
-		val = time.Unix(int64(x), 0)
+		val = ntime.FromEpoch(int64(x))
 	case int64:
-		val = time.Unix(x, 0)
+		val = ntime.FromEpoch(x)
 
 	// uint
 	//----------------------------------------------------------------------------------------------
 	case uint:
-		val = time.Unix(int64(x), 0)
+		val = ntime.FromEpoch(int64(x))
 	case uint32:
-		val = time.Unix(int64(x), 0)
+		val = ntime.FromEpoch(int64(x))
 	case uint64:
-		val = time.Unix(int64(x), 0)
+		val = ntime.FromEpoch(int64(x))
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToTimeE(v)
+			}
+			return
+		}
 		err = errors.Errorf("failed to convert type %T to time.Time", obj)
 	}
 
@@ -5577,6 +5710,12 @@ func ToUintE(obj interface{}) (val uint, err error) {
 			val = uint(v)
 		}
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToUintE(v)
+			}
+			return
+		}
 		err = errors.Errorf("unable to convert type %T to uint", x)
 	}
 	return
@@ -5644,6 +5783,12 @@ func ToUint8E(obj interface{}) (val uint8, err error) {
 			val = uint8(v)
 		}
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToUint8E(v)
+			}
+			return
+		}
 		err = errors.Errorf("unable to convert type %T to uint8", x)
 	}
 	return
@@ -5711,6 +5856,12 @@ func ToUint16E(obj interface{}) (val uint16, err error) {
 			val = uint16(v)
 		}
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToUint16E(v)
+			}
+			return
+		}
 		err = errors.Errorf("unable to convert type %T to uint16", x)
 	}
 	return
@@ -5778,6 +5929,12 @@ func ToUint32E(obj interface{}) (val uint32, err error) {
 			val = uint32(v)
 		}
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToUint32E(v)
+			}
+			return
+		}
 		err = errors.Errorf("unable to convert type %T to uint32", x)
 	}
 	return
@@ -5845,6 +6002,12 @@ func ToUint64E(obj interface{}) (val uint64, err error) {
 			val = v
 		}
 	default:
+		if v, ok, e := convert(x, val); ok {
+			if err = e; err == nil {
+				val, err = ToUint64E(v)
+			}
+			return
+		}
 		err = errors.Errorf("unable to convert type %T to uint64", x)
 	}
 	return
@@ -1551,6 +1551,73 @@ func TestToChar(t *testing.T) {
 	}
 }
 
+// ToDurationE
+// --------------------------------------------------------------------------------------------------
+func ExampleToDuration() {
+	fmt.Println(ToDuration("1d12h"))
+	// Output: 36h0m0s
+}
+
+func TestToDurationE(t *testing.T) {
+
+	// durations and numbers
+	{
+		val, err := ToDurationE(time.Minute)
+		assert.Nil(t, err)
+		assert.Equal(t, time.Minute, val)
+
+		val, err = ToDurationE(int64(5))
+		assert.Nil(t, err)
+		assert.Equal(t, time.Duration(5), val)
+
+		val, err = ToDurationE("5")
+		assert.Nil(t, err)
+		assert.Equal(t, time.Duration(5), val)
+
+		// integer strings are always base 10
+		val, err = ToDurationE("010")
+		assert.Nil(t, err)
+		assert.Equal(t, time.Duration(10), val)
+
+		_, err = ToDurationE("0x10")
+		assert.NotNil(t, err)
+	}
+
+	// strings
+	{
+		val, err := ToDurationE("1h30m")
+		assert.Nil(t, err)
+		assert.Equal(t, 90*time.Minute, val)
+
+		val, err = ToDurationE("1d")
+		assert.Nil(t, err)
+		assert.Equal(t, 24*time.Hour, val)
+
+		val, err = ToDurationE("2 weeks")
+		assert.Nil(t, err)
+		assert.Equal(t, 14*24*time.Hour, val)
+
+		val, err = ToDurationE("P1DT2H")
+		assert.Nil(t, err)
+		assert.Equal(t, 26*time.Hour, val)
+
+		test := "3 days"
+		val, err = ToDurationE(&test)
+		assert.Nil(t, err)
+		assert.Equal(t, 72*time.Hour, val)
+	}
+
+	// invalid
+	{
+		val, err := ToDurationE("foo")
+		assert.Equal(t, "failed to parse duration foo", err.Error())
+		assert.Equal(t, time.Duration(0), val)
+
+		_, err = ToDurationE(true)
+		assert.Equal(t, "failed to convert type bool to time.Duration", err.Error())
+	}
+}
+
 // ToFloat32
 // --------------------------------------------------------------------------------------------------
 func ExampleToFloat32() {
@@ -4829,6 +4896,40 @@ func TestTime(t *testing.T) {
 		assert.Equal(t, time.Date(2019, 9, 18, 11, 58, 56, 0, time.UTC), ToTime(uint64(1568807936)))
 	}
 
+	// epochs in milliseconds, microseconds and nanoseconds
+	{
+		assert.Equal(t, time.Date(2019, 9, 18, 11, 58, 56, 0, time.UTC), ToTime(int64(1568807936000)))
+		assert.Equal(t, time.Date(2019, 9, 18, 11, 58, 56, 0, time.UTC), ToTime(uint64(1568807936000000)))
+		assert.Equal(t, time.Date(2019, 9, 18, 11, 58, 56, 0, time.UTC), ToTime("1568807936000000000"))
+	}
+
+	// registered layouts and zones
+	{
+		assert.Equal(t, time.Date(2008, 1, 10, 9, 30, 0, 0, time.UTC), ToTime("2008-01-10 09:30"))
+		assert.Equal(t, time.Date(2008, 1, 10, 8, 30, 0, 0, time.UTC), ToTime("2008-01-10T09:30 Europe/Berlin"))
+		assert.Equal(t, time.Date(2008, 1, 10, 17, 30, 0, 0, time.UTC), ToTime("2008-01-10 09:30 PST"))
+	}
+
+	// relative expressions
+	{
+		now := time.Now().UTC()
+		val, err := ToTimeE("yesterday")
+		assert.Nil(t, err)
+		assert.Equal(t, time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1), val)
+
+		val, err = ToTimeE("3 days ago")
+		assert.Nil(t, err)
+		assert.WithinDuration(t, now.AddDate(0, 0, -3), val, time.Minute)
+		assert.Equal(t, time.UTC, val.Location())
+	}
+
+	// invalid
+	{
+		val, err := ToTimeE("foo")
+		assert.Equal(t, "failed to parse time foo", err.Error())
+		assert.Equal(t, time.Time{}, val)
+	}
+
 	// time pointer
 	{
 		result := time.Date(2008, 01, 10, 0, 0, 0, 0, time.UTC)
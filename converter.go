@@ -0,0 +1,441 @@
+package n
+
+import (
+	"encoding"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Converter converts the given value of the type it was registered for into the type it was
+// registered to convert to.
+type Converter func(obj interface{}) (interface{}, error)
+
+// converter is a registered conversion between two types
+type converter struct {
+	from reflect.Type
+	to   reflect.Type
+	conv Converter
+}
+
+var (
+	convertersLock sync.RWMutex
+	converters     []*converter // in registration order
+)
+
+// RegisterConverter registers the given converter to convert values of the same type as from
+// into values of the same type as to. Registered converters teach the ToXxxE conversion
+// functions, Object's conversion methods, Convert and Slice about application types that they
+// don't otherwise handle e.g. UUIDs, money or enums:
+//
+//	n.RegisterConverter(Color(0), "", func(obj interface{}) (interface{}, error) {
+//		return obj.(Color).String(), nil
+//	})
+//	n.RegisterConverter("", Color(0), func(obj interface{}) (interface{}, error) {
+//		return ParseColor(obj.(string))
+//	})
+//
+// Converting a value of the registered type to a type that has no converter registered for it
+// falls back on the first converter registered for the value's type with the result being
+// converted further e.g. the Color to string converter above is enough for ToStr, ToStrsE and
+// ToStringSliceE. Registering a converter for the same types again replaces it. Types not
+// registered that implement encoding.TextMarshaler or encoding.TextUnmarshaler are converted
+// via their text representation.
+func RegisterConverter(from, to interface{}, conv Converter) {
+	fromType, toType := reflect.TypeOf(from), reflect.TypeOf(to)
+	convertersLock.Lock()
+	defer convertersLock.Unlock()
+	for _, x := range converters {
+		if x.from == fromType && x.to == toType {
+			x.conv = conv
+			return
+		}
+	}
+	converters = append(converters, &converter{from: fromType, to: toType, conv: conv})
+}
+
+// UnregisterConverter removes the converter registered to convert values of the same type as
+// from into values of the same type as to.
+func UnregisterConverter(from, to interface{}) {
+	fromType, toType := reflect.TypeOf(from), reflect.TypeOf(to)
+	convertersLock.Lock()
+	defer convertersLock.Unlock()
+	for i, x := range converters {
+		if x.from == fromType && x.to == toType {
+			converters = append(converters[:i], converters[i+1:]...)
+			return
+		}
+	}
+}
+
+// Convert converts the given obj into the value pointed to by target using a registered
+// converter, the target's encoding.TextUnmarshaler implementation or the ToXxxE function for
+// the target's type in that order.
+//
+//	var color Color
+//	err := n.Convert("red", &color)
+func Convert(obj, target interface{}) (err error) {
+	v := reflect.ValueOf(target)
+	if !v.IsValid() || v.Kind() != reflect.Ptr || v.IsNil() {
+		return errors.Errorf("failed to convert type %T, target %T is not a non nil pointer", obj, target)
+	}
+	elem := v.Elem()
+
+	// Registered converters to the target type
+	if val, ok, e := convertTo(obj, elem.Type()); ok {
+		if e != nil {
+			return e
+		}
+		elem.Set(reflect.ValueOf(val))
+		return
+	}
+
+	// Types that can unmarshal themselves from text unless handled natively e.g. time.Time
+	if _, ok := convertible(elem.Type()); !ok {
+		if x, ok := target.(encoding.TextUnmarshaler); ok {
+			if err = x.UnmarshalText([]byte(ToString(obj))); err != nil {
+				err = errors.Wrapf(err, "failed to convert type %T to %v", obj, elem.Type())
+			}
+			return
+		}
+	}
+
+	return convertNative(obj, target)
+}
+
+// convertNative converts the given obj into the target using the ToXxxE function for the
+// target's type falling back on reflection for types that are directly convertible.
+func convertNative(obj, target interface{}) (err error) {
+	switch x := target.(type) {
+	case *interface{}:
+		*x = obj
+	case *bool:
+		*x, err = ToBoolE(obj)
+	case *float32:
+		*x, err = ToFloat32E(obj)
+	case *float64:
+		*x, err = ToFloat64E(obj)
+	case *int:
+		*x, err = ToIntE(obj)
+	case *int8:
+		*x, err = ToInt8E(obj)
+	case *int16:
+		*x, err = ToInt16E(obj)
+	case *int32:
+		*x, err = ToInt32E(obj)
+	case *int64:
+		*x, err = ToInt64E(obj)
+	case *string:
+		*x = ToString(obj)
+	case *[]string:
+		*x, err = ToStrsE(obj)
+	case *Str:
+		*x = *ToStr(obj)
+	case *time.Duration:
+		*x, err = ToDurationE(obj)
+	case *time.Time:
+		*x, err = ToTimeE(obj)
+	case *uint:
+		*x, err = ToUintE(obj)
+	case *uint8:
+		*x, err = ToUint8E(obj)
+	case *uint16:
+		*x, err = ToUint16E(obj)
+	case *uint32:
+		*x, err = ToUint32E(obj)
+	case *uint64:
+		*x, err = ToUint64E(obj)
+	case *FloatSlice:
+		var val *FloatSlice
+		if val, err = ToFloatSliceE(obj); err == nil {
+			*x = *val
+		}
+	case *IntSlice:
+		var val *IntSlice
+		if val, err = ToIntSliceE(obj); err == nil {
+			*x = *val
+		}
+	case *SliceOfMap:
+		var val *SliceOfMap
+		if val, err = ToSliceOfMapE(obj); err == nil {
+			*x = *val
+		}
+	case *StringMap:
+		var val *StringMap
+		if val, err = ToStringMapE(obj); err == nil {
+			*x = *val
+		}
+	case *StringSlice:
+		var val *StringSlice
+		if val, err = ToStringSliceE(obj); err == nil {
+			*x = *val
+		}
+	default:
+		elem := reflect.ValueOf(target).Elem()
+		v := reflect.ValueOf(obj)
+		switch {
+		case v.IsValid() && v.Type().AssignableTo(elem.Type()):
+			elem.Set(v)
+		case v.IsValid() && v.Kind() == elem.Kind() && v.Type().ConvertibleTo(elem.Type()):
+			elem.Set(v.Convert(elem.Type()))
+		default:
+			err = errors.Errorf("failed to convert type %T to %v", obj, elem.Type())
+		}
+	}
+	return
+}
+
+// convertible returns a pointer to a new value of the given type if the type can be converted
+// to by convertNative without reflection.
+func convertible(typ reflect.Type) (ptr interface{}, ok bool) {
+	ptr = reflect.New(typ).Interface()
+	switch ptr.(type) {
+	case *bool, *float32, *float64, *int, *int8, *int16, *int32, *int64, *string, *[]string, *Str,
+		*time.Duration, *time.Time, *uint, *uint8, *uint16, *uint32, *uint64,
+		*FloatSlice, *IntSlice, *SliceOfMap, *StringMap, *StringSlice:
+		return ptr, true
+	}
+	return nil, false
+}
+
+// convertTo converts the given obj to the given type using a converter registered for that
+// type. Converters registered for the obj's type are preferred otherwise the obj is first
+// converted to the type the converter expects if that can be done natively. ok is false if no
+// converter applies.
+func convertTo(obj interface{}, to reflect.Type) (val interface{}, ok bool, err error) {
+	var from reflect.Type
+	if obj != nil {
+		from = reflect.TypeOf(obj)
+	}
+
+	convertersLock.RLock()
+	var exact, indirect *converter
+	for _, x := range converters {
+		if x.to != to {
+			continue
+		}
+		if x.from == from {
+			exact = x
+			break
+		}
+		if indirect == nil {
+			if _, y := convertible(x.from); y {
+				indirect = x
+			}
+		}
+	}
+	convertersLock.RUnlock()
+
+	switch {
+	case exact != nil:
+		val, err = exact.conv(obj)
+	case indirect != nil:
+		ptr, _ := convertible(indirect.from)
+		if err = convertNative(obj, ptr); err == nil {
+			val, err = indirect.conv(reflect.ValueOf(ptr).Elem().Interface())
+		}
+	default:
+		return
+	}
+	ok = true
+	if err == nil && reflect.TypeOf(val) != to {
+		err = errors.Errorf("converter for type %T returned type %T rather than %v", obj, val, to)
+	}
+	if err != nil {
+		val = nil
+		err = errors.WithMessagef(err, "failed to convert type %T to %v", obj, to)
+	}
+	return
+}
+
+// convertRegistered converts the given obj, or the value it points to, using a converter
+// registered for its type. The converter registered for the given target's type is preferred
+// otherwise the first converter registered for the obj's type is used leaving the result to
+// be converted further by the caller. ok is false if no converter is registered for the type.
+func convertRegistered(obj, target interface{}) (val interface{}, ok bool, err error) {
+	if obj == nil {
+		return
+	}
+
+	// Use the converter for the value being pointed to if the pointer has none
+	var conv *converter
+	to := reflect.TypeOf(target)
+	for v := reflect.ValueOf(obj); ; v = v.Elem() {
+		if conv = lookupConverter(v.Type(), to); conv != nil {
+			obj = v.Interface()
+			break
+		}
+		if v.Kind() != reflect.Ptr || v.IsNil() {
+			return
+		}
+	}
+
+	ok = true
+	if val, err = conv.conv(obj); err != nil {
+		err = errors.WithMessagef(err, "failed to convert type %T to %v", obj, to)
+	} else if reflect.TypeOf(val) == conv.from {
+		err = errors.Errorf("failed to convert type %T to %v, converter returned the same type", obj, to)
+	}
+	return
+}
+
+// lookupConverter returns the converter registered to convert the from type to the to type
+// falling back on the first converter registered for the from type that converts to the same
+// class of type e.g. int for int64 or any type if there is none. Only converters to types
+// handled natively or with no converters of their own are considered as fallbacks to avoid
+// conversion cycles.
+func lookupConverter(from, to reflect.Type) (conv *converter) {
+	convertersLock.RLock()
+	defer convertersLock.RUnlock()
+	var similar *converter
+	for _, x := range converters {
+		if x.from != from {
+			continue
+		}
+		if x.to == to {
+			return x
+		}
+		if _, native := convertible(x.to); native || !registered(x.to) {
+			if conv == nil {
+				conv = x
+			}
+			if similar == nil && to != nil && kindClass(x.to) == kindClass(to) {
+				similar = x
+			}
+		}
+	}
+	if similar != nil {
+		conv = similar
+	}
+	return
+}
+
+// kindClass groups types into booleans, integers, floats, strings and everything else
+func kindClass(typ reflect.Type) int {
+	switch k := typ.Kind(); {
+	case k == reflect.Bool:
+		return 1
+	case k >= reflect.Int && k <= reflect.Uintptr:
+		return 2
+	case k == reflect.Float32 || k == reflect.Float64:
+		return 3
+	case k == reflect.String || typ == reflect.TypeOf(Str{}):
+		return 4
+	}
+	return 0
+}
+
+// registered tests if any converters are registered for the given type. The caller must hold
+// the converters lock.
+func registered(typ reflect.Type) bool {
+	for _, x := range converters {
+		if x.from == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// convert the given obj for the ToXxxE functions using a registered converter falling back on
+// the encoding.TextMarshaler implementation of the obj or the value it points to for types not
+// already handled natively. The result is to be converted further by the caller as it may not
+// be of the target's type. ok is false if the obj can't be converted this way.
+func convert(obj, target interface{}) (val interface{}, ok bool, err error) {
+	if val, ok, err = convertRegistered(obj, target); ok {
+		return
+	}
+	if x, y := textMarshaler(obj); y && !native(obj) {
+		var text []byte
+		if text, err = x.MarshalText(); err != nil {
+			err = errors.Wrapf(err, "failed to marshal type %T to text", obj)
+		}
+		return string(text), true, err
+	}
+	return
+}
+
+// native tests if the given obj, or the value it points to, is of a type the ToXxxE functions
+// handle natively.
+func native(obj interface{}) bool {
+	typ := reflect.TypeOf(obj)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	_, ok := convertible(typ)
+	return ok
+}
+
+// textMarshaler returns the encoding.TextMarshaler implementation of the given obj or the
+// value it points to if it has one.
+func textMarshaler(obj interface{}) (x encoding.TextMarshaler, ok bool) {
+	if x, ok = obj.(encoding.TextMarshaler); ok {
+		if v := reflect.ValueOf(obj); v.Kind() != reflect.Ptr || !v.IsNil() {
+			return
+		}
+		return nil, false
+	}
+	if v := reflect.ValueOf(obj); v.Kind() == reflect.Ptr && !v.IsNil() {
+		x, ok = v.Elem().Interface().(encoding.TextMarshaler)
+	}
+	return
+}
+
+// convertSlice creates a new Slice for the given obj based on the type the converter registered
+// for the elem's type converts to or a StringSlice if the elem's type implements
+// encoding.TextMarshaler. The elem is the obj itself or an element of the obj used to determine
+// the type with slices of types that don't convert themselves using their element type. The
+// result is nil if neither applies.
+func convertSlice(obj, elem interface{}) (slice ISlice) {
+	v := reflect.ValueOf(elem)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return
+	}
+
+	// Use the element type for slices of types that don't convert themselves
+	typ := v.Type()
+	if k := typ.Kind(); (k == reflect.Slice || k == reflect.Array) && !convertsItself(typ) {
+		if typ = typ.Elem(); typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+	}
+
+	var to reflect.Type
+	if conv := lookupConverter(typ, nil); conv != nil {
+		to = conv.to
+	} else if conv = lookupConverter(reflect.PtrTo(typ), nil); conv != nil {
+		to = conv.to
+	} else if convertsItself(typ) {
+		to = reflect.TypeOf("")
+	} else {
+		return
+	}
+
+	switch k := to.Kind(); {
+	case to == reflect.TypeOf(Str{}) || k == reflect.String:
+		slice = ToStringSlice(obj)
+	case k >= reflect.Int && k <= reflect.Uint64:
+		slice = ToIntSlice(obj)
+	case k == reflect.Float32 || k == reflect.Float64:
+		slice = ToFloatSlice(obj)
+	case to == reflect.TypeOf(&StringMap{}) || k == reflect.Map:
+		slice = ToSliceOfMap(obj)
+	}
+	return
+}
+
+// convertsItself tests if the given type has a converter registered for it or implements
+// encoding.TextMarshaler and isn't already handled natively e.g. time.Time.
+func convertsItself(typ reflect.Type) bool {
+	marshaler := reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	if _, native := convertible(typ); !native && (typ.Implements(marshaler) || reflect.PtrTo(typ).Implements(marshaler)) {
+		return true
+	}
+	convertersLock.RLock()
+	defer convertersLock.RUnlock()
+	return registered(typ) || registered(reflect.PtrTo(typ))
+}
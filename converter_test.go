@@ -0,0 +1,292 @@
+package n
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// color is an enum with converters registered to and from string
+type color int
+
+const (
+	red color = iota + 1
+	green
+	blue
+)
+
+var colorNames = []string{"", "red", "green", "blue"}
+
+func (p color) String() string {
+	return fmt.Sprintf("color(%d)", int(p))
+}
+
+func colorToString(obj interface{}) (interface{}, error) {
+	return colorNames[obj.(color)], nil
+}
+
+func stringToColor(obj interface{}) (interface{}, error) {
+	for i := range colorNames {
+		if i > 0 && colorNames[i] == strings.ToLower(obj.(string)) {
+			return color(i), nil
+		}
+	}
+	return nil, errors.Errorf("invalid color %s", obj)
+}
+
+// id is an array type that would otherwise be converted element by element
+type id [4]byte
+
+func idToString(obj interface{}) (interface{}, error) {
+	x := obj.(id)
+	return hex.EncodeToString(x[:]), nil
+}
+
+// ip implements encoding.TextMarshaler and encoding.TextUnmarshaler
+type ip struct {
+	a, b, c, d byte
+}
+
+func (p ip) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%d.%d.%d.%d", p.a, p.b, p.c, p.d)), nil
+}
+
+func (p *ip) UnmarshalText(text []byte) error {
+	if _, err := fmt.Sscanf(string(text), "%d.%d.%d.%d", &p.a, &p.b, &p.c, &p.d); err != nil {
+		return errors.Errorf("invalid ip %s", text)
+	}
+	return nil
+}
+
+func registerTestConverters() func() {
+	RegisterConverter(color(0), "", colorToString)
+	RegisterConverter("", color(0), stringToColor)
+	RegisterConverter(id{}, "", idToString)
+	return func() {
+		UnregisterConverter(color(0), "")
+		UnregisterConverter("", color(0))
+		UnregisterConverter(id{}, "")
+	}
+}
+
+// RegisterConverter
+// --------------------------------------------------------------------------------------------------
+func ExampleRegisterConverter() {
+	type level int
+	RegisterConverter(level(0), "", func(obj interface{}) (interface{}, error) {
+		return []string{"debug", "info", "warn"}[obj.(level)], nil
+	})
+	defer UnregisterConverter(level(0), "")
+	fmt.Println(ToStrs([]level{0, 2}))
+	// Output: [debug warn]
+}
+
+func TestRegisterConverter(t *testing.T) {
+
+	// without converters
+	{
+		assert.Equal(t, "color(1)", ToString(red))
+		_, err := ToIntE(struct{}{})
+		assert.Equal(t, "unable to convert type struct {} to int", err.Error())
+		assert.Equal(t, &StringSlice{"\x01", "\x02", "\x03", "\x04"}, ToStringSlice(id{1, 2, 3, 4}))
+	}
+
+	defer registerTestConverters()()
+
+	// strings
+	{
+		assert.Equal(t, "red", ToString(red))
+		assert.Equal(t, "green", ToString(&([]color{green})[0]))
+		assert.Equal(t, &StringSlice{"red", "blue"}, ToStringSlice([]color{red, blue}))
+		assert.Equal(t, &StringSlice{"01020304"}, ToStringSlice(id{1, 2, 3, 4}))
+		assert.Equal(t, []string{"01020304", "0a0b0c0d"}, ToStrs([]id{{1, 2, 3, 4}, {10, 11, 12, 13}}))
+		assert.Equal(t, "01020304", ToString(&id{1, 2, 3, 4}))
+		assert.Equal(t, 'r', ToRune(red))
+	}
+
+	// results are converted further
+	{
+		val, err := ToIntE(red)
+		assert.Equal(t, `failed to convert string to int: strconv.ParseInt: parsing "red": invalid syntax`, err.Error())
+		assert.Equal(t, 0, val)
+
+		RegisterConverter(color(0), 0, func(obj interface{}) (interface{}, error) {
+			return int(obj.(color)), nil
+		})
+		defer UnregisterConverter(color(0), 0)
+		val, err = ToIntE(blue)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, val)
+		assert.Equal(t, int64(3), ToInt64(blue))
+		assert.Equal(t, uint8(2), ToUint8(green))
+		assert.Equal(t, &IntSlice{1, 3}, ToIntSlice([]color{red, blue}))
+		assert.Equal(t, "blue", ToString(blue))
+	}
+
+	// registering again replaces the converter
+	{
+		RegisterConverter(color(0), "", func(obj interface{}) (interface{}, error) {
+			return strings.ToUpper(colorNames[obj.(color)]), nil
+		})
+		assert.Equal(t, "RED", ToString(red))
+		RegisterConverter(color(0), "", colorToString)
+		assert.Equal(t, "red", ToString(red))
+	}
+
+	// Object methods
+	{
+		assert.Equal(t, "green", Obj(green).ToStr().A())
+		assert.Equal(t, 2, Obj(green).ToInt())
+	}
+
+	// converter errors
+	{
+		type bad int
+		RegisterConverter(bad(0), "", func(obj interface{}) (interface{}, error) {
+			return nil, errors.New("bad value")
+		})
+		defer UnregisterConverter(bad(0), "")
+		_, err := ToStringSliceE(bad(1))
+		assert.Equal(t, "failed to convert type n.bad to *n.StringSlice: bad value", err.Error())
+
+		RegisterConverter(bad(0), 0.0, func(obj interface{}) (interface{}, error) {
+			return obj, nil
+		})
+		defer UnregisterConverter(bad(0), 0.0)
+		_, err = ToFloat64E(bad(1))
+		assert.Equal(t, "failed to convert type n.bad to float64, converter returned the same type", err.Error())
+	}
+
+	// conversion cycles fall back on types without converters
+	{
+		type a int
+		type b int
+		RegisterConverter(a(0), b(0), func(obj interface{}) (interface{}, error) { return b(obj.(a)), nil })
+		RegisterConverter(b(0), a(0), func(obj interface{}) (interface{}, error) { return a(obj.(b)), nil })
+		defer UnregisterConverter(a(0), b(0))
+		defer UnregisterConverter(b(0), a(0))
+		_, err := ToBoolE(a(1))
+		assert.Equal(t, "unable to convert type n.a to bool", err.Error())
+	}
+}
+
+func TestRegisterConverter_TextMarshaler(t *testing.T) {
+	assert.Equal(t, "10.0.0.1", ToString(ip{10, 0, 0, 1}))
+	assert.Equal(t, "10.0.0.1", ToString(&ip{10, 0, 0, 1}))
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, ToStrs([]ip{{10, 0, 0, 1}, {10, 0, 0, 2}}))
+
+	val, err := ToStringSliceE(ip{10, 0, 0, 1})
+	assert.Nil(t, err)
+	assert.Equal(t, &StringSlice{"10.0.0.1"}, val)
+
+	// native types are not affected
+	{
+		_, err := ToIntE(time.Time{})
+		assert.Equal(t, "unable to convert type time.Time to int", err.Error())
+	}
+}
+
+func TestUnregisterConverter(t *testing.T) {
+	RegisterConverter(color(0), "", colorToString)
+	assert.Equal(t, "red", ToString(red))
+	UnregisterConverter(color(0), "")
+	assert.Equal(t, "color(1)", ToString(red))
+
+	// unknown converters are ignored
+	UnregisterConverter(color(0), "")
+}
+
+// Convert
+// --------------------------------------------------------------------------------------------------
+func ExampleConvert() {
+	var x ip
+	Convert("192.168.1.1", &x)
+	fmt.Println(x.a, x.d)
+	// Output: 192 1
+}
+
+func TestConvert(t *testing.T) {
+	defer registerTestConverters()()
+
+	// registered converters
+	{
+		var c color
+		assert.Nil(t, Convert("green", &c))
+		assert.Equal(t, green, c)
+
+		// source types are converted to the type the converter expects
+		assert.Nil(t, Convert(A("blue"), &c))
+		assert.Equal(t, blue, c)
+		assert.Nil(t, Convert(Obj("red"), &c))
+		assert.Equal(t, red, c)
+
+		err := Convert("purple", &c)
+		assert.Equal(t, "failed to convert type string to n.color: invalid color purple", err.Error())
+		assert.Equal(t, red, c)
+	}
+
+	// text unmarshaler
+	{
+		var x ip
+		assert.Nil(t, Convert("10.0.0.1", &x))
+		assert.Equal(t, ip{10, 0, 0, 1}, x)
+		assert.Nil(t, Convert(A("10.0.0.2"), &x))
+		assert.Equal(t, ip{10, 0, 0, 2}, x)
+
+		err := Convert("foo", &x)
+		assert.Equal(t, "failed to convert type string to n.ip: invalid ip foo", err.Error())
+	}
+
+	// native types
+	{
+		var i int
+		assert.Nil(t, Convert("42", &i))
+		assert.Equal(t, 42, i)
+
+		var s string
+		assert.Nil(t, Convert(blue, &s))
+		assert.Equal(t, "blue", s)
+
+		var ss StringSlice
+		assert.Nil(t, Convert([]color{red, green}, &ss))
+		assert.Equal(t, StringSlice{"red", "green"}, ss)
+
+		var tm time.Time
+		assert.Nil(t, Convert(int64(1568807936), &tm))
+		assert.Equal(t, time.Date(2019, 9, 18, 11, 58, 56, 0, time.UTC), tm)
+
+		var o interface{}
+		assert.Nil(t, Convert(red, &o))
+		assert.Equal(t, red, o)
+	}
+
+	// reflection
+	{
+		type celsius float64
+		var c celsius
+		assert.Nil(t, Convert(21.5, &c))
+		assert.Equal(t, celsius(21.5), c)
+
+		var x ip
+		assert.Nil(t, Convert(ip{1, 2, 3, 4}, &x))
+		assert.Equal(t, ip{1, 2, 3, 4}, x)
+
+		var ch chan int
+		err := Convert(1, &ch)
+		assert.Equal(t, "failed to convert type int to chan int", err.Error())
+	}
+
+	// invalid targets
+	{
+		var c color
+		err := Convert("red", c)
+		assert.Equal(t, "failed to convert type string, target n.color is not a non nil pointer", err.Error())
+		err = Convert("red", nil)
+		assert.Equal(t, "failed to convert type string, target <nil> is not a non nil pointer", err.Error())
+	}
+}
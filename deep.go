@@ -0,0 +1,492 @@
+package n
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/phR0ze/n/pkg/opt"
+	yaml "github.com/phR0ze/yaml/v2"
+)
+
+var (
+	objectType           = reflect.TypeOf((*Object)(nil))
+	refSliceType         = reflect.TypeOf((*RefSlice)(nil))
+	persistentMapType    = reflect.TypeOf((*PersistentMap)(nil))
+	persistentVectorType = reflect.TypeOf((*PersistentVector)(nil))
+	stringMapType        = reflect.TypeOf(StringMap{})
+	mapSliceType         = reflect.TypeOf(yaml.MapSlice{})
+)
+
+// DeepCopy returns a copy of the given obj recursively copying the pointers, maps, slices and
+// interfaces it references such that the copy shares no mutable state with the original. Cycles
+// are reproduced in the copy rather than followed indefinitely. Unexported struct fields are
+// copied shallowly.
+func DeepCopy(obj interface{}) interface{} {
+	if obj == nil {
+		return nil
+	}
+	c := &copier{copies: map[visit]reflect.Value{}}
+	return c.copy(reflect.ValueOf(obj)).Interface()
+}
+
+// DeepEqual tests if the given objects are structurally equal. Nub types are compared by their
+// underlying values such that for example a *StringMap equals a map[string]interface{} with the
+// same key-value pairs regardless of key order and an *IntSlice equals a []int with the same
+// elements. Cycles are handled by treating values already being compared as equal.
+//
+// Supported options: IgnoreOrderOpt, IgnorePathsOpt and NumericOpt
+func DeepEqual(a, b interface{}, opts ...*opt.Opt) bool {
+	e := &equaler{
+		ignoreOrder: getIgnoreOrderOpt(opts),
+		numeric:     getNumericOpt(opts),
+		comparing:   map[[2]visit]bool{},
+	}
+	for _, path := range getIgnorePathsOpt(opts) {
+		if keys, err := KeysFromSelector(path); err == nil {
+			e.ignore = append(e.ignore, keys.G())
+		}
+	}
+	return e.equal(reflect.ValueOf(a), reflect.ValueOf(b), nil)
+}
+
+// Hash returns a stable structural hash of the given obj that is equal for objects that are
+// DeepEqual without options making it useful as a map key or for change detection. Map key order
+// doesn't affect the hash.
+func Hash(obj interface{}) uint64 {
+	h := &hasher{hashing: map[visit]bool{}}
+	return h.hash(reflect.ValueOf(obj))
+}
+
+// visit identifies a pointer, map or slice value for cycle detection
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+// copier tracks the copies made so far to reproduce cycles and shared references
+type copier struct {
+	copies map[visit]reflect.Value
+}
+
+// copy returns a deep copy of the given value
+func (c *copier) copy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		key := visit{v.Pointer(), v.Type(), 0}
+		if x, ok := c.copies[key]; ok {
+			return x
+		}
+
+		// Object and RefSlice hide their values in unexported fields while persistent types are
+		// immutable and can be shared
+		switch v.Type() {
+		case persistentMapType, persistentVectorType:
+			return v
+		case objectType:
+			x := &Object{}
+			c.copies[key] = reflect.ValueOf(x)
+			if o := v.Interface().(*Object).o; o != nil {
+				x.o = c.copy(reflect.ValueOf(o)).Interface()
+			}
+			return c.copies[key]
+		case refSliceType:
+			x := NewRefSliceV()
+			c.copies[key] = reflect.ValueOf(x)
+			if o := v.Interface().(*RefSlice).O(); o != nil {
+				*x = *NewRefSlice(c.copy(reflect.ValueOf(o)).Interface())
+			}
+			return c.copies[key]
+		}
+
+		x := reflect.New(v.Type().Elem())
+		c.copies[key] = x
+		x.Elem().Set(c.copy(v.Elem()))
+		return x
+
+	case reflect.Interface:
+		x := reflect.New(v.Type()).Elem()
+		if !v.IsNil() {
+			x.Set(c.copy(v.Elem()))
+		}
+		return x
+
+	case reflect.Map:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		key := visit{v.Pointer(), v.Type(), 0}
+		if x, ok := c.copies[key]; ok {
+			return x
+		}
+		x := reflect.MakeMapWithSize(v.Type(), v.Len())
+		c.copies[key] = x
+		for iter := v.MapRange(); iter.Next(); {
+			x.SetMapIndex(iter.Key(), c.copy(iter.Value()))
+		}
+		return x
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		key := visit{v.Pointer(), v.Type(), v.Len()}
+		if x, ok := c.copies[key]; ok {
+			return x
+		}
+		x := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		c.copies[key] = x
+		for i := 0; i < v.Len(); i++ {
+			x.Index(i).Set(c.copy(v.Index(i)))
+		}
+		return x
+
+	case reflect.Array:
+		x := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			x.Index(i).Set(c.copy(v.Index(i)))
+		}
+		return x
+
+	case reflect.Struct:
+		x := reflect.New(v.Type()).Elem()
+		x.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				x.Field(i).Set(c.copy(v.Field(i)))
+			}
+		}
+		return x
+	}
+	return v
+}
+
+// equaler compares values structurally with the given options
+type equaler struct {
+	ignoreOrder bool              // compare lists regardless of element order
+	numeric     bool              // compare numbers by value regardless of type
+	ignore      [][]string        // paths to skip
+	comparing   map[[2]visit]bool // pairs of values currently being compared
+}
+
+// equal tests if the given values are structurally equal where path is the location of the
+// values for matching the paths to ignore
+func (e *equaler) equal(a, b reflect.Value, path []string) bool {
+	if e.ignored(path) {
+		return true
+	}
+	var ka, kb visit
+	a, ka = unwrap(a)
+	b, kb = unwrap(b)
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+
+	// Values already being compared higher up are cyclic and considered equal
+	if ka.ptr != 0 && kb.ptr != 0 {
+		key := [2]visit{ka, kb}
+		if e.comparing[key] {
+			return true
+		}
+		e.comparing[key] = true
+		defer delete(e.comparing, key)
+	}
+
+	// Maps are compared regardless of key order
+	if aKeys, aVals, ok := entries(a); ok {
+		_, bVals, ok := entries(b)
+		if !ok || len(aVals) != len(bVals) {
+			return false
+		}
+		for _, k := range aKeys {
+			val, ok := bVals[k]
+			if !ok || !e.equal(aVals[k], val, append(path[:len(path):len(path)], k)) {
+				return false
+			}
+		}
+		return true
+	}
+	if _, _, ok := entries(b); ok {
+		return false
+	}
+
+	// Lists are compared in order unless ignoring order
+	if isList(a) || isList(b) {
+		if !isList(a) || !isList(b) || a.Len() != b.Len() {
+			return false
+		}
+		if e.ignoreOrder {
+			return e.equalUnordered(a, b, path)
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !e.equal(a.Index(i), b.Index(i), append(path[:len(path):len(path)], fmt.Sprintf("[%d]", i))) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if e.numeric && isNumber(a) && isNumber(b) {
+		return Compare(number(a), number(b)) == 0
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+	if a.Kind() == reflect.Struct {
+		for i := 0; i < a.NumField(); i++ {
+			if !e.equal(a.Field(i), b.Field(i), append(path[:len(path):len(path)], a.Type().Field(i).Name)) {
+				return false
+			}
+		}
+		return true
+	}
+	return equalScalar(a, b)
+}
+
+// equalUnordered tests if the given lists contain equal elements regardless of their order
+func (e *equaler) equalUnordered(a, b reflect.Value, path []string) bool {
+	used := make([]bool, b.Len())
+	for i := 0; i < a.Len(); i++ {
+		found := false
+		elemPath := append(path[:len(path):len(path)], fmt.Sprintf("[%d]", i))
+		for j := 0; j < b.Len(); j++ {
+			if !used[j] && e.equal(a.Index(i), b.Index(j), elemPath) {
+				used[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// ignored tests if the given path matches any of the paths to ignore
+func (e *equaler) ignored(path []string) bool {
+	for _, ignore := range e.ignore {
+		if len(ignore) != len(path) {
+			continue
+		}
+		match := true
+		for i := range ignore {
+			if ignore[i] != path[i] && ignore[i] != "*" && !(ignore[i] == "[]" && len(path[i]) > 0 && path[i][0] == '[') {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// hasher computes structural hashes
+type hasher struct {
+	hashing map[visit]bool // values currently being hashed
+}
+
+// hash returns the structural hash of the given value
+func (h *hasher) hash(v reflect.Value) uint64 {
+	f := fnv.New64a()
+	v, key := unwrap(v)
+	if !v.IsValid() {
+		f.Write([]byte("nil"))
+		return f.Sum64()
+	}
+
+	// Values already being hashed higher up are cyclic
+	if key.ptr != 0 {
+		if h.hashing[key] {
+			f.Write([]byte("cycle"))
+			return f.Sum64()
+		}
+		h.hashing[key] = true
+		defer delete(h.hashing, key)
+	}
+
+	buf := make([]byte, 8)
+	write := func(x uint64) {
+		binary.LittleEndian.PutUint64(buf, x)
+		f.Write(buf)
+	}
+
+	// Maps hash the same regardless of key order
+	if keys, vals, ok := entries(v); ok {
+		f.Write([]byte("map"))
+		sums := make([]uint64, 0, len(keys))
+		for _, k := range keys {
+			kf := fnv.New64a()
+			kf.Write([]byte(k))
+			sums = append(sums, kf.Sum64()*31+h.hash(vals[k]))
+		}
+		sort.Slice(sums, func(i, j int) bool { return sums[i] < sums[j] })
+		for _, sum := range sums {
+			write(sum)
+		}
+		return f.Sum64()
+	}
+	if isList(v) {
+		f.Write([]byte("list"))
+		for i := 0; i < v.Len(); i++ {
+			write(h.hash(v.Index(i)))
+		}
+		return f.Sum64()
+	}
+
+	f.Write([]byte(v.Type().String()))
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			f.Write([]byte(v.Type().Field(i).Name))
+			write(h.hash(v.Field(i)))
+		}
+	case reflect.Bool:
+		if v.Bool() {
+			write(1)
+		} else {
+			write(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		write(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		write(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		write(math.Float64bits(v.Float() + 0)) // normalize -0 to 0
+	case reflect.Complex64, reflect.Complex128:
+		write(math.Float64bits(real(v.Complex())))
+		write(math.Float64bits(imag(v.Complex())))
+	case reflect.String:
+		f.Write([]byte(v.String()))
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		write(uint64(v.Pointer()))
+	}
+	return f.Sum64()
+}
+
+// unwrap dereferences the given value through pointers, interfaces and the Nub wrapper types
+// returning the underlying value and the identity of the last reference for cycle detection
+func unwrap(v reflect.Value) (reflect.Value, visit) {
+	key := visit{}
+	for v.IsValid() {
+		switch {
+		case v.Type() == objectType:
+			if v.IsNil() {
+				return reflect.Value{}, key
+			}
+			key = visit{v.Pointer(), v.Type(), 0}
+			if v.CanInterface() {
+				v = reflect.ValueOf(v.Interface().(*Object).o)
+			} else {
+				v = v.Elem().Field(0)
+			}
+		case v.Type() == refSliceType && v.CanInterface():
+			v = reflect.ValueOf(v.Interface().(*RefSlice).O())
+		case v.Type() == persistentMapType && v.CanInterface():
+			v = reflect.ValueOf(v.Interface().(*PersistentMap).ToStringMap())
+		case v.Type() == persistentVectorType && v.CanInterface():
+			v = reflect.ValueOf(v.Interface().(*PersistentVector).ToInterSlice())
+		case v.Kind() == reflect.Ptr:
+			if v.IsNil() {
+				return reflect.Value{}, key
+			}
+			key = visit{v.Pointer(), v.Type(), 0}
+			v = v.Elem()
+		case v.Kind() == reflect.Interface:
+			if v.IsNil() {
+				return reflect.Value{}, key
+			}
+			v = v.Elem()
+		case v.Kind() == reflect.Map && !v.IsNil():
+			return v, visit{v.Pointer(), v.Type(), 0}
+		case v.Kind() == reflect.Slice && !v.IsNil():
+			return v, visit{v.Pointer(), v.Type(), v.Len()}
+		default:
+			return v, key
+		}
+	}
+	return v, key
+}
+
+// entries returns the keys in order and the values of the given map like value i.e. a Go map,
+// StringMap or yaml.MapSlice with the keys in their string form
+func entries(v reflect.Value) (keys []string, vals map[string]reflect.Value, ok bool) {
+	switch {
+	case v.Kind() == reflect.Map:
+		vals = map[string]reflect.Value{}
+		for iter := v.MapRange(); iter.Next(); {
+			k := fmt.Sprint(iter.Key())
+			keys = append(keys, k)
+			vals[k] = iter.Value()
+		}
+		sort.Strings(keys)
+		return keys, vals, true
+	case v.Type() == stringMapType || v.Type() == mapSliceType:
+		vals = map[string]reflect.Value{}
+		for i := 0; i < v.Len(); i++ {
+			k := fmt.Sprint(v.Index(i).Field(0))
+			keys = append(keys, k)
+			vals[k] = v.Index(i).Field(1)
+		}
+		return keys, vals, true
+	}
+	return
+}
+
+// isList tests if the given value is a slice or array that isn't map like
+func isList(v reflect.Value) bool {
+	return (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Type() != stringMapType && v.Type() != mapSliceType
+}
+
+// isNumber tests if the given value is an integer or float
+func isNumber(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// number returns the given numeric value as an int64, uint64 or float64 for comparison
+func number(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint()
+	}
+	return v.Float()
+}
+
+// equalScalar tests if the given values of the same type are equal
+func equalScalar(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return a.Complex() == b.Complex()
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Func:
+		return a.IsNil() && b.IsNil()
+	case reflect.Chan, reflect.UnsafePointer:
+		return a.Pointer() == b.Pointer()
+	}
+	return false
+}
@@ -0,0 +1,246 @@
+package n
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// node is a struct type for testing cycles
+type node struct {
+	Name string
+	Next *node
+	tags []string
+}
+
+// DeepCopy
+// --------------------------------------------------------------------------------------------------
+func ExampleDeepCopy() {
+	m := map[string]interface{}{"foo": []interface{}{1, 2}}
+	copy := DeepCopy(m).(map[string]interface{})
+	copy["foo"].([]interface{})[0] = 3
+	fmt.Println(m, copy)
+	// Output: map[foo:[1 2]] map[foo:[3 2]]
+}
+
+func TestDeepCopy(t *testing.T) {
+
+	// nil and values
+	{
+		assert.Nil(t, DeepCopy(nil))
+		assert.Equal(t, 1, DeepCopy(1))
+		assert.Equal(t, "foo", DeepCopy("foo"))
+		assert.Equal(t, (*StringMap)(nil), DeepCopy((*StringMap)(nil)))
+		assert.Equal(t, []int(nil), DeepCopy([]int(nil)))
+	}
+
+	// nested maps and slices aren't shared
+	{
+		m := map[string]interface{}{"a": map[string]interface{}{"b": []interface{}{1, []int{2}}}}
+		copy := DeepCopy(m).(map[string]interface{})
+		assert.Equal(t, m, copy)
+		copy["a"].(map[string]interface{})["b"].([]interface{})[1].([]int)[0] = 3
+		copy["a"].(map[string]interface{})["c"] = 4
+		assert.Equal(t, map[string]interface{}{"a": map[string]interface{}{"b": []interface{}{1, []int{2}}}}, m)
+	}
+
+	// nub types
+	{
+		m := NewStringMapV(map[string]interface{}{"a": NewStringMapV(map[string]interface{}{"b": 1})})
+		obj := Obj(NewSliceOfMapV(m))
+		copy := DeepCopy(obj).(*Object)
+		assert.Equal(t, obj, copy)
+		copy.ToSliceOfMap().At(0).M().Update("a.b", 2)
+		assert.Equal(t, 1, m.Query("a.b").O())
+		assert.Equal(t, 2, copy.ToSliceOfMap().At(0).M().Query("a.b").O())
+
+		ref := NewRefSliceV(&node{Name: "a"})
+		refCopy := DeepCopy(ref).(*RefSlice)
+		refCopy.At(0).O().(*node).Name = "b"
+		assert.Equal(t, "a", ref.At(0).O().(*node).Name)
+	}
+
+	// structs copy exported fields deeply and unexported fields shallowly
+	{
+		now := time.Now()
+		tags := []string{"foo"}
+		n := &node{Name: "a", Next: &node{Name: "b"}, tags: tags}
+		copy := DeepCopy(n).(*node)
+		assert.Equal(t, n, copy)
+		copy.Next.Name = "c"
+		assert.Equal(t, "b", n.Next.Name)
+		assert.Equal(t, &tags[0], &copy.tags[0])
+		assert.Equal(t, now, DeepCopy(now))
+	}
+
+	// cycles are reproduced
+	{
+		n := &node{Name: "a"}
+		n.Next = &node{Name: "b", Next: n}
+		copy := DeepCopy(n).(*node)
+		assert.True(t, copy != n)
+		assert.True(t, copy.Next.Next == copy)
+
+		m := map[string]interface{}{}
+		m["self"] = m
+		mCopy := DeepCopy(m).(map[string]interface{})
+		mCopy["foo"] = 1
+		assert.Equal(t, 1, mCopy["self"].(map[string]interface{})["foo"])
+		assert.Equal(t, 1, len(m))
+	}
+
+	// shared references stay shared
+	{
+		shared := []interface{}{1}
+		s := []interface{}{shared, shared}
+		copy := DeepCopy(s).([]interface{})
+		copy[0].([]interface{})[0] = 2
+		assert.Equal(t, 2, copy[1].([]interface{})[0])
+		assert.Equal(t, 1, shared[0])
+	}
+}
+
+// DeepEqual
+// --------------------------------------------------------------------------------------------------
+func ExampleDeepEqual() {
+	a := map[string]interface{}{"foo": []interface{}{1, 2}}
+	b := map[string]interface{}{"foo": []interface{}{2.0, 1.0}}
+	fmt.Println(DeepEqual(a, b), DeepEqual(a, b, IgnoreOrderOpt(true), NumericOpt(true)))
+	// Output: false true
+}
+
+func TestDeepEqual(t *testing.T) {
+
+	// nil
+	{
+		assert.True(t, DeepEqual(nil, nil))
+		assert.True(t, DeepEqual(nil, (*StringMap)(nil)))
+		assert.True(t, DeepEqual(Obj(nil), nil))
+		assert.False(t, DeepEqual(nil, 0))
+		assert.False(t, DeepEqual(NewStringMapV(), nil))
+	}
+
+	// values
+	{
+		assert.True(t, DeepEqual(1, 1))
+		assert.True(t, DeepEqual("foo", Obj("foo")))
+		assert.False(t, DeepEqual(1, 2))
+		assert.False(t, DeepEqual(1, int64(1)))
+		assert.False(t, DeepEqual(1, "1"))
+		assert.True(t, DeepEqual(node{Name: "a"}, node{Name: "a"}))
+		assert.False(t, DeepEqual(node{Name: "a"}, node{Name: "a", tags: []string{"foo"}}))
+	}
+
+	// nub types are compared by their underlying values
+	{
+		assert.True(t, DeepEqual(NewIntSliceV(1, 2), []int{1, 2}))
+		assert.True(t, DeepEqual(NewRefSliceV(1, 2), NewInterSliceV(1, 2)))
+		assert.False(t, DeepEqual(NewIntSliceV(1, 2), []int{2, 1}))
+		assert.True(t, DeepEqual(NewStringMapV(map[string]interface{}{"a": 1, "b": 2}), map[string]interface{}{"b": 2, "a": 1}))
+		assert.True(t, DeepEqual(&StringMap{{Key: "a", Value: 1}, {Key: "b", Value: 2}}, &StringMap{{Key: "b", Value: 2}, {Key: "a", Value: 1}}))
+		assert.False(t, DeepEqual(NewStringMapV(map[string]interface{}{"a": 1}), map[string]interface{}{"a": 1, "b": 2}))
+		assert.False(t, DeepEqual(NewStringMapV(map[string]interface{}{"a": 1}), []interface{}{"a", 1}))
+	}
+
+	// ignore order
+	{
+		a := []interface{}{1, []int{2, 3}, 1}
+		assert.False(t, DeepEqual(a, []interface{}{[]int{3, 2}, 1, 1}))
+		assert.True(t, DeepEqual(a, []interface{}{[]int{3, 2}, 1, 1}, IgnoreOrderOpt(true)))
+		assert.False(t, DeepEqual(a, []interface{}{[]int{3, 2}, 1, 2}, IgnoreOrderOpt(true)))
+	}
+
+	// numeric
+	{
+		assert.False(t, DeepEqual(1, 1.0))
+		assert.True(t, DeepEqual(1, 1.0, NumericOpt(true)))
+		assert.True(t, DeepEqual(int8(-1), int64(-1), NumericOpt(true)))
+		assert.False(t, DeepEqual(-1, uint(1), NumericOpt(true)))
+		assert.True(t, DeepEqual(map[string]interface{}{"a": uint8(1)}, map[string]interface{}{"a": 1.0}, NumericOpt(true)))
+		assert.False(t, DeepEqual(1, "1", NumericOpt(true)))
+	}
+
+	// ignore paths
+	{
+		a := map[string]interface{}{"a": 1, "b": []interface{}{map[string]interface{}{"c": 1, "d": 1}}}
+		b := map[string]interface{}{"a": 2, "b": []interface{}{map[string]interface{}{"c": 2, "d": 1}}}
+		assert.False(t, DeepEqual(a, b, IgnorePathsOpt("a")))
+		assert.True(t, DeepEqual(a, b, IgnorePathsOpt("a", "b.[0].c")))
+		assert.True(t, DeepEqual(a, b, IgnorePathsOpt("a", "b.[].c")))
+		assert.True(t, DeepEqual(a, b, IgnorePathsOpt("*", "b")))
+		assert.True(t, DeepEqual(node{Name: "a"}, node{Name: "b"}, IgnorePathsOpt("Name")))
+	}
+
+	// cycles
+	{
+		a := &node{Name: "a"}
+		a.Next = a
+		b := &node{Name: "a"}
+		b.Next = b
+		assert.True(t, DeepEqual(a, b))
+		b.Next = &node{Name: "b", Next: b}
+		assert.False(t, DeepEqual(a, b))
+	}
+}
+
+// Hash
+// --------------------------------------------------------------------------------------------------
+func ExampleHash() {
+	a := map[string]interface{}{"a": 1, "b": 2}
+	b := NewStringMapV(map[string]interface{}{"b": 2, "a": 1})
+	fmt.Println(Hash(a) == Hash(b))
+	// Output: true
+}
+
+func TestHash(t *testing.T) {
+
+	// stable
+	{
+		assert.Equal(t, Hash(nil), Hash(nil))
+		assert.Equal(t, Hash(nil), Hash((*StringMap)(nil)))
+		assert.Equal(t, Hash([]interface{}{1, "foo"}), Hash([]interface{}{1, "foo"}))
+		assert.Equal(t, Hash(0.0), Hash(math.Copysign(0, -1)))
+	}
+
+	// equal for objects that are DeepEqual
+	{
+		assert.Equal(t, Hash(NewIntSliceV(1, 2)), Hash([]int{1, 2}))
+		assert.Equal(t, Hash(Obj("foo")), Hash("foo"))
+		assert.Equal(t, Hash(&StringMap{{Key: "a", Value: 1}, {Key: "b", Value: 2}}), Hash(&StringMap{{Key: "b", Value: 2}, {Key: "a", Value: 1}}))
+		assert.Equal(t, Hash(node{Name: "a"}), Hash(node{Name: "a"}))
+	}
+
+	// different for structural changes
+	{
+		assert.NotEqual(t, Hash(1), Hash(2))
+		assert.NotEqual(t, Hash(1), Hash(int64(1)))
+		assert.NotEqual(t, Hash(1), Hash("1"))
+		assert.NotEqual(t, Hash([]int{1, 2}), Hash([]int{2, 1}))
+		assert.NotEqual(t, Hash([]interface{}{[]int{1}, 2}), Hash([]interface{}{1, []int{2}}))
+		assert.NotEqual(t, Hash(map[string]int{"a": 1}), Hash(map[string]int{"b": 1}))
+		assert.NotEqual(t, Hash(map[string]int{"a": 1}), Hash([]interface{}{"a", 1}))
+		assert.NotEqual(t, Hash(node{Name: "a"}), Hash(node{Name: "b"}))
+	}
+
+	// usable as a map key
+	{
+		seen := map[uint64]bool{}
+		for _, obj := range []interface{}{[]int{1}, NewIntSliceV(1), []int{2}} {
+			seen[Hash(obj)] = true
+		}
+		assert.Equal(t, 2, len(seen))
+	}
+
+	// cycles
+	{
+		a := &node{Name: "a"}
+		a.Next = a
+		assert.Equal(t, Hash(a), Hash(a))
+		m := map[string]interface{}{}
+		m["self"] = m
+		assert.Equal(t, Hash(m), Hash(m))
+	}
+}
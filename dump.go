@@ -0,0 +1,315 @@
+package n
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/phR0ze/n/pkg/term"
+)
+
+var (
+	charType = reflect.TypeOf(Char(0))
+	strType  = reflect.TypeOf(Str{})
+)
+
+// ANSI color codes used to syntax highlight the output of Dump
+const (
+	ansiBlue    = "34"
+	ansiCyan    = "36"
+	ansiGray    = "38;5;247"
+	ansiGreen   = "32"
+	ansiMagenta = "35"
+	ansiRed     = "31"
+	ansiYellow  = "33"
+)
+
+// Dump writes a pretty printed representation of the given obj to the given writer similar to
+// Ruby's pp. Values are annotated with their types where the type isn't already evident from the
+// enclosing container, Go map keys are sorted, pointers are followed with cycles printed as
+// <cycle> and Nub types are printed as their underlying values. Output is syntax highlighted
+// by default only when the writer is a terminal.
+//
+// Supported options: ColorOpt, MaxDepthOpt, MaxItemsOpt, TypesOpt and UnexportedOpt
+func Dump(w io.Writer, obj interface{}, opts ...*opt.Opt) (err error) {
+	_, err = io.WriteString(w, inspect(obj, getColorOpt(opts, isTerminal(w)), opts)+"\n")
+	return
+}
+
+// Inspect returns a pretty printed representation of the given obj, see Dump. Output is only
+// syntax highlighted when requested with ColorOpt(true) as the string may not end up in a
+// terminal e.g. test failure messages.
+//
+// Supported options: ColorOpt, MaxDepthOpt, MaxItemsOpt, TypesOpt and UnexportedOpt
+func Inspect(obj interface{}, opts ...*opt.Opt) string {
+	return inspect(obj, getColorOpt(opts, false), opts)
+}
+
+// inspect pretty prints the given obj optionally syntax highlighted
+func inspect(obj interface{}, color bool, opts []*opt.Opt) string {
+	d := &dumper{
+		color:      color,
+		maxDepth:   getMaxDepthOpt(opts),
+		maxItems:   getMaxItemsOpt(opts),
+		types:      getTypesOpt(opts),
+		unexported: getUnexportedOpt(opts),
+		visiting:   map[visit]bool{},
+	}
+	d.dump(reflect.ValueOf(obj), 0, true)
+	return d.buf.String()
+}
+
+// isTerminal tests if the given writer is a file attached to a terminal
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && f != nil && term.IsTTYP(f.Fd())
+}
+
+// dumper pretty prints values with the given options
+type dumper struct {
+	buf        strings.Builder
+	color      bool           // syntax highlight the output
+	maxDepth   int            // depth after which nested values are elided, 0 for unlimited
+	maxItems   int            // number of elements after which the rest are elided, 0 for unlimited
+	types      bool           // annotate values with their types
+	unexported bool           // include unexported struct fields
+	visiting   map[visit]bool // values currently being printed for cycle detection
+}
+
+// dump writes the given value at the given depth optionally annotated with its type
+func (d *dumper) dump(v reflect.Value, depth int, annotate bool) {
+	if !v.IsValid() {
+		d.write(ansiRed, "nil")
+		return
+	}
+
+	// Values of interfaces and Objects are annotated as their type isn't evident
+	switch {
+	case v.Type() == objectType:
+		if v.IsNil() {
+			d.write(ansiRed, "nil")
+		} else if v.CanInterface() {
+			d.dump(reflect.ValueOf(v.Interface().(*Object).o), depth, true)
+		} else {
+			d.dump(v.Elem().Field(0), depth, true)
+		}
+		return
+	case v.Kind() == reflect.Interface:
+		if v.IsNil() {
+			d.write(ansiRed, "nil")
+		} else {
+			d.dump(v.Elem(), depth, true)
+		}
+		return
+	}
+
+	if annotate && d.types {
+		d.write(ansiGray, "(%s) ", v.Type())
+	}
+	d.body(v, depth)
+}
+
+// key writes the given map key without its type
+func (d *dumper) key(v reflect.Value, depth int) {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	d.dump(v, depth, false)
+}
+
+// body writes the given value without its type
+func (d *dumper) body(v reflect.Value, depth int) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			d.write(ansiRed, "nil")
+			return
+		}
+		key := visit{v.Pointer(), v.Type(), 0}
+		if d.visiting[key] {
+			d.write(ansiYellow, "<cycle>")
+			return
+		}
+		d.visiting[key] = true
+		defer delete(d.visiting, key)
+
+		// RefSlice and the persistent types hide their values in unexported fields
+		if v.CanInterface() {
+			switch x := v.Interface().(type) {
+			case *RefSlice:
+				d.body(reflect.ValueOf(x.O()), depth)
+				return
+			case *PersistentMap:
+				d.body(reflect.ValueOf(x.ToStringMap()), depth)
+				return
+			case *PersistentVector:
+				d.body(reflect.ValueOf(x.ToInterSlice()), depth)
+				return
+			}
+		}
+		d.body(v.Elem(), depth)
+
+	case reflect.Map:
+		if v.IsNil() {
+			d.write(ansiRed, "nil")
+			return
+		}
+		key := visit{v.Pointer(), v.Type(), 0}
+		if d.visiting[key] {
+			d.write(ansiYellow, "<cycle>")
+			return
+		}
+		d.visiting[key] = true
+		defer delete(d.visiting, key)
+
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return lessValue(keys[i], keys[j]) })
+		d.composite("{", "}", len(keys), depth, func(i int) {
+			d.key(keys[i], depth+1)
+			d.buf.WriteString(": ")
+			d.dump(v.MapIndex(keys[i]), depth+1, false)
+		})
+
+	case reflect.Slice, reflect.Array:
+		switch {
+		case v.Type() == strType && v.CanInterface():
+			d.write(ansiGreen, "%s", strconv.Quote(string(v.Interface().(Str))))
+			return
+		case v.Kind() == reflect.Slice && v.IsNil():
+			d.write(ansiRed, "nil")
+			return
+		case v.Type() == stringMapType || v.Type() == mapSliceType:
+			d.composite("{", "}", v.Len(), depth, func(i int) {
+				d.key(v.Index(i).Field(0), depth+1)
+				d.buf.WriteString(": ")
+				d.dump(v.Index(i).Field(1), depth+1, false)
+			})
+			return
+		}
+		if v.Kind() == reflect.Slice {
+			key := visit{v.Pointer(), v.Type(), v.Len()}
+			if d.visiting[key] {
+				d.write(ansiYellow, "<cycle>")
+				return
+			}
+			d.visiting[key] = true
+			defer delete(d.visiting, key)
+		}
+		d.composite("[", "]", v.Len(), depth, func(i int) {
+			d.dump(v.Index(i), depth+1, false)
+		})
+
+	case reflect.Struct:
+		fields := []int{}
+		for i := 0; i < v.NumField(); i++ {
+			if d.unexported || v.Type().Field(i).IsExported() {
+				fields = append(fields, i)
+			}
+		}
+
+		// Structs without visible fields like time.Time are printed by their String method
+		if len(fields) == 0 {
+			if str, ok := stringer(v); ok {
+				d.buf.WriteString(str)
+				return
+			}
+		}
+		d.composite("{", "}", len(fields), depth, func(i int) {
+			d.write(ansiCyan, "%s", v.Type().Field(fields[i]).Name)
+			d.buf.WriteString(": ")
+			d.dump(v.Field(fields[i]), depth+1, false)
+		})
+
+	case reflect.Bool:
+		d.write(ansiMagenta, "%t", v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Type() == charType {
+			d.write(ansiGreen, "%s", strconv.QuoteRune(rune(v.Int())))
+		} else {
+			d.write(ansiBlue, "%d", v.Int())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		d.write(ansiBlue, "%d", v.Uint())
+	case reflect.Float32, reflect.Float64:
+		d.write(ansiBlue, "%s", strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	case reflect.Complex64, reflect.Complex128:
+		d.write(ansiBlue, "%v", v.Complex())
+	case reflect.String:
+		d.write(ansiGreen, "%s", strconv.Quote(v.String()))
+	default:
+		if v.IsNil() {
+			d.write(ansiRed, "nil")
+		} else {
+			d.write(ansiYellow, "%#x", v.Pointer())
+		}
+	}
+}
+
+// composite writes the given number of elements between the given delimiters one per line using
+// the given element writer eliding elements beyond the max depth or max items.
+func (d *dumper) composite(open, close string, length, depth int, elem func(i int)) {
+	if length == 0 {
+		d.buf.WriteString(open + close)
+		return
+	}
+	if d.maxDepth > 0 && depth >= d.maxDepth {
+		d.buf.WriteString(open)
+		d.write(ansiYellow, "...")
+		d.buf.WriteString(close)
+		return
+	}
+	indent := strings.Repeat("  ", depth+1)
+	d.buf.WriteString(open + "\n")
+	for i := 0; i < length; i++ {
+		d.buf.WriteString(indent)
+		if d.maxItems > 0 && i >= d.maxItems {
+			d.write(ansiYellow, "... %d more", length-i)
+			d.buf.WriteString("\n")
+			break
+		}
+		elem(i)
+		d.buf.WriteString(",\n")
+	}
+	d.buf.WriteString(strings.Repeat("  ", depth) + close)
+}
+
+// write the given formatted text in the given ANSI color if enabled
+func (d *dumper) write(color string, format string, a ...interface{}) {
+	if d.color {
+		d.buf.WriteString("\x1b[" + color + "m" + fmt.Sprintf(format, a...) + "\x1b[0m")
+	} else {
+		d.buf.WriteString(fmt.Sprintf(format, a...))
+	}
+}
+
+// lessValue orders map keys using Compare falling back on their string representations for
+// values that can't be accessed
+func lessValue(a, b reflect.Value) bool {
+	if a.CanInterface() && b.CanInterface() {
+		return Compare(a.Interface(), b.Interface()) < 0
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+// stringer returns the result of the given value's String or Error method if it has one
+func stringer(v reflect.Value) (str string, ok bool) {
+	if !v.CanInterface() {
+		return
+	}
+	obj := v.Interface()
+	if v.CanAddr() {
+		obj = v.Addr().Interface()
+	}
+	switch x := obj.(type) {
+	case error:
+		return x.Error(), true
+	case fmt.Stringer:
+		return x.String(), true
+	}
+	return
+}
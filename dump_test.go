@@ -0,0 +1,167 @@
+package n
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// failWriter is an io.Writer that always fails
+type failWriter struct{}
+
+func (w failWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+// Dump
+// --------------------------------------------------------------------------------------------------
+func ExampleDump() {
+	Dump(os.Stdout, map[string]interface{}{"b": []int{1, 2}, "a": 1.5})
+	// Output:
+	// (map[string]interface {}) {
+	//   "a": (float64) 1.5,
+	//   "b": ([]int) [
+	//     1,
+	//     2,
+	//   ],
+	// }
+}
+
+func TestDump(t *testing.T) {
+
+	// writes to any writer
+	{
+		buf := &bytes.Buffer{}
+		assert.Nil(t, Dump(buf, []string{"a"}))
+		assert.Equal(t, "([]string) [\n  \"a\",\n]\n", buf.String())
+	}
+
+	// write errors are returned
+	{
+		err := Dump(failWriter{}, 1)
+		assert.Equal(t, "write failed", err.Error())
+	}
+
+	// only highlighted by default when writing to a terminal
+	{
+		f, err := os.CreateTemp("", "dump")
+		assert.Nil(t, err)
+		defer os.Remove(f.Name())
+		assert.False(t, isTerminal(f))
+		assert.False(t, isTerminal(&bytes.Buffer{}))
+		assert.Nil(t, Dump(f, "a"))
+		assert.Nil(t, f.Close())
+		data, err := os.ReadFile(f.Name())
+		assert.Nil(t, err)
+		assert.Equal(t, "(string) \"a\"\n", string(data))
+
+		buf := &bytes.Buffer{}
+		assert.Nil(t, Dump(buf, "a", ColorOpt(true)))
+		assert.Equal(t, "\x1b[38;5;247m(string) \x1b[0m\x1b[32m\"a\"\x1b[0m\n", buf.String())
+	}
+}
+
+// Inspect
+// --------------------------------------------------------------------------------------------------
+func ExampleInspect() {
+	fmt.Println(Inspect(NewStringMapV("b: [1, foo]\na: {c: true}"), TypesOpt(false)))
+	// Output:
+	// {
+	//   "b": [
+	//     1,
+	//     "foo",
+	//   ],
+	//   "a": {
+	//     "c": true,
+	//   },
+	// }
+}
+
+func TestInspect(t *testing.T) {
+
+	// nil and values
+	{
+		assert.Equal(t, "nil", Inspect(nil))
+		assert.Equal(t, "(*int) nil", Inspect((*int)(nil)))
+		assert.Equal(t, "([]int) nil", Inspect([]int(nil)))
+		assert.Equal(t, "([]int) []", Inspect([]int{}))
+		assert.Equal(t, "(int) 1", Inspect(1))
+		assert.Equal(t, "(uint8) 1", Inspect(uint8(1)))
+		assert.Equal(t, "(float32) 1.5", Inspect(float32(1.5)))
+		assert.Equal(t, "(bool) true", Inspect(true))
+		assert.Equal(t, `(string) "a\n"`, Inspect("a\n"))
+		assert.Equal(t, "1", Inspect(1, TypesOpt(false)))
+		assert.Equal(t, "1", Inspect(1, ColorOpt(false), TypesOpt(false)))
+	}
+
+	// only highlighted when requested
+	{
+		assert.Equal(t, "\x1b[34m1\x1b[0m", Inspect(1, ColorOpt(true), TypesOpt(false)))
+		assert.Equal(t, "\x1b[31mnil\x1b[0m", Inspect(nil, ColorOpt(true)))
+	}
+
+	// nub types are printed as their values
+	{
+		assert.Equal(t, "(int) 1", Inspect(Obj(1)))
+		assert.Equal(t, "nil", Inspect(Obj(nil)))
+		assert.Equal(t, `(*n.Str) "foo"`, Inspect(A("foo")))
+		assert.Equal(t, `(n.Char) 'a'`, Inspect(Char('a')))
+		assert.Equal(t, "(*n.IntSlice) [\n  1,\n]", Inspect(NewIntSliceV(1)))
+		assert.Equal(t, "(*n.RefSlice) [\n  \"a\",\n]", Inspect(NewRefSliceV("a")))
+		assert.Equal(t, "(*n.StringMap) {\n  \"a\": (int) 1,\n}", Inspect(NewStringMapV("a: 1")))
+	}
+
+	// values of interfaces are annotated with their types
+	{
+		assert.Equal(t, "([]interface {}) [\n  (int) 1,\n  (string) \"a\",\n  nil,\n]", Inspect([]interface{}{1, "a", nil}))
+	}
+
+	// go map keys are sorted
+	{
+		assert.Equal(t, "(map[int]string) {\n  2: \"b\",\n  10: \"a\",\n}", Inspect(map[int]string{10: "a", 2: "b"}))
+	}
+
+	// structs with and without unexported fields
+	{
+		n := &node{Name: "a", tags: []string{"x"}}
+		assert.Equal(t, "(*n.node) {\n  Name: \"a\",\n  Next: nil,\n}", Inspect(n))
+		assert.Equal(t, "{\n  Name: \"a\",\n  Next: nil,\n  tags: [\n    \"x\",\n  ],\n}", Inspect(n, TypesOpt(false), UnexportedOpt(true)))
+		assert.Equal(t, "(time.Time) 2020-01-02 00:00:00 +0000 UTC", Inspect(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(t, "(*errors.fundamental) foo", Inspect(errors.New("foo")))
+	}
+
+	// cycles
+	{
+		n := &node{Name: "a"}
+		n.Next = &node{Name: "b", Next: n}
+		assert.Equal(t, "{\n  Name: \"a\",\n  Next: {\n    Name: \"b\",\n    Next: <cycle>,\n  },\n}", Inspect(n, TypesOpt(false)))
+
+		m := map[string]interface{}{}
+		m["self"] = m
+		assert.Equal(t, "{\n  \"self\": <cycle>,\n}", Inspect(m, TypesOpt(false)))
+	}
+
+	// shared references that aren't cycles are printed in full
+	{
+		shared := []int{1}
+		assert.Equal(t, "[\n  [\n    1,\n  ],\n  [\n    1,\n  ],\n]", Inspect([][]int{shared, shared}, TypesOpt(false)))
+	}
+
+	// max depth
+	{
+		obj := []interface{}{1, []interface{}{2, []int{3}}, map[string]int{}}
+		assert.Equal(t, "[\n  1,\n  [...],\n  {},\n]", Inspect(obj, TypesOpt(false), MaxDepthOpt(1)))
+		assert.Equal(t, "[\n  1,\n  [\n    2,\n    [...],\n  ],\n  {},\n]", Inspect(obj, TypesOpt(false), MaxDepthOpt(2)))
+	}
+
+	// max items
+	{
+		assert.Equal(t, "[\n  1,\n  2,\n  ... 3 more\n]", Inspect([]int{1, 2, 3, 4, 5}, TypesOpt(false), MaxItemsOpt(2)))
+		assert.Equal(t, "[\n  1,\n  2,\n]", Inspect([]int{1, 2}, TypesOpt(false), MaxItemsOpt(2)))
+	}
+}
@@ -0,0 +1,717 @@
+package n
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	exprTimeLiteral     = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(T\d{2}:\d{2}(:\d{2}(\.\d+)?)?(Z|[+-]\d{2}:\d{2})?)?`)
+	exprDurationLiteral = regexp.MustCompile(`^\d+(\.\d+)?[a-zA-Zµ]+(\d+(\.\d+)?[a-zA-Zµ]+)*`)
+	exprNumberLiteral   = regexp.MustCompile(`^\d+(\.\d+)?([eE][+-]?\d+)?`)
+)
+
+// Expr is a compiled filter expression that can be matched against the elements of a Slice. See
+// CompileExpr for the syntax.
+type Expr struct {
+	src  string   // source of the expression
+	root exprNode // root of the expression tree
+}
+
+// ExprError describes a syntax error found while compiling a filter expression along with the
+// position in the expression that the error was found at.
+type ExprError struct {
+	Msg    string // description of the error
+	Offset int    // byte offset into the expression
+	Column int    // column number in runes starting at 1
+}
+
+// Error implements the error interface
+func (e *ExprError) Error() string {
+	return fmt.Sprintf("%s at column %d", e.Msg, e.Column)
+}
+
+// CompileExpr compiles the given filter expression for matching against the elements of a Slice
+// e.g. `status == "failed" && age > 3d`. Errors are returned as *ExprError with the position of
+// the error in the expression.
+//
+// Field access uses the same jq type selectors as Object.Query e.g. `name`, `.meta.labels.app` or
+// `items.[0]` with `.` being the element itself. Missing fields evaluate to null.
+//
+// Literals: strings "foo" or 'foo', numbers 1 and 2.5, durations 3d or 1h30m, times 2021-01-02
+// or 2021-01-02T15:04:05Z, true, false, null, lists [1, 2] and the conversion functions
+// duration("1 day") and time("3 days ago") which use ToDurationE and ToTimeE.
+//
+// Operators in order of precedence:
+//
+//	!, not                            negation
+//	==, !=, <, <=, >, >=              comparison converting operands to durations, times or
+//	                                  numbers when the other operand is one
+//	=~, !~                            regular expression match of a string literal pattern
+//	in, not in                        list membership
+//	contains                          substring, list element or map key
+//	&&, and                           logical and
+//	||, or                            logical or
+func CompileExpr(src string) (expr *Expr, err error) {
+	p := &exprParser{src: src}
+	if err = p.next(); err != nil {
+		return
+	}
+	var root exprNode
+	if root, err = p.or(); err != nil {
+		return
+	}
+	if p.tok.kind != exprEOF {
+		err = p.errorf(p.tok.pos, "unexpected %s", p.tok)
+		return
+	}
+	expr = &Expr{src: src, root: root}
+	return
+}
+
+// Where compiles the given filter expression into a lambda selector for use with Select, DropW,
+// TakeW, CountW, AnyW and the like e.g. slice.Select(Where(`status == "failed"`)). An invalid
+// expression matches nothing, see WhereE to get the error.
+func Where(src string) func(O) bool {
+	sel, err := WhereE(src)
+	if err != nil {
+		return func(O) bool { return false }
+	}
+	return sel
+}
+
+// WhereE compiles the given filter expression into a lambda selector, see CompileExpr.
+func WhereE(src string) (sel func(O) bool, err error) {
+	var expr *Expr
+	if expr, err = CompileExpr(src); err != nil {
+		return
+	}
+	sel = expr.Match
+	return
+}
+
+// Match tests if the given obj satisfies the expression
+func (e *Expr) Match(obj O) bool {
+	if e == nil || e.root == nil {
+		return false
+	}
+	return truthy(e.root.eval(obj))
+}
+
+// String returns the source of the expression
+func (e *Expr) String() string {
+	if e == nil {
+		return ""
+	}
+	return e.src
+}
+
+// Expression tree
+//--------------------------------------------------------------------------------------------------
+
+// exprNode is a node in the expression tree
+type exprNode interface {
+	eval(obj O) interface{}
+}
+
+// exprLiteral is a constant value
+type exprLiteral struct {
+	val interface{}
+}
+
+func (n *exprLiteral) eval(obj O) interface{} {
+	return n.val
+}
+
+// exprPath is a field selector evaluated against the element
+type exprPath struct {
+	selector string
+}
+
+func (n *exprPath) eval(obj O) interface{} {
+	if n.selector == "." {
+		return DeReference(obj)
+	}
+	val, err := Obj(obj).QueryE(n.selector)
+	if err != nil {
+		return nil
+	}
+	return val.O()
+}
+
+// exprList is a list of values
+type exprList struct {
+	elems []exprNode
+}
+
+func (n *exprList) eval(obj O) interface{} {
+	vals := make([]interface{}, 0, len(n.elems))
+	for _, elem := range n.elems {
+		vals = append(vals, elem.eval(obj))
+	}
+	return vals
+}
+
+// exprNot negates its operand
+type exprNot struct {
+	x exprNode
+}
+
+func (n *exprNot) eval(obj O) interface{} {
+	return !truthy(n.x.eval(obj))
+}
+
+// exprLogic is a short circuiting logical and/or
+type exprLogic struct {
+	and  bool
+	x, y exprNode
+}
+
+func (n *exprLogic) eval(obj O) interface{} {
+	if n.and {
+		return truthy(n.x.eval(obj)) && truthy(n.y.eval(obj))
+	}
+	return truthy(n.x.eval(obj)) || truthy(n.y.eval(obj))
+}
+
+// exprCompare is a binary comparison
+type exprCompare struct {
+	op   string
+	x, y exprNode
+	re   *regexp.Regexp // compiled pattern for =~ and !~
+}
+
+func (n *exprCompare) eval(obj O) interface{} {
+	x := n.x.eval(obj)
+	switch n.op {
+	case "=~":
+		return x != nil && n.re.MatchString(ToString(x))
+	case "!~":
+		return x == nil || !n.re.MatchString(ToString(x))
+	}
+
+	y := n.y.eval(obj)
+	switch n.op {
+	case "==":
+		return exprEqual(x, y)
+	case "!=":
+		return !exprEqual(x, y)
+	case "in":
+		return exprContains(y, x)
+	case "not in":
+		return !exprContains(y, x)
+	case "contains":
+		return exprContains(x, y)
+	}
+	c, ok := exprCompareValues(x, y)
+	if !ok {
+		return false
+	}
+	switch n.op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	}
+	return c >= 0
+}
+
+// exprEqual tests if the given values are equal after conversion, see exprCompareValues
+func exprEqual(x, y interface{}) bool {
+	if c, ok := exprCompareValues(x, y); ok {
+		return c == 0
+	}
+	return DeepEqual(x, y, NumericOpt(true))
+}
+
+// exprContains tests if the given container holds the given value. Strings contain substrings,
+// lists contain elements and maps contain keys.
+func exprContains(container, val interface{}) bool {
+	if container == nil {
+		return false
+	}
+	if s, ok := container.(string); ok {
+		return val != nil && strings.Contains(s, ToString(val))
+	}
+	v := reflect.ValueOf(container)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Type() == stringMapType || v.Type() == mapSliceType {
+			return ToStringMap(container).Exists(val)
+		}
+		for i := 0; i < v.Len(); i++ {
+			if exprEqual(v.Index(i).Interface(), val) {
+				return true
+			}
+		}
+	case reflect.Map:
+		return ToStringMap(container).Exists(val)
+	case reflect.Ptr:
+		if !v.IsNil() {
+			return exprContains(v.Elem().Interface(), val)
+		}
+	}
+	return false
+}
+
+// exprCompareValues orders the given values converting one to a duration, time or number when
+// the other is one. Values that can't be ordered return false.
+func exprCompareValues(x, y interface{}) (c int, ok bool) {
+	x, y = DeReference(x), DeReference(y)
+	if x == nil || y == nil {
+		return
+	}
+
+	switch {
+	case isDuration(x) || isDuration(y):
+		a, errA := ToDurationE(x)
+		b, errB := ToDurationE(y)
+		if errA != nil || errB != nil {
+			return
+		}
+		return cmp.Compare(a, b), true
+	case isTime(x) || isTime(y):
+		a, errA := ToTimeE(x)
+		b, errB := ToTimeE(y)
+		if errA != nil || errB != nil {
+			return
+		}
+		return a.Compare(b), true
+	case isNumeric(x) || isNumeric(y):
+		a, errA := ToFloat64E(x)
+		b, errB := ToFloat64E(y)
+		if errA != nil || errB != nil {
+			return
+		}
+		return cmp.Compare(a, b), true
+	}
+
+	switch a := x.(type) {
+	case string:
+		if b, isStr := y.(string); isStr {
+			return strings.Compare(a, b), true
+		}
+	case bool:
+		if b, isBool := y.(bool); isBool {
+			return compareBool(a, b), true
+		}
+	}
+	return
+}
+
+func isDuration(x interface{}) bool {
+	_, ok := x.(time.Duration)
+	return ok
+}
+
+func isTime(x interface{}) bool {
+	_, ok := x.(time.Time)
+	return ok
+}
+
+func isNumeric(x interface{}) bool {
+	return x != nil && isNumber(reflect.ValueOf(x))
+}
+
+// truthy tests if the given value is considered true i.e. true, a non zero number or a non empty
+// string or collection
+func truthy(val interface{}) bool {
+	val = DeReference(val)
+	if val == nil {
+		return false
+	}
+	if x, ok := val.(bool); ok {
+		return x
+	}
+	v := reflect.ValueOf(val)
+	switch {
+	case isNumber(v):
+		return !v.IsZero()
+	case v.Kind() == reflect.String, v.Kind() == reflect.Slice, v.Kind() == reflect.Map, v.Kind() == reflect.Array:
+		return v.Len() != 0
+	}
+	return true
+}
+
+// Parser
+//--------------------------------------------------------------------------------------------------
+
+// exprKind is the kind of a token
+type exprKind int
+
+const (
+	exprEOF exprKind = iota
+	exprOp
+	exprIdent
+	exprValue
+)
+
+// exprToken is a lexical token of an expression
+type exprToken struct {
+	kind exprKind
+	pos  int         // byte offset of the token
+	text string      // text of the token
+	val  interface{} // value of literal tokens
+}
+
+// String returns a description of the token for error messages
+func (t exprToken) String() string {
+	if t.kind == exprEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// exprParser is a recursive descent parser for expressions
+type exprParser struct {
+	src string    // expression being parsed
+	pos int       // byte offset of the next token
+	tok exprToken // current token
+}
+
+// or := and (("||" | "or") and)*
+func (p *exprParser) or() (node exprNode, err error) {
+	if node, err = p.and(); err != nil {
+		return
+	}
+	for p.is("||", "or") {
+		if err = p.next(); err != nil {
+			return
+		}
+		var y exprNode
+		if y, err = p.and(); err != nil {
+			return
+		}
+		node = &exprLogic{x: node, y: y}
+	}
+	return
+}
+
+// and := not (("&&" | "and") not)*
+func (p *exprParser) and() (node exprNode, err error) {
+	if node, err = p.not(); err != nil {
+		return
+	}
+	for p.is("&&", "and") {
+		if err = p.next(); err != nil {
+			return
+		}
+		var y exprNode
+		if y, err = p.not(); err != nil {
+			return
+		}
+		node = &exprLogic{and: true, x: node, y: y}
+	}
+	return
+}
+
+// not := ("!" | "not") not | compare
+func (p *exprParser) not() (node exprNode, err error) {
+	if p.is("!", "not") {
+		if err = p.next(); err != nil {
+			return
+		}
+		if node, err = p.not(); err != nil {
+			return
+		}
+		return &exprNot{x: node}, nil
+	}
+	return p.compare()
+}
+
+// compare := value (op value)?
+func (p *exprParser) compare() (node exprNode, err error) {
+	if node, err = p.value(); err != nil {
+		return
+	}
+	if !p.is("==", "!=", "<", "<=", ">", ">=", "=~", "!~", "in", "not", "contains") {
+		return
+	}
+	cmp := &exprCompare{op: strings.ToLower(p.tok.text), x: node}
+	if err = p.next(); err != nil {
+		return
+	}
+	if cmp.op == "not" {
+		if !p.is("in") {
+			err = p.errorf(p.tok.pos, "expected \"in\" after \"not\" but found %s", p.tok)
+			return
+		}
+		cmp.op = "not in"
+		if err = p.next(); err != nil {
+			return
+		}
+	}
+
+	// Regular expressions must be string literals compiled up front
+	if cmp.op == "=~" || cmp.op == "!~" {
+		pattern, ok := p.tok.val.(string)
+		if p.tok.kind != exprValue || !ok {
+			err = p.errorf(p.tok.pos, "expected regular expression string but found %s", p.tok)
+			return
+		}
+		if cmp.re, err = regexp.Compile(pattern); err != nil {
+			err = p.errorf(p.tok.pos, "invalid regular expression: %v", err)
+			return
+		}
+		return cmp, p.next()
+	}
+
+	if cmp.y, err = p.value(); err != nil {
+		return
+	}
+	return cmp, nil
+}
+
+// value := literal | path | func "(" string ")" | "(" or ")" | "[" (value ("," value)*)? "]"
+func (p *exprParser) value() (node exprNode, err error) {
+	tok := p.tok
+	switch {
+	case tok.kind == exprValue:
+		return &exprLiteral{val: tok.val}, p.next()
+
+	case tok.kind == exprIdent:
+		if err = p.next(); err != nil {
+			return
+		}
+		if !p.is("(") {
+			return &exprPath{selector: tok.text}, nil
+		}
+		return p.call(tok)
+
+	case p.is("("):
+		if err = p.next(); err != nil {
+			return
+		}
+		if node, err = p.or(); err != nil {
+			return
+		}
+		if !p.is(")") {
+			err = p.errorf(p.tok.pos, "expected \")\" but found %s", p.tok)
+			return
+		}
+		return node, p.next()
+
+	case p.is("["):
+		if err = p.next(); err != nil {
+			return
+		}
+		list := &exprList{}
+		for !p.is("]") {
+			if len(list.elems) > 0 {
+				if !p.is(",") {
+					err = p.errorf(p.tok.pos, "expected \",\" or \"]\" but found %s", p.tok)
+					return
+				}
+				if err = p.next(); err != nil {
+					return
+				}
+			}
+			var elem exprNode
+			if elem, err = p.value(); err != nil {
+				return
+			}
+			list.elems = append(list.elems, elem)
+		}
+		return list, p.next()
+	}
+	err = p.errorf(tok.pos, "expected value but found %s", tok)
+	return
+}
+
+// call := ("duration" | "time") "(" string ")" converting the string at compile time
+func (p *exprParser) call(fn exprToken) (node exprNode, err error) {
+	if err = p.next(); err != nil {
+		return
+	}
+	arg := p.tok
+	str, ok := arg.val.(string)
+	if arg.kind != exprValue || !ok {
+		err = p.errorf(arg.pos, "expected string argument but found %s", arg)
+		return
+	}
+
+	var val interface{}
+	switch fn.text {
+	case "duration":
+		val, err = ToDurationE(str)
+	case "time":
+		val, err = ToTimeE(str)
+	default:
+		err = p.errorf(fn.pos, "unknown function %q", fn.text)
+		return
+	}
+	if err != nil {
+		err = p.errorf(arg.pos, "invalid %s %q", fn.text, str)
+		return
+	}
+	if err = p.next(); err != nil {
+		return
+	}
+	if !p.is(")") {
+		err = p.errorf(p.tok.pos, "expected \")\" but found %s", p.tok)
+		return
+	}
+	return &exprLiteral{val: val}, p.next()
+}
+
+// is tests if the current token is an operator or keyword in the given list ignoring the case
+// of keywords
+func (p *exprParser) is(texts ...string) bool {
+	if p.tok.kind != exprOp && p.tok.kind != exprIdent {
+		return false
+	}
+	for _, text := range texts {
+		if p.tok.text == text || p.tok.kind == exprIdent && strings.EqualFold(p.tok.text, text) {
+			return true
+		}
+	}
+	return false
+}
+
+// errorf returns a new ExprError for the given byte offset
+func (p *exprParser) errorf(pos int, format string, a ...interface{}) error {
+	return &ExprError{Msg: fmt.Sprintf(format, a...), Offset: pos, Column: utf8.RuneCountInString(p.src[:pos]) + 1}
+}
+
+// next scans the next token into the current token
+func (p *exprParser) next() (err error) {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+	start := p.pos
+	rest := p.src[p.pos:]
+	p.tok = exprToken{pos: start}
+	if rest == "" {
+		return
+	}
+
+	switch c := rest[0]; {
+
+	// Strings
+	case c == '"' || c == '\'':
+		var str string
+		if str, err = p.scanString(c); err != nil {
+			return
+		}
+		p.tok = exprToken{kind: exprValue, pos: start, text: p.src[start:p.pos], val: str}
+
+	// Times, durations and numbers with an optional sign
+	case c >= '0' && c <= '9' || (c == '-' || c == '+') && len(rest) > 1 && rest[1] >= '0' && rest[1] <= '9':
+		sign := ""
+		if c == '-' || c == '+' {
+			sign, rest = rest[:1], rest[1:]
+		}
+		if m := exprTimeLiteral.FindString(rest); m != "" && sign == "" {
+			p.pos += len(m)
+			p.tok = exprToken{kind: exprValue, pos: start, text: m}
+			if p.tok.val, err = ToTimeE(m); err != nil {
+				err = p.errorf(start, "invalid time %q", m)
+			}
+		} else if m := exprDurationLiteral.FindString(rest); m != "" && !strings.ContainsAny(exprNumberLiteral.FindString(rest), "eE") {
+			p.pos += len(sign) + len(m)
+			p.tok = exprToken{kind: exprValue, pos: start, text: sign + m}
+			var d time.Duration
+			if d, err = ToDurationE(m); err != nil {
+				err = p.errorf(start, "invalid duration %q", sign+m)
+			} else if sign == "-" {
+				d = -d
+			}
+			p.tok.val = d
+		} else {
+			m = exprNumberLiteral.FindString(rest)
+			p.pos += len(sign) + len(m)
+			p.tok = exprToken{kind: exprValue, pos: start, text: sign + m}
+			if i, e := strconv.ParseInt(sign+m, 10, 64); e == nil {
+				p.tok.val = int(i)
+			} else {
+				p.tok.val, _ = strconv.ParseFloat(sign+m, 64)
+			}
+		}
+
+	// Identifiers, keywords and paths
+	case c == '.' || c == '_' || c < utf8.RuneSelf && unicode.IsLetter(rune(c)) || c >= utf8.RuneSelf:
+		p.scanPath()
+		text := p.src[start:p.pos]
+		p.tok = exprToken{kind: exprIdent, pos: start, text: text}
+		switch text {
+		case "true", "false":
+			p.tok.kind, p.tok.val = exprValue, text == "true"
+		case "null", "nil":
+			p.tok.kind = exprValue
+		}
+
+	// Operators
+	default:
+		for _, op := range []string{"==", "!=", "<=", ">=", "=~", "!~", "&&", "||", "<", ">", "!", "(", ")", "[", "]", ","} {
+			if strings.HasPrefix(rest, op) {
+				p.pos += len(op)
+				p.tok = exprToken{kind: exprOp, pos: start, text: op}
+				return
+			}
+		}
+		r, _ := utf8.DecodeRuneInString(rest)
+		err = p.errorf(start, "unexpected character %q", r)
+	}
+	return
+}
+
+// scanPath advances over a path e.g. foo.bar, .foo.[0] or .[name==foo] where anything is
+// allowed within brackets and the \ character escapes the next character.
+func (p *exprParser) scanPath() {
+	depth := 0
+	for p.pos < len(p.src) {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		switch {
+		case r == '\\' && p.pos+size < len(p.src):
+			_, next := utf8.DecodeRuneInString(p.src[p.pos+size:])
+			size += next
+		case r == '[':
+			depth++
+		case r == ']' && depth > 0:
+			depth--
+		case depth > 0:
+		case r == ']' || !(r == '.' || r == '_' || r == '-' || unicode.IsLetter(r) || unicode.IsDigit(r)):
+			return
+		}
+		p.pos += size
+	}
+}
+
+// scanString advances over a string quoted with the given quote returning its unescaped value
+func (p *exprParser) scanString(quote byte) (str string, err error) {
+	start := p.pos
+	var builder strings.Builder
+	for p.pos++; p.pos < len(p.src); p.pos++ {
+		c := p.src[p.pos]
+		switch {
+		case c == quote:
+			p.pos++
+			return builder.String(), nil
+		case c == '\\' && p.pos+1 < len(p.src):
+			p.pos++
+			switch e := p.src[p.pos]; e {
+			case 'n':
+				builder.WriteByte('\n')
+			case 't':
+				builder.WriteByte('\t')
+			case 'r':
+				builder.WriteByte('\r')
+			default:
+				builder.WriteByte(e)
+			}
+		default:
+			builder.WriteByte(c)
+		}
+	}
+	err = p.errorf(start, "unterminated string")
+	return
+}
@@ -0,0 +1,186 @@
+package n
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// exprRows returns rows for testing filter expressions
+func exprRows() *SliceOfMap {
+	return NewSliceOfMapV(
+		"{name: a, status: failed, age: 5d, count: 3, tags: [x, z], meta: {app: web}}",
+		"{name: b, status: ok, age: 1d, count: 10.5, tags: [z], meta: {app: db}}",
+		"{name: c, status: failed, age: 2d, created: 2021-03-04}",
+	)
+}
+
+// exprNames returns the names of the given rows
+func exprNames(rows ISlice) (names []string) {
+	rows.Each(func(x O) { names = append(names, x.(*StringMap).Get("name").A()) })
+	return
+}
+
+// CompileExpr
+// --------------------------------------------------------------------------------------------------
+func ExampleCompileExpr() {
+	_, err := CompileExpr(`status == "failed" &&`)
+	fmt.Println(err)
+	// Output: expected value but found end of expression at column 22
+}
+
+func TestCompileExpr(t *testing.T) {
+
+	// valid
+	{
+		expr, err := CompileExpr(`status == "failed" && age > 3d`)
+		assert.Nil(t, err)
+		assert.Equal(t, `status == "failed" && age > 3d`, expr.String())
+	}
+
+	// errors with positions
+	for src, msg := range map[string]string{
+		``:                   "expected value but found end of expression at column 1",
+		`status ==`:          "expected value but found end of expression at column 10",
+		`"failed`:            "unterminated string at column 1",
+		`a == 1 )`:           "unexpected \")\" at column 8",
+		`(a == 1`:            "expected \")\" but found end of expression at column 8",
+		`a in [1 2]`:         "expected \",\" or \"]\" but found \"2\" at column 9",
+		`a # b`:              "unexpected character '#' at column 3",
+		`a * b`:              "unexpected character '*' at column 3",
+		`a not b`:            "expected \"in\" after \"not\" but found \"b\" at column 7",
+		`a =~ b`:             "expected regular expression string but found \"b\" at column 6",
+		`a =~ "("`:           "invalid regular expression: error parsing regexp: missing closing ): `(` at column 6",
+		`foo("a")`:           "unknown function \"foo\" at column 1",
+		`time(1)`:            "expected string argument but found \"1\" at column 6",
+		`duration("x")`:      "invalid duration \"x\" at column 10",
+		`é == 1 && b == 3xy`: "invalid duration \"3xy\" at column 16",
+	} {
+		_, err := CompileExpr(src)
+		assert.Equal(t, msg, err.Error(), src)
+		assert.IsType(t, &ExprError{}, err)
+	}
+
+	// offsets are in bytes and columns in runes
+	{
+		_, err := CompileExpr(`é == "`)
+		assert.Equal(t, 6, err.(*ExprError).Offset)
+		assert.Equal(t, 6, err.(*ExprError).Column)
+	}
+}
+
+// Expr_Match
+// --------------------------------------------------------------------------------------------------
+func ExampleExpr_Match() {
+	expr, _ := CompileExpr(`. > 2`)
+	fmt.Println(expr.Match(1), expr.Match(3))
+	// Output: false true
+}
+
+func TestExpr_Match(t *testing.T) {
+
+	// nil
+	{
+		var expr *Expr
+		assert.False(t, expr.Match(1))
+		assert.Equal(t, "", expr.String())
+	}
+
+	// element itself
+	{
+		assert.True(t, Where(`. == "foo"`)("foo"))
+		assert.True(t, Where(`. contains "oo"`)("foo"))
+		assert.True(t, Where(`. in [1, 2]`)(2))
+		assert.True(t, Where(`.`)(true))
+		assert.False(t, Where(`.`)(0))
+		assert.False(t, Where(`.`)(""))
+		assert.True(t, Where(`. >= 1.5`)(NewIntSliceV(2).At(0).O()))
+	}
+
+	// maps
+	{
+		m := map[string]interface{}{"a": map[string]interface{}{"b": 2}, "c": []int{1, 2}}
+		assert.True(t, Where(`a.b == 2`)(m))
+		assert.True(t, Where(`c contains 2 and a contains "b"`)(m))
+		assert.True(t, Where(`missing == null && !missing`)(m))
+		assert.False(t, Where(`missing > 1`)(m))
+	}
+}
+
+// Where
+// --------------------------------------------------------------------------------------------------
+func ExampleWhere() {
+	rows := NewSliceOfMapV("{name: a, status: failed}", "{name: b, status: ok}")
+	fmt.Println(rows.Select(Where(`status == "failed"`)))
+	// Output: [&[{name a} {status failed}]]
+}
+
+func TestWhere(t *testing.T) {
+	rows := exprRows()
+
+	// comparisons
+	assert.Equal(t, []string{"a", "c"}, exprNames(rows.Select(Where(`status == "failed"`))))
+	assert.Equal(t, []string{"b"}, exprNames(rows.Select(Where(`status != 'failed'`))))
+	assert.Equal(t, []string{"b"}, exprNames(rows.Select(Where(`count > 3`))))
+	assert.Equal(t, []string{"a", "b"}, exprNames(rows.Select(Where(`count >= 3`))))
+	assert.Equal(t, []string{"a"}, exprNames(rows.Select(Where(`count < 1e1`))))
+	assert.Equal(t, []string{"a"}, exprNames(rows.Select(Where(`count <= -1 || count == 3.0`))))
+	assert.Equal(t, []string{"a"}, exprNames(rows.Select(Where(`meta.app == "web"`))))
+	assert.Equal(t, []string{"a", "b"}, exprNames(rows.Select(Where(`.tags.[-1] == "z"`))))
+
+	// durations and times
+	assert.Equal(t, []string{"a"}, exprNames(rows.Select(Where(`age > 3d`))))
+	assert.Equal(t, []string{"a", "c"}, exprNames(rows.Select(Where(`age >= 48h`))))
+	assert.Equal(t, []string{"b"}, exprNames(rows.Select(Where(`age == duration("1 day")`))))
+	assert.Equal(t, []string{"c"}, exprNames(rows.Select(Where(`created > 2021-01-01`))))
+	assert.Equal(t, []string{"c"}, exprNames(rows.Select(Where(`created < 2021-03-04T00:00:01Z`))))
+	assert.Equal(t, []string{"c"}, exprNames(rows.Select(Where(`created < time("yesterday")`))))
+	assert.True(t, Where(`. < -1h30m`)(-2*time.Hour))
+
+	// boolean logic
+	assert.Equal(t, []string{"a"}, exprNames(rows.Select(Where(`status == "failed" && age > 3d`))))
+	assert.Equal(t, []string{"a", "b"}, exprNames(rows.Select(Where(`name == "a" or name == "b"`))))
+	assert.Equal(t, []string{"b"}, exprNames(rows.Select(Where(`!(status == "failed")`))))
+	assert.Equal(t, []string{"b"}, exprNames(rows.Select(Where(`not status == "failed"`))))
+	assert.Equal(t, []string{"a", "b"}, exprNames(rows.Select(Where(`tags`))))
+	assert.Equal(t, []string{"a"}, exprNames(rows.Select(Where(`name == "a" || name == "b" && count < 3`))))
+
+	// in, contains and regular expressions
+	assert.Equal(t, []string{"a", "c"}, exprNames(rows.Select(Where(`name in ["a", "c"]`))))
+	assert.Equal(t, []string{"b"}, exprNames(rows.Select(Where(`name not in ["a", "c"]`))))
+	assert.Equal(t, []string{"a"}, exprNames(rows.Select(Where(`tags contains "x"`))))
+	assert.Equal(t, []string{"a", "c"}, exprNames(rows.Select(Where(`status contains "fail"`))))
+	assert.Equal(t, []string{"a", "b"}, exprNames(rows.Select(Where(`meta contains "app"`))))
+	assert.Equal(t, []string{"a", "b"}, exprNames(rows.Select(Where(`name =~ "^[ab]$"`))))
+	assert.Equal(t, []string{"c"}, exprNames(rows.Select(Where(`name !~ '^[ab]$'`))))
+
+	// keywords are case insensitive
+	assert.Equal(t, []string{"a"}, exprNames(rows.Select(Where(`status == "failed" AND age > 3d`))))
+	assert.Equal(t, []string{"a", "b"}, exprNames(rows.Select(Where(`name == "a" Or name == "b"`))))
+	assert.Equal(t, []string{"b"}, exprNames(rows.Select(Where(`NOT status == "failed"`))))
+	assert.Equal(t, []string{"b"}, exprNames(rows.Select(Where(`name NOT IN ["a", "c"]`))))
+	assert.Equal(t, []string{"a"}, exprNames(rows.Select(Where(`tags CONTAINS "x"`))))
+
+	// usable by the other lambda methods
+	assert.Equal(t, 2, rows.CountW(Where(`status == "failed"`)))
+	assert.True(t, rows.AnyW(Where(`status == "ok"`)))
+	assert.Equal(t, []string{"b"}, exprNames(rows.Copy().DropW(Where(`status == "failed"`))))
+	taken := rows.Copy()
+	assert.Equal(t, []string{"a", "c"}, exprNames(taken.TakeW(Where(`status == "failed"`))))
+	assert.Equal(t, []string{"b"}, exprNames(taken))
+
+	// invalid expressions match nothing
+	assert.Equal(t, 0, rows.CountW(Where(`status ==`)))
+}
+
+func TestWhereE(t *testing.T) {
+	sel, err := WhereE(`status == "ok"`)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, exprRows().CountW(sel))
+
+	sel, err = WhereE(`status ==`)
+	assert.Nil(t, sel)
+	assert.Equal(t, "expected value but found end of expression at column 10", err.Error())
+}
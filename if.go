@@ -1,21 +1,36 @@
 package n
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/pkg/errors"
 )
 
+// errorType is the reflect.Type of the error interface
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
 // IF provides a way to deal with conditionals more elegantly in Go
+//
+// Branches chain in the same way as Go's if/else if/else such that only the first branch whose
+// condition is true executes e.g. If(x).Do(a).ElseIf(y).Do(b).Else(c) while Then chains further
+// steps onto the branch that executed e.g. If(x).Do(load).Then(parse).OnError(log).
 type IF struct {
 	State  bool          // Status of the result
 	Error  error         // Errors that may have been captured
 	Return []interface{} // Return values
+
+	taken   bool              // a branch of the chain has been taken
+	skip    bool              // the remaining branches of the chain are skipped
+	failed  bool              // the last step returned false or an error
+	recover bool              // recover from panics in the following steps
+	handler func(interface{}) // optional handler for recovered panics
+	args    []interface{}     // return values other than errors to pass to Then
 }
 
 // If provides a way to execute one line conditionals in Go
 func If(state bool, err ...error) *IF {
-	cond := &IF{State: state}
+	cond := &IF{State: state, taken: state}
 	if len(err) > 0 {
 		cond.Error = err[0]
 	}
@@ -28,57 +43,306 @@ func If(state bool, err ...error) *IF {
 // given to Do in the IF.Return slice and the first identified return bool will be
 // used for IF.State and the first identified return error will be used for IF.Error.
 func (cond *IF) Do(f interface{}, params ...interface{}) *IF {
-	if !cond.State {
+	if !cond.State || cond.skip {
+		return cond
+	}
+	return cond.call(f, params)
+}
+
+// Else executes the given function f with the given params the same as Do if no previous
+// branch of the chain was taken.
+func (cond *IF) Else(f interface{}, params ...interface{}) *IF {
+	if cond.taken {
+		cond.skip = true
+		return cond
+	}
+	cond.taken = true
+	return cond.call(f, params)
+}
+
+// ElseIf starts a new branch of the chain with the given state and optional error the same as
+// If when no previous branch of the chain was taken, otherwise the rest of the chain is skipped
+// keeping the results of the branch that was taken.
+func (cond *IF) ElseIf(state bool, err ...error) *IF {
+	if cond.taken {
+		cond.skip = true
+		return cond
+	}
+	cond.Reset()
+	cond.State, cond.taken = state, state
+	if len(err) > 0 {
+		cond.Error = err[0]
+	}
+	return cond
+}
+
+// Must panics with the IF.Error wrapped with the optional context if there is one, else returns
+// a reference to this IF for chaining.
+func (cond *IF) Must(context ...string) *IF {
+	if cond.Error != nil {
+		if len(context) > 0 {
+			panic(errors.Wrap(cond.Error, context[0]))
+		}
+		panic(cond.Error)
+	}
+	return cond
+}
+
+// OnError calls the given handler with the IF.Error if there is one and returns a reference to
+// this IF for chaining.
+func (cond *IF) OnError(handler func(err error)) *IF {
+	if cond.Error != nil {
+		handler(cond.Error)
+	}
+	return cond
+}
+
+// Recover causes any panic in the functions of the following steps of the chain to be recovered
+// from and captured in IF.Error, calling the optional handler with the recovered value.
+func (cond *IF) Recover(handler ...func(r interface{})) *IF {
+	cond.recover = true
+	if len(handler) > 0 {
+		cond.handler = handler[0]
+	}
+	return cond
+}
+
+// Reset defaults all the IF properties
+func (cond *IF) Reset() *IF {
+	cond.Error = nil
+	cond.State = false
+	cond.Return = []interface{}{}
+	cond.failed = false
+	cond.args = nil
+	return cond
+}
+
+// Then executes the given function f if the branch of the chain that was taken didn't fail i.e.
+// its function didn't return false or an error. The previous IF.Return values other than errors
+// are passed to f followed by the given params. The results are captured the same as Do.
+func (cond *IF) Then(f interface{}, params ...interface{}) *IF {
+	if !cond.taken || cond.failed {
 		return cond
 	}
+	return cond.call(f, append(cond.args, params...))
+}
+
+// call executes the given function with the given params capturing the results
+func (cond *IF) call(f interface{}, params []interface{}) *IF {
 	cond.Reset()
+	cond.invoke(f, params)
+	return cond
+}
+
+// invoke executes the given function with the given params capturing the results and any
+// recovered panic
+func (cond *IF) invoke(f interface{}, params []interface{}) {
+	if cond.recover {
+		defer func() {
+			if r := recover(); r != nil {
+				cond.Error = errors.Errorf("recovered from panic: %v", r)
+				cond.State, cond.failed = false, true
+				if cond.handler != nil {
+					cond.handler(r)
+				}
+			}
+		}()
+	}
+
+	// Avoid reflection for common function types
+	if len(params) == 0 {
+		switch x := f.(type) {
+		case func():
+			x()
+			return
+		case func() bool:
+			cond.results([]interface{}{x()}, []bool{false})
+			return
+		case func() error:
+			cond.results([]interface{}{x()}, []bool{true})
+			return
+		case func() interface{}:
+			cond.results([]interface{}{x()}, []bool{false})
+			return
+		case func() (interface{}, error):
+			val, err := x()
+			cond.results([]interface{}{val, err}, []bool{false, true})
+			return
+		}
+	}
 	vf := reflect.ValueOf(f)
 
 	// Ensure the target function is of the correctd type and params
 	if vf.Kind() != reflect.Func {
 		cond.Error = errors.Errorf("target function is not of type reflect.Func")
-		return cond
+		cond.failed = true
+		return
 	}
 	if vf.Type().NumIn() != len(params) {
 		cond.Error = errors.Errorf("incorrect number of parameters for the given function")
-		return cond
+		cond.failed = true
+		return
 	}
 
 	// Convert the given params into a slice of reflect.Value
 	vp := []reflect.Value{}
-	for _, param := range params {
-		vp = append(vp, reflect.ValueOf(param))
+	for i, param := range params {
+		if param == nil {
+			vp = append(vp, reflect.Zero(vf.Type().In(i)))
+		} else {
+			vp = append(vp, reflect.ValueOf(param))
+		}
 	}
 
 	// Execute the target function
+	results, errs := []interface{}{}, []bool{}
+	for i, val := range vf.Call(vp) {
+		results = append(results, val.Interface())
+		errs = append(errs, vf.Type().Out(i) == errorType)
+	}
+	cond.results(results, errs)
+}
+
+// results captures the given return values using the first bool for IF.State and the first error
+// for IF.Error. The given errs flags the return values declared as errors.
+func (cond *IF) results(results []interface{}, errs []bool) {
 	errSet, stateSet := false, false
-	for _, val := range vf.Call(vp) {
-		obj := val.Interface()
+	for i, obj := range results {
 		cond.Return = append(cond.Return, obj)
+		if !errs[i] {
+			cond.args = append(cond.args, obj)
+		}
 
 		if !stateSet || !errSet {
 			switch x := obj.(type) {
 			case bool:
 				if !stateSet {
 					cond.State = x
+					cond.failed = cond.failed || !x
 					stateSet = true
 				}
 			case error:
 				if !errSet {
 					cond.Error = x
+					cond.failed = true
 					errSet = true
 				}
 			}
 		}
 	}
+}
 
-	return cond
+// Result provides a typed companion to IF holding a value of type T or the error that prevented
+// it from being produced e.g. Try(os.ReadFile(path)).Then(parse).OnError(log).Or(defaults)
+type Result[T any] struct {
+	Value T     // Value of the result
+	Error error // Error that prevented the value being produced
+
+	recover bool // recover from panics in the following steps
 }
 
-// Reset defaults all the IF properties
-func (cond *IF) Reset() *IF {
-	cond.Error = nil
-	cond.State = false
-	cond.Return = []interface{}{}
-	return cond
+// Try creates a new Result from the given value and error typically the return values of a
+// function e.g. Try(strconv.Atoi("1"))
+func Try[T any](value T, err error) *Result[T] {
+	return &Result[T]{Value: value, Error: err}
+}
+
+// ThenTo executes the given function f with the Result's value if it has no error returning a
+// new Result of f's type, else a new Result with the same error.
+func ThenTo[T, U any](result *Result[T], f func(T) (U, error)) (new *Result[U]) {
+	new = &Result[U]{recover: result.recover}
+	if result.Error != nil {
+		new.Error = result.Error
+		return
+	}
+	defer new.recoverPanic()
+	new.Value, new.Error = f(result.Value)
+	return
+}
+
+// Else executes the given function f with the Result's error if it has one allowing the error to
+// be replaced with a value or a different error, else returns a reference to this Result.
+func (p *Result[T]) Else(f func(err error) (T, error)) (result *Result[T]) {
+	if result = p; p.Error == nil {
+		return
+	}
+	defer p.recoverPanic()
+	p.Value, p.Error = f(p.Error)
+	return
+}
+
+// Get returns the Result's value and error
+func (p *Result[T]) Get() (T, error) {
+	return p.Value, p.Error
+}
+
+// Must returns the Result's value or panics with its error wrapped with the optional context
+func (p *Result[T]) Must(context ...string) T {
+	if p.Error != nil {
+		if len(context) > 0 {
+			panic(errors.Wrap(p.Error, context[0]))
+		}
+		panic(p.Error)
+	}
+	return p.Value
+}
+
+// Ok tests if the Result has no error
+func (p *Result[T]) Ok() bool {
+	return p.Error == nil
+}
+
+// OnError calls the given handler with the Result's error if it has one and returns a reference
+// to this Result for chaining.
+func (p *Result[T]) OnError(handler func(err error)) *Result[T] {
+	if p.Error != nil {
+		handler(p.Error)
+	}
+	return p
+}
+
+// Or returns the Result's value if it has no error else the given default value
+func (p *Result[T]) Or(value T) T {
+	if p.Error != nil {
+		return value
+	}
+	return p.Value
+}
+
+// Recover causes any panic in the functions of the following steps of the chain to be recovered
+// from and captured as the Result's error.
+func (p *Result[T]) Recover() *Result[T] {
+	p.recover = true
+	return p
+}
+
+// Then executes the given function f with the Result's value if it has no error capturing its
+// results, else returns a reference to this Result. See ThenTo to change the value's type.
+func (p *Result[T]) Then(f func(T) (T, error)) (result *Result[T]) {
+	if result = p; p.Error != nil {
+		return
+	}
+	defer p.recoverPanic()
+	p.Value, p.Error = f(p.Value)
+	return
+}
+
+// recoverPanic recovers from a panic capturing it as the Result's error if enabled. Must be
+// deferred directly.
+func (p *Result[T]) recoverPanic() {
+	if !p.recover {
+		return
+	}
+	if r := recover(); r != nil {
+		var zero T
+		p.Value, p.Error = zero, errors.Errorf("recovered from panic: %v", r)
+	}
+}
+
+// String returns a string representation of the Result, implements the Stringer interface
+func (p *Result[T]) String() string {
+	if p.Error != nil {
+		return fmt.Sprintf("error: %v", p.Error)
+	}
+	return fmt.Sprint(p.Value)
 }
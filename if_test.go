@@ -1,6 +1,7 @@
 package n
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -86,7 +87,283 @@ func TestDo(t *testing.T) {
 	}
 }
 
+func TestElse(t *testing.T) {
+
+	// if taken
+	{
+		cond := If(true).Do(func() string { return "if" }).Else(func() string { return "else" })
+		assert.Equal(t, []interface{}{"if"}, cond.Return)
+	}
+
+	// else taken
+	{
+		cond := If(false).Do(func() string { return "if" }).Else(func() string { return "else" })
+		assert.Equal(t, []interface{}{"else"}, cond.Return)
+		assert.Nil(t, cond.Error)
+	}
+
+	// else with params
+	{
+		cond := If(false).Else(func(x, y int) (int, error) { return x + y, errors.New("foo") }, 1, 2)
+		assert.Equal(t, 3, cond.Return[0])
+		assert.Equal(t, "foo", cond.Error.Error())
+	}
+}
+
+func TestElseIf(t *testing.T) {
+	branch := func(x int) string {
+		return If(x == 1).Do(func() string { return "one" }).
+			ElseIf(x == 2).Do(func() string { return "two" }).
+			ElseIf(x == 3, errors.New("three")).
+			Else(func() string { return "other" }).Return[0].(string)
+	}
+
+	// first branch whose condition is true is taken
+	assert.Equal(t, "one", branch(1))
+	assert.Equal(t, "two", branch(2))
+	assert.Equal(t, "other", branch(4))
+
+	// branch without a function keeps its state and error
+	{
+		cond := If(false).ElseIf(true, errors.New("foo")).Else(func() string { return "other" })
+		assert.Equal(t, true, cond.State)
+		assert.Equal(t, "foo", cond.Error.Error())
+		assert.Equal(t, []interface{}{}, cond.Return)
+	}
+
+	// results of the taken branch are kept
+	{
+		cond := If(true).Do(func() (bool, error) { return false, errors.New("foo") }).ElseIf(true).Do(func() bool { return true })
+		assert.Equal(t, false, cond.State)
+		assert.Equal(t, "foo", cond.Error.Error())
+	}
+}
+
+func TestMust(t *testing.T) {
+
+	// no error
+	{
+		cond := If(true).Do(func() int { return 1 }).Must()
+		assert.Equal(t, 1, cond.Return[0])
+	}
+
+	// panics with context
+	{
+		defer func() {
+			err := recover()
+			assert.Equal(t, "failed to load: foo", err.(error).Error())
+		}()
+		If(true).Do(func() error { return errors.New("foo") }).Must("failed to load")
+	}
+}
+
+func TestOnError(t *testing.T) {
+
+	// no error
+	{
+		var err error
+		If(true).Do(func() int { return 1 }).OnError(func(e error) { err = e })
+		assert.Nil(t, err)
+	}
+
+	// error
+	{
+		var err error
+		If(true).Do(func() (int, error) { return 0, errors.New("foo") }).OnError(func(e error) { err = e })
+		assert.Equal(t, "foo", err.Error())
+	}
+
+	// error skips following steps
+	{
+		var err error
+		cond := If(true).Do(strconv.Atoi, "foo").Then(func(x int) int { return x * 2 }).OnError(func(e error) { err = e })
+		assert.Equal(t, `strconv.Atoi: parsing "foo": invalid syntax`, err.Error())
+		assert.Equal(t, 0, cond.Return[0])
+	}
+}
+
+func TestRecover(t *testing.T) {
+
+	// panic is captured as an error
+	{
+		var recovered interface{}
+		cond := If(true).Recover(func(r interface{}) { recovered = r }).Do(func() { panic("foo") })
+		assert.Equal(t, "recovered from panic: foo", cond.Error.Error())
+		assert.Equal(t, false, cond.State)
+		assert.Equal(t, "foo", recovered)
+	}
+
+	// recovered panic skips following steps
+	{
+		called := false
+		cond := If(true).Recover().Do(func(x int) int { panic("foo") }, 1).Then(func() { called = true })
+		assert.Equal(t, "recovered from panic: foo", cond.Error.Error())
+		assert.False(t, called)
+	}
+
+	// without recover the panic propagates
+	assert.Panics(t, func() { If(true).Do(func() { panic("foo") }) })
+}
+
+func TestThen(t *testing.T) {
+
+	// return values are passed to the next function
+	{
+		cond := If(true).Do(strconv.Atoi, "2").Then(func(x int) int { return x * 2 }).Then(strconv.Itoa)
+		assert.Equal(t, []interface{}{"4"}, cond.Return)
+		assert.Nil(t, cond.Error)
+	}
+
+	// return values are followed by the given params
+	{
+		cond := If(true).Do(func() int { return 2 }).Then(func(x, y int) int { return x * y }, 3)
+		assert.Equal(t, []interface{}{6}, cond.Return)
+	}
+
+	// nil return values are passed as zero values
+	{
+		cond := If(true).Do(func() interface{} { return nil }).Then(func(x interface{}) bool { return x == nil })
+		assert.Equal(t, true, cond.State)
+	}
+
+	// false stops the chain
+	{
+		called := false
+		If(true).Do(func() bool { return false }).Then(func(bool) { called = true })
+		assert.False(t, called)
+	}
+
+	// nothing taken
+	{
+		called := false
+		cond := If(false).Then(func() { called = true })
+		assert.False(t, called)
+		assert.Equal(t, false, cond.State)
+	}
+
+	// after else
+	{
+		cond := If(false).Do(func() int { return 1 }).Else(func() int { return 2 }).Then(func(x int) int { return x * 10 })
+		assert.Equal(t, []interface{}{20}, cond.Return)
+	}
+
+	// after else when the first branch was taken
+	{
+		calls := []string{}
+		cond := If(true).Do(func() int { calls = append(calls, "a"); return 1 }).
+			Else(func() int { calls = append(calls, "b"); return 2 }).
+			Then(func(x int) int { calls = append(calls, "c"); return x * 10 })
+		assert.Equal(t, []string{"a", "c"}, calls)
+		assert.Equal(t, []interface{}{10}, cond.Return)
+	}
+
+	// after else if when the first branch was taken
+	{
+		calls := []string{}
+		cond := If(true).Do(func() int { calls = append(calls, "a"); return 1 }).
+			ElseIf(true).Do(func() int { calls = append(calls, "b"); return 2 }).
+			Else(func() int { calls = append(calls, "c"); return 3 }).
+			Then(func(x int) int { calls = append(calls, "d"); return x * 10 })
+		assert.Equal(t, []string{"a", "d"}, calls)
+		assert.Equal(t, []interface{}{10}, cond.Return)
+	}
+
+	// after else if when the else if branch was taken
+	{
+		calls := []string{}
+		cond := If(false).Do(func() int { calls = append(calls, "a"); return 1 }).
+			ElseIf(true).Do(func() int { calls = append(calls, "b"); return 2 }).
+			Else(func() int { calls = append(calls, "c"); return 3 }).
+			Then(func(x int) int { calls = append(calls, "d"); return x * 10 })
+		assert.Equal(t, []string{"b", "d"}, calls)
+		assert.Equal(t, []interface{}{20}, cond.Return)
+	}
+}
+
 func TestReset(t *testing.T) {
 	assert.Equal(t, false, If(true).Reset().State)
 	assert.Equal(t, nil, If(true, errors.New("foo")).Reset().Error)
 }
+
+func TestResult(t *testing.T) {
+
+	// value
+	{
+		result := Try(strconv.Atoi("1"))
+		assert.True(t, result.Ok())
+		assert.Equal(t, 1, result.Must())
+		assert.Equal(t, 1, result.Or(5))
+		assert.Equal(t, "1", result.String())
+		val, err := result.Get()
+		assert.Equal(t, 1, val)
+		assert.Nil(t, err)
+	}
+
+	// error
+	{
+		result := Try(strconv.Atoi("foo"))
+		assert.False(t, result.Ok())
+		assert.Equal(t, 5, result.Or(5))
+		assert.Equal(t, `error: strconv.Atoi: parsing "foo": invalid syntax`, result.String())
+		assert.PanicsWithError(t, `failed to parse: strconv.Atoi: parsing "foo": invalid syntax`, func() { result.Must("failed to parse") })
+	}
+}
+
+func TestResult_Else(t *testing.T) {
+
+	// no error
+	assert.Equal(t, 1, Try(1, nil).Else(func(err error) (int, error) { return 2, nil }).Must())
+
+	// replace the error with a value
+	assert.Equal(t, 2, Try(0, errors.New("foo")).Else(func(err error) (int, error) { return 2, nil }).Must())
+
+	// replace the error
+	{
+		result := Try(0, errors.New("foo")).Else(func(err error) (int, error) { return 0, errors.Wrap(err, "bar") })
+		assert.Equal(t, "bar: foo", result.Error.Error())
+	}
+}
+
+func TestResult_OnError(t *testing.T) {
+	var err error
+	Try(1, nil).OnError(func(e error) { err = e })
+	assert.Nil(t, err)
+	Try(0, errors.New("foo")).OnError(func(e error) { err = e })
+	assert.Equal(t, "foo", err.Error())
+}
+
+func TestResult_Recover(t *testing.T) {
+
+	// panic is captured as an error
+	{
+		result := Try(1, nil).Recover().Then(func(x int) (int, error) { panic("foo") })
+		assert.Equal(t, "recovered from panic: foo", result.Error.Error())
+		assert.Equal(t, 0, result.Value)
+		assert.Equal(t, "recovered from panic: foo", ThenTo(Try("", nil).Recover(), func(string) (int, error) { panic("foo") }).Error.Error())
+	}
+
+	// without recover the panic propagates
+	assert.Panics(t, func() { Try(1, nil).Then(func(x int) (int, error) { panic("foo") }) })
+}
+
+func TestResult_Then(t *testing.T) {
+	double := func(x int) (int, error) { return x * 2, nil }
+
+	// chained
+	assert.Equal(t, 8, Try(strconv.Atoi("2")).Then(double).Then(double).Must())
+
+	// error stops the chain
+	{
+		called := false
+		result := Try(strconv.Atoi("foo")).Then(func(x int) (int, error) { called = true; return x, nil })
+		assert.False(t, called)
+		assert.Equal(t, `strconv.Atoi: parsing "foo": invalid syntax`, result.Error.Error())
+	}
+
+	// change the type
+	{
+		result := ThenTo(Try(strconv.Atoi("2")), func(x int) (string, error) { return strconv.Itoa(x * 2), nil })
+		assert.Equal(t, "4", result.Must())
+		assert.Equal(t, "foo", ThenTo(Try(0, errors.New("foo")), func(x int) (string, error) { return "", nil }).Error.Error())
+	}
+}
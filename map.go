@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/phR0ze/n/pkg/opt"
 	"github.com/pkg/errors"
 )
 
@@ -23,8 +24,10 @@ type IMap interface {
 	Copy(keys ...interface{}) (new IMap) // Copy returns a new Map with the indicated key-value pairs copied from this Map or all if not given.
 	// Count(elem interface{}) (cnt int)                 // Count the number of elements in this Map equal to the given element.
 	// CountW(sel func(O) bool) (cnt int)                // CountW counts the number of elements in this Map that match the lambda selector.
-	Delete(key interface{}) (val *Object) // Delete modifies this Map to delete the indicated key-value pair and returns the value from the Map.
-	DeleteM(key interface{}) IMap         // DeleteM modifies this Map to delete the indicated key-value pair and returns a reference to this Map rather than the key-value pair.
+	DeepCopy() (new IMap)                             // DeepCopy returns a new Map with all key-value pairs recursively copied from this Map.
+	DeepEqual(obj interface{}, opts ...*opt.Opt) bool // DeepEqual tests if this Map is structurally equal to the given obj.
+	Delete(key interface{}) (val *Object)             // Delete modifies this Map to delete the indicated key-value pair and returns the value from the Map.
+	DeleteM(key interface{}) IMap                     // DeleteM modifies this Map to delete the indicated key-value pair and returns a reference to this Map rather than the key-value pair.
 	//DeleteS(keys interface{}) (obj *Object) // DeleteS modifies this Map to delete the indicated key-value pairs and returns the values from the Map as a Slice.
 	Exists(key interface{}) bool // Exists checks if the given key exists in this Map.
 	// DeleteW(sel func(O) bool) Slice                     // DropW modifies this Map to delete the elements that match the lambda selector and returns a reference to this Map.
@@ -39,6 +42,7 @@ type IMap interface {
 	// Empty() bool                                      // Empty tests if this Map is empty.
 	Generic() bool                                                // Generic returns true if the underlying implementation uses reflection
 	Get(key interface{}) (val *Object)                            // Get returns the value at the given key location. Returns empty *Object if not found.
+	Hash() uint64                                                 // Hash returns a stable structural hash of this Map regardless of key order.
 	Update(selector string, val interface{}) IMap                 // Update sets the value for the given key location, using jq type selectors. Returns a reference to this Map.
 	UpdateE(selector string, val interface{}) (m IMap, err error) // UpdateE sets the value for the given key location, using jq type selectors. Returns a reference to this Map.
 	// Join(separator ...string) (str *Object)           // Join converts each element into a string then joins them together using the given separator or comma by default.
@@ -88,6 +92,31 @@ type IMap interface {
 	WriteYAML(filename string) (err error) // WriteYAML converts the Map into a map[string]interface{} then calls yaml.WriteYAML on it to write it out to disk.
 }
 
+// IMapView is the read only subset of IMap implemented by every Map type including the immutable
+// PersistentMap. Methods returning a 'new IMap' return a mutable copy.
+type IMapView interface {
+	Any(keys ...interface{}) bool                                           // Any tests if this Map is not empty or optionally if it contains any of the given variadic keys.
+	Copy(keys ...interface{}) (new IMap)                                    // Copy returns a new Map with the indicated key-value pairs copied from this Map or all if not given.
+	DeepEqual(obj interface{}, opts ...*opt.Opt) bool                       // DeepEqual tests if this Map is structurally equal to the given obj.
+	Exists(key interface{}) bool                                            // Exists checks if the given key exists in this Map.
+	Generic() bool                                                          // Generic returns true if the underlying implementation uses reflection
+	Get(key interface{}) (val *Object)                                      // Get returns the value at the given key location. Returns empty *Object if not found.
+	Hash() uint64                                                           // Hash returns a stable structural hash of this Map regardless of key order.
+	Keys() ISlice                                                           // Keys returns all the keys in this Map as a Slice of the key type.
+	Len() int                                                               // Len returns the number of elements in this Map.
+	M() (m *StringMap)                                                      // M is an alias to ToStringMap
+	MG() (m map[string]interface{})                                         // MG is an alias to ToStringMapG
+	O() interface{}                                                         // O returns the underlying data structure as is.
+	Query(selector string, params ...interface{}) (val *Object)             // Query returns the value at the given selector location, using jq type selectors. Returns empty *Object if not found.
+	QueryE(selector string, params ...interface{}) (val *Object, err error) // Query returns the value at the given selector location, using jq type selectors. Returns empty *Object if not found.
+	ToStringMap() (m *StringMap)                                            // ToStringMap converts the map to a *StringMap
+	ToStringMapG() (m map[string]interface{})                               // ToStringMapG converts the map to a Golang map[string]interface{}
+	YAML() (data string)                                                    // YAML converts the Map into a YAML string
+	YAMLE() (data string, err error)                                        // YAMLE converts the Map into a YAML string
+	WriteJSON(filename string) (err error)                                  // WriteJSON converts the Map into a map[string]interface{} then calls json.WriteJSON on it to write it out to disk.
+	WriteYAML(filename string) (err error)                                  // WriteYAML converts the Map into a map[string]interface{} then calls yaml.WriteYAML on it to write it out to disk.
+}
+
 // Map provides a generic way to work with Map types. It does this by wrapping Go types
 // directly for optimized types thus avoiding reflection processing overhead and making a plethora
 // of Map methods available. Non-optimized types will fall back on reflection to generically
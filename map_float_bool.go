@@ -1,5 +1,12 @@
 package n
 
+import (
+	"strconv"
+
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/pkg/errors"
+)
+
 // FloatMapBool implements the Map interface providing a generic way to work with map types
 // including convenience methods on par with rapid development languages.
 type FloatMapBool map[float64]bool
@@ -39,6 +46,26 @@ func (p *FloatMapBool) Len() int {
 	return len(*p)
 }
 
+// MarshalJSON implements the json.Marshaler interface encoding this Map as a json object.
+// Keys are encoded as their shortest string representation.
+func (p *FloatMapBool) MarshalJSON() ([]byte, error) {
+	x := map[string]bool{}
+	if p != nil {
+		for k, v := range *p {
+			x[strconv.FormatFloat(k, 'g', -1, 64)] = v
+		}
+	}
+	return json.Marshal(x)
+}
+
+// MarshalYAML implements the yaml.Marshaler interface encoding this Map as a yaml map.
+func (p *FloatMapBool) MarshalYAML() (interface{}, error) {
+	if p == nil {
+		return map[float64]bool{}, nil
+	}
+	return map[float64]bool(*p), nil
+}
+
 // Set the value for the given key to the given val. Returns true if the key did not yet exists in this Map.
 func (p *FloatMapBool) Set(key, val interface{}) bool {
 	if p == nil {
@@ -55,3 +82,33 @@ func (p *FloatMapBool) Set(key, val interface{}) bool {
 	}
 	return false
 }
+
+// UnmarshalJSON implements the json.Unmarshaler interface decoding a json object into this Map.
+// Keys must be valid float strings.
+func (p *FloatMapBool) UnmarshalJSON(data []byte) (err error) {
+	x := map[string]bool{}
+	if err = json.Unmarshal(data, &x); err != nil {
+		return
+	}
+	m := map[float64]bool{}
+	for k, v := range x {
+		var key float64
+		if key, err = strconv.ParseFloat(k, 64); err != nil {
+			err = errors.Errorf("invalid float key %q", k)
+			return
+		}
+		m[key] = v
+	}
+	*p = FloatMapBool(m)
+	return
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface decoding a yaml map into this Map.
+func (p *FloatMapBool) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	x := map[float64]bool{}
+	if err = unmarshal(&x); err != nil {
+		return
+	}
+	*p = FloatMapBool(x)
+	return
+}
@@ -1,5 +1,9 @@
 package n
 
+import (
+	"github.com/phR0ze/n/pkg/enc/json"
+)
+
 // IntMapBool implements the Map interface providing a generic way to work with map types
 // including convenience methods on par with rapid development languages.
 type IntMapBool map[int]bool
@@ -39,6 +43,22 @@ func (p *IntMapBool) Len() int {
 	return len(*p)
 }
 
+// MarshalJSON implements the json.Marshaler interface encoding this Map as a json object.
+func (p *IntMapBool) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		return json.Marshal(map[int]bool{})
+	}
+	return json.Marshal(map[int]bool(*p))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface encoding this Map as a yaml map.
+func (p *IntMapBool) MarshalYAML() (interface{}, error) {
+	if p == nil {
+		return map[int]bool{}, nil
+	}
+	return map[int]bool(*p), nil
+}
+
 // Set the value for the given key to the given val. Returns true if the key did not yet exists in this Map.
 func (p *IntMapBool) Set(key, val interface{}) bool {
 	if p == nil {
@@ -55,3 +75,23 @@ func (p *IntMapBool) Set(key, val interface{}) bool {
 	}
 	return false
 }
+
+// UnmarshalJSON implements the json.Unmarshaler interface decoding a json object into this Map.
+func (p *IntMapBool) UnmarshalJSON(data []byte) (err error) {
+	x := map[int]bool{}
+	if err = json.Unmarshal(data, &x); err != nil {
+		return
+	}
+	*p = IntMapBool(x)
+	return
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface decoding a yaml map into this Map.
+func (p *IntMapBool) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	x := map[int]bool{}
+	if err = unmarshal(&x); err != nil {
+		return
+	}
+	*p = IntMapBool(x)
+	return
+}
@@ -0,0 +1,482 @@
+package n
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/phR0ze/yaml/v2"
+	"github.com/pkg/errors"
+)
+
+// PersistentMap is an immutable map with string keys implementing IMapView. Rather than modifying
+// the map in place every modifying method returns a new version of the map in O(log n) that
+// shares all but O(log n) of its structure with the original making snapshots and undo histories
+// cheap and safe to share between goroutines. Keys keep their insertion order like StringMap, with
+// updated keys keeping their original position, and nested maps and slices are converted into
+// PersistentMaps and PersistentVectors so that the whole tree of values is immutable. The zero
+// value is an empty map.
+type PersistentMap struct {
+	root  *pnode // entries by key
+	order *pnode // keys by insertion sequence number
+	seq   uint64 // sequence number of the next new key
+}
+
+// NewPersistentMap creates a new *PersistentMap from the given map or YAML/JSON string, see
+// ToStringMap for the supported types. The order of StringMaps and MapSlices is preserved while
+// Go maps are inserted in sorted key order.
+func NewPersistentMap(obj interface{}) *PersistentMap {
+	if m, ok := freeze(obj).(*PersistentMap); ok {
+		return m
+	}
+	return freeze(ToStringMap(obj)).(*PersistentMap)
+}
+
+// NewPersistentMapV creates a new empty *PersistentMap if nothing given else converts the given
+// value into a *PersistentMap.
+func NewPersistentMapV(m ...interface{}) *PersistentMap {
+	if len(m) == 0 {
+		return &PersistentMap{}
+	}
+	return NewPersistentMap(m[0])
+}
+
+// Any tests if this Map is not empty or optionally if it contains any of the given variadic keys.
+func (p *PersistentMap) Any(keys ...interface{}) bool {
+	if p.Len() == 0 {
+		return false
+	}
+	ks := ToStrs(keys)
+	if len(ks) == 0 {
+		return true
+	}
+	for _, k := range ks {
+		if p.Exists(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// Copy returns a new mutable Map with the indicated key-value pairs copied from this Map or all
+// if not given.
+func (p *PersistentMap) Copy(keys ...interface{}) (new IMap) {
+	m := p.ToStringMap()
+	if len(keys) == 0 {
+		return m
+	}
+	return m.Copy(keys...)
+}
+
+// DeepEqual tests if this Map is structurally equal to the given obj, see DeepEqual.
+//
+// Supported options: IgnoreOrderOpt, IgnorePathsOpt and NumericOpt
+func (p *PersistentMap) DeepEqual(obj interface{}, opts ...*opt.Opt) bool {
+	return DeepEqual(p, obj, opts...)
+}
+
+// Delete returns a new version of this Map without the given key
+func (p *PersistentMap) Delete(key interface{}) *PersistentMap {
+	if p == nil {
+		return &PersistentMap{}
+	}
+	return p.del(ToString(key))
+}
+
+// Each calls the given lambda once for each key-value pair in this Map in insertion order
+func (p *PersistentMap) Each(action func(key string, val O)) *PersistentMap {
+	if p != nil {
+		peach(p.order, func(o *pnode) bool {
+			n := pget(p.root, o.val.(string))
+			action(n.key, n.val)
+			return true
+		})
+	}
+	return p
+}
+
+// Exists checks if the given key exists in this Map.
+func (p *PersistentMap) Exists(key interface{}) bool {
+	return p != nil && pget(p.root, ToString(key)) != nil
+}
+
+// Generic returns true if the underlying implementation uses reflection
+func (p *PersistentMap) Generic() bool {
+	return false
+}
+
+// Get returns the value at the given key location. Returns empty *Object if not found.
+func (p *PersistentMap) Get(key interface{}) (val *Object) {
+	val = &Object{}
+	if p == nil {
+		return
+	}
+	if n := pget(p.root, ToString(key)); n != nil {
+		val.o = n.val
+	}
+	return
+}
+
+// Hash returns a stable structural hash of this Map, see Hash.
+func (p *PersistentMap) Hash() uint64 {
+	return Hash(p)
+}
+
+// Keys returns all the keys in this Map in insertion order as a *StringSlice.
+func (p *PersistentMap) Keys() ISlice {
+	keys := NewStringSliceV()
+	p.Each(func(key string, val O) {
+		*keys = append(*keys, key)
+	})
+	return keys
+}
+
+// Len returns the number of elements in this Map.
+func (p *PersistentMap) Len() int {
+	if p == nil {
+		return 0
+	}
+	return plen(p.root)
+}
+
+// M is an alias to ToStringMap
+func (p *PersistentMap) M() (m *StringMap) {
+	return p.ToStringMap()
+}
+
+// MG is an alias to ToStringMapG
+func (p *PersistentMap) MG() (m map[string]interface{}) {
+	return p.ToStringMapG()
+}
+
+// O returns a mutable copy of this Map as a map[string]interface{}
+func (p *PersistentMap) O() interface{} {
+	return p.ToStringMapG()
+}
+
+// Query returns the value at the given selector location, using jq type selectors. Returns empty
+// *Object if not found.
+//   - `selector` supports dot notation similar to https://stedolan.github.io/jq/manual/#Basicfilters with some caveats
+//   - `params` are the string interpolation paramaters similar to fmt.Sprintf()
+//   - use the \\ character to escape periods that don't separate keys e.g. "[version=1\\.2\\.3]"
+func (p *PersistentMap) Query(selector string, params ...interface{}) (val *Object) {
+	var err error
+	if val, err = p.QueryE(selector, params...); err != nil {
+		val = &Object{}
+	}
+	return
+}
+
+// QueryE returns the value at the given selector location, using jq type selectors. Returns empty
+// *Object if not found.
+//   - `selector` supports dot notation similar to https://stedolan.github.io/jq/manual/#Basicfilters with some caveats
+//   - `params` are the string interpolation paramaters similar to fmt.Sprintf()
+//   - use the \\ character to escape periods that don't separate keys e.g. "[version=1\\.2\\.3]"
+func (p *PersistentMap) QueryE(selector string, params ...interface{}) (val *Object, err error) {
+	if p.Len() == 0 {
+		err = errors.Errorf("failed to query empty map")
+		return
+	}
+	val = &Object{o: p}
+
+	var keys *StringSlice
+	if keys, err = KeysFromSelector(selector, params...); err != nil {
+		return
+	}
+	for _, key := range *keys {
+		switch x := val.o.(type) {
+		case *PersistentMap:
+			val.o = x.Get(key).O()
+		case *PersistentVector:
+			var i int
+			if i, err = x.index(key); err != nil {
+				val.o = nil
+				return
+			}
+			if i != -1 {
+				val.o = x.At(i).O()
+			}
+		}
+	}
+	return
+}
+
+// Remove returns a new version of this Map without the value at the given selector location,
+// using jq type selectors, see RemoveE.
+func (p *PersistentMap) Remove(selector string, params ...interface{}) *PersistentMap {
+	m, err := p.RemoveE(selector, params...)
+	if err != nil {
+		return p
+	}
+	return m
+}
+
+// RemoveE returns a new version of this Map without the value at the given selector location,
+// using jq type selectors. Returns this Map if the location doesn't exist.
+//   - `selector` supports dot notation similar to https://stedolan.github.io/jq/manual/#Basicfilters with some caveats
+//   - `params` are the string interpolation paramaters similar to fmt.Sprintf()
+//   - use the \\ character to escape periods that don't separate keys e.g. "[version=1\\.2\\.3]"
+func (p *PersistentMap) RemoveE(selector string, params ...interface{}) (m *PersistentMap, err error) {
+	if p == nil {
+		p = &PersistentMap{}
+	}
+	var keys *StringSlice
+	if keys, err = KeysFromSelector(selector, params...); err != nil {
+		return
+	}
+	var val interface{}
+	if val, err = premoveIn(p, *keys); err != nil {
+		return
+	}
+	return val.(*PersistentMap), nil
+}
+
+// Set returns a new version of this Map with the given key set to the given value
+func (p *PersistentMap) Set(key, val interface{}) *PersistentMap {
+	if p == nil {
+		p = &PersistentMap{}
+	}
+	return p.put(ToString(key), freeze(val))
+}
+
+// String returns a string representation of this Map, implements the Stringer interface
+func (p *PersistentMap) String() string {
+	return fmt.Sprint(*p.ToStringMap())
+}
+
+// ToStringMap converts this Map to a new mutable *StringMap preserving the insertion order
+func (p *PersistentMap) ToStringMap() (m *StringMap) {
+	m = NewStringMapV()
+	p.Each(func(key string, val O) {
+		*m = append(*m, yaml.MapItem{Key: key, Value: thaw(val)})
+	})
+	return
+}
+
+// ToStringMapG converts this Map to a new Golang map[string]interface{}
+func (p *PersistentMap) ToStringMapG() (m map[string]interface{}) {
+	return p.ToStringMap().G()
+}
+
+// Update returns a new version of this Map with the value at the given selector location set to
+// the given value, using jq type selectors, see UpdateE.
+func (p *PersistentMap) Update(selector string, val interface{}) *PersistentMap {
+	m, err := p.UpdateE(selector, val)
+	if err != nil {
+		return p
+	}
+	return m
+}
+
+// UpdateE returns a new version of this Map with the value at the given selector location set to
+// the given value, using jq type selectors. Missing maps along the way are created while slice
+// elements must already exist. An empty selector merges the given map into this Map.
+//   - `selector` supports dot notation similar to https://stedolan.github.io/jq/manual/#Basicfilters with some caveats
+//   - use the \\ character to escape periods that don't separate keys e.g. "[version=1\\.2\\.3]"
+func (p *PersistentMap) UpdateE(selector string, val interface{}) (m *PersistentMap, err error) {
+	if p == nil {
+		p = &PersistentMap{}
+	}
+	var keys *StringSlice
+	if keys, err = KeysFromSelector(selector); err != nil {
+		return
+	}
+
+	// Merge at root as no keys were given
+	if len(*keys) == 0 {
+		var x *StringMap
+		if x, err = ToStringMapE(val); err != nil {
+			err = errors.Errorf("invalid selector for the type of value given, '%T'", val)
+			return
+		}
+		m = p
+		for _, item := range *x {
+			m = m.Set(item.Key, item.Value)
+		}
+		return
+	}
+
+	var result interface{}
+	if result, err = psetIn(p, *keys, freeze(val)); err != nil {
+		return
+	}
+	return result.(*PersistentMap), nil
+}
+
+// WriteJSON converts the Map into a map[string]interface{} then calls json.WriteJSON on it to
+// write it out to disk.
+func (p *PersistentMap) WriteJSON(filename string) (err error) {
+	return p.ToStringMap().WriteJSON(filename)
+}
+
+// WriteJSONO converts the Map into a map[string]interface{} then calls json.WriteJSONO on it to
+// write it out to disk.
+//
+// Supported options: json.IndentOpt, sys.AtomicOpt, sys.BackupOpt and sys.PermsOpt
+func (p *PersistentMap) WriteJSONO(filename string, opts ...*opt.Opt) (err error) {
+	return p.ToStringMap().WriteJSONO(filename, opts...)
+}
+
+// WriteYAML converts the Map into a map[string]interface{} then calls yaml.WriteYAML on it to
+// write it out to disk.
+func (p *PersistentMap) WriteYAML(filename string) (err error) {
+	return p.ToStringMap().WriteYAML(filename)
+}
+
+// WriteYAMLO converts the Map into a map[string]interface{} then calls yaml.WriteYAMLO on it to
+// write it out to disk.
+//
+// Supported options: sys.AtomicOpt, sys.BackupOpt and sys.PermsOpt
+func (p *PersistentMap) WriteYAMLO(filename string, opts ...*opt.Opt) (err error) {
+	return p.ToStringMap().WriteYAMLO(filename, opts...)
+}
+
+// YAML converts the Map into a YAML string
+func (p *PersistentMap) YAML() (data string) {
+	return p.ToStringMap().YAML()
+}
+
+// YAMLE converts the Map into a YAML string
+func (p *PersistentMap) YAMLE() (data string, err error) {
+	return p.ToStringMap().YAMLE()
+}
+
+// put returns a new version of the given Map with the given key set to the given frozen value.
+// New keys are appended to the insertion order while existing keys keep their position.
+func (p *PersistentMap) put(key string, val interface{}) *PersistentMap {
+	m := &PersistentMap{root: pput(p.root, key, val, p.seq), order: p.order, seq: p.seq}
+	if plen(m.root) > plen(p.root) {
+		m.order = pput(m.order, pseqKey(p.seq), key, 0)
+		m.seq++
+	}
+	return m
+}
+
+// del returns a new version of the given Map without the given key
+func (p *PersistentMap) del(key string) *PersistentMap {
+	n := pget(p.root, key)
+	if n == nil {
+		return &PersistentMap{root: p.root, order: p.order, seq: p.seq}
+	}
+	return &PersistentMap{root: pdelete(p.root, key), order: pdelete(p.order, pseqKey(n.seq)), seq: p.seq}
+}
+
+// pseqKey returns the order tree key for the given sequence number which sorts as the number does
+func pseqKey(seq uint64) string {
+	return fmt.Sprintf("%016x", seq)
+}
+
+// freeze converts the maps and slices in the given value into PersistentMaps and
+// PersistentVectors recursively leaving other values as is
+func freeze(obj interface{}) interface{} {
+	switch x := obj.(type) {
+	case nil, *PersistentMap, *PersistentVector, Str, *Str, []byte:
+		return obj
+	case *Object:
+		if x == nil {
+			return nil
+		}
+		return freeze(x.o)
+	case *RefSlice:
+		return freeze(x.O())
+	}
+
+	v := reflect.ValueOf(obj)
+	for v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() != reflect.Struct {
+		v = v.Elem()
+	}
+	if keys, vals, ok := entries(v); ok {
+		m := &PersistentMap{}
+		for _, key := range keys {
+			m = m.put(key, freeze(vals[key].Interface()))
+		}
+		return m
+	}
+	if isList(v) {
+		elems := make([]interface{}, v.Len())
+		for i := range elems {
+			elems[i] = freeze(v.Index(i).Interface())
+		}
+		return &PersistentVector{root: pfromSlice(elems)}
+	}
+	return obj
+}
+
+// thaw converts the PersistentMaps and PersistentVectors in the given value into yaml.MapSlices
+// and []interface{} slices recursively, the same types used for nested values by StringMap
+func thaw(obj interface{}) interface{} {
+	switch x := obj.(type) {
+	case *PersistentMap:
+		return yaml.MapSlice(*x.ToStringMap())
+	case *PersistentVector:
+		return x.ToInterSlice()
+	}
+	return obj
+}
+
+// premoveIn returns a copy of the given value without the value at the given keys
+func premoveIn(obj interface{}, keys []string) (result interface{}, err error) {
+	key := keys[0]
+	switch x := obj.(type) {
+	case *PersistentMap:
+		if len(keys) == 1 {
+			return x.Delete(key), nil
+		}
+		if !x.Exists(key) {
+			return x, nil
+		}
+		var val interface{}
+		if val, err = premoveIn(x.Get(key).O(), keys[1:]); err != nil {
+			return
+		}
+		return x.Set(key, val), nil
+
+	case *PersistentVector:
+		var i int
+		if i, err = x.index(key); err != nil || i == -1 {
+			return x, err
+		}
+		if len(keys) == 1 {
+			return x.DropAt(i), nil
+		}
+		var val interface{}
+		if val, err = premoveIn(x.At(i).O(), keys[1:]); err != nil {
+			return
+		}
+		return x.Set(i, val), nil
+	}
+	return obj, nil
+}
+
+// psetIn returns a copy of the given value with the value at the given keys set to the given
+// frozen value creating missing maps along the way
+func psetIn(obj interface{}, keys []string, val interface{}) (result interface{}, err error) {
+	if len(keys) == 0 {
+		return val, nil
+	}
+	key := keys[0]
+	switch x := obj.(type) {
+	case *PersistentVector:
+		var i int
+		if i, err = x.index(key); err != nil {
+			return
+		}
+		if i == -1 {
+			err = errors.Errorf("invalid array index selector %v", key)
+			return
+		}
+		var elem interface{}
+		if elem, err = psetIn(x.At(i).O(), keys[1:], val); err != nil {
+			return
+		}
+		return x.Set(i, elem), nil
+
+	case *PersistentMap:
+		var elem interface{}
+		if elem, err = psetIn(x.Get(key).O(), keys[1:], val); err != nil {
+			return
+		}
+		return x.put(key, elem), nil
+	}
+	return psetIn(&PersistentMap{}, keys, val)
+}
@@ -0,0 +1,558 @@
+package n
+
+import (
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/phR0ze/n/pkg/sys"
+	"github.com/phR0ze/yaml/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// NewPersistentMap
+// --------------------------------------------------------------------------------------------------
+func ExampleNewPersistentMap() {
+	fmt.Println(NewPersistentMap(map[string]interface{}{"k": "v"}))
+	// Output: [{k v}]
+}
+
+func TestNewPersistentMap(t *testing.T) {
+
+	// map[string]interface
+	{
+		m := NewPersistentMap(map[string]interface{}{"b": "2", "a": "1"})
+		assert.Equal(t, 2, m.Len())
+		assert.Equal(t, []string{"a", "b"}, m.Keys().ToStrs())
+	}
+
+	// StringMap and yaml order is preserved
+	{
+		m := NewPersistentMap(NewStringMap(yaml.MapSlice{{Key: "b", Value: 2}, {Key: "a", Value: 1}}))
+		assert.Equal(t, []string{"b", "a"}, m.Keys().ToStrs())
+		m = NewPersistentMap("c: 3\na:\n  z: 1\n  x: 2\nb: 2\n")
+		assert.Equal(t, []string{"c", "a", "b"}, m.Keys().ToStrs())
+		assert.Equal(t, "c: 3\na:\n  z: 1\n  x: 2\nb: 2\n", m.YAML())
+	}
+
+	// yaml string
+	{
+		m := NewPersistentMap("foo:\n  bar: 1\n  list: [1, 2]\n")
+		assert.IsType(t, &PersistentMap{}, m.Get("foo").O())
+		assert.IsType(t, &PersistentVector{}, m.Query("foo.list").O())
+	}
+
+	// nested slices of maps are frozen
+	{
+		m := NewPersistentMap(map[string]interface{}{"list": []map[string]interface{}{{"k": "v"}}})
+		assert.IsType(t, &PersistentMap{}, m.Query("list.[0]").O())
+	}
+
+	// invalid
+	{
+		assert.Equal(t, 0, NewPersistentMap(nil).Len())
+	}
+}
+
+// NewPersistentMapV
+// --------------------------------------------------------------------------------------------------
+func ExampleNewPersistentMapV() {
+	fmt.Println(NewPersistentMapV(map[string]interface{}{"k": "v"}))
+	// Output: [{k v}]
+}
+
+func TestNewPersistentMapV(t *testing.T) {
+
+	// empty
+	{
+		assert.Equal(t, 0, NewPersistentMapV().Len())
+		assert.Equal(t, &PersistentMap{}, NewPersistentMapV())
+	}
+
+	// map[string]interface
+	{
+		m := NewPersistentMapV(map[string]interface{}{"k": "v"})
+		assert.Equal(t, "v", m.Get("k").A())
+	}
+}
+
+// Any
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_Any() {
+	m := NewPersistentMapV(map[string]interface{}{"k": "v"})
+	fmt.Println(m.Any())
+	// Output: true
+}
+
+func TestPersistentMap_Any(t *testing.T) {
+
+	// nil
+	{
+		assert.Equal(t, false, (*PersistentMap)(nil).Any())
+	}
+
+	// Not empty
+	{
+		assert.Equal(t, false, NewPersistentMapV().Any())
+		assert.Equal(t, true, NewPersistentMapV().Set("1", "one").Any())
+	}
+
+	// Specific keys
+	{
+		assert.Equal(t, false, NewPersistentMapV().Set("1", "one").Any("2"))
+		assert.Equal(t, true, NewPersistentMapV().Set("1", "one").Any("2", "1"))
+	}
+}
+
+// Copy
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_Copy() {
+	m := NewPersistentMapV(map[string]interface{}{"1": "one", "2": "two"})
+	fmt.Println(m.Copy("1"))
+	// Output: &[{1 one}]
+}
+
+func TestPersistentMap_Copy(t *testing.T) {
+
+	// all
+	{
+		m := NewPersistentMapV(map[string]interface{}{"1": "one", "2": map[string]interface{}{"3": "three"}})
+		cp := m.Copy()
+		assert.Equal(t, "&[{1 one} {2 [{3 three}]}]", fmt.Sprint(cp))
+
+		// copies are mutable and independent
+		cp.Set("1", "uno")
+		assert.Equal(t, "one", m.Get("1").A())
+	}
+
+	// specific keys
+	{
+		m := NewPersistentMapV(map[string]interface{}{"1": "one", "2": "two"})
+		assert.Equal(t, NewStringMapV(map[string]interface{}{"2": "two"}), m.Copy("2", "3"))
+	}
+}
+
+// DeepEqual
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_DeepEqual() {
+	m := NewPersistentMapV(map[string]interface{}{"k": []int{1, 2}})
+	fmt.Println(m.DeepEqual(map[string]interface{}{"k": []interface{}{1, 2}}))
+	// Output: true
+}
+
+func TestPersistentMap_DeepEqual(t *testing.T) {
+	m := NewPersistentMapV(map[string]interface{}{"1": "one", "2": map[string]interface{}{"3": []int{3}}})
+
+	// versions
+	{
+		assert.True(t, m.DeepEqual(m.Set("1", "one")))
+		assert.False(t, m.DeepEqual(m.Set("1", "uno")))
+	}
+
+	// other map types
+	{
+		assert.True(t, m.DeepEqual(m.ToStringMap()))
+		assert.True(t, m.DeepEqual(m.ToStringMapG()))
+		assert.False(t, m.DeepEqual(NewStringMapV()))
+	}
+}
+
+// Delete
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_Delete() {
+	m := NewPersistentMapV(map[string]interface{}{"1": "one", "2": "two"})
+	fmt.Println(m.Delete("1"))
+	// Output: [{2 two}]
+}
+
+func TestPersistentMap_Delete(t *testing.T) {
+
+	// nil
+	{
+		assert.Equal(t, 0, (*PersistentMap)(nil).Delete("1").Len())
+	}
+
+	// original is unchanged
+	{
+		m := NewPersistentMapV(map[string]interface{}{"1": "one", "2": "two", "3": "three"})
+		assert.Equal(t, []string{"1", "3"}, m.Delete("2").Keys().ToStrs())
+		assert.Equal(t, []string{"1", "2", "3"}, m.Keys().ToStrs())
+	}
+
+	// missing and non string keys
+	{
+		m := NewPersistentMapV(map[string]interface{}{"1": "one", "2": "two"})
+		assert.Equal(t, []string{"1", "2"}, m.Delete("3").Keys().ToStrs())
+		assert.Equal(t, []string{"2"}, m.Delete(1).Keys().ToStrs())
+	}
+}
+
+// Each
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_Each() {
+	m := NewPersistentMapV(map[string]interface{}{"2": "two", "1": "one"})
+	m.Each(func(key string, val O) {
+		fmt.Printf("%s:%v ", key, val)
+	})
+	// Output: 1:one 2:two
+}
+
+func TestPersistentMap_Each(t *testing.T) {
+
+	// nil
+	{
+		called := false
+		(*PersistentMap)(nil).Each(func(key string, val O) { called = true })
+		assert.False(t, called)
+	}
+
+	// in insertion order
+	{
+		keys := []string{}
+		m := NewPersistentMapV(map[string]interface{}{"c": 3, "a": 1, "b": 2})
+		assert.Equal(t, m, m.Each(func(key string, val O) {
+			keys = append(keys, key)
+		}))
+		assert.Equal(t, []string{"a", "b", "c"}, keys)
+
+		keys = []string{}
+		m.Set("0", 0).Set("b", 4).Each(func(key string, val O) {
+			keys = append(keys, key)
+		})
+		assert.Equal(t, []string{"a", "b", "c", "0"}, keys)
+	}
+}
+
+// Exists
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_Exists() {
+	m := NewPersistentMapV(map[string]interface{}{"1": "one"})
+	fmt.Println(m.Exists("1"))
+	// Output: true
+}
+
+func TestPersistentMap_Exists(t *testing.T) {
+	assert.False(t, (*PersistentMap)(nil).Exists("1"))
+	m := NewPersistentMapV(map[string]interface{}{"1": "one"})
+	assert.True(t, m.Exists("1"))
+	assert.True(t, m.Exists(1))
+	assert.False(t, m.Exists("2"))
+	assert.False(t, m.Delete("1").Exists("1"))
+}
+
+// Get
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_Get() {
+	m := NewPersistentMapV(map[string]interface{}{"1": "one"})
+	fmt.Println(m.Get("1"))
+	// Output: one
+}
+
+func TestPersistentMap_Get(t *testing.T) {
+
+	// nil
+	{
+		assert.Equal(t, &Object{}, (*PersistentMap)(nil).Get("1"))
+	}
+
+	// missing
+	{
+		assert.Equal(t, &Object{}, NewPersistentMapV().Set("1", "one").Get("2"))
+	}
+
+	// nested values are persistent
+	{
+		m := NewPersistentMapV(map[string]interface{}{"1": map[string]interface{}{"2": "two"}})
+		assert.Equal(t, "two", m.Get("1").O().(*PersistentMap).Get("2").A())
+	}
+}
+
+// Hash
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_Hash() {
+	m := NewPersistentMapV(map[string]interface{}{"1": "one"})
+	fmt.Println(m.Hash() == Hash(map[string]interface{}{"1": "one"}))
+	// Output: true
+}
+
+func TestPersistentMap_Hash(t *testing.T) {
+	m := NewPersistentMapV(map[string]interface{}{"1": "one", "2": []int{1, 2}})
+	assert.Equal(t, m.Hash(), m.ToStringMap().Hash())
+	assert.Equal(t, m.Hash(), m.Delete("3").Hash())
+	assert.NotEqual(t, m.Hash(), m.Set("1", "uno").Hash())
+}
+
+// Keys
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_Keys() {
+	m := NewPersistentMapV(map[string]interface{}{"2": "two", "1": "one"})
+	fmt.Println(m.Keys())
+	// Output: [1 2]
+}
+
+func TestPersistentMap_Keys(t *testing.T) {
+	assert.Equal(t, NewStringSliceV(), (*PersistentMap)(nil).Keys())
+	m := NewPersistentMapV(map[string]interface{}{"b": 2, "c": 3, "a": 1})
+	assert.Equal(t, NewStringSliceV("a", "b", "c"), m.Keys())
+}
+
+// Len
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_Len() {
+	m := NewPersistentMapV(map[string]interface{}{"1": "one", "2": "two"})
+	fmt.Println(m.Len())
+	// Output: 2
+}
+
+func TestPersistentMap_Len(t *testing.T) {
+	assert.Equal(t, 0, (*PersistentMap)(nil).Len())
+	assert.Equal(t, 0, NewPersistentMapV().Len())
+
+	// versions share structure but not length
+	m := NewPersistentMapV()
+	versions := []*PersistentMap{m}
+	for i := 0; i < 100; i++ {
+		m = m.Set(i, i)
+		versions = append(versions, m)
+	}
+	for i, x := range versions {
+		assert.Equal(t, i, x.Len())
+	}
+}
+
+// O
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_O() {
+	m := NewPersistentMapV(map[string]interface{}{"1": "one"})
+	fmt.Println(m.O())
+	// Output: map[1:one]
+}
+
+func TestPersistentMap_O(t *testing.T) {
+	m := NewPersistentMapV(map[string]interface{}{"1": map[string]interface{}{"2": []int{2}}})
+	assert.Equal(t, map[string]interface{}{"1": map[string]interface{}{"2": []interface{}{2}}}, m.O())
+	assert.Equal(t, m.O(), m.MG())
+}
+
+// Query
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_Query() {
+	m := NewPersistentMap("foo:\n  bar: 1\n")
+	fmt.Println(m.Query("foo.bar"))
+	// Output: 1
+}
+
+func TestPersistentMap_Query(t *testing.T) {
+	m := NewPersistentMap(`foo:
+  bar: 1
+  list:
+    - name: one
+    - name: two
+`)
+
+	// keys
+	{
+		assert.Equal(t, 1, m.Query("foo.bar").O())
+		assert.Equal(t, 1, m.Query(".foo.bar").O())
+		assert.Equal(t, &Object{}, m.Query("foo.missing"))
+	}
+
+	// slice indices
+	{
+		assert.Equal(t, "one", m.Query("foo.list.[0].name").A())
+		assert.Equal(t, "two", m.Query("foo.list.[-1].name").A())
+		assert.Equal(t, &Object{}, m.Query("foo.list.[5].name"))
+	}
+
+	// slice key value selection
+	{
+		assert.Equal(t, "two", m.Query("foo.list.[name==two].name").A())
+		assert.Equal(t, "two", m.Query("foo.list.[name==%s].name", "two").A())
+	}
+
+	// errors
+	{
+		_, err := NewPersistentMapV().QueryE("foo")
+		assert.Equal(t, "failed to query empty map", err.Error())
+		_, err = m.QueryE("foo.list.[foo]")
+		assert.NotNil(t, err)
+	}
+}
+
+// Remove
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_Remove() {
+	m := NewPersistentMap("foo:\n  bar: 1\n  baz: 2\n")
+	fmt.Println(m.Remove("foo.bar"))
+	// Output: [{foo [{baz 2}]}]
+}
+
+func TestPersistentMap_Remove(t *testing.T) {
+	m := NewPersistentMap(`foo:
+  bar: 1
+  list:
+    - name: one
+    - name: two
+`)
+
+	// original is unchanged
+	{
+		assert.Equal(t, "[{foo [{list [[{name one}] [{name two}]]}]}]", m.Remove("foo.bar").String())
+		assert.Equal(t, 1, m.Query("foo.bar").O())
+	}
+
+	// slice elements
+	{
+		assert.Equal(t, "[{foo [{bar 1} {list [[{name two}]]}]}]", m.Remove("foo.list.[0]").String())
+		assert.Equal(t, "[{foo [{bar 1} {list [[{name one}]]}]}]", m.Remove("foo.list.[name==two]").String())
+		assert.Equal(t, "[{foo [{bar 1} {list [[{name one}] [{name two}]]}]}]", m.Remove("foo.list.[-1].name").Remove("foo.list.[-1]").Set("foo", m.Get("foo")).String())
+	}
+
+	// missing locations return the same map
+	{
+		assert.Equal(t, m, m.Remove("foo.missing"))
+		assert.Equal(t, m, m.Remove("foo.list.[5]"))
+	}
+
+	// errors
+	{
+		_, err := m.RemoveE("foo.list.[foo]")
+		assert.NotNil(t, err)
+	}
+}
+
+// Set
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_Set() {
+	m := NewPersistentMapV()
+	fmt.Println(m.Set("1", "one"), m)
+	// Output: [{1 one}] []
+}
+
+func TestPersistentMap_Set(t *testing.T) {
+
+	// nil
+	{
+		assert.Equal(t, "[{1 one}]", (*PersistentMap)(nil).Set("1", "one").String())
+	}
+
+	// replace
+	{
+		m := NewPersistentMapV().Set("1", "one")
+		assert.Equal(t, "[{1 uno}]", m.Set(1, "uno").String())
+		assert.Equal(t, "[{1 one}]", m.String())
+	}
+
+	// new keys are appended and existing keys keep their position
+	{
+		m := NewPersistentMapV().Set("b", 1).Set("a", 2)
+		assert.Equal(t, "[{b 1} {a 2}]", m.String())
+		assert.Equal(t, "[{b 3} {a 2}]", m.Set("b", 3).String())
+		assert.Equal(t, "[{a 2} {b 3}]", m.Delete("b").Set("b", 3).String())
+		assert.Equal(t, "[{b 1} {a 2}]", m.Delete("c").String())
+	}
+
+	// values are frozen
+	{
+		m := NewPersistentMapV().Set("1", []string{"one"})
+		assert.IsType(t, &PersistentVector{}, m.Get("1").O())
+	}
+}
+
+// ToStringMap
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_ToStringMap() {
+	m := NewPersistentMapV(map[string]interface{}{"1": "one"})
+	fmt.Println(m.ToStringMap())
+	// Output: &[{1 one}]
+}
+
+func TestPersistentMap_ToStringMap(t *testing.T) {
+
+	// nil
+	{
+		assert.Equal(t, NewStringMapV(), (*PersistentMap)(nil).ToStringMap())
+	}
+
+	// nested values are thawed
+	{
+		m := NewPersistentMapV(map[string]interface{}{"1": map[string]interface{}{"2": []int{2}}})
+		m2 := m.ToStringMap()
+		assert.Equal(t, []interface{}{2}, m2.Query("1.2").O())
+		assert.Equal(t, m2, m.M())
+		assert.Equal(t, m2, ToStringMap(m))
+	}
+
+	// insertion order round trips
+	{
+		m := NewStringMap(yaml.MapSlice{{Key: "b", Value: 2}, {Key: "c", Value: 3}, {Key: "a", Value: 1}})
+		assert.Equal(t, m, NewPersistentMap(m).ToStringMap())
+		assert.Equal(t, m, NewPersistentMap(m).Update("c", 4).Update("c", 3).ToStringMap())
+	}
+}
+
+// Update
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentMap_Update() {
+	m := NewPersistentMapV()
+	fmt.Println(m.Update("foo.bar", 1))
+	// Output: [{foo [{bar 1}]}]
+}
+
+func TestPersistentMap_Update(t *testing.T) {
+	m := NewPersistentMap(`foo:
+  bar: 1
+  list:
+    - name: one
+    - name: two
+`)
+
+	// original is unchanged
+	{
+		assert.Equal(t, 2, m.Update("foo.bar", 2).Query("foo.bar").O())
+		assert.Equal(t, 1, m.Query("foo.bar").O())
+	}
+
+	// unchanged branches are shared
+	{
+		m2 := m.Update("foo.bar", 2)
+		assert.True(t, m.Query("foo.list").O() == m2.Query("foo.list").O())
+	}
+
+	// slice elements
+	{
+		m2 := m.Update("foo.list.[name==two].name", "three")
+		assert.Equal(t, "three", m2.Query("foo.list.[1].name").A())
+		assert.Equal(t, "uno", m2.Update("foo.list.[0]", "uno").Query("foo.list.[0]").A())
+		assert.Equal(t, "two", m.Query("foo.list.[1].name").A())
+	}
+
+	// merge at root
+	{
+		m2 := m.Update(".", map[string]interface{}{"baz": 2})
+		assert.Equal(t, []string{"foo", "baz"}, m2.Keys().ToStrs())
+	}
+
+	// errors return the same map
+	{
+		assert.Equal(t, m, m.Update("foo.list.[5].name", "five"))
+		_, err := m.UpdateE("foo.list.[5]", "five")
+		assert.NotNil(t, err)
+		_, err = m.UpdateE(".", 1)
+		assert.Equal(t, "invalid selector for the type of value given, 'int'", err.Error())
+	}
+}
+
+// WriteYAML
+// --------------------------------------------------------------------------------------------------
+func TestPersistentMap_WriteYAML(t *testing.T) {
+	clearTmpDir()
+	tmpfile := path.Join(tmpDir, ".test.yaml")
+
+	m := NewPersistentMap("foo:\n  bar: 1\n")
+	assert.Nil(t, m.WriteYAML(tmpfile))
+	data, err := sys.ReadString(tmpfile)
+	assert.Nil(t, err)
+	assert.Equal(t, "foo:\n  bar: 1\n", data)
+	assert.Equal(t, data, m.YAML())
+}
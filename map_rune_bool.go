@@ -1,5 +1,12 @@
 package n
 
+import (
+	"unicode/utf8"
+
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/pkg/errors"
+)
+
 // RuneMapBool implements the Map interface providing a generic way to work with map types
 // including convenience methods on par with rapid development languages.
 type RuneMapBool map[rune]bool
@@ -39,6 +46,18 @@ func (p *RuneMapBool) Len() int {
 	return len(*p)
 }
 
+// MarshalJSON implements the json.Marshaler interface encoding this Map as a json object.
+// Keys are encoded as the character they represent.
+func (p *RuneMapBool) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toStringKeys())
+}
+
+// MarshalYAML implements the yaml.Marshaler interface encoding this Map as a yaml map.
+// Keys are encoded as the character they represent.
+func (p *RuneMapBool) MarshalYAML() (interface{}, error) {
+	return p.toStringKeys(), nil
+}
+
 // Set the value for the given key to the given val. Returns true if the key did not yet exists in this Map.
 func (p *RuneMapBool) Set(key, val interface{}) bool {
 	if p == nil {
@@ -55,3 +74,49 @@ func (p *RuneMapBool) Set(key, val interface{}) bool {
 	}
 	return false
 }
+
+// toStringKeys converts this Map into a map keyed by the character each rune represents
+func (p *RuneMapBool) toStringKeys() (m map[string]bool) {
+	m = map[string]bool{}
+	if p != nil {
+		for k, v := range *p {
+			m[string(k)] = v
+		}
+	}
+	return
+}
+
+// fromStringKeys sets this Map from a map keyed by single characters
+func (p *RuneMapBool) fromStringKeys(x map[string]bool) (err error) {
+	m := map[rune]bool{}
+	for k, v := range x {
+		if utf8.RuneCountInString(k) != 1 {
+			err = errors.Errorf("invalid rune key %q", k)
+			return
+		}
+		r, _ := utf8.DecodeRuneInString(k)
+		m[r] = v
+	}
+	*p = RuneMapBool(m)
+	return
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface decoding a json object into this Map.
+// Keys must be single characters.
+func (p *RuneMapBool) UnmarshalJSON(data []byte) (err error) {
+	x := map[string]bool{}
+	if err = json.Unmarshal(data, &x); err != nil {
+		return
+	}
+	return p.fromStringKeys(x)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface decoding a yaml map into this Map.
+// Keys must be single characters.
+func (p *RuneMapBool) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	x := map[string]bool{}
+	if err = unmarshal(&x); err != nil {
+		return
+	}
+	return p.fromStringKeys(x)
+}
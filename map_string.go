@@ -2,7 +2,9 @@ package n
 
 import (
 	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/phR0ze/n/pkg/enc/msgpack"
 	yaml_enc "github.com/phR0ze/n/pkg/enc/yaml"
+	"github.com/phR0ze/n/pkg/opt"
 	yaml "github.com/phR0ze/yaml/v2"
 	"github.com/pkg/errors"
 )
@@ -111,6 +113,23 @@ func (p *StringMap) Copy(keys ...interface{}) (new IMap) {
 	return val
 }
 
+// DeepCopy returns a new Map with all key-value pairs recursively copied from this Map such that
+// nested maps and slices are no longer shared with this Map, see DeepCopy.
+func (p *StringMap) DeepCopy() (new IMap) {
+	if p == nil || len(*p) == 0 {
+		return NewStringMapV()
+	}
+	return DeepCopy(p).(*StringMap)
+}
+
+// DeepEqual tests if this Map is structurally equal to the given obj regardless of key order,
+// see DeepEqual.
+//
+// Supported options: IgnoreOrderOpt, IgnorePathsOpt and NumericOpt
+func (p *StringMap) DeepEqual(obj interface{}, opts ...*opt.Opt) bool {
+	return DeepEqual(p, obj, opts...)
+}
+
 // Delete modifies this Map to delete the indicated key-value pair and returns the value from the Map.
 func (p *StringMap) Delete(key interface{}) (val *Object) {
 	val = &Object{}
@@ -225,6 +244,11 @@ func (p *StringMap) Get(key interface{}) (val *Object) {
 	return
 }
 
+// Hash returns a stable structural hash of this Map regardless of key order, see Hash.
+func (p *StringMap) Hash() uint64 {
+	return Hash(p)
+}
+
 // Update sets the value for the given selector, using jq type selectors. Returns a reference to this Map.
 func (p *StringMap) Update(selector string, val interface{}) IMap {
 	m, _ := p.UpdateE(selector, val)
@@ -417,6 +441,33 @@ func (p *StringMap) MG() (m map[string]interface{}) {
 	return p.G()
 }
 
+// MarshalJSON implements the json.Marshaler interface encoding this Map as a json object
+// while preserving the key order.
+func (p *StringMap) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		return json.MarshalOrdered(yaml.MapSlice{})
+	}
+	return json.MarshalOrdered(yaml.MapSlice(*p))
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface encoding this Map as a msgpack map
+// while preserving the key order.
+func (p *StringMap) MarshalMsgpack() ([]byte, error) {
+	if p == nil {
+		return msgpack.Marshal(yaml.MapSlice{})
+	}
+	return msgpack.Marshal(yaml.MapSlice(*p))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface encoding this Map as a yaml map
+// while preserving the key order.
+func (p *StringMap) MarshalYAML() (interface{}, error) {
+	if p == nil {
+		return yaml.MapSlice{}, nil
+	}
+	return yaml.MapSlice(*p), nil
+}
+
 // Merge modifies this Map by overriding its values at selector with the given map
 // where they both exist and returns a reference to this Map. Converting all string
 // maps into *StringMap instances.
@@ -577,6 +628,15 @@ func (p *StringMap) QueryE(selector string, params ...interface{}) (val *Object,
 	return
 }
 
+// Reader creates a new *Reader for reading typed values out of this Map with defaults, required
+// checks and aggregated errors, see Reader.
+func (p *StringMap) Reader() *Reader {
+	if p == nil {
+		return NewReader(nil)
+	}
+	return NewReader(p)
+}
+
 // Remove modifies this Map to delete the given key location, using jq type selectors
 // and returns a reference to this Map rather than the deleted value.
 //   - `selector` supports dot notation similar to https://stedolan.github.io/jq/manual/#Basicfilters with some caveats
@@ -783,6 +843,46 @@ func (p *StringMap) ToStringMapG() (m map[string]interface{}) {
 	return p.O().(map[string]interface{})
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface decoding a json object into this Map
+// while preserving the key order.
+func (p *StringMap) UnmarshalJSON(data []byte) (err error) {
+	var x interface{}
+	if x, err = json.UnmarshalOrdered(data); err != nil {
+		return
+	}
+	switch m := x.(type) {
+	case yaml.MapSlice:
+		*p = StringMap(m)
+	case nil:
+		*p = StringMap{}
+	default:
+		err = errors.Errorf("can't unmarshal %T into StringMap", x)
+	}
+	return
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface decoding a msgpack map into this Map
+// while preserving the key order.
+func (p *StringMap) UnmarshalMsgpack(data []byte) (err error) {
+	x := yaml.MapSlice{}
+	if err = msgpack.Unmarshal(data, &x); err != nil {
+		return
+	}
+	*p = StringMap(x)
+	return
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface decoding a yaml map into this Map
+// while preserving the key order.
+func (p *StringMap) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	x := yaml.MapSlice{}
+	if err = unmarshal(&x); err != nil {
+		return
+	}
+	*p = StringMap(x)
+	return
+}
+
 // YAML converts the Map into a YAML string
 func (p *StringMap) YAML() (data string) {
 	_data, err := yaml.Marshal(yaml.MapSlice(*p))
@@ -810,8 +910,24 @@ func (p *StringMap) WriteJSON(filename string) (err error) {
 	return json.WriteJSON(filename, p.G())
 }
 
+// WriteJSONO converts the *StringMap into a map[string]interface{} then calls
+// json.WriteJSONO on it to write it out to disk.
+//
+// Supported options: json.IndentOpt, sys.AtomicOpt, sys.BackupOpt and sys.PermsOpt
+func (p *StringMap) WriteJSONO(filename string, opts ...*opt.Opt) (err error) {
+	return json.WriteJSONO(filename, p.G(), opts...)
+}
+
 // WriteYAML converts the *StringMap into a map[string]interface{} then calls
 // yaml.WriteYAML on it to write it out to disk.
 func (p *StringMap) WriteYAML(filename string) (err error) {
 	return yaml_enc.WriteYAML(filename, yaml.MapSlice(*p))
 }
+
+// WriteYAMLO converts the *StringMap into a map[string]interface{} then calls
+// yaml.WriteYAMLO on it to write it out to disk.
+//
+// Supported options: sys.AtomicOpt, sys.BackupOpt and sys.PermsOpt
+func (p *StringMap) WriteYAMLO(filename string, opts ...*opt.Opt) (err error) {
+	return yaml_enc.WriteYAMLO(filename, yaml.MapSlice(*p), opts...)
+}
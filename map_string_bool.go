@@ -1,5 +1,9 @@
 package n
 
+import (
+	"github.com/phR0ze/n/pkg/enc/json"
+)
+
 // StringMapBool implements the Map interface providing a generic way to work with map types
 // including convenience methods on par with rapid development languages.
 type StringMapBool map[string]bool
@@ -39,6 +43,22 @@ func (p *StringMapBool) Len() int {
 	return len(*p)
 }
 
+// MarshalJSON implements the json.Marshaler interface encoding this Map as a json object.
+func (p *StringMapBool) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		return json.Marshal(map[string]bool{})
+	}
+	return json.Marshal(map[string]bool(*p))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface encoding this Map as a yaml map.
+func (p *StringMapBool) MarshalYAML() (interface{}, error) {
+	if p == nil {
+		return map[string]bool{}, nil
+	}
+	return map[string]bool(*p), nil
+}
+
 // Set the value for the given key to the given val. Returns true if the key did not yet exists in this Map.
 func (p *StringMapBool) Set(key, val interface{}) bool {
 	if p == nil {
@@ -55,3 +75,23 @@ func (p *StringMapBool) Set(key, val interface{}) bool {
 	}
 	return false
 }
+
+// UnmarshalJSON implements the json.Unmarshaler interface decoding a json object into this Map.
+func (p *StringMapBool) UnmarshalJSON(data []byte) (err error) {
+	x := map[string]bool{}
+	if err = json.Unmarshal(data, &x); err != nil {
+		return
+	}
+	*p = StringMapBool(x)
+	return
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface decoding a yaml map into this Map.
+func (p *StringMapBool) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	x := map[string]bool{}
+	if err = unmarshal(&x); err != nil {
+		return
+	}
+	*p = StringMapBool(x)
+	return
+}
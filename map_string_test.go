@@ -5,6 +5,9 @@ import (
 	"os"
 	"testing"
 
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/phR0ze/n/pkg/enc/msgpack"
+	"github.com/phR0ze/n/pkg/sys"
 	yaml "github.com/phR0ze/yaml/v2"
 	"github.com/stretchr/testify/assert"
 )
@@ -181,6 +184,67 @@ func TestStringMap_Copy(t *testing.T) {
 	}
 }
 
+// DeepCopy
+// --------------------------------------------------------------------------------------------------
+func ExampleStringMap_DeepCopy() {
+	m := NewStringMapV(map[string]interface{}{"a": map[string]interface{}{"b": 1}})
+	copy := m.DeepCopy().Update("a.b", 2)
+	fmt.Println(m, copy)
+	// Output: &[{a [{b 1}]}] &[{a [{b 2}]}]
+}
+
+func TestStringMap_DeepCopy(t *testing.T) {
+
+	// nil or empty
+	{
+		var m *StringMap
+		assert.Equal(t, NewStringMapV(), m.DeepCopy())
+		assert.Equal(t, NewStringMapV(), NewStringMapV().DeepCopy())
+	}
+
+	// nested maps and slices aren't shared
+	{
+		m := NewStringMapV(map[string]interface{}{"a": map[string]interface{}{"b": 1}, "c": []interface{}{1}})
+		copy := m.DeepCopy()
+		assert.Equal(t, m, copy)
+		copy.Update("a.b", 2)
+		copy.Update("a.d", 3)
+		copy.Get("c").O().([]interface{})[0] = 2
+		assert.Equal(t, map[string]interface{}{"a": map[string]interface{}{"b": 1}, "c": []interface{}{1}}, m.O())
+		assert.Equal(t, map[string]interface{}{"a": map[string]interface{}{"b": 2, "d": 3}, "c": []interface{}{2}}, copy.O())
+	}
+}
+
+// DeepEqual
+// --------------------------------------------------------------------------------------------------
+func ExampleStringMap_DeepEqual() {
+	m := NewStringMapV(map[string]interface{}{"a": 1, "b": 2})
+	fmt.Println(m.DeepEqual(map[string]interface{}{"b": 2, "a": 1}))
+	// Output: true
+}
+
+func TestStringMap_DeepEqual(t *testing.T) {
+
+	// nil or empty
+	{
+		var m *StringMap
+		assert.True(t, m.DeepEqual(nil))
+		assert.True(t, NewStringMapV().DeepEqual(map[string]interface{}{}))
+		assert.False(t, NewStringMapV().DeepEqual(nil))
+	}
+
+	// compare with options
+	{
+		m := NewStringMapV(map[string]interface{}{"a": []int{1, 2}, "b": map[string]interface{}{"c": 1, "d": "foo"}})
+		assert.True(t, m.DeepEqual(m.DeepCopy()))
+		assert.True(t, m.DeepEqual(map[string]interface{}{"b": map[string]interface{}{"d": "foo", "c": 1}, "a": []int{1, 2}}))
+		assert.False(t, m.DeepEqual(map[string]interface{}{"a": []int{1, 2}}))
+		assert.False(t, m.DeepEqual(map[string]interface{}{"a": []int{2, 1}, "b": map[string]interface{}{"c": 1.0, "d": "bar"}}))
+		assert.True(t, m.DeepEqual(map[string]interface{}{"a": []int{2, 1}, "b": map[string]interface{}{"c": 1.0, "d": "bar"}},
+			IgnoreOrderOpt(true), NumericOpt(true), IgnorePathsOpt("b.d")))
+	}
+}
+
 // Delete
 // --------------------------------------------------------------------------------------------------
 func ExampleStringMap_Delete() {
@@ -403,6 +467,24 @@ func TestStringMap_Get(t *testing.T) {
 	assert.Equal(t, 3, m.Len())
 }
 
+// Hash
+// --------------------------------------------------------------------------------------------------
+func ExampleStringMap_Hash() {
+	m := NewStringMapV(map[string]interface{}{"a": 1})
+	before := m.Hash()
+	m.Set("a", 2)
+	fmt.Println(before == m.Hash())
+	// Output: false
+}
+
+func TestStringMap_Hash(t *testing.T) {
+	m := NewStringMapV(map[string]interface{}{"a": []int{1, 2}, "b": map[string]interface{}{"c": 1}})
+	assert.Equal(t, m.Hash(), m.DeepCopy().Hash())
+	assert.Equal(t, m.Hash(), Hash(map[string]interface{}{"b": map[string]interface{}{"c": 1}, "a": []int{1, 2}}))
+	assert.NotEqual(t, m.Hash(), m.DeepCopy().Update("b.c", 2).Hash())
+	assert.NotEqual(t, m.Hash(), NewStringMapV().Hash())
+}
+
 // Update
 // --------------------------------------------------------------------------------------------------
 func ExampleStringMap_Update() {
@@ -679,6 +761,112 @@ func TestStringMap_Len(t *testing.T) {
 	assert.Equal(t, 0, m.DeleteM("1").Len())
 }
 
+// MarshalJSON
+// --------------------------------------------------------------------------------------------------
+func TestStringMap_MarshalJSON(t *testing.T) {
+
+	// preserves key order at every depth
+	{
+		m := M().Add("b", 1).Add("a", 2.5).Add("c", M().Add("z", "3").Add("y", []interface{}{"1", M().Add("x", true)}))
+		data, err := m.MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, `{"b":1,"a":2.5,"c":{"z":"3","y":["1",{"x":true}]}}`, string(data))
+
+		m2 := M()
+		assert.Nil(t, m2.UnmarshalJSON(data))
+		assert.Equal(t, m, m2)
+		assert.Equal(t, []string{"b", "a", "c"}, m2.Keys().ToStrs())
+		assert.Equal(t, true, m2.Query("c.y.[1].x").ToBool())
+	}
+
+	// as a field in a struct
+	{
+		type config struct {
+			Name string     `json:"name"`
+			Data *StringMap `json:"data"`
+		}
+		data, err := json.Marshal(config{Name: "foo", Data: M().Add("2", "two").Add("1", "one")})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"name":"foo","data":{"2":"two","1":"one"}}`, string(data))
+
+		var c config
+		assert.Nil(t, json.Unmarshal(data, &c))
+		assert.Equal(t, M().Add("2", "two").Add("1", "one"), c.Data)
+	}
+
+	// invalid
+	assert.Equal(t, "can't unmarshal []interface {} into StringMap", M().UnmarshalJSON([]byte(`[1]`)).Error())
+}
+
+// MarshalMsgpack
+// --------------------------------------------------------------------------------------------------
+func TestStringMap_MarshalMsgpack(t *testing.T) {
+
+	// preserves key order and number types
+	{
+		m := M().Add("b", 1).Add("a", uint(2)).Add("c", M().Add("z", 3.5).Add("y", []interface{}{"1", M().Add("x", true)}))
+		data, err := m.MarshalMsgpack()
+		assert.Nil(t, err)
+
+		m2 := M()
+		assert.Nil(t, m2.UnmarshalMsgpack(data))
+		assert.Equal(t, m, m2)
+		assert.Equal(t, []string{"b", "a", "c"}, m2.Keys().ToStrs())
+		assert.Equal(t, uint(2), m2.Get("a").O())
+		assert.Equal(t, true, m2.Query("c.y.[1].x").ToBool())
+	}
+
+	// as a field in a struct
+	{
+		type config struct {
+			Name string
+			Data *StringMap
+		}
+		data, err := msgpack.Marshal(config{Name: "foo", Data: M().Add("1", "one")})
+		assert.Nil(t, err)
+
+		var c config
+		assert.Nil(t, msgpack.Unmarshal(data, &c))
+		assert.Equal(t, "foo", c.Name)
+		assert.Equal(t, M().Add("1", "one"), c.Data)
+	}
+}
+
+// MarshalYAML
+// --------------------------------------------------------------------------------------------------
+func TestStringMap_MarshalYAML(t *testing.T) {
+
+	// preserves key order
+	{
+		m := M().Add("b", 1).Add("a", M().Add("z", "3").Add("w", []interface{}{M().Add("x", true)}))
+		data, err := yaml.Marshal(m)
+		assert.Nil(t, err)
+		assert.Equal(t, "b: 1\na:\n  z: \"3\"\n  w:\n  - x: true\n", string(data))
+
+		m2 := M()
+		assert.Nil(t, yaml.Unmarshal(data, m2))
+		assert.Equal(t, m, m2)
+	}
+
+	// as a field in a struct
+	{
+		type config struct {
+			Name string     `yaml:"name"`
+			Data *StringMap `yaml:"data"`
+		}
+		data, err := yaml.Marshal(config{Name: "foo", Data: M().Add("2", "two").Add("1", "one")})
+		assert.Nil(t, err)
+		assert.Equal(t, "name: foo\ndata:\n  \"2\": two\n  \"1\": one\n", string(data))
+
+		var c config
+		assert.Nil(t, yaml.Unmarshal(data, &c))
+		assert.Equal(t, M().Add("2", "two").Add("1", "one"), c.Data)
+	}
+
+	// invalid
+	assert.NotNil(t, yaml.Unmarshal([]byte("- 1"), M()))
+}
+
 // Merge
 // --------------------------------------------------------------------------------------------------
 func ExampleStringMap_Merge() {
@@ -1196,6 +1384,31 @@ func TestStringMap_Query(t *testing.T) {
 	}
 }
 
+// Reader
+// --------------------------------------------------------------------------------------------------
+func ExampleStringMap_Reader() {
+	r := ToStringMap("port: 80\n").Reader()
+	fmt.Println(r.Int(".port", 8080), r.String(".host", "localhost"), r.Err())
+	// Output: 80 localhost <nil>
+}
+
+func TestStringMap_Reader(t *testing.T) {
+
+	// nil
+	{
+		r := (*StringMap)(nil).Reader()
+		assert.Equal(t, 8080, r.Int(".port", 8080))
+		assert.Nil(t, r.Err())
+	}
+
+	// errors
+	{
+		r := ToStringMap("port: 80\n").Reader()
+		assert.Equal(t, "", r.Required().String(".host"))
+		assert.Equal(t, "1 invalid value\n  .host: missing required value", r.Err().Error())
+	}
+}
+
 // Remove
 // --------------------------------------------------------------------------------------------------
 func ExampleStringMap_Remove() {
@@ -1524,4 +1737,14 @@ func TestWriteYAML(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, M().Add("b", "b1").Add("a", "a1"), m)
 	}
+	// atomic with backup
+	{
+		assert.NoError(t, MV("a: a2\n").WriteYAMLO(tmpFile, sys.AtomicOpt(true), sys.BackupOpt(true)))
+		data, err := sys.ReadString(tmpFile)
+		assert.NoError(t, err)
+		assert.Equal(t, "a: a2\n", data)
+		data, err = sys.ReadString(tmpFile + ".bak")
+		assert.NoError(t, err)
+		assert.Equal(t, "b: b1\na: a1\n", data)
+	}
 }
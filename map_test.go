@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	yaml "github.com/phR0ze/yaml/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -461,3 +462,96 @@ func TestStringMap_KeysFromSelector(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, &StringSlice{"1"}, keys)
 }
+
+// MarshalJSON
+// --------------------------------------------------------------------------------------------------
+func TestMapBool_MarshalJSON(t *testing.T) {
+
+	// StringMapBool
+	{
+		data, err := NewStringMapBool(map[string]bool{"a": true}).MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, `{"a":true}`, string(data))
+		m := NewStringMapBool()
+		assert.Nil(t, m.UnmarshalJSON(data))
+		assert.Equal(t, NewStringMapBool(map[string]bool{"a": true}), m)
+	}
+
+	// IntMapBool
+	{
+		data, err := NewIntMapBool(map[int]bool{2: true, 1: false}).MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, `{"1":false,"2":true}`, string(data))
+		m := NewIntMapBool()
+		assert.Nil(t, m.UnmarshalJSON(data))
+		assert.Equal(t, NewIntMapBool(map[int]bool{2: true, 1: false}), m)
+	}
+
+	// FloatMapBool
+	{
+		data, err := NewFloatMapBool(map[float64]bool{1.5: true, 2: false}).MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, `{"1.5":true,"2":false}`, string(data))
+		m := NewFloatMapBool()
+		assert.Nil(t, m.UnmarshalJSON(data))
+		assert.Equal(t, NewFloatMapBool(map[float64]bool{1.5: true, 2: false}), m)
+		assert.Equal(t, `invalid float key "a"`, m.UnmarshalJSON([]byte(`{"a":true}`)).Error())
+	}
+
+	// RuneMapBool
+	{
+		data, err := NewRuneMapBool(map[rune]bool{'a': true, 'b': false}).MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, `{"a":true,"b":false}`, string(data))
+		m := NewRuneMapBool()
+		assert.Nil(t, m.UnmarshalJSON(data))
+		assert.Equal(t, NewRuneMapBool(map[rune]bool{'a': true, 'b': false}), m)
+		assert.Equal(t, `invalid rune key "ab"`, m.UnmarshalJSON([]byte(`{"ab":true}`)).Error())
+	}
+}
+
+// MarshalYAML
+// --------------------------------------------------------------------------------------------------
+func TestMapBool_MarshalYAML(t *testing.T) {
+
+	// StringMapBool
+	{
+		data, err := yaml.Marshal(NewStringMapBool(map[string]bool{"a": true}))
+		assert.Nil(t, err)
+		assert.Equal(t, "a: true\n", string(data))
+		m := NewStringMapBool()
+		assert.Nil(t, yaml.Unmarshal(data, m))
+		assert.Equal(t, NewStringMapBool(map[string]bool{"a": true}), m)
+	}
+
+	// IntMapBool
+	{
+		data, err := yaml.Marshal(NewIntMapBool(map[int]bool{2: true, 1: false}))
+		assert.Nil(t, err)
+		assert.Equal(t, "1: false\n2: true\n", string(data))
+		m := NewIntMapBool()
+		assert.Nil(t, yaml.Unmarshal(data, m))
+		assert.Equal(t, NewIntMapBool(map[int]bool{2: true, 1: false}), m)
+	}
+
+	// FloatMapBool
+	{
+		data, err := yaml.Marshal(NewFloatMapBool(map[float64]bool{1.5: true}))
+		assert.Nil(t, err)
+		assert.Equal(t, "1.5: true\n", string(data))
+		m := NewFloatMapBool()
+		assert.Nil(t, yaml.Unmarshal(data, m))
+		assert.Equal(t, NewFloatMapBool(map[float64]bool{1.5: true}), m)
+	}
+
+	// RuneMapBool
+	{
+		data, err := yaml.Marshal(NewRuneMapBool(map[rune]bool{'a': true}))
+		assert.Nil(t, err)
+		assert.Equal(t, "a: true\n", string(data))
+		m := NewRuneMapBool()
+		assert.Nil(t, yaml.Unmarshal(data, m))
+		assert.Equal(t, NewRuneMapBool(map[rune]bool{'a': true}), m)
+		assert.NotNil(t, yaml.Unmarshal([]byte("ab: true"), m))
+	}
+}
@@ -3,6 +3,10 @@ package n
 import (
 	"time"
 
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/phR0ze/n/pkg/enc/msgpack"
+	yaml_enc "github.com/phR0ze/n/pkg/enc/yaml"
+	"github.com/phR0ze/n/pkg/opt"
 	"github.com/pkg/errors"
 )
 
@@ -41,6 +45,39 @@ func (p *Object) A() string {
 	return p.String()
 }
 
+// Convert converts the Object's value into the value pointed to by target using registered
+// converters, see Convert.
+func (p *Object) Convert(target interface{}) error {
+	return Convert(p.O(), target)
+}
+
+// DeepCopy returns a new Object with a deep copy of the Object's value, see DeepCopy.
+func (p *Object) DeepCopy() *Object {
+	if p == nil {
+		return &Object{}
+	}
+	return &Object{DeepCopy(p.o)}
+}
+
+// DeepEqual tests if the Object's value is structurally equal to the given obj, see DeepEqual.
+//
+// Supported options: IgnoreOrderOpt, IgnorePathsOpt and NumericOpt
+func (p *Object) DeepEqual(obj interface{}, opts ...*opt.Opt) bool {
+	return DeepEqual(p.O(), obj, opts...)
+}
+
+// Hash returns a stable structural hash of the Object's value, see Hash.
+func (p *Object) Hash() uint64 {
+	return Hash(p.O())
+}
+
+// Inspect returns a pretty printed representation of the Object's value, see Dump.
+//
+// Supported options: ColorOpt, MaxDepthOpt, MaxItemsOpt, TypesOpt and UnexportedOpt
+func (p *Object) Inspect(opts ...*opt.Opt) string {
+	return Inspect(p.O(), opts...)
+}
+
 // M is an alias to ToStringMap
 func (p *Object) M() *StringMap {
 	return p.ToStringMap()
@@ -64,6 +101,54 @@ func (p *Object) String() string {
 	return ToString(p.o)
 }
 
+// MarshalJSON implements the json.Marshaler interface encoding the wrapped value.
+func (p *Object) MarshalJSON() ([]byte, error) {
+	return json.MarshalOrdered(p.O())
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface encoding the wrapped value.
+func (p *Object) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(p.O())
+}
+
+// MarshalYAML implements the yaml.Marshaler interface encoding the wrapped value.
+func (p *Object) MarshalYAML() (interface{}, error) {
+	return p.O(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface decoding into the wrapped value.
+// Maps are decoded as ordered yaml.MapSlice values to match the StringMap representation.
+func (p *Object) UnmarshalJSON(data []byte) (err error) {
+	var x interface{}
+	if x, err = json.UnmarshalOrdered(data); err != nil {
+		return
+	}
+	p.o = x
+	return
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface decoding into the wrapped value.
+// Maps are decoded as ordered yaml.MapSlice values to match the StringMap representation.
+func (p *Object) UnmarshalMsgpack(data []byte) (err error) {
+	var x interface{}
+	if err = msgpack.UnmarshalOrdered(data, &x); err != nil {
+		return
+	}
+	p.o = x
+	return
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface decoding into the wrapped value.
+// Maps are decoded as ordered yaml.MapSlice values to match the StringMap representation.
+func (p *Object) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	x := &yaml_enc.Ordered{}
+	if err = unmarshal(x); err != nil {
+		return
+	}
+	p.o = x.Value
+	return
+}
+
 // Bool
 //--------------------------------------------------------------------------------------------------
 
@@ -142,6 +227,12 @@ func (p *Object) QueryE(key string) (obj *Object, err error) {
 	return
 }
 
+// Reader creates a new *Reader for reading typed values out of an object if it is a StringMap
+// type, see Reader.
+func (p *Object) Reader() *Reader {
+	return NewReader(p.O())
+}
+
 // Time related
 //--------------------------------------------------------------------------------------------------
 
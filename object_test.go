@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	yaml "github.com/phR0ze/yaml/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -18,6 +19,18 @@ func TestObject_Query(t *testing.T) {
 	assert.Equal(t, "invalid key", err.Error())
 }
 
+func TestObject_Reader(t *testing.T) {
+	obj := NewStringMapV(map[string]interface{}{"one": map[string]interface{}{"two": "2"}}).Query("one")
+	r := obj.Reader()
+	assert.Equal(t, 2, r.Int("two"))
+	assert.Equal(t, 3, r.Int("three", 3))
+	assert.Nil(t, r.Err())
+
+	// Check non map objects
+	assert.Equal(t, ".: expected map but got int 1", (&Object{1}).Reader().Err().(ReaderErrors)[0].Error())
+	assert.Nil(t, (*Object)(nil).Reader().Err())
+}
+
 func TestObject_ToBool(t *testing.T) {
 
 	// w/out error
@@ -52,6 +65,55 @@ func TestObject_ToTime(t *testing.T) {
 	}
 }
 
+func TestObject_Convert(t *testing.T) {
+	defer registerTestConverters()()
+
+	var c color
+	assert.Nil(t, Obj("blue").Convert(&c))
+	assert.Equal(t, blue, c)
+
+	var s string
+	assert.Nil(t, Obj(green).Convert(&s))
+	assert.Equal(t, "green", s)
+
+	var x ip
+	assert.Nil(t, Obj("10.0.0.1").Convert(&x))
+	assert.Equal(t, ip{10, 0, 0, 1}, x)
+}
+
+func TestObject_DeepCopy(t *testing.T) {
+	var obj *Object
+	assert.Equal(t, &Object{}, obj.DeepCopy())
+
+	obj = Obj(map[string]interface{}{"a": []int{1}})
+	copy := obj.DeepCopy()
+	assert.Equal(t, obj, copy)
+	copy.MG()["a"].([]int)[0] = 2
+	assert.Equal(t, map[string]interface{}{"a": []int{1}}, obj.O())
+}
+
+func TestObject_DeepEqual(t *testing.T) {
+	var obj *Object
+	assert.True(t, obj.DeepEqual(nil))
+	assert.True(t, Obj([]int{1, 2}).DeepEqual(NewIntSliceV(1, 2)))
+	assert.False(t, Obj([]int{1, 2}).DeepEqual([]float64{2, 1}))
+	assert.True(t, Obj([]int{1, 2}).DeepEqual([]float64{2, 1}, IgnoreOrderOpt(true), NumericOpt(true)))
+}
+
+func TestObject_Hash(t *testing.T) {
+	var obj *Object
+	assert.Equal(t, Hash(nil), obj.Hash())
+	assert.Equal(t, Obj([]int{1, 2}).Hash(), NewIntSliceV(1, 2).Hash())
+	assert.NotEqual(t, Obj([]int{1, 2}).Hash(), Obj([]int{2, 1}).Hash())
+}
+
+func TestObject_Inspect(t *testing.T) {
+	var obj *Object
+	assert.Equal(t, "nil", obj.Inspect())
+	assert.Equal(t, "(int) 1", Obj(1).Inspect())
+	assert.Equal(t, "[\n  1,\n]", Obj([]int{1}).Inspect(TypesOpt(false)))
+}
+
 func TestObject_ToDuration(t *testing.T) {
 
 	// w/out error
@@ -389,3 +451,90 @@ func TestObject_ToIntSlice(t *testing.T) {
 		assert.IsType(t, []int{}, obj)
 	}
 }
+
+func TestObject_MarshalMsgpack(t *testing.T) {
+
+	// scalar
+	{
+		data, err := Obj(uint8(3)).MarshalMsgpack()
+		assert.Nil(t, err)
+		obj := &Object{}
+		assert.Nil(t, obj.UnmarshalMsgpack(data))
+		assert.Equal(t, uint(3), obj.O())
+	}
+
+	// nested nub types
+	{
+		data, err := Obj(NewStringSliceV("1", "2")).MarshalMsgpack()
+		assert.Nil(t, err)
+		obj := &Object{}
+		assert.Nil(t, obj.UnmarshalMsgpack(data))
+		assert.Equal(t, []string{"1", "2"}, obj.ToStrs())
+	}
+
+	// RefSlice
+	{
+		data, err := Obj(NewRefSliceV(Integer{1}, Integer{2})).MarshalMsgpack()
+		assert.Nil(t, err)
+		obj := &Object{}
+		assert.Nil(t, obj.UnmarshalMsgpack(data))
+		assert.Equal(t, []interface{}{yaml.MapSlice{{Key: "Value", Value: 1}}, yaml.MapSlice{{Key: "Value", Value: 2}}}, obj.O())
+	}
+}
+
+// MarshalJSON
+// --------------------------------------------------------------------------------------------------
+func TestObject_MarshalJSON(t *testing.T) {
+
+	// scalars
+	{
+		data, err := Obj(3).MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, "3", string(data))
+
+		obj := &Object{}
+		assert.Nil(t, obj.UnmarshalJSON([]byte(`"foo"`)))
+		assert.Equal(t, "foo", obj.O())
+	}
+
+	// maps are ordered
+	{
+		data, err := Obj(M().Add("b", 1).Add("a", 2)).MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, `{"b":1,"a":2}`, string(data))
+
+		obj := &Object{}
+		assert.Nil(t, obj.UnmarshalJSON(data))
+		assert.Equal(t, yaml.MapSlice{{Key: "b", Value: 1}, {Key: "a", Value: 2}}, obj.O())
+	}
+
+	// invalid
+	assert.NotNil(t, (&Object{}).UnmarshalJSON([]byte(`{"a"`)))
+}
+
+// MarshalYAML
+// --------------------------------------------------------------------------------------------------
+func TestObject_MarshalYAML(t *testing.T) {
+
+	// scalars
+	{
+		data, err := yaml.Marshal(Obj(3))
+		assert.Nil(t, err)
+		assert.Equal(t, "3\n", string(data))
+
+		obj := &Object{}
+		assert.Nil(t, yaml.Unmarshal([]byte("foo"), obj))
+		assert.Equal(t, "foo", obj.O())
+	}
+
+	// maps are ordered at every depth
+	{
+		data, err := yaml.Marshal(Obj([]interface{}{M().Add("b", 1).Add("a", 2)}))
+		assert.Nil(t, err)
+		assert.Equal(t, "- b: 1\n  a: 2\n", string(data))
+
+		obj := &Object{}
+		assert.Nil(t, yaml.Unmarshal(data, obj))
+		assert.Equal(t, []interface{}{yaml.MapSlice{{Key: "b", Value: 1}, {Key: "a", Value: 2}}}, obj.O())
+	}
+}
@@ -0,0 +1,145 @@
+package n
+
+import (
+	"github.com/phR0ze/n/pkg/opt"
+)
+
+// ColorOpt creates a new color option with the given value. When true Dump and Inspect syntax
+// highlight their output with ANSI escape codes and when false they don't. Without the option
+// Dump only highlights when writing to a terminal and Inspect never does.
+// -------------------------------------------------------------------------------------------------
+func ColorOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "color", Val: val}
+}
+
+// get the color option from the options slice defaulting to the given value
+func getColorOpt(opts []*opt.Opt, def bool) (result bool) {
+	result = def
+	if o := opt.Get(opts, "color"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// IgnoreOrderOpt creates a new ignore order option with the given value. When true DeepEqual
+// will treat lists as equal if they contain the same elements regardless of their order.
+// -------------------------------------------------------------------------------------------------
+func IgnoreOrderOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "ignore-order", Val: val}
+}
+
+// get the ignore order option from the options slice defaulting to false
+func getIgnoreOrderOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "ignore-order"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// IgnorePathsOpt creates a new ignore paths option with the given paths. DeepEqual will skip
+// the values at the given paths which use the same jq type selectors as Query e.g. "foo.[0].bar"
+// with "*" matching any key and "[]" matching any index.
+// -------------------------------------------------------------------------------------------------
+func IgnorePathsOpt(paths ...string) *opt.Opt {
+	return &opt.Opt{Key: "ignore-paths", Val: paths}
+}
+
+// get the ignore paths option from the options slice defaulting to none
+func getIgnorePathsOpt(opts []*opt.Opt) (result []string) {
+	if o := opt.Get(opts, "ignore-paths"); o != nil {
+		if val, ok := o.Val.([]string); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// MaxDepthOpt creates a new max depth option with the given value. Dump will elide values
+// nested deeper than the given depth with "...".
+// -------------------------------------------------------------------------------------------------
+func MaxDepthOpt(val int) *opt.Opt {
+	return &opt.Opt{Key: "max-depth", Val: val}
+}
+
+// get the max depth option from the options slice defaulting to 0 for unlimited
+func getMaxDepthOpt(opts []*opt.Opt) (result int) {
+	if o := opt.Get(opts, "max-depth"); o != nil {
+		if val, ok := o.Val.(int); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// MaxItemsOpt creates a new max items option with the given value. Dump will elide the
+// elements of a collection beyond the given number with "... n more".
+// -------------------------------------------------------------------------------------------------
+func MaxItemsOpt(val int) *opt.Opt {
+	return &opt.Opt{Key: "max-items", Val: val}
+}
+
+// get the max items option from the options slice defaulting to 0 for unlimited
+func getMaxItemsOpt(opts []*opt.Opt) (result int) {
+	if o := opt.Get(opts, "max-items"); o != nil {
+		if val, ok := o.Val.(int); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// NumericOpt creates a new numeric option with the given value. When true DeepEqual will treat
+// numbers of different types as equal if their values are equal e.g. int 1 and float64 1.0.
+// -------------------------------------------------------------------------------------------------
+func NumericOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "numeric", Val: val}
+}
+
+// get the numeric option from the options slice defaulting to false
+func getNumericOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "numeric"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// TypesOpt creates a new types option with the given value. When false Dump won't annotate
+// values with their types.
+// -------------------------------------------------------------------------------------------------
+func TypesOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "types", Val: val}
+}
+
+// get the types option from the options slice defaulting to true
+func getTypesOpt(opts []*opt.Opt) (result bool) {
+	result = true
+	if o := opt.Get(opts, "types"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// UnexportedOpt creates a new unexported option with the given value. When true Dump will
+// include unexported struct fields.
+// -------------------------------------------------------------------------------------------------
+func UnexportedOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "unexported", Val: val}
+}
+
+// get the unexported option from the options slice defaulting to false
+func getUnexportedOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "unexported"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
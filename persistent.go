@@ -0,0 +1,232 @@
+package n
+
+// Persistent weight balanced trees shared by PersistentMap, ordered by key and by insertion,
+// and PersistentVector, ordered by position. Nodes are never modified once created so every operation copies only the
+// O(log n) nodes along its path and shares the rest with the original tree. All operations are
+// built on join and split as described in "Just Join for Parallel Ordered Sets" by Blelloch,
+// Ferizovic and Sun.
+//--------------------------------------------------------------------------------------------------
+
+// pnode is an immutable node of a persistent weight balanced tree
+type pnode struct {
+	key         string      // key of map nodes
+	val         interface{} // value of the node
+	seq         uint64      // insertion sequence number of map nodes
+	size        int         // number of nodes in this subtree
+	left, right *pnode      // subtrees
+}
+
+// plen returns the number of nodes in the given tree
+func plen(t *pnode) int {
+	if t == nil {
+		return 0
+	}
+	return t.size
+}
+
+// pnew creates a new node with the key and value of the given node between the given subtrees
+func pnew(left, mid, right *pnode) *pnode {
+	return &pnode{key: mid.key, val: mid.val, seq: mid.seq, size: plen(left) + plen(right) + 1, left: left, right: right}
+}
+
+// pbalanced tests if subtrees of the given sizes are balanced i.e. the weight of each is at least
+// 29% of their combined weight where the weight of a tree is its size plus one
+func pbalanced(a, b int) bool {
+	wa, wb := a+1, b+1
+	return 100*wa >= 29*(wa+wb) && 100*wb >= 29*(wa+wb)
+}
+
+// pjoin returns a balanced tree of the given subtrees with the given node between them where all
+// nodes in left order before the node and all nodes in right order after it
+func pjoin(left, mid, right *pnode) *pnode {
+	l, r := plen(left)+1, plen(right)+1
+	switch {
+	case 100*r < 29*(l+r):
+		return pjoinRight(left, mid, right)
+	case 100*l < 29*(l+r):
+		return pjoinLeft(left, mid, right)
+	}
+	return pnew(left, mid, right)
+}
+
+// pjoinRight joins the given trees where left is heavier by descending its right spine
+func pjoinRight(left, mid, right *pnode) *pnode {
+	if pbalanced(plen(left), plen(right)) {
+		return pnew(left, mid, right)
+	}
+	t := pjoinRight(left.right, mid, right)
+	switch {
+	case pbalanced(plen(left.left), plen(t)):
+		return pnew(left.left, left, t)
+	case pbalanced(plen(left.left), plen(t.left)) && pbalanced(plen(left.left)+plen(t.left)+1, plen(t.right)):
+		return protateLeft(pnew(left.left, left, t))
+	}
+	return protateLeft(pnew(left.left, left, protateRight(t)))
+}
+
+// pjoinLeft joins the given trees where right is heavier by descending its left spine
+func pjoinLeft(left, mid, right *pnode) *pnode {
+	if pbalanced(plen(left), plen(right)) {
+		return pnew(left, mid, right)
+	}
+	t := pjoinLeft(left, mid, right.left)
+	switch {
+	case pbalanced(plen(t), plen(right.right)):
+		return pnew(t, right, right.right)
+	case pbalanced(plen(t.right), plen(right.right)) && pbalanced(plen(t.left), plen(t.right)+plen(right.right)+1):
+		return protateRight(pnew(t, right, right.right))
+	}
+	return protateRight(pnew(protateLeft(t), right, right.right))
+}
+
+// protateLeft makes the right child of the given tree its root
+func protateLeft(t *pnode) *pnode {
+	return pnew(pnew(t.left, t, t.right.left), t.right, t.right.right)
+}
+
+// protateRight makes the left child of the given tree its root
+func protateRight(t *pnode) *pnode {
+	return pnew(t.left.left, t.left, pnew(t.left.right, t, t.right))
+}
+
+// pconcat returns a balanced tree of the nodes in left followed by the nodes in right
+func pconcat(left, right *pnode) *pnode {
+	if left == nil {
+		return right
+	}
+	rest, last := psplitLast(left)
+	return pjoin(rest, last, right)
+}
+
+// psplitLast returns the given non empty tree without its last node along with that node
+func psplitLast(t *pnode) (rest, last *pnode) {
+	if t.right == nil {
+		return t.left, t
+	}
+	rest, last = psplitLast(t.right)
+	return pjoin(t.left, t, rest), last
+}
+
+// Positional operations
+//--------------------------------------------------------------------------------------------------
+
+// pat returns the node at the given position which must be in bounds
+func pat(t *pnode, i int) *pnode {
+	for {
+		switch l := plen(t.left); {
+		case i < l:
+			t = t.left
+		case i > l:
+			t, i = t.right, i-l-1
+		default:
+			return t
+		}
+	}
+}
+
+// pfromSlice returns a perfectly balanced tree of the given values in O(n)
+func pfromSlice(vals []interface{}) *pnode {
+	if len(vals) == 0 {
+		return nil
+	}
+	m := len(vals) / 2
+	left, right := pfromSlice(vals[:m]), pfromSlice(vals[m+1:])
+	return &pnode{val: vals[m], size: len(vals), left: left, right: right}
+}
+
+// psetAt returns a copy of the given tree with the value at the given position replaced which
+// must be in bounds
+func psetAt(t *pnode, i int, val interface{}) *pnode {
+	switch l := plen(t.left); {
+	case i < l:
+		return &pnode{val: t.val, size: t.size, left: psetAt(t.left, i, val), right: t.right}
+	case i > l:
+		return &pnode{val: t.val, size: t.size, left: t.left, right: psetAt(t.right, i-l-1, val)}
+	}
+	return &pnode{val: val, size: t.size, left: t.left, right: t.right}
+}
+
+// psplitAt returns the first i nodes of the given tree and the rest
+func psplitAt(t *pnode, i int) (left, right *pnode) {
+	if t == nil {
+		return nil, nil
+	}
+	l := plen(t.left)
+	if i <= l {
+		left, right = psplitAt(t.left, i)
+		return left, pjoin(right, t, t.right)
+	}
+	left, right = psplitAt(t.right, i-l-1)
+	return pjoin(t.left, t, left), right
+}
+
+// Keyed operations
+//--------------------------------------------------------------------------------------------------
+
+// pdelete returns a copy of the given tree without the node with the given key
+func pdelete(t *pnode, key string) *pnode {
+	switch {
+	case t == nil:
+		return nil
+	case key < t.key:
+		if left := pdelete(t.left, key); left != t.left {
+			return pjoin(left, t, t.right)
+		}
+		return t
+	case key > t.key:
+		if right := pdelete(t.right, key); right != t.right {
+			return pjoin(t.left, t, right)
+		}
+		return t
+	}
+	return pconcat(t.left, t.right)
+}
+
+// pget returns the node with the given key or nil if not found
+func pget(t *pnode, key string) *pnode {
+	for t != nil {
+		switch {
+		case key < t.key:
+			t = t.left
+		case key > t.key:
+			t = t.right
+		default:
+			return t
+		}
+	}
+	return nil
+}
+
+// pput returns a copy of the given tree with the given key set to the given value. The given
+// sequence number is only used for new keys, existing keys keep theirs.
+func pput(t *pnode, key string, val interface{}, seq uint64) *pnode {
+	switch {
+	case t == nil:
+		return &pnode{key: key, val: val, seq: seq, size: 1}
+	case key < t.key:
+		return pjoin(pput(t.left, key, val, seq), t, t.right)
+	case key > t.key:
+		return pjoin(t.left, t, pput(t.right, key, val, seq))
+	}
+	return &pnode{key: key, val: val, seq: t.seq, size: t.size, left: t.left, right: t.right}
+}
+
+// Iteration
+//--------------------------------------------------------------------------------------------------
+
+// peach calls the given action for each node of the given tree in order until it returns false
+func peach(t *pnode, action func(*pnode) bool) bool {
+	if t == nil {
+		return true
+	}
+	return peach(t.left, action) && action(t) && peach(t.right, action)
+}
+
+// peachR calls the given action for each node of the given tree in reverse order until it
+// returns false
+func peachR(t *pnode, action func(*pnode) bool) bool {
+	if t == nil {
+		return true
+	}
+	return peachR(t.right, action) && action(t) && peachR(t.left, action)
+}
@@ -0,0 +1,116 @@
+package n
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pcheck validates the sizes and balance of the given tree returning its size
+func pcheck(t *pnode) (size int, err error) {
+	if t == nil {
+		return 0, nil
+	}
+	var l, r int
+	if l, err = pcheck(t.left); err != nil {
+		return
+	}
+	if r, err = pcheck(t.right); err != nil {
+		return
+	}
+	if t.size != l+r+1 {
+		return 0, fmt.Errorf("invalid size %d for subtrees of %d and %d", t.size, l, r)
+	}
+	if !pbalanced(l, r) {
+		return 0, fmt.Errorf("unbalanced subtrees of %d and %d", l, r)
+	}
+	return t.size, nil
+}
+
+// pvals returns the values of the given tree in order
+func pvals(t *pnode) (vals []interface{}) {
+	peach(t, func(n *pnode) bool {
+		vals = append(vals, n.val)
+		return true
+	})
+	return
+}
+
+func TestPersistent_Positional(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	var tree *pnode
+	expected := []interface{}{}
+	for i := 0; i < 2000; i++ {
+		old, oldVals := tree, pvals(tree)
+		switch op := rng.Intn(5); {
+
+		// insert
+		case op < 2:
+			j := rng.Intn(len(expected) + 1)
+			left, right := psplitAt(tree, j)
+			tree = pjoin(left, &pnode{val: i}, right)
+			expected = append(expected[:j], append([]interface{}{i}, expected[j:]...)...)
+
+		// drop a range
+		case op == 2 && len(expected) > 0:
+			j := rng.Intn(len(expected))
+			k := min(j+rng.Intn(3), len(expected)-1)
+			left, rest := psplitAt(tree, j)
+			_, right := psplitAt(rest, k-j+1)
+			tree = pconcat(left, right)
+			expected = append(expected[:j:j], expected[k+1:]...)
+
+		// concat a balanced tree
+		case op == 3:
+			tree = pconcat(tree, pfromSlice([]interface{}{i, i + 1, i + 2}))
+			expected = append(expected, i, i+1, i+2)
+
+		// set
+		case op == 4 && len(expected) > 0:
+			j := rng.Intn(len(expected))
+			tree = psetAt(tree, j, -i)
+			expected[j] = -i
+		}
+
+		_, err := pcheck(tree)
+		assert.Nil(t, err)
+		assert.Equal(t, oldVals, pvals(old))
+	}
+	assert.Equal(t, expected, pvals(tree))
+	for i := range expected {
+		assert.Equal(t, expected[i], pat(tree, i).val)
+	}
+}
+
+func TestPersistent_Keyed(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	var tree *pnode
+	expected := map[string]int{}
+	for i := 0; i < 5000; i++ {
+		key := fmt.Sprint(rng.Intn(500))
+		if rng.Intn(3) == 0 {
+			tree = pdelete(tree, key)
+			delete(expected, key)
+		} else {
+			tree = pput(tree, key, i, 0)
+			expected[key] = i
+		}
+		_, err := pcheck(tree)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, len(expected), plen(tree))
+	for key, val := range expected {
+		assert.Equal(t, val, pget(tree, key).val)
+	}
+	assert.Nil(t, pget(tree, "missing"))
+
+	// keys are in order
+	last := ""
+	peach(tree, func(n *pnode) bool {
+		assert.True(t, last < n.key)
+		last = n.key
+		return true
+	})
+}
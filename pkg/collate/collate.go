@@ -0,0 +1,192 @@
+// Package collate provides string orderings beyond Go's byte wise comparison i.e. natural
+// ordering where embedded numbers compare numerically, version ordering following semantic
+// versioning precedence and locale independent case insensitive ordering.
+//
+// All comparisons return -1 if a sorts before b, 0 if they are equivalent and 1 if a sorts
+// after b in the same way as strings.Compare.
+package collate
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Fold compares a and b rune by rune ignoring case using Unicode simple case folding which
+// unlike strings.ToLower doesn't depend on locale e.g. 'K', 'k' and the Kelvin sign all fold
+// to 'k'. Strings that differ only in case are equivalent.
+func Fold(a, b string) int {
+	for a != "" && b != "" {
+		x, i := utf8.DecodeRuneInString(a)
+		y, j := utf8.DecodeRuneInString(b)
+		if c := compareRune(x, y, true); c != 0 {
+			return c
+		}
+		a, b = a[i:], b[j:]
+	}
+	return compareInt(len(a), len(b))
+}
+
+// Natural compares a and b treating runs of ASCII digits as numbers such that file2 sorts
+// before file10. Numbers that are equal but zero padded differently sort by length such that
+// file1 sorts before file01.
+func Natural(a, b string) int {
+	return natural(a, b, false)
+}
+
+// NaturalFold compares a and b the same as Natural but ignores case the same as Fold
+func NaturalFold(a, b string) int {
+	return natural(a, b, true)
+}
+
+// Version compares a and b as versions following semantic versioning precedence. An optional
+// leading 'v' is ignored, dot separated numbers compare numerically with missing numbers
+// treated as 0, a release sorts after its pre-releases e.g. 1.0.0-rc.1 < 1.0.0 and build
+// metadata e.g. +build.5 is ignored. Anything that isn't a number is compared naturally
+// such that non conforming versions e.g. 1.0.0-rc2 < 1.0.0-rc10 still order sensibly.
+func Version(a, b string) int {
+	acore, apre := splitVersion(a)
+	bcore, bpre := splitVersion(b)
+
+	// Compare the dot separated core with missing parts treated as 0
+	x, y := strings.Split(acore, "."), strings.Split(bcore, ".")
+	for i := 0; i < max(len(x), len(y)); i++ {
+		p, q := "0", "0"
+		if i < len(x) {
+			p = x[i]
+		}
+		if i < len(y) {
+			q = y[i]
+		}
+		if c := natural(p, q, false); c != 0 {
+			return c
+		}
+	}
+
+	// A release has higher precedence than any of its pre-releases
+	switch {
+	case apre == "" && bpre == "":
+		return 0
+	case apre == "":
+		return 1
+	case bpre == "":
+		return -1
+	}
+
+	// Numeric identifiers have lower precedence than alphanumeric ones and a larger set of
+	// identifiers has higher precedence when all preceding identifiers are equal.
+	x, y = strings.Split(apre, "."), strings.Split(bpre, ".")
+	for i := 0; i < min(len(x), len(y)); i++ {
+		xnum, ynum := isNumber(x[i]), isNumber(y[i])
+		switch {
+		case xnum && !ynum:
+			return -1
+		case !xnum && ynum:
+			return 1
+		}
+		if c := natural(x[i], y[i], false); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(x), len(y))
+}
+
+// natural compares a and b treating runs of ASCII digits as numbers, optionally ignoring case
+func natural(a, b string, fold bool) int {
+	for a != "" && b != "" {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			i, j := digits(a), digits(b)
+			if c := compareNumber(a[:i], b[:j]); c != 0 {
+				return c
+			}
+			a, b = a[i:], b[j:]
+			continue
+		}
+		x, i := utf8.DecodeRuneInString(a)
+		y, j := utf8.DecodeRuneInString(b)
+		if c := compareRune(x, y, fold); c != 0 {
+			return c
+		}
+		a, b = a[i:], b[j:]
+	}
+	return compareInt(len(a), len(b))
+}
+
+// compareNumber compares the two strings of ASCII digits numerically without size limits
+// falling back on the length such that more zero padding sorts later.
+func compareNumber(a, b string) int {
+	x, y := strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+	if c := compareInt(len(x), len(y)); c != 0 {
+		return c
+	}
+	if c := strings.Compare(x, y); c != 0 {
+		return c
+	}
+	return compareInt(len(a), len(b))
+}
+
+// compareRune compares the two runes optionally ignoring case
+func compareRune(x, y rune, fold bool) int {
+	if fold {
+		x, y = foldRune(x), foldRune(y)
+	}
+	return compareInt(int(x), int(y))
+}
+
+// compareInt compares the two ints
+func compareInt(x, y int) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+// digits returns the number of leading ASCII digits in the given string
+func digits(s string) (i int) {
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return
+}
+
+// foldRune returns the lower case form of the smallest rune equivalent to the given rune under
+// Unicode simple case folding such that all case variants of a rune fold to the same rune.
+func foldRune(r rune) rune {
+	if r < utf8.RuneSelf {
+		if 'A' <= r && r <= 'Z' {
+			r += 'a' - 'A'
+		}
+		return r
+	}
+	lo := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		lo = min(lo, f)
+	}
+	return unicode.ToLower(lo)
+}
+
+// isDigit tests if the given byte is an ASCII digit
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+// isNumber tests if the given string is made up entirely of ASCII digits
+func isNumber(s string) bool {
+	return s != "" && digits(s) == len(s)
+}
+
+// splitVersion strips the optional leading 'v' and build metadata from the given version and
+// splits it into its core and pre-release
+func splitVersion(version string) (core, pre string) {
+	if len(version) > 1 && (version[0] == 'v' || version[0] == 'V') && isDigit(version[1]) {
+		version = version[1:]
+	}
+	if i := strings.IndexByte(version, '+'); i != -1 {
+		version = version[:i]
+	}
+	core, pre, _ = strings.Cut(version, "-")
+	return
+}
@@ -0,0 +1,100 @@
+package collate
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFold(t *testing.T) {
+
+	// equal ignoring case
+	assert.Equal(t, 0, Fold("", ""))
+	assert.Equal(t, 0, Fold("Hello", "hELLO"))
+	assert.Equal(t, 0, Fold("Straße", "STRAßE"))
+	assert.Equal(t, 0, Fold("ΣΊΣΥΦΟΣ", "σίσυφος"))
+	assert.Equal(t, 0, Fold("k", "K"))
+
+	// ordered ignoring case
+	assert.Equal(t, -1, Fold("apple", "Banana"))
+	assert.Equal(t, 1, Fold("Banana", "apple"))
+	assert.Equal(t, -1, Fold("app", "Apple"))
+	assert.Equal(t, 1, Fold("Apple", "app"))
+
+	// underscore sorts before letters regardless of case
+	assert.Equal(t, -1, Fold("_", "A"))
+	assert.Equal(t, -1, Fold("_", "a"))
+
+	// dotless i doesn't fold to i without a turkish locale
+	assert.NotEqual(t, 0, Fold("ı", "i"))
+}
+
+func TestNatural(t *testing.T) {
+
+	// plain strings
+	assert.Equal(t, 0, Natural("", ""))
+	assert.Equal(t, 0, Natural("abc", "abc"))
+	assert.Equal(t, -1, Natural("abc", "abd"))
+	assert.Equal(t, -1, Natural("ab", "abc"))
+	assert.Equal(t, -1, Natural("B", "a"))
+
+	// embedded numbers
+	assert.Equal(t, -1, Natural("file2", "file10"))
+	assert.Equal(t, 1, Natural("file10", "file2"))
+	assert.Equal(t, -1, Natural("file2.txt", "file2a.txt"))
+	assert.Equal(t, -1, Natural("2", "a"))
+	assert.Equal(t, -1, Natural("a1b2", "a1b10"))
+	assert.Equal(t, -1, Natural("x99999999999999999999999", "x100000000000000000000000"))
+
+	// zero padding
+	assert.Equal(t, -1, Natural("file1", "file01"))
+	assert.Equal(t, -1, Natural("file01", "file2"))
+
+	// sort
+	{
+		files := []string{"file10", "file1", "file2", "File3", "file01"}
+		sort.Slice(files, func(i, j int) bool { return Natural(files[i], files[j]) < 0 })
+		assert.Equal(t, []string{"File3", "file1", "file01", "file2", "file10"}, files)
+	}
+}
+
+func TestNaturalFold(t *testing.T) {
+	assert.Equal(t, 0, NaturalFold("File10", "file10"))
+	assert.Equal(t, -1, NaturalFold("file2", "FILE10"))
+	{
+		files := []string{"file10", "file1", "file2", "File3"}
+		sort.Slice(files, func(i, j int) bool { return NaturalFold(files[i], files[j]) < 0 })
+		assert.Equal(t, []string{"file1", "file2", "File3", "file10"}, files)
+	}
+}
+
+func TestVersion(t *testing.T) {
+
+	// core
+	assert.Equal(t, 0, Version("1.2.3", "1.2.3"))
+	assert.Equal(t, 0, Version("v1.2.3", "1.2.3"))
+	assert.Equal(t, 0, Version("1.2", "1.2.0"))
+	assert.Equal(t, -1, Version("1.2.3", "1.2.10"))
+	assert.Equal(t, -1, Version("1.9.0", "1.10.0"))
+	assert.Equal(t, 1, Version("2.0.0", "1.99.99"))
+
+	// pre-release
+	assert.Equal(t, -1, Version("1.0.0-alpha", "1.0.0"))
+	assert.Equal(t, 1, Version("1.0.0", "1.0.0-rc.1"))
+	assert.Equal(t, -1, Version("1.0.0-rc2", "1.0.0-rc10"))
+
+	// build metadata is ignored
+	assert.Equal(t, 0, Version("1.0.0+build.1", "1.0.0+build.2"))
+	assert.Equal(t, -1, Version("1.0.0-rc.1+build.1", "1.0.0"))
+
+	// semver spec precedence example
+	{
+		expected := []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta",
+			"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0"}
+		versions := []string{"1.0.0", "1.0.0-beta.11", "1.0.0-alpha.beta", "1.0.0-rc.1",
+			"1.0.0-alpha", "1.0.0-beta.2", "1.0.0-alpha.1", "1.0.0-beta"}
+		sort.Slice(versions, func(i, j int) bool { return Version(versions[i], versions[j]) < 0 })
+		assert.Equal(t, expected, versions)
+	}
+}
@@ -0,0 +1,189 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/pkg/errors"
+)
+
+// MarshalCanonical returns the canonical json encoding of the given obj as described by
+// RFC 8785 (JSON Canonicalization Scheme) such that the output is suitable for hashing and
+// signing. The obj is first marshalled normally, honoring any custom marshalers, then
+// re-encoded with no whitespace, object keys sorted by their UTF-16 code units, numbers
+// formatted as IEEE 754 doubles the way ECMAScript does and minimal string escaping.
+func MarshalCanonical(o interface{}) (data []byte, err error) {
+	if data, err = json.Marshal(o); err != nil {
+		err = errors.Wrapf(err, "failed to marshal object %T", o)
+		return
+	}
+	return Canonicalize(data)
+}
+
+// Canonicalize converts the given json data into its RFC 8785 canonical form
+func Canonicalize(data []byte) (result []byte, err error) {
+	var obj interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err = dec.Decode(&obj); err != nil {
+		err = errors.Wrap(err, "failed to unmarshal json")
+		return
+	}
+	buf := &bytes.Buffer{}
+	if err = writeCanonical(buf, obj); err != nil {
+		return
+	}
+	result = buf.Bytes()
+	return
+}
+
+// writeCanonical writes the decoded json value in canonical form
+func writeCanonical(buf *bytes.Buffer, o interface{}) (err error) {
+	switch x := o.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(x))
+	case json.Number:
+		var f float64
+		if f, err = strconv.ParseFloat(string(x), 64); err != nil {
+			err = errors.Errorf("invalid number %s for canonical json", x)
+			return
+		}
+		var str string
+		if str, err = FormatNumber(f); err != nil {
+			return
+		}
+		buf.WriteString(str)
+	case string:
+		writeCanonicalString(buf, x)
+	case []interface{}:
+		buf.WriteByte('[')
+		for i := range x {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err = writeCanonical(buf, x[i]); err != nil {
+				return
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return lessUTF16(keys[i], keys[j])
+		})
+		buf.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeCanonicalString(buf, k)
+			buf.WriteByte(':')
+			if err = writeCanonical(buf, x[k]); err != nil {
+				return
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		err = errors.Errorf("unsupported type %T for canonical json", o)
+	}
+	return
+}
+
+// writeCanonicalString writes the string escaping only what is required
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	const hex = "0123456789abcdef"
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hex[r>>4])
+				buf.WriteByte(hex[r&0xf])
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
+
+// lessUTF16 compares the two strings by their UTF-16 code units as required by RFC 8785
+func lessUTF16(a, b string) bool {
+	x, y := utf16.Encode([]rune(a)), utf16.Encode([]rune(b))
+	for i := 0; i < len(x) && i < len(y); i++ {
+		if x[i] != y[i] {
+			return x[i] < y[i]
+		}
+	}
+	return len(x) < len(y)
+}
+
+// FormatNumber formats the given float the way ECMAScript's Number.prototype.toString does
+// as required by RFC 8785. NaN and Infinity are not valid json and return an error.
+func FormatNumber(f float64) (str string, err error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		err = errors.Errorf("invalid number %v for canonical json", f)
+		return
+	}
+	if f == 0 {
+		return "0", nil
+	}
+
+	sign := ""
+	if f < 0 {
+		sign, f = "-", -f
+	}
+
+	// Shortest round trip digits and exponent e.g. 1.2345e+06 => "12345", 6
+	exp := strconv.FormatFloat(f, 'e', -1, 64)
+	i := strings.IndexByte(exp, 'e')
+	digits := strings.Replace(exp[:i], ".", "", 1)
+	e, _ := strconv.Atoi(exp[i+1:])
+	k, n := len(digits), e+1
+
+	switch {
+	case k <= n && n <= 21:
+		str = digits + strings.Repeat("0", n-k)
+	case 0 < n && n <= 21:
+		str = digits[:n] + "." + digits[n:]
+	case -6 < n && n <= 0:
+		str = "0." + strings.Repeat("0", -n) + digits
+	default:
+		str = digits[:1]
+		if k > 1 {
+			str += "." + digits[1:]
+		}
+		if n-1 >= 0 {
+			str += "e+" + strconv.Itoa(n-1)
+		} else {
+			str += "e" + strconv.Itoa(n-1)
+		}
+	}
+	str = sign + str
+	return
+}
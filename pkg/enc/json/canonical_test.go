@@ -0,0 +1,78 @@
+package json
+
+import (
+	"math"
+	"testing"
+
+	yaml "github.com/phR0ze/yaml/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalCanonical(t *testing.T) {
+
+	// sorted keys, no whitespace and normalized numbers
+	{
+		obj := map[string]interface{}{
+			"numbers":  []interface{}{333333333.33333329, 1e30, 4.50, 2e-3, 0.000000000000000000000000001, -0.0, 100},
+			"string":   "€$\u000f\nA'B\"\\\\\"/<>&",
+			"literals": []interface{}{nil, true, false},
+		}
+		data, err := MarshalCanonical(obj)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27,0,100],"string":"€$\u000f\nA'B\"\\\\\"/<>&"}`, string(data))
+	}
+
+	// keys are sorted by utf-16 code units
+	{
+		obj := map[string]int{"\u20ac": 1, "\r": 2, "\ufb33": 3, "1": 4, "\U0001f600": 5, "\u0080": 6, "\u00f6": 7}
+		data, err := MarshalCanonical(obj)
+		assert.Nil(t, err)
+		assert.Equal(t, "{\"\\r\":2,\"1\":4,\"\u0080\":6,\"\u00f6\":7,\"\u20ac\":1,\"\U0001f600\":5,\"\ufb33\":3}", string(data))
+	}
+
+	// ordered maps are sorted too
+	{
+		data, err := MarshalCanonical(map[string]interface{}{"a": yaml.MapSlice{{Key: "b", Value: 1}}})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"a":[{"Key":"b","Value":1}]}`, string(data))
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	data, err := Canonicalize([]byte(`{ "b" : [ 1.0 , 2E2 ], "a" : "\u0041" }`))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"a":"A","b":[1,200]}`, string(data))
+
+	_, err = Canonicalize([]byte(`{"a"`))
+	assert.NotNil(t, err)
+}
+
+func TestFormatNumber(t *testing.T) {
+	for _, x := range []struct {
+		f   float64
+		str string
+	}{
+		{0, "0"},
+		{math.Copysign(0, -1), "0"},
+		{1, "1"},
+		{-1.5, "-1.5"},
+		{123456789012345680000, "123456789012345680000"},
+		{1e21, "1e+21"},
+		{1e-6, "0.000001"},
+		{1e-7, "1e-7"},
+		{5e-324, "5e-324"},
+		{1.7976931348623157e308, "1.7976931348623157e+308"},
+		{9007199254740992, "9007199254740992"},
+		{295147905179352830000, "295147905179352830000"},
+		{0.30000000000000004, "0.30000000000000004"},
+	} {
+		str, err := FormatNumber(x.f)
+		assert.Nil(t, err)
+		assert.Equal(t, x.str, str)
+	}
+
+	_, err := FormatNumber(math.NaN())
+	assert.Equal(t, "invalid number NaN for canonical json", err.Error())
+	_, err = FormatNumber(math.Inf(1))
+	assert.Equal(t, "invalid number +Inf for canonical json", err.Error())
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/phR0ze/n/pkg/opt"
 	"github.com/phR0ze/n/pkg/sys"
 	"github.com/pkg/errors"
 )
@@ -16,8 +17,10 @@ func Marshal(o interface{}) ([]byte, error) {
 }
 
 // ReadJSON reads the target file and returns a map[string]interface{} data
-// structure representing the json read in.
-func ReadJSON(filepath string) (obj map[string]interface{}, err error) {
+// structure representing the json read in. Files with comments, trailing commas,
+// single quoted strings or unquoted keys e.g. VS Code settings can be read by
+// passing in TolerantOpt(true).
+func ReadJSON(filepath string, opts ...*opt.Opt) (obj map[string]interface{}, err error) {
 	if filepath, err = sys.Abs(filepath); err != nil {
 		return
 	}
@@ -29,6 +32,14 @@ func ReadJSON(filepath string) (obj map[string]interface{}, err error) {
 		return
 	}
 
+	// Strip out tolerant syntax if requested
+	if getTolerantOpt(opts) {
+		if data, err = Standardize(data); err != nil {
+			err = errors.Wrapf(err, "failed to parse the file %s", filepath)
+			return
+		}
+	}
+
 	// Convert data structure into a json string
 	if err = json.Unmarshal(data, &obj); err != nil {
 		err = errors.Wrapf(err, "failed to unmarshal object %T", obj)
@@ -44,6 +55,19 @@ func Unmarshal(y []byte, o interface{}) error {
 // WriteJSON converts the given obj interface{} into json then writes to disk
 // with default permissions. Expects obj to be a structure that encoding/json understands
 func WriteJSON(filepath string, obj interface{}, indent ...int) (err error) {
+	opts := []*opt.Opt{}
+	if len(indent) > 0 {
+		opts = append(opts, IndentOpt(indent[0]))
+	}
+	return WriteJSONO(filepath, obj, opts...)
+}
+
+// WriteJSONO converts the given obj interface{} into json then writes to disk
+// with default permissions. Expects obj to be a structure that encoding/json understands.
+// Pass in CanonicalOpt(true) to write canonical json instead, see MarshalCanonical.
+//
+// Supported options: CanonicalOpt, IndentOpt, sys.AtomicOpt, sys.BackupOpt and sys.PermsOpt
+func WriteJSONO(filepath string, obj interface{}, opts ...*opt.Opt) (err error) {
 	if filepath, err = sys.Abs(filepath); err != nil {
 		return
 	}
@@ -56,14 +80,15 @@ func WriteJSON(filepath string, obj interface{}, indent ...int) (err error) {
 	}
 
 	// Set indent level
-	i := 2
-	if len(indent) > 0 {
-		i = indent[0]
-	}
+	i := getIndentOpt(opts)
 
-	// Convert data structure into a json string
+	// Convert data structure into a json string ignoring the indent for canonical json
 	var data []byte
-	if i == 0 {
+	if getCanonicalOpt(opts) {
+		if data, err = MarshalCanonical(obj); err != nil {
+			return
+		}
+	} else if i == 0 {
 		if data, err = json.Marshal(obj); err != nil {
 			err = errors.Wrapf(err, "failed to marshal object %T", obj)
 			return
@@ -75,8 +100,7 @@ func WriteJSON(filepath string, obj interface{}, indent ...int) (err error) {
 		}
 	}
 
-	// Use default permissions for file
-	if err = os.WriteFile(filepath, data, os.FileMode(0644)); err != nil {
+	if err = sys.WriteBytesO(filepath, data, opts...); err != nil {
 		err = errors.Wrapf(err, "failed to write out json data to file %s", filepath)
 	}
 	return
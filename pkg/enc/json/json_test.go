@@ -42,6 +42,54 @@ func TestReadJSON(t *testing.T) {
 	assert.Equal(t, data1, data2)
 }
 
+func TestReadJSONTolerant(t *testing.T) {
+	clearTmpDir()
+
+	// Write out a vscode style settings file
+	jsondata := `{
+	// editor settings
+	"editor.tabSize": 2,
+	'files.exclude': {"**/.git": true,},
+}`
+	assert.Nil(t, os.WriteFile(tmpfile, []byte(jsondata), 0644))
+
+	// Strict parsing fails
+	_, err := ReadJSON(tmpfile)
+	assert.NotNil(t, err)
+
+	// Tolerant parsing succeeds
+	data, err := ReadJSON(tmpfile, TolerantOpt(true))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"editor.tabSize": 2.0, "files.exclude": map[string]interface{}{"**/.git": true}}, data)
+
+	// Errors include the position
+	assert.Nil(t, os.WriteFile(tmpfile, []byte("{\n  a: 1\n  b: 2\n}"), 0644))
+	_, err = ReadJSON(tmpfile, TolerantOpt(true))
+	assert.Contains(t, err.Error(), "invalid character 'b' expecting ',' or '}' after object value at line 3, column 3")
+}
+
+func TestWriteJSONCanonical(t *testing.T) {
+	clearTmpDir()
+
+	// Canonical ignores the indent
+	obj := map[string]interface{}{"b": []interface{}{1.0, 2.5}, "a": "<x>"}
+	err := WriteJSONO(tmpfile, obj, CanonicalOpt(true), IndentOpt(4))
+	assert.Nil(t, err)
+	data, err := os.ReadFile(tmpfile)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"a":"<x>","b":[1,2.5]}`, string(data))
+
+	// Canonical and atomic
+	err = WriteJSONO(tmpfile, obj, CanonicalOpt(true), sys.AtomicOpt(true))
+	assert.Nil(t, err)
+	data, err = os.ReadFile(tmpfile)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"a":"<x>","b":[1,2.5]}`, string(data))
+
+	// invalid data structures
+	assert.Equal(t, "invalid data structure to marshal - string", WriteJSONO(tmpfile, "foo", CanonicalOpt(true)).Error())
+}
+
 func TestWriteJSONCompact(t *testing.T) {
 	clearTmpDir()
 
@@ -57,6 +105,13 @@ func TestWriteJSONCompact(t *testing.T) {
 	data, err := os.ReadFile(tmpfile)
 	assert.Nil(t, err)
 	assert.Equal(t, jsondata1, string(data))
+
+	// Compact and atomic
+	err = WriteJSONO(tmpfile, data1, IndentOpt(0), sys.AtomicOpt(true))
+	assert.Nil(t, err)
+	data, err = os.ReadFile(tmpfile)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"foo":{"bar":[1,2]}}`, string(data))
 }
 
 func TestWriteJSONPretty(t *testing.T) {
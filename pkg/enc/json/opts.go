@@ -0,0 +1,58 @@
+package json
+
+import (
+	"github.com/phR0ze/n/pkg/opt"
+)
+
+// TolerantOpt creates a new tolerant option with the given value. When true json will be
+// parsed in tolerant mode allowing comments, trailing commas, single quoted strings and
+// unquoted object keys. See Standardize for details.
+// -------------------------------------------------------------------------------------------------
+func TolerantOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "tolerant", Val: val}
+}
+
+// get the tolerant option from the options slice defaulting to false
+func getTolerantOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "tolerant"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// CanonicalOpt creates a new canonical option with the given value. When true json will be
+// written in canonical form as described by RFC 8785. See MarshalCanonical for details.
+// -------------------------------------------------------------------------------------------------
+func CanonicalOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "canonical", Val: val}
+}
+
+// get the canonical option from the options slice defaulting to false
+func getCanonicalOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "canonical"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// IndentOpt creates a new indent option with the given number of spaces to indent json with. An
+// indent of 0 writes compact json.
+// -------------------------------------------------------------------------------------------------
+func IndentOpt(val int) *opt.Opt {
+	return &opt.Opt{Key: "indent", Val: val}
+}
+
+// get the indent option from the options slice defaulting to 2
+func getIndentOpt(opts []*opt.Opt) (result int) {
+	result = 2
+	if o := opt.Get(opts, "indent"); o != nil {
+		if val, ok := o.Val.(int); ok {
+			result = val
+		}
+	}
+	return
+}
@@ -0,0 +1,204 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sort"
+
+	yaml "github.com/phR0ze/yaml/v2"
+	"github.com/pkg/errors"
+)
+
+// MarshalOrdered returns the json encoding of the given obj just like Marshal except that
+// any yaml.MapSlice values found in the obj are encoded as json objects preserving the
+// order of their keys rather than as a json array of key/value items.
+func MarshalOrdered(o interface{}) (data []byte, err error) {
+	buf := &bytes.Buffer{}
+	if err = marshalOrdered(buf, o); err != nil {
+		return
+	}
+	data = buf.Bytes()
+	return
+}
+
+// marshalOrdered walks the maps and slices encoding yaml.MapSlice values in order
+func marshalOrdered(buf *bytes.Buffer, o interface{}) (err error) {
+	switch x := o.(type) {
+	case yaml.MapSlice:
+		buf.WriteByte('{')
+		for i := range x {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			var key []byte
+			if key, err = json.Marshal(toKey(x[i].Key)); err != nil {
+				return
+			}
+			buf.Write(key)
+			buf.WriteByte(':')
+			if err = marshalOrdered(buf, x[i].Value); err != nil {
+				return
+			}
+		}
+		buf.WriteByte('}')
+
+	case []yaml.MapSlice:
+		if x == nil {
+			buf.WriteString("null")
+			return
+		}
+		buf.WriteByte('[')
+		for i := range x {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err = marshalOrdered(buf, x[i]); err != nil {
+				return
+			}
+		}
+		buf.WriteByte(']')
+
+	case []interface{}:
+		if x == nil {
+			buf.WriteString("null")
+			return
+		}
+		buf.WriteByte('[')
+		for i := range x {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err = marshalOrdered(buf, x[i]); err != nil {
+				return
+			}
+		}
+		buf.WriteByte(']')
+
+	case map[string]interface{}:
+		if x == nil {
+			buf.WriteString("null")
+			return
+		}
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		m := make(yaml.MapSlice, 0, len(keys))
+		for _, k := range keys {
+			m = append(m, yaml.MapItem{Key: k, Value: x[k]})
+		}
+		return marshalOrdered(buf, m)
+
+	default:
+		var data []byte
+		if data, err = json.Marshal(o); err != nil {
+			err = errors.Wrapf(err, "failed to marshal object %T", o)
+			return
+		}
+		buf.Write(data)
+	}
+	return
+}
+
+// toKey converts the given map key into a string suitable for a json object key
+func toKey(key interface{}) string {
+	switch x := key.(type) {
+	case string:
+		return x
+	case nil:
+		return ""
+	}
+	data, err := json.Marshal(key)
+	if err != nil {
+		return ""
+	}
+	if len(data) > 1 && data[0] == '"' {
+		var str string
+		if json.Unmarshal(data, &str) == nil {
+			return str
+		}
+	}
+	return string(data)
+}
+
+// UnmarshalOrdered parses the json encoded data and returns the result where json objects
+// are returned as yaml.MapSlice preserving the order of their keys. Integral numbers are
+// returned as int and all other numbers as float64.
+func UnmarshalOrdered(data []byte) (obj interface{}, err error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if obj, err = decodeOrdered(dec); err != nil {
+		err = errors.Wrap(err, "failed to unmarshal json")
+		return
+	}
+	if _, e := dec.Token(); e != io.EOF {
+		obj = nil
+		err = errors.Errorf("invalid trailing data after json value")
+	}
+	return
+}
+
+// decodeOrdered decodes the next json value from the token stream
+func decodeOrdered(dec *json.Decoder) (obj interface{}, err error) {
+	var token json.Token
+	if token, err = dec.Token(); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return
+	}
+
+	switch x := token.(type) {
+	case json.Delim:
+		switch x {
+		case '{':
+			m := yaml.MapSlice{}
+			for dec.More() {
+				var key json.Token
+				if key, err = dec.Token(); err != nil {
+					return
+				}
+				var value interface{}
+				if value, err = decodeOrdered(dec); err != nil {
+					return
+				}
+				m = append(m, yaml.MapItem{Key: key.(string), Value: value})
+			}
+			if _, err = dec.Token(); err != nil {
+				return
+			}
+			obj = m
+		case '[':
+			s := []interface{}{}
+			for dec.More() {
+				var value interface{}
+				if value, err = decodeOrdered(dec); err != nil {
+					return
+				}
+				s = append(s, value)
+			}
+			if _, err = dec.Token(); err != nil {
+				return
+			}
+			obj = s
+		default:
+			err = errors.Errorf("invalid json delimiter %v", x)
+		}
+	case json.Number:
+		obj = toNumber(x)
+	default:
+		obj = x
+	}
+	return
+}
+
+// toNumber converts the json number into an int if integral else a float64
+func toNumber(x json.Number) interface{} {
+	if i, err := x.Int64(); err == nil && int64(int(i)) == i {
+		return int(i)
+	}
+	f, _ := x.Float64()
+	return f
+}
@@ -0,0 +1,61 @@
+package json
+
+import (
+	"testing"
+
+	yaml "github.com/phR0ze/yaml/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalOrdered(t *testing.T) {
+
+	// ordered maps at every depth
+	{
+		obj := yaml.MapSlice{{Key: "b", Value: 1}, {Key: "a", Value: []interface{}{
+			yaml.MapSlice{{Key: "z", Value: "1"}, {Key: "y", Value: nil}}}}}
+		data, err := MarshalOrdered(obj)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"b":1,"a":[{"z":"1","y":null}]}`, string(data))
+	}
+
+	// go maps are sorted and non string keys converted
+	{
+		obj := map[string]interface{}{"b": yaml.MapSlice{{Key: 2, Value: true}, {Key: 1, Value: false}}, "a": 1.5}
+		data, err := MarshalOrdered(obj)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"a":1.5,"b":{"2":true,"1":false}}`, string(data))
+	}
+
+	// unsupported values
+	{
+		_, err := MarshalOrdered(yaml.MapSlice{{Key: "a", Value: make(chan int)}})
+		assert.Equal(t, "failed to marshal object chan int: json: unsupported type: chan int", err.Error())
+	}
+}
+
+func TestUnmarshalOrdered(t *testing.T) {
+
+	// ordered maps at every depth
+	{
+		obj, err := UnmarshalOrdered([]byte(`{"b": 1, "a": [{"z": 1.5, "y": null}, "x", true, 1e100]}`))
+		assert.Nil(t, err)
+		assert.Equal(t, yaml.MapSlice{{Key: "b", Value: 1}, {Key: "a", Value: []interface{}{
+			yaml.MapSlice{{Key: "z", Value: 1.5}, {Key: "y", Value: nil}}, "x", true, 1e100}}}, obj)
+	}
+
+	// scalars
+	{
+		obj, err := UnmarshalOrdered([]byte(`"foo"`))
+		assert.Nil(t, err)
+		assert.Equal(t, "foo", obj)
+	}
+
+	// invalid
+	{
+		_, err := UnmarshalOrdered([]byte(`{"a": 1`))
+		assert.Equal(t, "failed to unmarshal json: unexpected end of JSON input", err.Error())
+
+		_, err = UnmarshalOrdered([]byte(`1 2`))
+		assert.Equal(t, "invalid trailing data after json value", err.Error())
+	}
+}
@@ -0,0 +1,394 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// SyntaxError describes a syntax error found while parsing json in tolerant mode along with
+// the position in the original input that the error was found at.
+type SyntaxError struct {
+	Msg    string // description of the error
+	Offset int    // byte offset into the input
+	Line   int    // line number starting at 1
+	Column int    // column number in runes starting at 1
+}
+
+// Error implements the error interface
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s at line %d, column %d", e.Msg, e.Line, e.Column)
+}
+
+// Standardize converts the given tolerant json data into strict json. Tolerant json is a
+// subset of JSON5 commonly used for configuration files e.g. VS Code settings and supports:
+// `//` line comments, `/* */` block comments, trailing commas in objects and arrays, single
+// quoted strings and unquoted object keys consisting of letters, digits, '_' and '$'.
+// Errors are returned as *SyntaxError with the line and column of the original input.
+func Standardize(data []byte) (result []byte, err error) {
+	p := &tolerantParser{data: data}
+	if err = p.skip(); err != nil {
+		return
+	}
+	if p.eof() {
+		err = p.errorf("unexpected end of input")
+		return
+	}
+	if err = p.value(); err != nil {
+		return
+	}
+	if err = p.skip(); err != nil {
+		return
+	}
+	if !p.eof() {
+		err = p.errorf("invalid character %q after top-level value", p.peekRune())
+		return
+	}
+	result = p.out.Bytes()
+	return
+}
+
+// UnmarshalTolerant parses the tolerant json data and stores the result in the value pointed
+// to by o. See Standardize for the supported syntax.
+func UnmarshalTolerant(data []byte, o interface{}) (err error) {
+	if data, err = Standardize(data); err != nil {
+		return
+	}
+	if err = json.Unmarshal(data, o); err != nil {
+		err = errors.Wrapf(err, "failed to unmarshal object %T", o)
+	}
+	return
+}
+
+// tolerantParser converts tolerant json into strict json validating as it goes
+type tolerantParser struct {
+	data []byte
+	pos  int
+	out  bytes.Buffer
+}
+
+func (p *tolerantParser) eof() bool {
+	return p.pos >= len(p.data)
+}
+
+func (p *tolerantParser) peekRune() rune {
+	r, _ := utf8.DecodeRune(p.data[p.pos:])
+	return r
+}
+
+// errorf creates a new syntax error at the current position
+func (p *tolerantParser) errorf(format string, a ...interface{}) error {
+	return p.errorAt(p.pos, format, a...)
+}
+
+// errorAt creates a new syntax error at the given offset calculating the line and column
+func (p *tolerantParser) errorAt(offset int, format string, a ...interface{}) error {
+	if offset > len(p.data) {
+		offset = len(p.data)
+	}
+	line, start := 1, 0
+	for i := 0; i < offset; i++ {
+		if p.data[i] == '\n' {
+			line++
+			start = i + 1
+		}
+	}
+	return &SyntaxError{Msg: fmt.Sprintf(format, a...), Offset: offset,
+		Line: line, Column: utf8.RuneCount(p.data[start:offset]) + 1}
+}
+
+// skip whitespace and comments
+func (p *tolerantParser) skip() (err error) {
+	for !p.eof() {
+		switch p.data[p.pos] {
+		case ' ', '\t', '\n', '\r':
+			p.pos++
+		case '/':
+			if p.pos+1 >= len(p.data) {
+				return p.errorf("invalid character '/'")
+			}
+			switch p.data[p.pos+1] {
+			case '/':
+				for !p.eof() && p.data[p.pos] != '\n' {
+					p.pos++
+				}
+			case '*':
+				start := p.pos
+				end := bytes.Index(p.data[p.pos+2:], []byte("*/"))
+				if end == -1 {
+					return p.errorAt(start, "unterminated block comment")
+				}
+				p.pos += end + 4
+			default:
+				return p.errorf("invalid character '/'")
+			}
+		default:
+			return
+		}
+	}
+	return
+}
+
+// value parses any json value at the current position
+func (p *tolerantParser) value() (err error) {
+	switch c := p.data[p.pos]; {
+	case c == '{':
+		return p.object()
+	case c == '[':
+		return p.array()
+	case c == '"' || c == '\'':
+		return p.str()
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.number()
+	default:
+		for _, lit := range []string{"true", "false", "null"} {
+			if bytes.HasPrefix(p.data[p.pos:], []byte(lit)) && !isIdent(p.data, p.pos+len(lit)) {
+				p.out.WriteString(lit)
+				p.pos += len(lit)
+				return
+			}
+		}
+		return p.errorf("invalid character %q looking for beginning of value", p.peekRune())
+	}
+}
+
+// object parses a json object allowing unquoted keys and a trailing comma
+func (p *tolerantParser) object() (err error) {
+	start := p.pos
+	p.pos++
+	p.out.WriteByte('{')
+	for first := true; ; first = false {
+		if err = p.skip(); err != nil {
+			return
+		}
+		if p.eof() {
+			return p.errorAt(start, "unterminated object")
+		}
+		if p.data[p.pos] == '}' {
+			p.pos++
+			p.out.WriteByte('}')
+			return
+		}
+		if !first {
+			p.out.WriteByte(',')
+		}
+
+		// Key
+		switch c := p.data[p.pos]; {
+		case c == '"' || c == '\'':
+			if err = p.str(); err != nil {
+				return
+			}
+		case isIdentStart(c):
+			end := p.pos
+			for isIdent(p.data, end) {
+				end++
+			}
+			p.out.WriteByte('"')
+			p.out.Write(p.data[p.pos:end])
+			p.out.WriteByte('"')
+			p.pos = end
+		default:
+			return p.errorf("invalid character %q looking for beginning of object key", p.peekRune())
+		}
+
+		// Colon
+		if err = p.skip(); err != nil {
+			return
+		}
+		if p.eof() || p.data[p.pos] != ':' {
+			return p.expected("':' after object key")
+		}
+		p.pos++
+		p.out.WriteByte(':')
+
+		// Value
+		if err = p.skip(); err != nil {
+			return
+		}
+		if p.eof() {
+			return p.errorAt(start, "unterminated object")
+		}
+		if err = p.value(); err != nil {
+			return
+		}
+
+		// Separator
+		if err = p.skip(); err != nil {
+			return
+		}
+		if p.eof() {
+			return p.errorAt(start, "unterminated object")
+		}
+		switch p.data[p.pos] {
+		case ',':
+			p.pos++
+		case '}':
+		default:
+			return p.expected("',' or '}' after object value")
+		}
+	}
+}
+
+// array parses a json array allowing a trailing comma
+func (p *tolerantParser) array() (err error) {
+	start := p.pos
+	p.pos++
+	p.out.WriteByte('[')
+	for first := true; ; first = false {
+		if err = p.skip(); err != nil {
+			return
+		}
+		if p.eof() {
+			return p.errorAt(start, "unterminated array")
+		}
+		if p.data[p.pos] == ']' {
+			p.pos++
+			p.out.WriteByte(']')
+			return
+		}
+		if !first {
+			p.out.WriteByte(',')
+		}
+		if err = p.value(); err != nil {
+			return
+		}
+		if err = p.skip(); err != nil {
+			return
+		}
+		if p.eof() {
+			return p.errorAt(start, "unterminated array")
+		}
+		switch p.data[p.pos] {
+		case ',':
+			p.pos++
+		case ']':
+		default:
+			return p.expected("',' or ']' after array element")
+		}
+	}
+}
+
+// str parses a single or double quoted string writing it out double quoted
+func (p *tolerantParser) str() (err error) {
+	start := p.pos
+	quote := p.data[p.pos]
+	p.pos++
+	p.out.WriteByte('"')
+	for {
+		if p.eof() {
+			return p.errorAt(start, "unterminated string")
+		}
+		c := p.data[p.pos]
+		switch {
+		case c == quote:
+			p.pos++
+			p.out.WriteByte('"')
+			return
+		case c < 0x20:
+			return p.errorf("invalid control character %q in string", rune(c))
+		case c == '"':
+			p.out.WriteString(`\"`)
+			p.pos++
+		case c == '\\':
+			if p.pos+1 >= len(p.data) {
+				return p.errorAt(start, "unterminated string")
+			}
+			switch e := p.data[p.pos+1]; e {
+			case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+				p.out.Write(p.data[p.pos : p.pos+2])
+				p.pos += 2
+			case '\'':
+				p.out.WriteByte('\'')
+				p.pos += 2
+			case 'u':
+				if p.pos+6 > len(p.data) || !isHex(p.data[p.pos+2:p.pos+6]) {
+					return p.errorf("invalid unicode escape in string")
+				}
+				p.out.Write(p.data[p.pos : p.pos+6])
+				p.pos += 6
+			default:
+				return p.errorf("invalid escape character %q in string", rune(e))
+			}
+		default:
+			p.out.WriteByte(c)
+			p.pos++
+		}
+	}
+}
+
+// number parses a strict json number
+func (p *tolerantParser) number() (err error) {
+	start := p.pos
+	digits := func() int {
+		n := 0
+		for !p.eof() && p.data[p.pos] >= '0' && p.data[p.pos] <= '9' {
+			p.pos++
+			n++
+		}
+		return n
+	}
+
+	if p.data[p.pos] == '-' {
+		p.pos++
+	}
+	if !p.eof() && p.data[p.pos] == '0' {
+		p.pos++
+	} else if digits() == 0 {
+		return p.errorf("invalid number")
+	}
+	if !p.eof() && p.data[p.pos] == '.' {
+		p.pos++
+		if digits() == 0 {
+			return p.errorf("invalid number")
+		}
+	}
+	if !p.eof() && (p.data[p.pos] == 'e' || p.data[p.pos] == 'E') {
+		p.pos++
+		if !p.eof() && (p.data[p.pos] == '+' || p.data[p.pos] == '-') {
+			p.pos++
+		}
+		if digits() == 0 {
+			return p.errorf("invalid number")
+		}
+	}
+	if isIdent(p.data, p.pos) {
+		return p.errorf("invalid character %q in number", p.peekRune())
+	}
+	p.out.Write(p.data[start:p.pos])
+	return
+}
+
+// expected creates an error for the current position noting what was expected
+func (p *tolerantParser) expected(what string) error {
+	if p.eof() {
+		return p.errorf("unexpected end of input expecting %s", what)
+	}
+	return p.errorf("invalid character %q expecting %s", p.peekRune(), what)
+}
+
+// isIdentStart tests if the given character can start an unquoted key
+func isIdentStart(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// isIdent tests if the character at the given position can be part of an unquoted key
+func isIdent(data []byte, i int) bool {
+	if i >= len(data) {
+		return false
+	}
+	return isIdentStart(data[i]) || (data[i] >= '0' && data[i] <= '9')
+}
+
+// isHex tests if the given characters are all hex digits
+func isHex(data []byte) bool {
+	for _, c := range data {
+		if !((c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')) {
+			return false
+		}
+	}
+	return true
+}
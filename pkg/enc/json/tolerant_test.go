@@ -0,0 +1,91 @@
+package json
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStandardize(t *testing.T) {
+
+	// strict json passes through untouched
+	{
+		data, err := Standardize([]byte(`{"a":[1,-2.5e3,"x\u00e9\n"],"b":{"c":null,"d":true,"e":false}}`))
+		assert.Nil(t, err)
+		assert.Equal(t, `{"a":[1,-2.5e3,"x\u00e9\n"],"b":{"c":null,"d":true,"e":false}}`, string(data))
+	}
+
+	// comments and trailing commas
+	{
+		data, err := Standardize([]byte(`// settings
+{
+	/* editor
+	   settings */
+	"editor.fontSize": 14, // font
+	"files.exclude": [
+		"**/.git",
+	],
+}
+`))
+		assert.Nil(t, err)
+		assert.Equal(t, `{"editor.fontSize":14,"files.exclude":["**/.git"]}`, string(data))
+	}
+
+	// single quotes and unquoted keys
+	{
+		data, err := Standardize([]byte(`{name: 'it\'s "quoted"', $id_1: 'a', "b": "c'd"}`))
+		assert.Nil(t, err)
+		assert.Equal(t, `{"name":"it's \"quoted\"","$id_1":"a","b":"c'd"}`, string(data))
+	}
+
+	// scalars
+	{
+		data, err := Standardize([]byte(" 'foo' // comment"))
+		assert.Nil(t, err)
+		assert.Equal(t, `"foo"`, string(data))
+	}
+}
+
+func TestStandardize_errors(t *testing.T) {
+	for _, x := range []struct {
+		data string
+		err  string
+	}{
+		{"", "unexpected end of input at line 1, column 1"},
+		{"{\n  \"a\": 1\n  \"b\": 2\n}", `invalid character '"' expecting ',' or '}' after object value at line 3, column 3`},
+		{"[1, 2", "unterminated array at line 1, column 1"},
+		{"{\"a\": 1", "unterminated object at line 1, column 1"},
+		{"{a 1}", "invalid character '1' expecting ':' after object key at line 1, column 4"},
+		{"{1: 2}", "invalid character '1' looking for beginning of object key at line 1, column 2"},
+		{"[1,,2]", "invalid character ',' looking for beginning of value at line 1, column 4"},
+		{"\"héllo", "unterminated string at line 1, column 1"},
+		{"[\"é\", tru]", "invalid character 't' looking for beginning of value at line 1, column 7"},
+		{"/* open", "unterminated block comment at line 1, column 1"},
+		{"{} {}", "invalid character '{' after top-level value at line 1, column 4"},
+		{"[01]", "invalid character '1' in number at line 1, column 3"},
+		{"[1.]", "invalid number at line 1, column 4"},
+		{"['\\x']", "invalid escape character 'x' in string at line 1, column 3"},
+		{"[\"\\u12\"]", "invalid unicode escape in string at line 1, column 3"},
+		{"[1a]", "invalid character 'a' in number at line 1, column 3"},
+	} {
+		_, err := Standardize([]byte(x.data))
+		assert.Equal(t, x.err, err.Error(), x.data)
+	}
+
+	// error details
+	_, err := Standardize([]byte("{\n\ta: @\n}"))
+	serr, ok := err.(*SyntaxError)
+	assert.True(t, ok)
+	assert.Equal(t, &SyntaxError{Msg: "invalid character '@' looking for beginning of value", Offset: 6, Line: 2, Column: 5}, serr)
+}
+
+func TestUnmarshalTolerant(t *testing.T) {
+	obj := map[string]interface{}{}
+	assert.Nil(t, UnmarshalTolerant([]byte("{a: [1, 2,], /* b */ c: 'd',}"), &obj))
+	assert.Equal(t, map[string]interface{}{"a": []interface{}{1.0, 2.0}, "c": "d"}, obj)
+
+	// type errors
+	var list []int
+	assert.Equal(t, "failed to unmarshal object *[]int: json: cannot unmarshal object into Go value of type []int",
+		UnmarshalTolerant([]byte("{a: 1}"), &list).Error())
+}
@@ -0,0 +1,761 @@
+package msgpack
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	yaml "github.com/phR0ze/yaml/v2"
+	"github.com/pkg/errors"
+)
+
+// allocChunk bounds the bytes or elements allocated up front for a value as its length comes from
+// the untrusted input. Longer values grow as their data actually arrives.
+const allocChunk = 64 * 1024
+
+var (
+	fieldCache      sync.Map
+	unmarshalerType = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+)
+
+// reader wraps a buffered reader providing the ability to record the raw bytes read
+type reader struct {
+	br  *bufio.Reader
+	rec *[]byte
+}
+
+func newReader(r io.Reader) *reader {
+	if br, ok := r.(*bufio.Reader); ok {
+		return &reader{br: br}
+	}
+	return &reader{br: bufio.NewReader(r)}
+}
+
+// peek returns the next byte without advancing the reader
+func (r *reader) peek() (b byte, err error) {
+	var x []byte
+	if x, err = r.br.Peek(1); err != nil {
+		return
+	}
+	b = x[0]
+	return
+}
+
+func (r *reader) readByte() (b byte, err error) {
+	if b, err = r.br.ReadByte(); err != nil {
+		err = eof(err)
+		return
+	}
+	if r.rec != nil {
+		*r.rec = append(*r.rec, b)
+	}
+	return
+}
+
+func (r *reader) readN(n int) (data []byte, err error) {
+	if n <= allocChunk {
+		data = make([]byte, n)
+		if _, err = io.ReadFull(r.br, data); err != nil {
+			err = eof(err)
+			return
+		}
+	} else {
+		buf := bytes.NewBuffer(make([]byte, 0, allocChunk))
+		if _, err = io.CopyN(buf, r.br, int64(n)); err != nil {
+			err = eof(err)
+			return
+		}
+		data = buf.Bytes()
+	}
+	if r.rec != nil {
+		*r.rec = append(*r.rec, data...)
+	}
+	return
+}
+
+func (r *reader) readUint(n int) (x uint64, err error) {
+	var data []byte
+	if data, err = r.readN(n); err != nil {
+		return
+	}
+	switch n {
+	case 1:
+		x = uint64(data[0])
+	case 2:
+		x = uint64(binary.BigEndian.Uint16(data))
+	case 4:
+		x = uint64(binary.BigEndian.Uint32(data))
+	default:
+		x = binary.BigEndian.Uint64(data)
+	}
+	return
+}
+
+// eof converts a plain io.EOF mid value into an unexpected EOF error
+func eof(err error) error {
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return errors.Wrap(err, "failed to read msgpack data")
+}
+
+// Decode reads the next MessagePack value from the stream and stores it in the value pointed
+// to by obj. Returns io.EOF when no more values are available in the stream.
+func (d *Decoder) Decode(obj interface{}) (err error) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		err = errors.Errorf("invalid decode target %T, must be a non-nil pointer", obj)
+		return
+	}
+	if _, err = d.r.peek(); err != nil {
+		return
+	}
+	return d.decode(v.Elem())
+}
+
+// decode the next value into the given settable value
+func (d *Decoder) decode(v reflect.Value) (err error) {
+	var code byte
+	if code, err = d.r.peek(); err != nil {
+		return eof(err)
+	}
+
+	// Nil resets the target to its zero value
+	if code == codeNil {
+		d.r.readByte()
+		v.Set(reflect.Zero(v.Type()))
+		return
+	}
+
+	// Custom unmarshalers take precedence
+	if v.Kind() != reflect.Ptr && v.CanAddr() && v.Addr().Type().Implements(unmarshalerType) {
+		var raw []byte
+		if raw, err = d.readRaw(); err != nil {
+			return
+		}
+		if err = v.Addr().Interface().(Unmarshaler).UnmarshalMsgpack(raw); err != nil {
+			err = errors.Wrapf(err, "failed to unmarshal type %v", v.Type())
+		}
+		return
+	}
+
+	// Ordered maps decode their nested maps as ordered as well
+	if v.Type() == mapSliceType {
+		prev := d.ordered
+		d.ordered = true
+		var x interface{}
+		x, err = d.decodeInterface()
+		d.ordered = prev
+		if err != nil {
+			return
+		}
+		if m, ok := x.(yaml.MapSlice); ok {
+			v.Set(reflect.ValueOf(m))
+			return
+		}
+		return errors.Errorf("can't assign %T to %v", x, v.Type())
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return d.decode(v.Elem())
+	case reflect.Interface:
+		var x interface{}
+		if x, err = d.decodeInterface(); err != nil {
+			return
+		}
+		if x == nil {
+			v.Set(reflect.Zero(v.Type()))
+			return
+		}
+		xv := reflect.ValueOf(x)
+		if !xv.Type().AssignableTo(v.Type()) {
+			return errors.Errorf("can't assign %T to %v", x, v.Type())
+		}
+		v.Set(xv)
+		return
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return d.decodeSlice(v)
+		}
+	case reflect.Array:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return d.decodeSlice(v)
+		}
+	case reflect.Map:
+		return d.decodeMap(v)
+	case reflect.Struct:
+		if v.Type() != timeType && v.Type() != extType {
+			return d.decodeStruct(v)
+		}
+	}
+
+	// Scalars are decoded generically then assigned with conversion
+	var x interface{}
+	if x, err = d.decodeInterface(); err != nil {
+		return
+	}
+	return assign(v, x)
+}
+
+// decodeSlice decodes an array into the given slice or array value
+func (d *Decoder) decodeSlice(v reflect.Value) (err error) {
+	var l int
+	if l, err = d.readArrayLen(); err != nil {
+		return
+	}
+	if err = d.nest(); err != nil {
+		return
+	}
+	defer d.unnest()
+	if v.Kind() == reflect.Slice {
+		v.Set(reflect.MakeSlice(v.Type(), 0, min(l, allocChunk)))
+	}
+	for i := 0; i < l; i++ {
+		if v.Kind() == reflect.Slice {
+			v.Set(reflect.Append(v, reflect.Zero(v.Type().Elem())))
+		}
+		if i < v.Len() {
+			if err = d.decode(v.Index(i)); err != nil {
+				return
+			}
+		} else if err = d.skip(); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// decodeMap decodes a map into the given map value
+func (d *Decoder) decodeMap(v reflect.Value) (err error) {
+	var l int
+	if l, err = d.readMapLen(); err != nil {
+		return
+	}
+	if err = d.nest(); err != nil {
+		return
+	}
+	defer d.unnest()
+	if v.IsNil() {
+		v.Set(reflect.MakeMapWithSize(v.Type(), min(l, allocChunk)))
+	}
+	kt, vt := v.Type().Key(), v.Type().Elem()
+	for i := 0; i < l; i++ {
+		key := reflect.New(kt).Elem()
+		if err = d.decode(key); err != nil {
+			return
+		}
+		val := reflect.New(vt).Elem()
+		if err = d.decode(val); err != nil {
+			return
+		}
+		v.SetMapIndex(key, val)
+	}
+	return
+}
+
+// decodeStruct decodes a map into the given struct matching keys to fields
+func (d *Decoder) decodeStruct(v reflect.Value) (err error) {
+	var l int
+	if l, err = d.readMapLen(); err != nil {
+		return
+	}
+	if err = d.nest(); err != nil {
+		return
+	}
+	defer d.unnest()
+	fields := cachedFields(v.Type())
+	for i := 0; i < l; i++ {
+		var key interface{}
+		if key, err = d.decodeInterface(); err != nil {
+			return
+		}
+		name, _ := key.(string)
+		f := fields.find(name)
+		if f == nil {
+			if err = d.skip(); err != nil {
+				return
+			}
+			continue
+		}
+		if err = d.decode(v.Field(f.index)); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// decodeInterface decodes the next value into its natural Go type
+func (d *Decoder) decodeInterface() (val interface{}, err error) {
+	var code byte
+	if code, err = d.r.readByte(); err != nil {
+		return
+	}
+
+	switch {
+	case code <= 0x7f:
+		val = int(code)
+	case code >= 0xe0:
+		val = int(int8(code))
+	case code&0xe0 == 0xa0:
+		val, err = d.readString(int(code & 0x1f))
+	case code&0xf0 == 0x90:
+		val, err = d.decodeArrayBody(int(code & 0x0f))
+	case code&0xf0 == 0x80:
+		val, err = d.decodeMapBody(int(code&0x0f), d.ordered)
+	default:
+		switch code {
+		case codeNil:
+		case codeFalse:
+			val = false
+		case codeTrue:
+			val = true
+		case codeUint8, codeUint16, codeUint32, codeUint64:
+			var x uint64
+			if x, err = d.r.readUint(1 << (code - codeUint8)); err == nil {
+				val = uint(x)
+			}
+		case codeInt8, codeInt16, codeInt32, codeInt64:
+			var x uint64
+			n := 1 << (code - codeInt8)
+			if x, err = d.r.readUint(n); err == nil {
+				switch n {
+				case 1:
+					val = int(int8(x))
+				case 2:
+					val = int(int16(x))
+				case 4:
+					val = int(int32(x))
+				default:
+					val = int(int64(x))
+				}
+			}
+		case codeFloat32:
+			var x uint64
+			if x, err = d.r.readUint(4); err == nil {
+				val = math.Float32frombits(uint32(x))
+			}
+		case codeFloat64:
+			var x uint64
+			if x, err = d.r.readUint(8); err == nil {
+				val = math.Float64frombits(x)
+			}
+		case codeStr8, codeStr16, codeStr32:
+			var l int
+			if l, err = d.readLen(1 << (code - codeStr8)); err == nil {
+				val, err = d.readString(l)
+			}
+		case codeBin8, codeBin16, codeBin32:
+			var l int
+			if l, err = d.readLen(1 << (code - codeBin8)); err == nil {
+				val, err = d.r.readN(l)
+			}
+		case codeArray16, codeArray32:
+			var l int
+			if l, err = d.readLen(2 << (code - codeArray16)); err == nil {
+				val, err = d.decodeArrayBody(l)
+			}
+		case codeMap16, codeMap32:
+			var l int
+			if l, err = d.readLen(2 << (code - codeMap16)); err == nil {
+				val, err = d.decodeMapBody(l, d.ordered)
+			}
+		case codeFixExt1, codeFixExt2, codeFixExt4, codeFixExt8, codeFixExt16:
+			val, err = d.decodeExt(1 << (code - codeFixExt1))
+		case codeExt8, codeExt16, codeExt32:
+			var l int
+			if l, err = d.readLen(1 << (code - codeExt8)); err == nil {
+				val, err = d.decodeExt(l)
+			}
+		default:
+			err = errors.Errorf("invalid msgpack format code 0x%x", code)
+		}
+	}
+	return
+}
+
+func (d *Decoder) readString(l int) (val string, err error) {
+	var data []byte
+	if data, err = d.r.readN(l); err == nil {
+		val = string(data)
+	}
+	return
+}
+
+func (d *Decoder) decodeArrayBody(l int) (val []interface{}, err error) {
+	if err = d.nest(); err != nil {
+		return
+	}
+	defer d.unnest()
+	val = make([]interface{}, 0, min(l, allocChunk))
+	for i := 0; i < l; i++ {
+		var x interface{}
+		if x, err = d.decodeInterface(); err != nil {
+			return
+		}
+		val = append(val, x)
+	}
+	return
+}
+
+// decodeMapBody decodes l key value pairs into a generic map or ordered yaml.MapSlice
+func (d *Decoder) decodeMapBody(l int, ordered bool) (val interface{}, err error) {
+	if err = d.nest(); err != nil {
+		return
+	}
+	defer d.unnest()
+	keys, vals := make([]interface{}, 0, min(l, allocChunk)), make([]interface{}, 0, min(l, allocChunk))
+	strKeys := true
+	for i := 0; i < l; i++ {
+		var key, value interface{}
+		if key, err = d.decodeInterface(); err != nil {
+			return
+		}
+		switch x := key.(type) {
+		case string:
+		case []byte:
+			key = string(x)
+		default:
+			strKeys = false
+			if key != nil && !reflect.TypeOf(key).Comparable() {
+				err = errors.Errorf("invalid map key type %T", key)
+				return
+			}
+		}
+		if value, err = d.decodeInterface(); err != nil {
+			return
+		}
+		keys, vals = append(keys, key), append(vals, value)
+	}
+
+	switch {
+	case ordered:
+		m := make(yaml.MapSlice, len(keys))
+		for i := range keys {
+			m[i] = yaml.MapItem{Key: keys[i], Value: vals[i]}
+		}
+		val = m
+	case strKeys:
+		m := make(map[string]interface{}, len(keys))
+		for i := range keys {
+			m[keys[i].(string)] = vals[i]
+		}
+		val = m
+	default:
+		m := make(map[interface{}]interface{}, len(keys))
+		for i := range keys {
+			m[keys[i]] = vals[i]
+		}
+		val = m
+	}
+	return
+}
+
+// decodeExt decodes the extension type and data converting timestamps into time.Time
+func (d *Decoder) decodeExt(l int) (val interface{}, err error) {
+	var typ byte
+	if typ, err = d.r.readByte(); err != nil {
+		return
+	}
+	var data []byte
+	if data, err = d.r.readN(l); err != nil {
+		return
+	}
+	if int8(typ) != TimeExtType {
+		val = Ext{Type: int8(typ), Data: data}
+		return
+	}
+	switch l {
+	case 4:
+		val = time.Unix(int64(binary.BigEndian.Uint32(data)), 0)
+	case 8:
+		x := binary.BigEndian.Uint64(data)
+		val = time.Unix(int64(x&0x00000003ffffffff), int64(x>>34))
+	case 12:
+		nsec := binary.BigEndian.Uint32(data[:4])
+		val = time.Unix(int64(binary.BigEndian.Uint64(data[4:])), int64(nsec))
+	default:
+		err = errors.Errorf("invalid msgpack timestamp length %d", l)
+	}
+	return
+}
+
+// readArrayLen reads an array header returning the number of elements
+func (d *Decoder) readArrayLen() (l int, err error) {
+	var code byte
+	if code, err = d.r.readByte(); err != nil {
+		return
+	}
+	switch {
+	case code&0xf0 == 0x90:
+		l = int(code & 0x0f)
+	case code == codeArray16 || code == codeArray32:
+		l, err = d.readLen(2 << (code - codeArray16))
+	default:
+		err = errors.Errorf("invalid msgpack array format code 0x%x", code)
+	}
+	return
+}
+
+// readMapLen reads a map header returning the number of key value pairs
+func (d *Decoder) readMapLen() (l int, err error) {
+	var code byte
+	if code, err = d.r.readByte(); err != nil {
+		return
+	}
+	switch {
+	case code&0xf0 == 0x80:
+		l = int(code & 0x0f)
+	case code == codeMap16 || code == codeMap32:
+		l, err = d.readLen(2 << (code - codeMap16))
+	default:
+		err = errors.Errorf("invalid msgpack map format code 0x%x", code)
+	}
+	return
+}
+
+// readLen reads an n byte length failing if it exceeds the decoder's maximum length
+func (d *Decoder) readLen(n int) (l int, err error) {
+	var x uint64
+	if x, err = d.r.readUint(n); err != nil {
+		return
+	}
+	if d.maxLen > 0 && x > uint64(d.maxLen) {
+		err = errors.Errorf("msgpack length %d exceeds the maximum of %d", x, d.maxLen)
+		return
+	}
+	l = int(x)
+	return
+}
+
+// nest enters a nested array or map failing if the maximum depth would be exceeded. Every
+// successful call must be paired with a call to unnest once the nested value is done.
+func (d *Decoder) nest() (err error) {
+	if d.maxDepth > 0 && d.depth >= d.maxDepth {
+		err = errors.Errorf("msgpack nesting depth exceeds the maximum of %d", d.maxDepth)
+		return
+	}
+	d.depth++
+	return
+}
+
+// unnest leaves a nested array or map entered with nest
+func (d *Decoder) unnest() {
+	d.depth--
+}
+
+// readRaw reads the next complete value returning its raw encoded bytes
+func (d *Decoder) readRaw() (raw []byte, err error) {
+	prev := d.r.rec
+	raw = []byte{}
+	d.r.rec = &raw
+	err = d.skip()
+	d.r.rec = prev
+	if prev != nil {
+		*prev = append(*prev, raw...)
+	}
+	return
+}
+
+// skip reads past the next complete value
+func (d *Decoder) skip() (err error) {
+	var code byte
+	if code, err = d.r.peek(); err != nil {
+		return eof(err)
+	}
+	switch {
+	case code&0xf0 == 0x90 || code == codeArray16 || code == codeArray32:
+		var l int
+		if l, err = d.readArrayLen(); err != nil {
+			return
+		}
+		if err = d.nest(); err != nil {
+			return
+		}
+		defer d.unnest()
+		for i := 0; i < l; i++ {
+			if err = d.skip(); err != nil {
+				return
+			}
+		}
+	case code&0xf0 == 0x80 || code == codeMap16 || code == codeMap32:
+		var l int
+		if l, err = d.readMapLen(); err != nil {
+			return
+		}
+		if err = d.nest(); err != nil {
+			return
+		}
+		defer d.unnest()
+		for i := 0; i < 2*l; i++ {
+			if err = d.skip(); err != nil {
+				return
+			}
+		}
+	default:
+		_, err = d.decodeInterface()
+	}
+	return
+}
+
+// assign the generically decoded value x to v converting as required
+func assign(v reflect.Value, x interface{}) (err error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if b, ok := x.(bool); ok {
+			v.SetBool(b)
+			return
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		switch y := x.(type) {
+		case int:
+			i = int64(y)
+		case uint:
+			if uint64(y) > math.MaxInt64 {
+				return errors.Errorf("value %v overflows %v", y, v.Type())
+			}
+			i = int64(y)
+		default:
+			return errors.Errorf("can't assign %T to %v", x, v.Type())
+		}
+		if v.OverflowInt(i) {
+			return errors.Errorf("value %v overflows %v", i, v.Type())
+		}
+		v.SetInt(i)
+		return
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var u uint64
+		switch y := x.(type) {
+		case uint:
+			u = uint64(y)
+		case int:
+			if y < 0 {
+				return errors.Errorf("value %v overflows %v", y, v.Type())
+			}
+			u = uint64(y)
+		default:
+			return errors.Errorf("can't assign %T to %v", x, v.Type())
+		}
+		if v.OverflowUint(u) {
+			return errors.Errorf("value %v overflows %v", u, v.Type())
+		}
+		v.SetUint(u)
+		return
+	case reflect.Float32, reflect.Float64:
+		switch y := x.(type) {
+		case float32:
+			v.SetFloat(float64(y))
+		case float64:
+			v.SetFloat(y)
+		case int:
+			v.SetFloat(float64(y))
+		case uint:
+			v.SetFloat(float64(y))
+		default:
+			return errors.Errorf("can't assign %T to %v", x, v.Type())
+		}
+		return
+	case reflect.String:
+		switch y := x.(type) {
+		case string:
+			v.SetString(y)
+			return
+		case []byte:
+			v.SetString(string(y))
+			return
+		}
+	case reflect.Slice:
+		switch y := x.(type) {
+		case []byte:
+			v.SetBytes(y)
+			return
+		case string:
+			v.SetBytes([]byte(y))
+			return
+		}
+	case reflect.Array:
+		if y, ok := x.([]byte); ok {
+			reflect.Copy(v, reflect.ValueOf(y))
+			return
+		}
+	case reflect.Struct:
+		xv := reflect.ValueOf(x)
+		if xv.Type().AssignableTo(v.Type()) {
+			v.Set(xv)
+			return
+		}
+	}
+	return errors.Errorf("can't assign %T to %v", x, v.Type())
+}
+
+// field describes an encodable struct field
+type field struct {
+	name      string
+	index     int
+	omitEmpty bool
+}
+
+// omit returns true if the field should be omitted from the encoding
+func (f *field) omit(v reflect.Value) bool {
+	return f.omitEmpty && v.IsZero()
+}
+
+type fields []field
+
+// find the field by name preferring an exact match over a case insensitive one
+func (x fields) find(name string) *field {
+	for i := range x {
+		if x[i].name == name {
+			return &x[i]
+		}
+	}
+	for i := range x {
+		if strings.EqualFold(x[i].name, name) {
+			return &x[i]
+		}
+	}
+	return nil
+}
+
+// cachedFields returns the encodable fields for the struct type honoring `msgpack` tags
+// with the familiar name,omitempty and "-" conventions.
+func cachedFields(t reflect.Type) fields {
+	if x, ok := fieldCache.Load(t); ok {
+		return x.(fields)
+	}
+	result := fields{}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+		f := field{name: sf.Name, index: i}
+		if tag, ok := sf.Tag.Lookup("msgpack"); ok {
+			if tag == "-" {
+				continue
+			}
+			pieces := strings.Split(tag, ",")
+			if pieces[0] != "" {
+				f.name = pieces[0]
+			}
+			for _, opt := range pieces[1:] {
+				if opt == "omitempty" {
+					f.omitEmpty = true
+				}
+			}
+		}
+		result = append(result, f)
+	}
+	fieldCache.Store(t, result)
+	return result
+}
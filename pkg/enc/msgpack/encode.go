@@ -0,0 +1,352 @@
+package msgpack
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"sort"
+	"time"
+
+	yaml "github.com/phR0ze/yaml/v2"
+	"github.com/pkg/errors"
+)
+
+var (
+	marshalerType = reflect.TypeOf((*Marshaler)(nil)).Elem()
+	timeType      = reflect.TypeOf(time.Time{})
+	extType       = reflect.TypeOf(Ext{})
+	mapSliceType  = reflect.TypeOf(yaml.MapSlice{})
+)
+
+// Encode writes the MessagePack encoding of the given obj to the stream
+func (e *Encoder) Encode(obj interface{}) (err error) {
+	e.buf = e.buf[:0]
+	if err = e.encode(reflect.ValueOf(obj)); err != nil {
+		return
+	}
+	if _, err = e.w.Write(e.buf); err != nil {
+		err = errors.Wrap(err, "failed to write msgpack data")
+	}
+	return
+}
+
+// encode the given value into the internal buffer
+func (e *Encoder) encode(v reflect.Value) (err error) {
+	if !v.IsValid() {
+		e.writeNil()
+		return
+	}
+
+	// Custom marshalers take precedence
+	if v.Type().Implements(marshalerType) {
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			e.writeNil()
+			return
+		}
+		var data []byte
+		if data, err = v.Interface().(Marshaler).MarshalMsgpack(); err != nil {
+			err = errors.Wrapf(err, "failed to marshal type %v", v.Type())
+			return
+		}
+		e.buf = append(e.buf, data...)
+		return
+	}
+	if v.Kind() != reflect.Ptr && v.CanAddr() && reflect.PtrTo(v.Type()).Implements(marshalerType) {
+		return e.encode(v.Addr())
+	}
+
+	// Special types
+	switch v.Type() {
+	case timeType:
+		e.writeTime(v.Interface().(time.Time))
+		return
+	case extType:
+		x := v.Interface().(Ext)
+		e.writeExt(x.Type, x.Data)
+		return
+	case mapSliceType:
+		return e.encodeMapSlice(v.Interface().(yaml.MapSlice))
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			e.buf = append(e.buf, codeTrue)
+		} else {
+			e.buf = append(e.buf, codeFalse)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.writeInt(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		e.writeUint(v.Uint())
+	case reflect.Float32:
+		e.buf = append(e.buf, codeFloat32)
+		e.buf = binary.BigEndian.AppendUint32(e.buf, math.Float32bits(float32(v.Float())))
+	case reflect.Float64:
+		e.buf = append(e.buf, codeFloat64)
+		e.buf = binary.BigEndian.AppendUint64(e.buf, math.Float64bits(v.Float()))
+	case reflect.String:
+		e.writeString(v.String())
+	case reflect.Slice:
+		if v.IsNil() {
+			e.writeNil()
+			return
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			e.writeBin(v.Bytes())
+			return
+		}
+		return e.encodeArray(v)
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			data := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(data), v)
+			e.writeBin(data)
+			return
+		}
+		return e.encodeArray(v)
+	case reflect.Map:
+		if v.IsNil() {
+			e.writeNil()
+			return
+		}
+		return e.encodeMap(v)
+	case reflect.Struct:
+		return e.encodeStruct(v)
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			e.writeNil()
+			return
+		}
+		return e.encode(v.Elem())
+	default:
+		err = errors.Errorf("unsupported type %v for msgpack encoding", v.Type())
+	}
+	return
+}
+
+// encodeArray encodes the slice or array elements
+func (e *Encoder) encodeArray(v reflect.Value) (err error) {
+	l := v.Len()
+	e.writeArrayLen(l)
+	for i := 0; i < l; i++ {
+		if err = e.encode(v.Index(i)); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// encodeMap encodes the map sorting the keys so that output is deterministic
+func (e *Encoder) encodeMap(v reflect.Value) (err error) {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return sortKey(keys[i]) < sortKey(keys[j])
+	})
+	e.writeMapLen(len(keys))
+	for _, k := range keys {
+		if err = e.encode(k); err != nil {
+			return
+		}
+		if err = e.encode(v.MapIndex(k)); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// encodeMapSlice encodes the ordered yaml.MapSlice as a map preserving key order
+func (e *Encoder) encodeMapSlice(m yaml.MapSlice) (err error) {
+	e.writeMapLen(len(m))
+	for i := range m {
+		if err = e.encode(reflect.ValueOf(m[i].Key)); err != nil {
+			return
+		}
+		if err = e.encode(reflect.ValueOf(m[i].Value)); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// encodeStruct encodes the exported fields of the struct as a map
+func (e *Encoder) encodeStruct(v reflect.Value) (err error) {
+	fields := cachedFields(v.Type())
+	cnt := 0
+	for i := range fields {
+		if !fields[i].omit(v.Field(fields[i].index)) {
+			cnt++
+		}
+	}
+	e.writeMapLen(cnt)
+	for i := range fields {
+		fv := v.Field(fields[i].index)
+		if fields[i].omit(fv) {
+			continue
+		}
+		e.writeString(fields[i].name)
+		if err = e.encode(fv); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// sortKey provides a sortable representation of a map key
+func sortKey(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Interface:
+		if !v.IsNil() {
+			return sortKey(v.Elem())
+		}
+	}
+	b, _ := Marshal(v.Interface())
+	return string(b)
+}
+
+func (e *Encoder) writeNil() {
+	e.buf = append(e.buf, codeNil)
+}
+
+// writeInt uses the smallest signed format able to hold the value
+func (e *Encoder) writeInt(x int64) {
+	switch {
+	case x >= -32 && x <= math.MaxInt8:
+		e.buf = append(e.buf, byte(x))
+	case x >= math.MinInt8 && x <= math.MaxInt8:
+		e.buf = append(e.buf, codeInt8, byte(x))
+	case x >= math.MinInt16 && x <= math.MaxInt16:
+		e.buf = append(e.buf, codeInt16)
+		e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(x))
+	case x >= math.MinInt32 && x <= math.MaxInt32:
+		e.buf = append(e.buf, codeInt32)
+		e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(x))
+	default:
+		e.buf = append(e.buf, codeInt64)
+		e.buf = binary.BigEndian.AppendUint64(e.buf, uint64(x))
+	}
+}
+
+// writeUint uses the smallest unsigned format able to hold the value. The positive fixint
+// format is never used so that unsigned values can be distinguished from signed values.
+func (e *Encoder) writeUint(x uint64) {
+	switch {
+	case x <= math.MaxUint8:
+		e.buf = append(e.buf, codeUint8, byte(x))
+	case x <= math.MaxUint16:
+		e.buf = append(e.buf, codeUint16)
+		e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(x))
+	case x <= math.MaxUint32:
+		e.buf = append(e.buf, codeUint32)
+		e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(x))
+	default:
+		e.buf = append(e.buf, codeUint64)
+		e.buf = binary.BigEndian.AppendUint64(e.buf, x)
+	}
+}
+
+func (e *Encoder) writeString(x string) {
+	l := len(x)
+	switch {
+	case l < 32:
+		e.buf = append(e.buf, byte(0xa0|l))
+	case l <= math.MaxUint8:
+		e.buf = append(e.buf, codeStr8, byte(l))
+	case l <= math.MaxUint16:
+		e.buf = append(e.buf, codeStr16)
+		e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(l))
+	default:
+		e.buf = append(e.buf, codeStr32)
+		e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(l))
+	}
+	e.buf = append(e.buf, x...)
+}
+
+func (e *Encoder) writeBin(x []byte) {
+	l := len(x)
+	switch {
+	case l <= math.MaxUint8:
+		e.buf = append(e.buf, codeBin8, byte(l))
+	case l <= math.MaxUint16:
+		e.buf = append(e.buf, codeBin16)
+		e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(l))
+	default:
+		e.buf = append(e.buf, codeBin32)
+		e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(l))
+	}
+	e.buf = append(e.buf, x...)
+}
+
+func (e *Encoder) writeArrayLen(l int) {
+	switch {
+	case l < 16:
+		e.buf = append(e.buf, byte(0x90|l))
+	case l <= math.MaxUint16:
+		e.buf = append(e.buf, codeArray16)
+		e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(l))
+	default:
+		e.buf = append(e.buf, codeArray32)
+		e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(l))
+	}
+}
+
+func (e *Encoder) writeMapLen(l int) {
+	switch {
+	case l < 16:
+		e.buf = append(e.buf, byte(0x80|l))
+	case l <= math.MaxUint16:
+		e.buf = append(e.buf, codeMap16)
+		e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(l))
+	default:
+		e.buf = append(e.buf, codeMap32)
+		e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(l))
+	}
+}
+
+func (e *Encoder) writeExt(typ int8, data []byte) {
+	l := len(data)
+	switch l {
+	case 1:
+		e.buf = append(e.buf, codeFixExt1)
+	case 2:
+		e.buf = append(e.buf, codeFixExt2)
+	case 4:
+		e.buf = append(e.buf, codeFixExt4)
+	case 8:
+		e.buf = append(e.buf, codeFixExt8)
+	case 16:
+		e.buf = append(e.buf, codeFixExt16)
+	default:
+		switch {
+		case l <= math.MaxUint8:
+			e.buf = append(e.buf, codeExt8, byte(l))
+		case l <= math.MaxUint16:
+			e.buf = append(e.buf, codeExt16)
+			e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(l))
+		default:
+			e.buf = append(e.buf, codeExt32)
+			e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(l))
+		}
+	}
+	e.buf = append(e.buf, byte(typ))
+	e.buf = append(e.buf, data...)
+}
+
+// writeTime uses the smallest of the 32, 64 or 96 bit timestamp formats
+func (e *Encoder) writeTime(t time.Time) {
+	sec, nsec := t.Unix(), int64(t.Nanosecond())
+	switch {
+	case sec>>34 == 0 && nsec == 0 && sec <= math.MaxUint32:
+		data := binary.BigEndian.AppendUint32(nil, uint32(sec))
+		e.writeExt(TimeExtType, data)
+	case sec>>34 == 0:
+		data := binary.BigEndian.AppendUint64(nil, uint64(nsec)<<34|uint64(sec))
+		e.writeExt(TimeExtType, data)
+	default:
+		data := binary.BigEndian.AppendUint32(nil, uint32(nsec))
+		data = binary.BigEndian.AppendUint64(data, uint64(sec))
+		e.writeExt(TimeExtType, data)
+	}
+}
@@ -0,0 +1,176 @@
+// Package msgpack provides a MessagePack encoder/decoder for the Nub types
+//
+// MessagePack is a compact binary serialization format that unlike JSON is able to preserve
+// the distinction between signed integers, unsigned integers and floats as well as carry
+// binary data and timestamps natively. Signed Go integers are always encoded using the
+// signed int family and unsigned Go integers are always encoded using the unsigned uint
+// family so that a round trip through this package returns the same kind of number. When
+// decoding into an interface{} signed formats become int, unsigned formats become uint,
+// float32 becomes float32 and float64 becomes float64. time.Time values are encoded using
+// the standard timestamp extension type -1.
+package msgpack
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Format codes as defined by the MessagePack specification
+const (
+	codeNil      = 0xc0
+	codeFalse    = 0xc2
+	codeTrue     = 0xc3
+	codeBin8     = 0xc4
+	codeBin16    = 0xc5
+	codeBin32    = 0xc6
+	codeExt8     = 0xc7
+	codeExt16    = 0xc8
+	codeExt32    = 0xc9
+	codeFloat32  = 0xca
+	codeFloat64  = 0xcb
+	codeUint8    = 0xcc
+	codeUint16   = 0xcd
+	codeUint32   = 0xce
+	codeUint64   = 0xcf
+	codeInt8     = 0xd0
+	codeInt16    = 0xd1
+	codeInt32    = 0xd2
+	codeInt64    = 0xd3
+	codeFixExt1  = 0xd4
+	codeFixExt2  = 0xd5
+	codeFixExt4  = 0xd6
+	codeFixExt8  = 0xd7
+	codeFixExt16 = 0xd8
+	codeStr8     = 0xd9
+	codeStr16    = 0xda
+	codeStr32    = 0xdb
+	codeArray16  = 0xdc
+	codeArray32  = 0xdd
+	codeMap16    = 0xde
+	codeMap32    = 0xdf
+
+	// TimeExtType is the extension type reserved by the specification for timestamps
+	TimeExtType int8 = -1
+
+	// DefaultMaxLen is the default maximum length of the strings, binary data, extensions,
+	// arrays and maps accepted by a Decoder, see Decoder.MaxLen.
+	DefaultMaxLen = 64 * 1024 * 1024
+
+	// DefaultMaxDepth is the default maximum nesting depth of the arrays and maps accepted by a
+	// Decoder, see Decoder.MaxDepth.
+	DefaultMaxDepth = 10000
+)
+
+// Marshaler is the interface implemented by types that can marshal themselves into
+// valid MessagePack.
+type Marshaler interface {
+	MarshalMsgpack() ([]byte, error)
+}
+
+// Unmarshaler is the interface implemented by types that can unmarshal a MessagePack
+// description of themselves. The data given is a single complete MessagePack value
+// which must be copied if it is to be retained after returning.
+type Unmarshaler interface {
+	UnmarshalMsgpack(data []byte) error
+}
+
+// Ext is used to carry application specific extension types through the encoder and
+// decoder as is. Any extension type other than the timestamp will be decoded into an Ext.
+type Ext struct {
+	Type int8   // application specific type in the range 0 to 127
+	Data []byte // raw extension data
+}
+
+// Marshal returns the MessagePack encoding of the given obj
+func Marshal(obj interface{}) (data []byte, err error) {
+	buf := &bytes.Buffer{}
+	if err = NewEncoder(buf).Encode(obj); err != nil {
+		return
+	}
+	data = buf.Bytes()
+	return
+}
+
+// Unmarshal parses the MessagePack encoded data and stores the result in the value
+// pointed to by obj. Maps decoded into an interface{} will be map[string]interface{}
+// if all keys are strings else map[interface{}]interface{}.
+func Unmarshal(data []byte, obj interface{}) (err error) {
+	return unmarshal(data, obj, false)
+}
+
+// UnmarshalOrdered parses the MessagePack encoded data and stores the result in the
+// value pointed to by obj just like Unmarshal except that maps decoded into an interface{}
+// will be yaml.MapSlice preserving the order the keys were encoded in.
+func UnmarshalOrdered(data []byte, obj interface{}) (err error) {
+	return unmarshal(data, obj, true)
+}
+
+// unmarshal decodes the single value in data ensuring there is nothing trailing it
+func unmarshal(data []byte, obj interface{}, ordered bool) (err error) {
+	dec := NewDecoder(bytes.NewReader(data)).OrderedMaps(ordered)
+	if err = dec.Decode(obj); err != nil {
+		return
+	}
+	if dec.buffered() {
+		err = errors.Errorf("invalid trailing data after msgpack value")
+	}
+	return
+}
+
+// Encoder writes MessagePack values to an output stream
+type Encoder struct {
+	w   io.Writer
+	buf []byte
+}
+
+// NewEncoder returns a new encoder that writes to the given writer
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w, buf: make([]byte, 0, 64)}
+}
+
+// Decoder reads and decodes MessagePack values from an input stream
+type Decoder struct {
+	r        *reader
+	ordered  bool
+	maxLen   int
+	depth    int
+	maxDepth int
+}
+
+// NewDecoder returns a new decoder that reads from the given reader. The decoder buffers
+// its input and may read data from r beyond the MessagePack values requested.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: newReader(r), maxLen: DefaultMaxLen, maxDepth: DefaultMaxDepth}
+}
+
+// MaxDepth configures the maximum nesting depth of the arrays and maps the decoder accepts
+// failing on deeper values rather than recursing until the stack is exhausted. Zero or less
+// means no maximum. Returns a reference to the decoder for chaining.
+func (d *Decoder) MaxDepth(n int) *Decoder {
+	d.maxDepth = n
+	return d
+}
+
+// MaxLen configures the maximum length of the strings, binary data, extensions, arrays and maps
+// the decoder accepts failing on longer values rather than attempting to read them. Zero or less
+// means no maximum. Either way memory is only allocated as the data for a value arrives so that
+// a length header alone can't exhaust memory. Returns a reference to the decoder for chaining.
+func (d *Decoder) MaxLen(n int) *Decoder {
+	d.maxLen = n
+	return d
+}
+
+// OrderedMaps configures the decoder to decode maps into an interface{} as yaml.MapSlice
+// thus preserving the key order. Returns a reference to the decoder for chaining.
+func (d *Decoder) OrderedMaps(ordered bool) *Decoder {
+	d.ordered = ordered
+	return d
+}
+
+// buffered returns true if there is more data available from the underlying reader
+func (d *Decoder) buffered() bool {
+	_, err := d.r.peek()
+	return err == nil
+}
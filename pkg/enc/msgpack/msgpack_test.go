@@ -0,0 +1,343 @@
+package msgpack
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"runtime"
+	"testing"
+	"time"
+
+	yaml "github.com/phR0ze/yaml/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshal_scalars(t *testing.T) {
+
+	// nil and bools
+	{
+		data, err := Marshal(nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{0xc0}, data)
+
+		data, err = Marshal(true)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{0xc3}, data)
+	}
+
+	// signed ints use the smallest signed format
+	{
+		data, _ := Marshal(1)
+		assert.Equal(t, []byte{0x01}, data)
+		data, _ = Marshal(-1)
+		assert.Equal(t, []byte{0xff}, data)
+		data, _ = Marshal(-33)
+		assert.Equal(t, []byte{0xd0, 0xdf}, data)
+		data, _ = Marshal(int64(math.MaxInt64))
+		assert.Equal(t, []byte{0xd3, 0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, data)
+	}
+
+	// unsigned ints never use fixint
+	{
+		data, _ := Marshal(uint(1))
+		assert.Equal(t, []byte{0xcc, 0x01}, data)
+		data, _ = Marshal(uint16(300))
+		assert.Equal(t, []byte{0xcd, 0x01, 0x2c}, data)
+	}
+
+	// strings and binary
+	{
+		data, _ := Marshal("foo")
+		assert.Equal(t, []byte{0xa3, 'f', 'o', 'o'}, data)
+		data, _ = Marshal([]byte("foo"))
+		assert.Equal(t, []byte{0xc4, 0x03, 'f', 'o', 'o'}, data)
+	}
+}
+
+func TestUnmarshal_preservesNumbers(t *testing.T) {
+	obj := []interface{}{1, -200, uint(5), uint64(math.MaxUint64), float32(1.5), 2.25}
+	data, err := Marshal(obj)
+	assert.Nil(t, err)
+
+	var result interface{}
+	assert.Nil(t, Unmarshal(data, &result))
+	assert.Equal(t, []interface{}{1, -200, uint(5), uint(math.MaxUint64), float32(1.5), 2.25}, result)
+}
+
+func TestUnmarshal_time(t *testing.T) {
+	for _, tm := range []time.Time{
+		time.Unix(1556496000, 0),                    // 32 bit
+		time.Unix(1556496000, 123456789),            // 64 bit
+		time.Unix(-1, 5),                            // 96 bit
+		time.Date(2500, 1, 1, 0, 0, 0, 0, time.UTC), // 96 bit
+	} {
+		data, err := Marshal(tm)
+		assert.Nil(t, err)
+
+		var result time.Time
+		assert.Nil(t, Unmarshal(data, &result))
+		assert.True(t, tm.Equal(result))
+
+		var generic interface{}
+		assert.Nil(t, Unmarshal(data, &generic))
+		assert.True(t, tm.Equal(generic.(time.Time)))
+	}
+}
+
+func TestUnmarshal_ext(t *testing.T) {
+	data, err := Marshal(Ext{Type: 5, Data: []byte{1, 2, 3}})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0xc7, 0x03, 0x05, 0x01, 0x02, 0x03}, data)
+
+	var result interface{}
+	assert.Nil(t, Unmarshal(data, &result))
+	assert.Equal(t, Ext{Type: 5, Data: []byte{1, 2, 3}}, result)
+}
+
+func TestUnmarshal_maps(t *testing.T) {
+
+	// generic maps
+	{
+		data, err := Marshal(map[string]interface{}{"b": 1, "a": []interface{}{"x", true}})
+		assert.Nil(t, err)
+
+		var result interface{}
+		assert.Nil(t, Unmarshal(data, &result))
+		assert.Equal(t, map[string]interface{}{"b": 1, "a": []interface{}{"x", true}}, result)
+	}
+
+	// ordered maps
+	{
+		m := yaml.MapSlice{{Key: "b", Value: 1}, {Key: "a", Value: yaml.MapSlice{{Key: "z", Value: "1"}, {Key: "y", Value: "2"}}}}
+		data, err := Marshal(m)
+		assert.Nil(t, err)
+
+		var result interface{}
+		assert.Nil(t, UnmarshalOrdered(data, &result))
+		assert.Equal(t, m, result)
+
+		var typed yaml.MapSlice
+		assert.Nil(t, Unmarshal(data, &typed))
+		assert.Equal(t, m, typed)
+	}
+
+	// non string keys
+	{
+		data, err := Marshal(map[int]string{1: "one", 2: "two"})
+		assert.Nil(t, err)
+
+		var result interface{}
+		assert.Nil(t, Unmarshal(data, &result))
+		assert.Equal(t, map[interface{}]interface{}{1: "one", 2: "two"}, result)
+
+		typed := map[int]string{}
+		assert.Nil(t, Unmarshal(data, &typed))
+		assert.Equal(t, map[int]string{1: "one", 2: "two"}, typed)
+	}
+}
+
+func TestUnmarshal_struct(t *testing.T) {
+	type inner struct {
+		Data []byte
+	}
+	type outer struct {
+		Name    string            `msgpack:"name"`
+		Skip    string            `msgpack:"-"`
+		Empty   string            `msgpack:",omitempty"`
+		Count   uint16            `msgpack:"count"`
+		Ratio   float32           `msgpack:"ratio"`
+		When    time.Time         `msgpack:"when"`
+		Inner   *inner            `msgpack:"inner"`
+		Labels  map[string]string `msgpack:"labels"`
+		private int
+	}
+	obj := outer{Name: "foo", Skip: "bar", Count: 3, Ratio: 0.5, When: time.Unix(10, 0),
+		Inner: &inner{Data: []byte{1}}, Labels: map[string]string{"a": "b"}}
+	data, err := Marshal(obj)
+	assert.Nil(t, err)
+
+	var result outer
+	assert.Nil(t, Unmarshal(data, &result))
+	assert.Equal(t, "foo", result.Name)
+	assert.Equal(t, "", result.Skip)
+	assert.Equal(t, uint16(3), result.Count)
+	assert.Equal(t, float32(0.5), result.Ratio)
+	assert.True(t, obj.When.Equal(result.When))
+	assert.Equal(t, []byte{1}, result.Inner.Data)
+	assert.Equal(t, map[string]string{"a": "b"}, result.Labels)
+
+	var generic map[string]interface{}
+	assert.Nil(t, Unmarshal(data, &generic))
+	assert.NotContains(t, generic, "Empty")
+	assert.NotContains(t, generic, "Skip")
+}
+
+func TestUnmarshal_errors(t *testing.T) {
+
+	// not a pointer
+	assert.Equal(t, "invalid decode target int, must be a non-nil pointer", Unmarshal([]byte{0x01}, 1).Error())
+
+	// overflow
+	var small int8
+	assert.Equal(t, "value 300 overflows int8", Unmarshal([]byte{0xd1, 0x01, 0x2c}, &small).Error())
+	var unsigned uint
+	assert.Equal(t, "value -1 overflows uint", Unmarshal([]byte{0xff}, &unsigned).Error())
+
+	// wrong type
+	var str string
+	assert.Equal(t, "can't assign int to string", Unmarshal([]byte{0x01}, &str).Error())
+
+	// truncated and trailing data
+	var x interface{}
+	assert.Equal(t, "failed to read msgpack data: unexpected EOF", Unmarshal([]byte{0xa3, 'f'}, &x).Error())
+	assert.Equal(t, "invalid trailing data after msgpack value", Unmarshal([]byte{0x01, 0x02}, &x).Error())
+}
+
+func TestUnmarshal_hugeLength(t *testing.T) {
+	headers := [][]byte{
+		{0xc6, 0xff, 0xff, 0xff, 0xff},       // bin32
+		{0xdb, 0xff, 0xff, 0xff, 0xff},       // str32
+		{0xdd, 0xff, 0xff, 0xff, 0xff},       // array32
+		{0xdf, 0xff, 0xff, 0xff, 0xff},       // map32
+		{0xc9, 0xff, 0xff, 0xff, 0xff, 0x01}, // ext32
+	}
+
+	// lengths beyond the maximum are rejected up front
+	for _, data := range headers {
+		var x interface{}
+		assert.Equal(t, "msgpack length 4294967295 exceeds the maximum of 67108864", Unmarshal(data, &x).Error())
+	}
+	var ints []int
+	assert.Equal(t, "msgpack length 4294967295 exceeds the maximum of 67108864", Unmarshal(headers[2], &ints).Error())
+	var m map[string]int
+	assert.Equal(t, "msgpack length 4294967295 exceeds the maximum of 67108864", Unmarshal(headers[3], &m).Error())
+
+	// without a maximum truncated data fails without allocating for the claimed length
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for _, data := range headers {
+		var x interface{}
+		err := NewDecoder(bytes.NewReader(data)).MaxLen(0).Decode(&x)
+		assert.Equal(t, "failed to read msgpack data: unexpected EOF", err.Error())
+	}
+	err := NewDecoder(bytes.NewReader(headers[2])).MaxLen(0).Decode(&ints)
+	assert.Equal(t, "failed to read msgpack data: unexpected EOF", err.Error())
+	err = NewDecoder(bytes.NewReader(headers[3])).MaxLen(0).Decode(&m)
+	assert.Equal(t, "failed to read msgpack data: unexpected EOF", err.Error())
+	runtime.ReadMemStats(&after)
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(16*1024*1024))
+
+	// a custom maximum
+	var str string
+	dec := NewDecoder(bytes.NewReader([]byte{0xd9, 0x03, 'f', 'o', 'o'})).MaxLen(2)
+	assert.Equal(t, "msgpack length 3 exceeds the maximum of 2", dec.Decode(&str).Error())
+	assert.Nil(t, NewDecoder(bytes.NewReader([]byte{0xd9, 0x03, 'f', 'o', 'o'})).MaxLen(3).Decode(&str))
+	assert.Equal(t, "foo", str)
+}
+
+func TestUnmarshal_deepNesting(t *testing.T) {
+	maxErr := "msgpack nesting depth exceeds the maximum of 10000"
+
+	// deeply nested arrays and maps fail rather than overflowing the stack
+	arrays := bytes.Repeat([]byte{0x91}, 20*1024*1024)
+	maps := bytes.Repeat([]byte{0x81, 0xa1, 'k'}, 1024*1024)
+	for _, data := range [][]byte{arrays, maps} {
+		var x interface{}
+		assert.Equal(t, maxErr, Unmarshal(data, &x).Error())
+		assert.Equal(t, maxErr, UnmarshalOrdered(data, &x).Error())
+	}
+	var xs []interface{}
+	assert.Equal(t, maxErr, Unmarshal(arrays, &xs).Error())
+	var m map[string]interface{}
+	assert.Equal(t, maxErr, Unmarshal(maps, &m).Error())
+
+	// skipped values and custom unmarshalers are limited as well
+	var s struct{ Name string }
+	assert.Equal(t, maxErr, Unmarshal(append([]byte{0x81, 0xa1, 'x'}, arrays...), &s).Error())
+	var c custom
+	assert.Equal(t, maxErr, Unmarshal(arrays, &c).Error())
+
+	// a custom maximum
+	var x interface{}
+	dec := NewDecoder(bytes.NewReader([]byte{0x91, 0x91, 0x91, 0x01, 0x91, 0x91, 0x01})).MaxDepth(2)
+	assert.Equal(t, "msgpack nesting depth exceeds the maximum of 2", dec.Decode(&x).Error())
+	assert.Nil(t, NewDecoder(bytes.NewReader([]byte{0x91, 0x91, 0x01})).MaxDepth(2).Decode(&x))
+	assert.Equal(t, []interface{}{[]interface{}{1}}, x)
+
+	// no maximum
+	assert.Nil(t, NewDecoder(bytes.NewReader(append(bytes.Repeat([]byte{0x91}, 20000), 0x01))).MaxDepth(0).Decode(&x))
+}
+
+type custom struct {
+	val string
+}
+
+func (p *custom) MarshalMsgpack() ([]byte, error) {
+	return Marshal("custom:" + p.val)
+}
+
+func (p *custom) UnmarshalMsgpack(data []byte) (err error) {
+	var str string
+	if err = Unmarshal(data, &str); err == nil {
+		p.val = str[len("custom:"):]
+	}
+	return
+}
+
+func TestMarshaler(t *testing.T) {
+	obj := map[string]*custom{"a": {val: "foo"}}
+	data, err := Marshal(obj)
+	assert.Nil(t, err)
+
+	var generic map[string]interface{}
+	assert.Nil(t, Unmarshal(data, &generic))
+	assert.Equal(t, "custom:foo", generic["a"])
+
+	result := map[string]*custom{}
+	assert.Nil(t, Unmarshal(data, &result))
+	assert.Equal(t, "foo", result["a"].val)
+
+	list := []custom{}
+	data, _ = Marshal([]interface{}{"custom:bar", "custom:baz"})
+	assert.Nil(t, Unmarshal(data, &list))
+	assert.Equal(t, []custom{{"bar"}, {"baz"}}, list)
+}
+
+func TestEncoderDecoder_stream(t *testing.T) {
+	buf := &bytes.Buffer{}
+	enc := NewEncoder(buf)
+	assert.Nil(t, enc.Encode(1))
+	assert.Nil(t, enc.Encode("two"))
+	assert.Nil(t, enc.Encode([]uint{3}))
+
+	dec := NewDecoder(buf)
+	var a int
+	var b string
+	var c []uint
+	assert.Nil(t, dec.Decode(&a))
+	assert.Nil(t, dec.Decode(&b))
+	assert.Nil(t, dec.Decode(&c))
+	assert.Equal(t, 1, a)
+	assert.Equal(t, "two", b)
+	assert.Equal(t, []uint{3}, c)
+
+	var d interface{}
+	assert.Equal(t, io.EOF, dec.Decode(&d))
+}
+
+func TestEncode_large(t *testing.T) {
+	list := make([]int, 70000)
+	str := string(bytes.Repeat([]byte("x"), 70000))
+	m := map[string]int{}
+	for i := 0; i < 20; i++ {
+		m[string(rune('a'+i))] = i
+	}
+	data, err := Marshal([]interface{}{list, str, m})
+	assert.Nil(t, err)
+
+	var result []interface{}
+	assert.Nil(t, Unmarshal(data, &result))
+	assert.Len(t, result[0], 70000)
+	assert.Equal(t, str, result[1])
+	assert.Len(t, result[2], 20)
+}
@@ -3,7 +3,11 @@ package unit
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
+
+	"github.com/pkg/errors"
 )
 
 const (
@@ -15,8 +19,26 @@ const (
 	GiB      = Gibibyte
 	Tebibyte = Gibibyte * 1024
 	TiB      = Tebibyte
+
+	Kilobyte = 1000
+	KB       = Kilobyte
+	Megabyte = Kilobyte * 1000
+	MB       = Megabyte
+	Gigabyte = Megabyte * 1000
+	GB       = Gigabyte
+	Terabyte = Gigabyte * 1000
+	TB       = Terabyte
 )
 
+// sizeUnits maps the lower case size units to their number of bytes
+var sizeUnits = map[string]float64{
+	"": 1, "b": 1, "byte": 1, "bytes": 1,
+	"k": KiB, "kib": KiB, "kb": KB,
+	"m": MiB, "mib": MiB, "mb": MB,
+	"g": GiB, "gib": GiB, "gb": GB,
+	"t": TiB, "tib": TiB, "tb": TB,
+}
+
 // HumanBase2 converts the given value in bytes to a human readable format
 // e.g. 3195728 = 3.05 MiB
 func HumanBase2(val int64) (result string) {
@@ -62,3 +84,33 @@ func ToGiB(val int64) (gib float64) {
 func ToTiB(val int64) (tib float64) {
 	return float64(val) / float64(TiB)
 }
+
+// ParseSize parses the given human readable size into bytes e.g. "512", "10 KiB", "1.5GB" or
+// "2m". Units are case insensitive with the IEC units KiB, MiB, GiB and TiB as well as the single
+// letters K, M, G and T being base 2 while the SI units KB, MB, GB and TB are base 10.
+func ParseSize(s string) (val int64, err error) {
+	str := strings.TrimSpace(s)
+	i := strings.IndexFunc(str, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if i == -1 {
+		i = len(str)
+	}
+	num, unit := str[:i], strings.ToLower(strings.TrimSpace(str[i:]))
+
+	var f float64
+	if f, err = strconv.ParseFloat(num, 64); err != nil {
+		err = errors.Errorf("invalid size %q", s)
+		return
+	}
+	mult, ok := sizeUnits[unit]
+	if !ok {
+		err = errors.Errorf("invalid size unit %q in %q", strings.TrimSpace(str[i:]), s)
+		return
+	}
+	if f*mult > math.MaxInt64 {
+		err = errors.Errorf("size %q is too large", s)
+		return
+	}
+	return int64(f * mult), nil
+}
@@ -17,6 +17,61 @@ func TestToBase2Bytes(t *testing.T) {
 	assert.Equal(t, "3.05 MiB", HumanBase2(int64(3195728)))
 }
 
+func TestParseSize(t *testing.T) {
+
+	// bytes
+	{
+		val, err := ParseSize("512")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(512), val)
+
+		val, err = ParseSize(" 10 bytes ")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(10), val)
+	}
+
+	// base 2
+	{
+		val, err := ParseSize("10KiB")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(10*KiB), val)
+
+		val, err = ParseSize("1.5 gib")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1536*MiB), val)
+
+		val, err = ParseSize("2m")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2*MiB), val)
+	}
+
+	// base 10
+	{
+		val, err := ParseSize("3MB")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3*MB), val)
+
+		val, err = ParseSize("1 TB")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(TB), val)
+	}
+
+	// invalid
+	{
+		_, err := ParseSize("")
+		assert.Equal(t, "invalid size \"\"", err.Error())
+
+		_, err = ParseSize("-1KiB")
+		assert.Equal(t, "invalid size \"-1KiB\"", err.Error())
+
+		_, err = ParseSize("10 parsecs")
+		assert.Equal(t, "invalid size unit \"parsecs\" in \"10 parsecs\"", err.Error())
+
+		_, err = ParseSize("100000000TiB")
+		assert.Equal(t, "size \"100000000TiB\" is too large", err.Error())
+	}
+}
+
 func TestToKiB(t *testing.T) {
 	assert.Equal(t, 1000.0, ToKiB(1000*KiB))
 }
@@ -0,0 +1,55 @@
+package yaml
+
+import (
+	yaml "github.com/phR0ze/yaml/v2"
+	"github.com/pkg/errors"
+)
+
+// Ordered implements the yaml.Unmarshaler interface decoding any yaml value such that
+// maps at every depth, including those nested in sequences, are yaml.MapSlice values
+// preserving the order of their keys.
+type Ordered struct {
+	Value interface{}
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface
+func (p *Ordered) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	var x interface{}
+	if err = unmarshal(&x); err != nil {
+		return
+	}
+
+	switch x.(type) {
+	case map[interface{}]interface{}:
+		m := yaml.MapSlice{}
+		if err = unmarshal(&m); err != nil {
+			return
+		}
+		p.Value = m
+	case []interface{}:
+		s := []Ordered{}
+		if err = unmarshal(&s); err != nil {
+			return
+		}
+		values := make([]interface{}, len(s))
+		for i := range s {
+			values[i] = s[i].Value
+		}
+		p.Value = values
+	default:
+		p.Value = x
+	}
+	return
+}
+
+// UnmarshalOrdered parses the yaml encoded data and returns the result where maps at
+// every depth are returned as yaml.MapSlice preserving the order of their keys.
+func UnmarshalOrdered(data []byte) (obj interface{}, err error) {
+	x := &Ordered{}
+	if err = yaml.Unmarshal(data, x); err != nil {
+		err = errors.Wrap(err, "failed to unmarshal yaml")
+		return
+	}
+	obj = x.Value
+	return
+}
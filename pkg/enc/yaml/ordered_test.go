@@ -0,0 +1,32 @@
+package yaml
+
+import (
+	"testing"
+
+	yaml "github.com/phR0ze/yaml/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalOrdered(t *testing.T) {
+
+	// maps nested in sequences are ordered
+	{
+		obj, err := UnmarshalOrdered([]byte("- b: 1\n  a:\n  - d: 2\n    c: 3\n- foo\n"))
+		assert.Nil(t, err)
+		assert.Equal(t, []interface{}{yaml.MapSlice{{Key: "b", Value: 1}, {Key: "a", Value: []interface{}{
+			yaml.MapSlice{{Key: "d", Value: 2}, {Key: "c", Value: 3}}}}}, "foo"}, obj)
+	}
+
+	// scalars
+	{
+		obj, err := UnmarshalOrdered([]byte("1.5"))
+		assert.Nil(t, err)
+		assert.Equal(t, 1.5, obj)
+	}
+
+	// invalid
+	{
+		_, err := UnmarshalOrdered([]byte("a: [1"))
+		assert.NotNil(t, err)
+	}
+}
@@ -4,6 +4,7 @@ package yaml
 import (
 	"os"
 
+	"github.com/phR0ze/n/pkg/opt"
 	"github.com/phR0ze/n/pkg/sys"
 	yaml "github.com/phR0ze/yaml/v2"
 	"github.com/pkg/errors"
@@ -47,6 +48,18 @@ func Unmarshal(y []byte, obj interface{}) error {
 // WriteYAML converts the given obj interface{} into yaml then writes to disk
 // with default permissions. Expects obj to be a structure that github.com/ghodss/yaml understands
 func WriteYAML(filepath string, obj interface{}, perms ...uint32) (err error) {
+	opts := []*opt.Opt{}
+	if len(perms) > 0 {
+		opts = append(opts, sys.PermsOpt(os.FileMode(perms[0])))
+	}
+	return WriteYAMLO(filepath, obj, opts...)
+}
+
+// WriteYAMLO converts the given obj interface{} into yaml then writes to disk
+// with default permissions. Expects obj to be a structure that github.com/ghodss/yaml understands
+//
+// Supported options: sys.AtomicOpt, sys.BackupOpt and sys.PermsOpt
+func WriteYAMLO(filepath string, obj interface{}, opts ...*opt.Opt) (err error) {
 	if filepath, err = sys.Abs(filepath); err != nil {
 		return
 	}
@@ -70,11 +83,7 @@ func WriteYAML(filepath string, obj interface{}, perms ...uint32) (err error) {
 		return
 	}
 
-	perm := os.FileMode(0644)
-	if len(perms) > 0 {
-		perm = os.FileMode(perms[0])
-	}
-	if err = os.WriteFile(filepath, data, perm); err != nil {
+	if err = sys.WriteBytesO(filepath, data, opts...); err != nil {
 		err = errors.Wrapf(err, "failed to write out yaml data to file %s", filepath)
 	}
 	return
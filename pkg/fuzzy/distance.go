@@ -0,0 +1,244 @@
+package fuzzy
+
+import (
+	"sort"
+)
+
+// Levenshtein returns the minimum number of single rune insertions, deletions and
+// substitutions required to change a into b.
+func Levenshtein(a, b string) int {
+	x, y := []rune(a), []rune(b)
+	if len(x) < len(y) {
+		x, y = y, x
+	}
+
+	// Only two rows of the matrix are needed at any given time
+	prev := make([]int, len(y)+1)
+	cur := make([]int, len(y)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(x); i++ {
+		cur[0] = i
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(y)]
+}
+
+// DamerauLevenshtein returns the minimum number of single rune insertions, deletions,
+// substitutions and transpositions of two adjacent runes required to change a into b. Unlike
+// the restricted optimal string alignment distance a substring may be edited more than once
+// such that "ca" to "abc" is 2 rather than 3.
+func DamerauLevenshtein(a, b string) int {
+	return newDamerau([]rune(b)).distance([]rune(a), len(a)+len(b)+1)
+}
+
+// damerau calculates Damerau-Levenshtein distances to b reusing its buffers between calls
+type damerau struct {
+	b        []rune       // string to calculate distances to
+	ids      []int        // alphabet index of each rune of b
+	alphabet map[rune]int // runes of b mapped to an index into last
+	last     []int        // last row each rune of the alphabet was seen in
+	d        []int        // distance matrix with an extra border row and column
+}
+
+// newDamerau creates a new damerau for calculating distances to b
+func newDamerau(b []rune) *damerau {
+	p := &damerau{b: b, ids: make([]int, len(b)), alphabet: map[rune]int{}}
+	for j, r := range b {
+		id, ok := p.alphabet[r]
+		if !ok {
+			id = len(p.alphabet)
+			p.alphabet[r] = id
+		}
+		p.ids[j] = id
+	}
+	p.last = make([]int, len(p.alphabet))
+	return p
+}
+
+// distance returns the Damerau-Levenshtein distance between a and b or the given bound if the
+// distance is known to be at least the bound without finishing the calculation.
+func (p *damerau) distance(a []rune, bound int) int {
+	b := p.b
+	if len(a) == 0 || len(b) == 0 {
+		return min(len(a)+len(b), bound)
+	}
+	for i := range p.last {
+		p.last[i] = 0
+	}
+
+	// Initialize the matrix borders with the max distance
+	maxDist := len(a) + len(b)
+	cols := len(b) + 2
+	if size := (len(a) + 2) * cols; cap(p.d) < size {
+		p.d = make([]int, size)
+	} else {
+		p.d = p.d[:size]
+	}
+	d := p.d
+	d[0] = maxDist
+	for i := 0; i <= len(a); i++ {
+		d[(i+1)*cols] = maxDist
+		d[(i+1)*cols+1] = i
+	}
+	for j := 0; j <= len(b); j++ {
+		d[j+1] = maxDist
+		d[cols+j+1] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		id, ok := p.alphabet[a[i-1]]
+		db, rowMin := 0, i
+		for j := 1; j <= len(b); j++ {
+			k := p.last[p.ids[j-1]]
+			l := db
+			cost := 1
+			if ok && id == p.ids[j-1] {
+				cost = 0
+				db = j
+			}
+			dist := min(
+				d[i*cols+j]+cost,              // substitution
+				d[(i+1)*cols+j]+1,             // insertion
+				d[i*cols+j+1]+1,               // deletion
+				d[k*cols+l]+(i-k-1)+1+(j-l-1), // transposition
+			)
+			d[(i+1)*cols+j+1] = dist
+			rowMin = min(rowMin, dist)
+		}
+
+		// The row minimum never decreases so it is a lower bound for the distance
+		if rowMin >= bound {
+			return bound
+		}
+		if ok {
+			p.last[id] = i
+		}
+	}
+	return min(d[(len(a)+1)*cols+len(b)+1], bound)
+}
+
+// Jaro returns the Jaro similarity of a and b between 0 for no similarity and 1 for an exact
+// match based on the number of matching runes and transpositions between them.
+func Jaro(a, b string) float64 {
+	x, y := []rune(a), []rune(b)
+	if len(x) == 0 && len(y) == 0 {
+		return 1
+	}
+	if len(x) == 0 || len(y) == 0 {
+		return 0
+	}
+
+	// Runes match if they are the same and within the match window of each other
+	window := max(len(x), len(y))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+	xMatched, yMatched := make([]bool, len(x)), make([]bool, len(y))
+	matches := 0
+	for i := range x {
+		for j := max(0, i-window); j < min(len(y), i+window+1); j++ {
+			if !yMatched[j] && x[i] == y[j] {
+				xMatched[i], yMatched[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	// Count the matched runes that are out of order
+	transpositions := 0
+	for i, j := 0, 0; i < len(x); i++ {
+		if !xMatched[i] {
+			continue
+		}
+		for !yMatched[j] {
+			j++
+		}
+		if x[i] != y[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	return (m/float64(len(x)) + m/float64(len(y)) + (m-float64(transpositions/2))/m) / 3
+}
+
+// JaroWinkler returns the Jaro-Winkler similarity of a and b between 0 for no similarity and
+// 1 for an exact match. The Jaro similarity is boosted for strings sharing a common prefix of
+// up to 4 runes when the Jaro similarity is above 0.7 favoring typos at the end of words.
+func JaroWinkler(a, b string) float64 {
+	sim := Jaro(a, b)
+	if sim <= 0.7 {
+		return sim
+	}
+	prefix := 0
+	for x, y := []rune(a), []rune(b); prefix < min(len(x), len(y), 4) && x[prefix] == y[prefix]; {
+		prefix++
+	}
+	return sim + float64(prefix)*0.1*(1-sim)
+}
+
+// Closest returns up to n of the given targets closest to the query ordered by their
+// Damerau-Levenshtein distance with ties kept in their original order. Once n targets have been
+// found the worst distance kept so far bounds the calculation for the remaining targets such
+// that most of a large target list is skipped early.
+func Closest(query string, targets []string, n int) (closest []string) {
+	closest = []string{}
+	if n <= 0 {
+		return
+	}
+
+	type ranked struct {
+		index, dist int
+	}
+	best := make([]ranked, 0, n+1)
+	q := []rune(query)
+	calc := newDamerau(q)
+	for i, target := range targets {
+		t := []rune(target)
+		bound := len(q) + len(t) + 1
+		if len(best) == n {
+			if bound = best[len(best)-1].dist; abs(len(q)-len(t)) >= bound {
+				continue
+			}
+		}
+		dist := calc.distance(t, bound)
+		if dist >= bound {
+			continue
+		}
+
+		// Insert after any equal distances to keep the original order for ties
+		j := sort.Search(len(best), func(j int) bool { return best[j].dist > dist })
+		best = append(best, ranked{})
+		copy(best[j+1:], best[j:])
+		best[j] = ranked{index: i, dist: dist}
+		if len(best) > n {
+			best = best[:n]
+		}
+	}
+	for _, x := range best {
+		closest = append(closest, targets[x.index])
+	}
+	return
+}
+
+// abs returns the absolute value of the given int
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
@@ -0,0 +1,90 @@
+package fuzzy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLevenshtein(t *testing.T) {
+	assert.Equal(t, 0, Levenshtein("", ""))
+	assert.Equal(t, 3, Levenshtein("", "abc"))
+	assert.Equal(t, 3, Levenshtein("abc", ""))
+	assert.Equal(t, 0, Levenshtein("abc", "abc"))
+	assert.Equal(t, 3, Levenshtein("kitten", "sitting"))
+	assert.Equal(t, 3, Levenshtein("sitting", "kitten"))
+	assert.Equal(t, 2, Levenshtein("ab", "ba"))
+	assert.Equal(t, 1, Levenshtein("日本語", "日本"))
+}
+
+func TestDamerauLevenshtein(t *testing.T) {
+	assert.Equal(t, 0, DamerauLevenshtein("", ""))
+	assert.Equal(t, 3, DamerauLevenshtein("", "abc"))
+	assert.Equal(t, 3, DamerauLevenshtein("abc", ""))
+	assert.Equal(t, 0, DamerauLevenshtein("abc", "abc"))
+	assert.Equal(t, 3, DamerauLevenshtein("kitten", "sitting"))
+	assert.Equal(t, 1, DamerauLevenshtein("ab", "ba"))
+	assert.Equal(t, 1, DamerauLevenshtein("staus", "stuas"))
+	assert.Equal(t, 2, DamerauLevenshtein("ca", "abc"))
+	assert.Equal(t, 1, DamerauLevenshtein("日本", "本日"))
+}
+
+func TestJaro(t *testing.T) {
+	assert.Equal(t, 1.0, Jaro("", ""))
+	assert.Equal(t, 0.0, Jaro("", "abc"))
+	assert.Equal(t, 0.0, Jaro("abc", "xyz"))
+	assert.Equal(t, 1.0, Jaro("abc", "abc"))
+	assert.Equal(t, "0.944", fmt.Sprintf("%.3f", Jaro("MARTHA", "MARHTA")))
+	assert.Equal(t, "0.822", fmt.Sprintf("%.3f", Jaro("DWAYNE", "DUANE")))
+	assert.Equal(t, "0.767", fmt.Sprintf("%.3f", Jaro("DIXON", "DICKSONX")))
+}
+
+func TestJaroWinkler(t *testing.T) {
+	assert.Equal(t, 1.0, JaroWinkler("", ""))
+	assert.Equal(t, 0.0, JaroWinkler("abc", "xyz"))
+	assert.Equal(t, 1.0, JaroWinkler("abc", "abc"))
+	assert.Equal(t, "0.961", fmt.Sprintf("%.3f", JaroWinkler("MARTHA", "MARHTA")))
+	assert.Equal(t, "0.840", fmt.Sprintf("%.3f", JaroWinkler("DWAYNE", "DUANE")))
+	assert.Equal(t, "0.813", fmt.Sprintf("%.3f", JaroWinkler("DIXON", "DICKSONX")))
+
+	// no boost below the threshold
+	assert.Equal(t, Jaro("abcxyz", "abqrst"), JaroWinkler("abcxyz", "abqrst"))
+}
+
+func TestClosest(t *testing.T) {
+	commands := []string{"status", "stash", "commit", "checkout", "cherry-pick", "push", "pull"}
+
+	// none requested or no targets
+	{
+		assert.Equal(t, []string{}, Closest("stats", commands, 0))
+		assert.Equal(t, []string{}, Closest("stats", nil, 3))
+	}
+
+	// ranked by distance
+	{
+		assert.Equal(t, []string{"status"}, Closest("stauts", commands, 1))
+		assert.Equal(t, []string{"status", "stash"}, Closest("stats", commands, 2))
+		assert.Equal(t, []string{"push", "pull"}, Closest("puhs", commands, 2))
+	}
+
+	// more requested than available
+	{
+		assert.Equal(t, 7, len(Closest("x", commands, 10)))
+	}
+
+	// ties keep their original order
+	{
+		assert.Equal(t, []string{"ab", "ba", "bb"}, Closest("bab", []string{"ab", "ba", "bb"}, 3))
+	}
+}
+
+func BenchmarkClosest(t *testing.B) {
+	targets := make([]string, 20000)
+	for i := range targets {
+		targets[i] = fmt.Sprintf("command-%d-name", i)
+	}
+	for i := 0; i < t.N; i++ {
+		Closest("comand-1234-nmae", targets, 5)
+	}
+}
@@ -0,0 +1,237 @@
+// Package fuzzy provides string similarity metrics and fuzzy finder style matching.
+//
+// Distance metrics Levenshtein, DamerauLevenshtein and JaroWinkler are useful for "did you
+// mean ...?" suggestions while Match and Filter score and rank candidates for a query the way
+// interactive fuzzy finders like fzf and skim do returning the matched positions so that they
+// can be highlighted.
+package fuzzy
+
+import (
+	"sort"
+	"unicode"
+)
+
+// Scoring constants modelled after fzf such that matches on word boundaries and consecutive
+// matches are favored over matches scattered across the text.
+const (
+	scoreMatch        = 16
+	scoreGapStart     = -3
+	scoreGapExtension = -1
+
+	bonusBoundary            = scoreMatch / 2
+	bonusNonWord             = scoreMatch / 2
+	bonusCamel123            = bonusBoundary + scoreGapExtension
+	bonusConsecutive         = -(scoreGapStart + scoreGapExtension)
+	bonusFirstCharMultiplier = 2
+	bonusBoundaryWhite       = bonusBoundary + 2
+	bonusBoundaryDelimiter   = bonusBoundary + 1
+)
+
+// charClass is used to calculate the bonus for matching a rune
+type charClass int
+
+const (
+	charWhite charClass = iota
+	charNonWord
+	charDelimiter
+	charLower
+	charUpper
+	charLetter
+	charNumber
+)
+
+// Result is a successful fuzzy match of a query against a text
+type Result struct {
+	Text      string // text that was matched
+	Index     int    // index of the text in the slice given to Filter
+	Score     int    // higher scores are better matches
+	Positions []int  // rune positions of the matched characters in the text
+}
+
+// Match scores the text against the given query returning nil if the text doesn't contain all
+// the runes of the query in order. Matching is case insensitive unless the query contains an
+// upper case letter. The match is found in the same way as fzf's v1 algorithm by finding the
+// first occurrence of the query then shrinking it from the end to find the shortest match.
+// Matches at the start of words, after delimiters, on camelCase humps and consecutive matches
+// score higher while gaps between matched runes are penalized.
+func Match(query, text string) *Result {
+	q, sensitive := prepare(query)
+	return match(q, text, sensitive)
+}
+
+// Filter matches all the texts against the given query returning the matches ranked by their
+// score. Ties are ranked by shorter text first then by their original order.
+func Filter(query string, texts []string) (results []*Result) {
+	results = []*Result{}
+	q, sensitive := prepare(query)
+	for i, text := range texts {
+		if result := match(q, text, sensitive); result != nil {
+			result.Index = i
+			results = append(results, result)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return len(results[i].Text) < len(results[j].Text)
+	})
+	return
+}
+
+// prepare the query for matching using smart case i.e. only case sensitive if the query
+// contains an upper case letter otherwise the query is lowered.
+func prepare(query string) (q []rune, sensitive bool) {
+	q = []rune(query)
+	for _, r := range q {
+		if unicode.IsUpper(r) {
+			return q, true
+		}
+	}
+	for i := range q {
+		q[i] = unicode.ToLower(q[i])
+	}
+	return
+}
+
+// match finds and scores the query in the text
+func match(query []rune, text string, sensitive bool) *Result {
+	t := []rune(text)
+	if len(query) == 0 {
+		return &Result{Text: text, Positions: []int{}}
+	}
+	equal := func(i, qi int) bool {
+		r := t[i]
+		if !sensitive {
+			r = unicode.ToLower(r)
+		}
+		return r == query[qi]
+	}
+
+	// Forward scan for the end of the first occurrence
+	start, end := -1, -1
+	for i, qi := 0, 0; i < len(t); i++ {
+		if equal(i, qi) {
+			if start == -1 {
+				start = i
+			}
+			if qi++; qi == len(query) {
+				end = i + 1
+				break
+			}
+		}
+	}
+	if end == -1 {
+		return nil
+	}
+
+	// Backward scan for the latest start that still matches
+	for i, qi := end-1, len(query)-1; i >= start; i-- {
+		if equal(i, qi) {
+			if qi--; qi < 0 {
+				start = i
+				break
+			}
+		}
+	}
+
+	score, positions := calculateScore(t, query, start, end, sensitive)
+	return &Result{Text: text, Score: score, Positions: positions}
+}
+
+// calculateScore scores the query matched in the text between start and end
+func calculateScore(text, query []rune, start, end int, sensitive bool) (score int, positions []int) {
+	positions = make([]int, 0, len(query))
+	prevClass := charWhite
+	if start > 0 {
+		prevClass = classOf(text[start-1])
+	}
+
+	qi, inGap, consecutive, firstBonus := 0, false, 0, 0
+	for i := start; i < end; i++ {
+		r := text[i]
+		class := classOf(r)
+		if !sensitive {
+			r = unicode.ToLower(r)
+		}
+		if qi < len(query) && r == query[qi] {
+			positions = append(positions, i)
+			score += scoreMatch
+			bonus := bonusFor(prevClass, class)
+			if consecutive == 0 {
+				firstBonus = bonus
+			} else {
+				// Break consecutive chunks on boundaries with a higher bonus
+				if bonus >= bonusBoundary && bonus > firstBonus {
+					firstBonus = bonus
+				}
+				bonus = max(bonus, firstBonus, bonusConsecutive)
+			}
+			if qi == 0 {
+				score += bonus * bonusFirstCharMultiplier
+			} else {
+				score += bonus
+			}
+			inGap, consecutive = false, consecutive+1
+			qi++
+		} else {
+			if inGap {
+				score += scoreGapExtension
+			} else {
+				score += scoreGapStart
+			}
+			inGap, consecutive, firstBonus = true, 0, 0
+		}
+		prevClass = class
+	}
+	return
+}
+
+// bonusFor returns the bonus for matching a rune of the given class after the previous class
+func bonusFor(prev, class charClass) int {
+	if class > charNonWord && class != charDelimiter {
+		switch prev {
+		case charWhite:
+			return bonusBoundaryWhite
+		case charDelimiter:
+			return bonusBoundaryDelimiter
+		case charNonWord:
+			return bonusBoundary
+		}
+	}
+	if prev == charLower && class == charUpper || prev != charNumber && class == charNumber {
+		return bonusCamel123
+	}
+	switch class {
+	case charNonWord, charDelimiter:
+		return bonusNonWord
+	case charWhite:
+		return bonusBoundaryWhite
+	}
+	return 0
+}
+
+// classOf returns the character class of the given rune
+func classOf(r rune) charClass {
+	switch {
+	case r >= 'a' && r <= 'z':
+		return charLower
+	case r >= 'A' && r <= 'Z':
+		return charUpper
+	case r >= '0' && r <= '9':
+		return charNumber
+	case r == '/' || r == ',' || r == ':' || r == ';' || r == '|':
+		return charDelimiter
+	case unicode.IsSpace(r):
+		return charWhite
+	case unicode.IsLower(r):
+		return charLower
+	case unicode.IsUpper(r):
+		return charUpper
+	case unicode.IsLetter(r):
+		return charLetter
+	case unicode.IsNumber(r):
+		return charNumber
+	}
+	return charNonWord
+}
@@ -0,0 +1,90 @@
+package fuzzy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+
+	// no match
+	{
+		assert.Nil(t, Match("abc", ""))
+		assert.Nil(t, Match("abc", "acb"))
+		assert.Nil(t, Match("A", "abc"))
+	}
+
+	// empty query matches everything
+	{
+		assert.Equal(t, &Result{Text: "abc", Positions: []int{}}, Match("", "abc"))
+	}
+
+	// smart case
+	{
+		assert.Equal(t, []int{0}, Match("a", "Abc").Positions)
+		assert.Equal(t, []int{3}, Match("A", "abcAbc").Positions)
+	}
+
+	// shortest match is found
+	{
+		assert.Equal(t, []int{2, 3, 4}, Match("abc", "aaabc").Positions)
+		assert.Equal(t, []int{0, 2, 4}, Match("abc", "axbxcxxx").Positions)
+	}
+
+	// positions are in runes
+	{
+		assert.Equal(t, []int{2, 3}, Match("本語", "日本本語").Positions)
+	}
+}
+
+func TestMatch_scoring(t *testing.T) {
+
+	// consecutive beats scattered
+	assert.Greater(t, Match("abc", "abcxxx").Score, Match("abc", "axbxcx").Score)
+
+	// word boundaries beat the middle of words
+	assert.Greater(t, Match("fb", "foo bar").Score, Match("fb", "xfxbxx").Score)
+	assert.Greater(t, Match("fb", "foo/bar").Score, Match("fb", "foobbar").Score)
+
+	// camel case humps beat the middle of words
+	assert.Greater(t, Match("fb", "fooBar").Score, Match("fb", "foobar").Score)
+
+	// exact fzf scores
+	assert.Equal(t, 16*3+10*2+10+10, Match("abc", "abc").Score)
+	assert.Equal(t, 16*2+10*2-3+10, Match("fb", "f b").Score)
+}
+
+func TestFilter(t *testing.T) {
+
+	// empty
+	{
+		assert.Equal(t, []*Result{}, Filter("abc", nil))
+		assert.Equal(t, []*Result{}, Filter("abc", []string{"xyz"}))
+	}
+
+	// ranked by score then length then original order
+	{
+		files := []string{"src/main_test.go", "main.go", "cmd/main.go", "readme.md", "pkg/mxaxixn.go", "main.go"}
+		results := Filter("main", files)
+		texts := []string{}
+		for _, x := range results {
+			texts = append(texts, x.Text)
+		}
+		assert.Equal(t, []string{"main.go", "main.go", "cmd/main.go", "src/main_test.go", "pkg/mxaxixn.go"}, texts)
+		assert.Equal(t, 1, results[0].Index)
+		assert.Equal(t, 5, results[1].Index)
+		assert.Equal(t, []int{4, 5, 6, 7}, results[2].Positions)
+	}
+}
+
+func BenchmarkFilter(t *testing.B) {
+	texts := make([]string, 50000)
+	for i := range texts {
+		texts[i] = fmt.Sprintf("pkg/module%d/file_%d_test.go", i%100, i)
+	}
+	for i := 0; i < t.N; i++ {
+		Filter("mod42fil", texts)
+	}
+}
@@ -0,0 +1,21 @@
+package shell
+
+import (
+	"github.com/phR0ze/n/pkg/opt"
+)
+
+// VarsOpt creates a new vars option with the given variables to expand while splitting
+// -------------------------------------------------------------------------------------------------
+func VarsOpt(val map[string]string) *opt.Opt {
+	return &opt.Opt{Key: "vars", Val: val}
+}
+
+// get the vars option from the options slice defaulting to nil
+func getVarsOpt(opts []*opt.Opt) map[string]string {
+	if o := opt.Get(opts, "vars"); o != nil {
+		if val, ok := o.Val.(map[string]string); ok {
+			return val
+		}
+	}
+	return nil
+}
@@ -0,0 +1,405 @@
+// Package shell provides POSIX shell compatible word splitting, quoting and escaping.
+//
+// Split breaks a command line into words the way sh does removing quotes and escapes while
+// Quote and Join do the inverse escaping arbitrary strings such that sh or bash will see them
+// as a single word. Split(Join(args)) always returns the original args. Segments uses the same
+// double quote and escape rules to break a string into its quoted and unquoted parts as is.
+package shell
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/pkg/errors"
+)
+
+// Split breaks the given string into words following the POSIX shell rules for quoting:
+//   - unquoted whitespace separates words
+//   - a backslash preserves the literal value of the next character and a backslash newline
+//     is a line continuation
+//   - single quotes preserve the literal value of every character within them
+//   - double quotes preserve the literal value of every character within them except a
+//     backslash which only escapes $, `, ", \ and newline
+//   - $'...' ANSI-C quoting as supported by bash, zsh and ksh e.g. $'a\tb'
+//   - a # at the start of a word begins a comment that runs to the end of the line
+//
+// Variables e.g. $HOME or ${HOME} are left as is unless variable expansion is requested by
+// passing in VarsOpt(vars) in which case they are expanded from the given map with undefined
+// variables expanding to an empty string. As in a shell unquoted expansions are further split
+// on whitespace while expansions in double quotes are not. Unterminated quotes return an error.
+func Split(s string, opts ...*opt.Opt) (words []string, err error) {
+	l := &lexer{src: []rune(s), vars: getVarsOpt(opts)}
+	return l.split()
+}
+
+// Segments breaks the given string into its unquoted and double quoted segments following the
+// same double quote and backslash escape rules as Split. Unlike Split the segments are the
+// source text as is including the quotes, escapes and whitespace, only double quotes separate
+// segments and empty double quotes are removed e.g. `."a.b"""\"c` => [`.`, `"a.b"`, `\"c`].
+// This suits jq style selectors which double quote keys. Unterminated quotes return an error.
+func Segments(s string) (segments []string, err error) {
+	l := &lexer{src: []rune(s), raw: true}
+	return l.segments()
+}
+
+// Quote returns the given string quoted if needed such that sh or bash will treat it as a
+// single word with no expansions. Strings made up of only safe characters are returned as is,
+// an empty string becomes an empty pair of single quotes and everything else is single quoted
+// with any embedded single quotes closing the quote, adding an escaped quote and reopening it.
+func Quote(s string) string {
+	if s == "" {
+		return "''"
+	}
+	safe := true
+	for _, r := range s {
+		if !isSafe(r) {
+			safe = false
+			break
+		}
+	}
+	if safe {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// Join quotes each of the given args as needed and joins them together with spaces such that
+// the result can be safely passed to sh or bash as a command line.
+func Join(args []string) string {
+	quoted := make([]string, len(args))
+	for i := range args {
+		quoted[i] = Quote(args[i])
+	}
+	return strings.Join(quoted, " ")
+}
+
+// isSafe tests if the given rune never needs quoting
+func isSafe(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') ||
+		strings.ContainsRune("@%+=:,./-_", r)
+}
+
+// isBlank tests if the given rune separates words
+func isBlank(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n'
+}
+
+// lexer splits the source into words
+type lexer struct {
+	src    []rune
+	pos    int
+	vars   map[string]string
+	raw    bool // keep quotes and escapes as is
+	words  []string
+	word   []rune
+	inWord bool // a word has been started even if it is empty e.g. ""
+}
+
+// split the source into words
+func (l *lexer) split() (words []string, err error) {
+	l.words = []string{}
+	for l.pos < len(l.src) {
+		r := l.src[l.pos]
+		switch {
+		case isBlank(r):
+			l.flush()
+			l.pos++
+		case r == '#' && !l.inWord:
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+				l.pos++
+			}
+		case r == '\\':
+			l.pos++
+			l.escape()
+		case r == '\'':
+			if err = l.singleQuote(); err != nil {
+				return
+			}
+		case r == '"':
+			if err = l.doubleQuote(); err != nil {
+				return
+			}
+		case r == '$' && l.peek(1) == '\'':
+			if err = l.ansiQuote(); err != nil {
+				return
+			}
+		case r == '$' && l.vars != nil:
+			var val string
+			var ok bool
+			if val, ok, err = l.variable(); err != nil {
+				return
+			}
+			if ok {
+				l.fields(val)
+			} else {
+				l.append('$')
+			}
+		default:
+			l.append(r)
+			l.pos++
+		}
+	}
+	l.flush()
+	words = l.words
+	return
+}
+
+// segments splits the source at double quotes keeping the source text as is
+func (l *lexer) segments() (segments []string, err error) {
+	l.words = []string{}
+	for l.pos < len(l.src) {
+		r := l.src[l.pos]
+		switch {
+		case r == '\\':
+			l.append(l.src[l.pos:min(l.pos+2, len(l.src))]...)
+			l.pos += 2
+		case r == '"':
+			l.flush()
+			if err = l.doubleQuote(); err != nil {
+				return
+			}
+			if len(l.word) == 2 {
+				l.word, l.inWord = nil, false
+			}
+			l.flush()
+		default:
+			l.append(r)
+			l.pos++
+		}
+	}
+	l.flush()
+	segments = l.words
+	return
+}
+
+// peek returns the rune at the given offset from the current position or 0 if out of bounds
+func (l *lexer) peek(offset int) rune {
+	if l.pos+offset < len(l.src) {
+		return l.src[l.pos+offset]
+	}
+	return 0
+}
+
+// append the given runes to the current word
+func (l *lexer) append(r ...rune) {
+	l.word = append(l.word, r...)
+	l.inWord = true
+}
+
+// flush the current word if one has been started
+func (l *lexer) flush() {
+	if l.inWord {
+		l.words = append(l.words, string(l.word))
+	}
+	l.word, l.inWord = nil, false
+}
+
+// fields appends the unquoted expansion to the current word splitting it on whitespace
+func (l *lexer) fields(val string) {
+	fields := strings.FieldsFunc(val, isBlank)
+	if val != "" && isBlank([]rune(val)[0]) {
+		l.flush()
+	}
+	for i := range fields {
+		if i > 0 {
+			l.flush()
+		}
+		l.append([]rune(fields[i])...)
+	}
+	if len(fields) > 0 && isBlank([]rune(val)[len([]rune(val))-1]) {
+		l.flush()
+	}
+}
+
+// escape handles the rune following an unquoted backslash
+func (l *lexer) escape() {
+	switch {
+	case l.pos >= len(l.src):
+		l.append('\\')
+	case l.src[l.pos] == '\n':
+		l.pos++
+	default:
+		l.append(l.src[l.pos])
+		l.pos++
+	}
+}
+
+// singleQuote reads a single quoted string
+func (l *lexer) singleQuote() (err error) {
+	start := l.pos
+	l.pos++
+	l.inWord = true
+	for l.pos < len(l.src) {
+		if r := l.src[l.pos]; r != '\'' {
+			l.append(r)
+			l.pos++
+			continue
+		}
+		l.pos++
+		return
+	}
+	return errors.Errorf("unterminated single quote at offset %d", start)
+}
+
+// doubleQuote reads a double quoted string expanding variables if requested
+func (l *lexer) doubleQuote() (err error) {
+	start := l.pos
+	l.pos++
+	l.inWord = true
+	if l.raw {
+		l.append('"')
+	}
+	for l.pos < len(l.src) {
+		r := l.src[l.pos]
+		switch {
+		case r == '"':
+			if l.raw {
+				l.append(r)
+			}
+			l.pos++
+			return
+		case r == '\\' && strings.ContainsRune("$`\"\\\n", l.peek(1)):
+			if l.raw {
+				l.append(r, l.peek(1))
+			} else if l.peek(1) != '\n' {
+				l.append(l.peek(1))
+			}
+			l.pos += 2
+		case r == '$' && l.vars != nil:
+			var val string
+			var ok bool
+			if val, ok, err = l.variable(); err != nil {
+				return
+			}
+			if ok {
+				l.append([]rune(val)...)
+			} else {
+				l.append('$')
+			}
+		default:
+			l.append(r)
+			l.pos++
+		}
+	}
+	return errors.Errorf("unterminated double quote at offset %d", start)
+}
+
+// variable reads a $NAME or ${NAME} variable at the current position returning its value. If
+// there isn't a valid variable name following the $ then ok is false and only the $ is read.
+func (l *lexer) variable() (val string, ok bool, err error) {
+	start := l.pos
+	l.pos++
+	braces := l.peek(0) == '{'
+	if braces {
+		l.pos++
+	}
+
+	// Read the variable name
+	nameStart := l.pos
+	for l.pos < len(l.src) {
+		r := l.src[l.pos]
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9' && l.pos > nameStart) {
+			l.pos++
+			continue
+		}
+		break
+	}
+	name := string(l.src[nameStart:l.pos])
+	if braces {
+		if l.peek(0) != '}' || name == "" {
+			err = errors.Errorf("invalid variable substitution at offset %d", start)
+			return
+		}
+		l.pos++
+	} else if name == "" {
+		l.pos = start + 1
+		return
+	}
+	return l.vars[name], true, nil
+}
+
+// ansiQuote reads a $'...' ANSI-C quoted string
+func (l *lexer) ansiQuote() (err error) {
+	start := l.pos
+	l.pos += 2
+	l.inWord = true
+	for l.pos < len(l.src) {
+		r := l.src[l.pos]
+		l.pos++
+		switch r {
+		case '\'':
+			return
+		case '\\':
+			if l.pos >= len(l.src) {
+				break
+			}
+			l.ansiEscape()
+		default:
+			l.append(r)
+		}
+	}
+	return errors.Errorf("unterminated ANSI-C quote at offset %d", start)
+}
+
+// ansiEscape handles the escape sequence following a backslash in an ANSI-C quoted string
+func (l *lexer) ansiEscape() {
+	r := l.src[l.pos]
+	l.pos++
+	switch r {
+	case 'a':
+		l.append('\a')
+	case 'b':
+		l.append('\b')
+	case 'e', 'E':
+		l.append(0x1b)
+	case 'f':
+		l.append('\f')
+	case 'n':
+		l.append('\n')
+	case 'r':
+		l.append('\r')
+	case 't':
+		l.append('\t')
+	case 'v':
+		l.append('\v')
+	case '\\', '\'', '"', '?':
+		l.append(r)
+	case 'c':
+		if l.pos < len(l.src) {
+			l.append(l.src[l.pos] & 0x1f)
+			l.pos++
+		}
+	case 'x', 'u', 'U':
+		if val, ok := l.number(16, map[rune]int{'x': 2, 'u': 4, 'U': 8}[r]); ok {
+			l.append(val)
+		} else {
+			l.append('\\', r)
+		}
+	default:
+		l.pos--
+		if val, ok := l.number(8, 3); ok {
+			l.append(val)
+		} else {
+			l.append('\\', r)
+			l.pos++
+		}
+	}
+}
+
+// number reads up to max digits in the given base returning the value as a rune. If there are
+// no digits then ok is false.
+func (l *lexer) number(base, max int) (val rune, ok bool) {
+	start := l.pos
+	for l.pos < len(l.src) && l.pos-start < max {
+		if _, err := strconv.ParseUint(string(l.src[l.pos]), base, 8); err != nil {
+			break
+		}
+		l.pos++
+	}
+	if l.pos == start {
+		return
+	}
+	x, _ := strconv.ParseUint(string(l.src[start:l.pos]), base, 32)
+	return rune(x), true
+}
@@ -0,0 +1,212 @@
+package shell
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplit(t *testing.T) {
+
+	// empty and whitespace
+	{
+		words, err := Split("")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{}, words)
+
+		words, err = Split(" \t\n ")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{}, words)
+	}
+
+	// plain words
+	{
+		words, err := Split("  ls   -la\t/tmp ")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"ls", "-la", "/tmp"}, words)
+	}
+
+	// single quotes
+	{
+		words, err := Split(`echo '  hello   $HOME \n "world"'`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"echo", `  hello   $HOME \n "world"`}, words)
+	}
+
+	// double quotes only escape $ ` " \ and newline
+	{
+		words, err := Split(`echo "a \"b\" \$c \\ \d \` + "\n" + `e"`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"echo", `a "b" $c \ \d e`}, words)
+	}
+
+	// backslash escapes and line continuations
+	{
+		words, err := Split(`a\ b c\\d e\` + "\n" + `f g\`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a b", `c\d`, "ef", `g\`}, words)
+	}
+
+	// adjacent quoted and unquoted parts form a single word
+	{
+		words, err := Split(`--name="foo bar"'s'x`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"--name=foo bars" + "x"}, words)
+	}
+
+	// empty quotes are empty words
+	{
+		words, err := Split(`a "" '' b`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "", "", "b"}, words)
+	}
+
+	// ANSI-C quotes
+	{
+		words, err := Split(`$'a\tb\n' $'it\'s' $'\x41\101é\U0001F600\e\cA' $'\q\x'`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a\tb\n", "it's", "AAé😀\x1b\x01", `\q\x`}, words)
+	}
+
+	// comments
+	{
+		words, err := Split("ls # list files\necho a#b '#c' # done")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"ls", "echo", "a#b", "#c"}, words)
+	}
+
+	// variables are left as is without expansion
+	{
+		words, err := Split(`echo $HOME "${USER}"`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"echo", "$HOME", "${USER}"}, words)
+	}
+
+	// unterminated quotes
+	{
+		_, err := Split(`echo 'foo`)
+		assert.Equal(t, "unterminated single quote at offset 5", err.Error())
+
+		_, err = Split(`echo "foo\"`)
+		assert.Equal(t, "unterminated double quote at offset 5", err.Error())
+
+		_, err = Split(`echo $'foo\'`)
+		assert.Equal(t, "unterminated ANSI-C quote at offset 5", err.Error())
+	}
+}
+
+func TestSplit_vars(t *testing.T) {
+	vars := VarsOpt(map[string]string{"HOME": "/home/foo", "MSG": " hello  world ", "EMPTY": ""})
+
+	// unquoted and quoted expansion
+	{
+		words, err := Split(`cd $HOME/bin ${HOME}x "$HOME" '$HOME'`, vars)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"cd", "/home/foo/bin", "/home/foox", "/home/foo", "$HOME"}, words)
+	}
+
+	// unquoted expansions are split on whitespace while quoted ones are not
+	{
+		words, err := Split(`echo a$MSG"b" "$MSG"`, vars)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"echo", "a", "hello", "world", "b", " hello  world "}, words)
+	}
+
+	// empty and undefined variables
+	{
+		words, err := Split(`echo $EMPTY $UNDEFINED "$EMPTY" x$EMPTY`, vars)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"echo", "", "x"}, words)
+	}
+
+	// dollars that aren't variables and escaped dollars
+	{
+		words, err := Split(`echo $ $1x "$" \$HOME "\$HOME"`, vars)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"echo", "$", "$1x", "$", "$HOME", "$HOME"}, words)
+	}
+
+	// invalid substitutions
+	{
+		_, err := Split(`echo ${HOME`, vars)
+		assert.Equal(t, "invalid variable substitution at offset 5", err.Error())
+
+		_, err = Split(`echo "${}"`, vars)
+		assert.Equal(t, "invalid variable substitution at offset 6", err.Error())
+	}
+}
+
+func TestSegments(t *testing.T) {
+
+	// empty
+	{
+		segments, err := Segments("")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{}, segments)
+	}
+
+	// quotes, escapes and whitespace are kept
+	{
+		segments, err := Segments(`foo "bar baz" qux`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`foo `, `"bar baz"`, ` qux`}, segments)
+
+		segments, err = Segments(`."a.b"""\"c`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`.`, `"a.b"`, `\"c`}, segments)
+
+		segments, err = Segments(`."a\"b\\".c\.d 'e'`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`.`, `"a\"b\\"`, `.c\.d 'e'`}, segments)
+
+		segments, err = Segments(`"$HOME \x"`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`"$HOME \x"`}, segments)
+	}
+
+	// empty quotes are removed
+	{
+		segments, err := Segments(`""foo""bar""`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`foo`, `bar`}, segments)
+	}
+
+	// unterminated
+	{
+		_, err := Segments(`foo"bar`)
+		assert.Equal(t, "unterminated double quote at offset 3", err.Error())
+
+		_, err = Segments(`"foo\"`)
+		assert.Equal(t, "unterminated double quote at offset 0", err.Error())
+
+		segments, err := Segments(`foo\`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`foo\`}, segments)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	assert.Equal(t, "''", Quote(""))
+	assert.Equal(t, "foo", Quote("foo"))
+	assert.Equal(t, "--name=/tmp/foo.txt", Quote("--name=/tmp/foo.txt"))
+	assert.Equal(t, "'foo bar'", Quote("foo bar"))
+	assert.Equal(t, `'it'\''s'`, Quote("it's"))
+	assert.Equal(t, `'$HOME'`, Quote("$HOME"))
+	assert.Equal(t, `'*'`, Quote("*"))
+	assert.Equal(t, "'a\nb'", Quote("a\nb"))
+	assert.Equal(t, "'é'", Quote("é"))
+}
+
+func TestJoin(t *testing.T) {
+	assert.Equal(t, "", Join(nil))
+	assert.Equal(t, `echo 'hello world' '' 'it'\''s' '$(rm -rf /)'`,
+		Join([]string{"echo", "hello world", "", "it's", "$(rm -rf /)"}))
+
+	// round trip
+	{
+		args := []string{"a b", `c"d`, `e\f`, "g'h", "", "\t\n", "$x", "#y", "!z", "é"}
+		words, err := Split(Join(args))
+		assert.Nil(t, err)
+		assert.Equal(t, args, words)
+	}
+}
@@ -0,0 +1,128 @@
+package stats
+
+import (
+	"math"
+	"slices"
+
+	"github.com/pkg/errors"
+)
+
+// errOverflow is returned by int aggregates whose result doesn't fit in an int
+var errOverflow = errors.New("integer overflow")
+
+// SumInt returns the sum of the given data or an error if the sum overflows an int in which
+// case the wrapped around sum is returned.
+func SumInt(data []int) (sum int, err error) {
+	var ok bool
+	for _, x := range data {
+		if sum, ok = add(sum, x); !ok && err == nil {
+			err = errOverflow
+		}
+	}
+	return
+}
+
+// ProductInt returns the product of the given data or 1 for an empty data set. Returns an
+// error if the product overflows an int in which case the wrapped around product is returned.
+func ProductInt(data []int) (product int, err error) {
+	var ok bool
+	product = 1
+	for _, x := range data {
+		if product, ok = mul(product, x); !ok && err == nil {
+			err = errOverflow
+		}
+	}
+	return
+}
+
+// CumSumInt returns a new slice of the running totals of the given data or an error if any of
+// the totals overflow an int.
+func CumSumInt(data []int) (result []int, err error) {
+	var ok bool
+	result = make([]int, len(data))
+	sum := 0
+	for i, x := range data {
+		if sum, ok = add(sum, x); !ok && err == nil {
+			err = errOverflow
+		}
+		result[i] = sum
+	}
+	return
+}
+
+// ScaleInt returns a new slice of the given data each multiplied by the given factor or an
+// error if any of the results overflow an int.
+func ScaleInt(data []int, factor int) (result []int, err error) {
+	var ok bool
+	result = make([]int, len(data))
+	for i, x := range data {
+		if result[i], ok = mul(x, factor); !ok && err == nil {
+			err = errOverflow
+		}
+	}
+	return
+}
+
+// MinInt returns the smallest value in the given data
+func MinInt(data []int) (val int, err error) {
+	if len(data) == 0 {
+		return 0, errEmpty
+	}
+	return slices.Min(data), nil
+}
+
+// MaxInt returns the largest value in the given data
+func MaxInt(data []int) (val int, err error) {
+	if len(data) == 0 {
+		return 0, errEmpty
+	}
+	return slices.Max(data), nil
+}
+
+// ModeInt returns the most frequent values in the given data in ascending order. All values
+// are returned if they occur equally often and nothing is returned for an empty data set.
+func ModeInt(data []int) (modes []int) {
+	modes = []int{}
+	sorted := append([]int{}, data...)
+	slices.Sort(sorted)
+	best := 0
+	for i := 0; i < len(sorted); {
+		j := i + 1
+		for j < len(sorted) && sorted[j] == sorted[i] {
+			j++
+		}
+		if j-i > best {
+			best, modes = j-i, modes[:0]
+		}
+		if j-i == best {
+			modes = append(modes, sorted[i])
+		}
+		i = j
+	}
+	return
+}
+
+// Floats returns the given int data as float64 data for use with the float functions which
+// can't overflow e.g. Mean, Variance and Percentile.
+func Floats(data []int) (result []float64) {
+	result = make([]float64, len(data))
+	for i, x := range data {
+		result[i] = float64(x)
+	}
+	return
+}
+
+// add returns a+b and false if the result overflowed
+func add(a, b int) (int, bool) {
+	c := a + b
+	return c, (b >= 0) == (c >= a)
+}
+
+// mul returns a*b and false if the result overflowed
+func mul(a, b int) (int, bool) {
+	c := a * b
+	if a == 0 || b == 0 {
+		return c, true
+	}
+	return c, c/b == a && !(a == -1 && b == math.MinInt) && !(b == -1 && a == math.MinInt)
+}
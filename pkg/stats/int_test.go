@@ -0,0 +1,96 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSumInt(t *testing.T) {
+	val, err := SumInt(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, val)
+
+	val, err = SumInt([]int{1, 2, -3, 4})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, val)
+
+	// temporary overflow is still an error
+	val, err = SumInt([]int{math.MaxInt, 1, -1})
+	assert.Equal(t, "integer overflow", err.Error())
+	assert.Equal(t, math.MaxInt, val)
+
+	_, err = SumInt([]int{math.MinInt, -1})
+	assert.NotNil(t, err)
+
+	val, err = SumInt([]int{math.MinInt, math.MaxInt})
+	assert.Nil(t, err)
+	assert.Equal(t, -1, val)
+}
+
+func TestProductInt(t *testing.T) {
+	val, err := ProductInt(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, val)
+
+	val, err = ProductInt([]int{2, -3, 4})
+	assert.Nil(t, err)
+	assert.Equal(t, -24, val)
+
+	val, err = ProductInt([]int{math.MaxInt, 0})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, val)
+
+	for _, data := range [][]int{{math.MaxInt, 2}, {math.MinInt, -1}, {-1, math.MinInt}, {1 << 32, 1 << 32}} {
+		_, err = ProductInt(data)
+		assert.NotNil(t, err, data)
+	}
+}
+
+func TestCumSumInt(t *testing.T) {
+	val, err := CumSumInt([]int{1, 2, 3})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 3, 6}, val)
+
+	_, err = CumSumInt([]int{math.MaxInt, 1})
+	assert.Equal(t, "integer overflow", err.Error())
+}
+
+func TestScaleInt(t *testing.T) {
+	val, err := ScaleInt([]int{1, -2, 3}, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{3, -6, 9}, val)
+
+	_, err = ScaleInt([]int{1, math.MaxInt / 2}, 3)
+	assert.Equal(t, "integer overflow", err.Error())
+}
+
+func TestMinMaxInt(t *testing.T) {
+	_, err := MinInt(nil)
+	assert.Equal(t, "empty data set", err.Error())
+	_, err = MaxInt(nil)
+	assert.Equal(t, "empty data set", err.Error())
+
+	val, err := MinInt([]int{3, math.MinInt, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, math.MinInt, val)
+
+	val, err = MaxInt([]int{3, math.MaxInt, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, math.MaxInt, val)
+}
+
+func TestModeInt(t *testing.T) {
+	assert.Equal(t, []int{}, ModeInt(nil))
+	assert.Equal(t, []int{2}, ModeInt([]int{1, 2, 3, 2}))
+	assert.Equal(t, []int{1, 3}, ModeInt([]int{3, 1, 3, 2, 1}))
+
+	// exact for values that can't be represented as float64
+	assert.Equal(t, []int{math.MaxInt}, ModeInt([]int{math.MaxInt, math.MaxInt, math.MaxInt - 1}))
+}
+
+func TestFloats(t *testing.T) {
+	assert.Equal(t, []float64{}, Floats(nil))
+	assert.Equal(t, []float64{1, -2}, Floats([]int{1, -2}))
+}
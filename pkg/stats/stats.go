@@ -0,0 +1,300 @@
+// Package stats provides descriptive statistics and numeric aggregates over float64 and int
+// data sets.
+//
+// Float functions propagate NaN values in the data the same way float arithmetic does such that
+// a NaN in the data results in NaN rather than silently skewing the result. Use HasNaN to detect
+// them or SkipNaN to drop them before aggregating. Int functions detect overflow returning an
+// error rather than silently wrapping around.
+package stats
+
+import (
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// Interpolation selects how Percentile calculates a percentile that falls between two data
+// points using the same names as numpy.
+type Interpolation int
+
+const (
+	// Linear interpolates between the two closest data points
+	Linear Interpolation = iota
+
+	// Lower uses the lower of the two closest data points
+	Lower
+
+	// Higher uses the higher of the two closest data points
+	Higher
+
+	// Nearest uses the closest data point rounding half way to the even index
+	Nearest
+
+	// Midpoint uses the average of the two closest data points
+	Midpoint
+)
+
+// Bucket is a single range of a Histogram. Values v are counted in the bucket when
+// Min <= v < Max except for the last bucket which also counts values equal to its Max.
+type Bucket struct {
+	Min   float64 // inclusive lower bound of the bucket
+	Max   float64 // exclusive upper bound of the bucket
+	Count int     // number of values that fall in the bucket
+}
+
+// errEmpty is returned by aggregates that are undefined for empty data sets
+var errEmpty = errors.New("empty data set")
+
+// HasNaN tests if any of the given data is NaN
+func HasNaN(data []float64) bool {
+	for _, x := range data {
+		if math.IsNaN(x) {
+			return true
+		}
+	}
+	return false
+}
+
+// SkipNaN returns a new slice of the given data with any NaN values removed
+func SkipNaN(data []float64) (result []float64) {
+	result = make([]float64, 0, len(data))
+	for _, x := range data {
+		if !math.IsNaN(x) {
+			result = append(result, x)
+		}
+	}
+	return
+}
+
+// Sum returns the sum of the given data using Neumaier's compensated summation to minimize
+// the floating point error accumulated over large data sets.
+func Sum(data []float64) float64 {
+	sum, c := 0.0, 0.0
+	for _, x := range data {
+		t := sum + x
+		if math.Abs(sum) >= math.Abs(x) {
+			c += (sum - t) + x
+		} else {
+			c += (x - t) + sum
+		}
+		sum = t
+	}
+
+	// Infinities make the compensation NaN
+	if math.IsInf(sum, 0) {
+		return sum
+	}
+	return sum + c
+}
+
+// Product returns the product of the given data or 1 for an empty data set
+func Product(data []float64) float64 {
+	product := 1.0
+	for _, x := range data {
+		product *= x
+	}
+	return product
+}
+
+// Min returns the smallest value in the given data
+func Min(data []float64) (min float64, err error) {
+	if len(data) == 0 {
+		return 0, errEmpty
+	}
+	min = data[0]
+	for _, x := range data[1:] {
+		min = math.Min(min, x)
+	}
+	return
+}
+
+// Max returns the largest value in the given data
+func Max(data []float64) (max float64, err error) {
+	if len(data) == 0 {
+		return 0, errEmpty
+	}
+	max = data[0]
+	for _, x := range data[1:] {
+		max = math.Max(max, x)
+	}
+	return
+}
+
+// Mean returns the arithmetic mean of the given data
+func Mean(data []float64) (mean float64, err error) {
+	if len(data) == 0 {
+		return 0, errEmpty
+	}
+	return Sum(data) / float64(len(data)), nil
+}
+
+// Median returns the middle value of the given data or the mean of the two middle values for
+// an even number of values.
+func Median(data []float64) (median float64, err error) {
+	return Percentile(data, 50, Midpoint)
+}
+
+// Mode returns the most frequent values in the given data in ascending order. All values are
+// returned if they occur equally often and nothing is returned for an empty data set. NaN values
+// are never equal to each other and so are never the mode.
+func Mode(data []float64) (modes []float64) {
+	modes = []float64{}
+	sorted := sortedCopy(SkipNaN(data))
+	best := 0
+	for i := 0; i < len(sorted); {
+		j := i + 1
+		for j < len(sorted) && sorted[j] == sorted[i] {
+			j++
+		}
+		if j-i > best {
+			best, modes = j-i, modes[:0]
+		}
+		if j-i == best {
+			modes = append(modes, sorted[i])
+		}
+		i = j
+	}
+	return
+}
+
+// Variance returns the population variance of the given data calculated in a single pass with
+// Welford's algorithm to avoid the catastrophic cancellation of the naive calculation.
+func Variance(data []float64) (variance float64, err error) {
+	if len(data) == 0 {
+		return 0, errEmpty
+	}
+	mean, m2 := 0.0, 0.0
+	for i, x := range data {
+		delta := x - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (x - mean)
+	}
+	return m2 / float64(len(data)), nil
+}
+
+// StdDev returns the population standard deviation of the given data
+func StdDev(data []float64) (stddev float64, err error) {
+	var variance float64
+	if variance, err = Variance(data); err != nil {
+		return
+	}
+	return math.Sqrt(variance), nil
+}
+
+// Percentile returns the p-th percentile of the given data where p is between 0 and 100 using
+// the given interpolation method when the percentile falls between two data points. Returns NaN
+// if the data contains NaN.
+func Percentile(data []float64, p float64, method Interpolation) (val float64, err error) {
+	if len(data) == 0 {
+		return 0, errEmpty
+	}
+	if math.IsNaN(p) || p < 0 || p > 100 {
+		return 0, errors.Errorf("percentile %v is out of range [0, 100]", p)
+	}
+	if HasNaN(data) {
+		return math.NaN(), nil
+	}
+	sorted := sortedCopy(data)
+
+	// Fractional rank between the closest data points
+	rank := p / 100 * float64(len(sorted)-1)
+	lo, hi := int(math.Floor(rank)), int(math.Ceil(rank))
+	frac := rank - float64(lo)
+	switch method {
+	case Linear:
+		val = sorted[lo] + frac*(sorted[hi]-sorted[lo])
+	case Lower:
+		val = sorted[lo]
+	case Higher:
+		val = sorted[hi]
+	case Nearest:
+		val = sorted[int(math.RoundToEven(rank))]
+	case Midpoint:
+		val = sorted[lo]/2 + sorted[hi]/2
+	default:
+		err = errors.Errorf("invalid interpolation method %d", method)
+	}
+	return
+}
+
+// Histogram counts the given data into the given number of equal width buckets spanning the
+// smallest to largest value. All values fall into the first bucket if they are all the same.
+func Histogram(data []float64, buckets int) (histogram []Bucket, err error) {
+	if buckets <= 0 {
+		return nil, errors.Errorf("invalid number of histogram buckets %d", buckets)
+	}
+	if HasNaN(data) {
+		return nil, errors.New("failed to create histogram for data containing NaN")
+	}
+	histogram = make([]Bucket, buckets)
+	if len(data) == 0 {
+		return
+	}
+	lo, _ := Min(data)
+	hi, _ := Max(data)
+	if math.IsInf(lo, 0) || math.IsInf(hi, 0) {
+		return nil, errors.New("failed to create histogram for data containing infinity")
+	}
+
+	width := (hi - lo) / float64(buckets)
+	for i := range histogram {
+		histogram[i].Min = lo + float64(i)*width
+		histogram[i].Max = lo + float64(i+1)*width
+	}
+	histogram[buckets-1].Max = hi
+	for _, x := range data {
+		i := 0
+		if width > 0 {
+			i = min(int((x-lo)/width), buckets-1)
+		}
+		histogram[i].Count++
+	}
+	return
+}
+
+// CumSum returns a new slice of the running totals of the given data
+func CumSum(data []float64) (result []float64) {
+	result = make([]float64, len(data))
+	sum := 0.0
+	for i, x := range data {
+		sum += x
+		result[i] = sum
+	}
+	return
+}
+
+// Normalize returns a new slice of the given data rescaled to the range [0, 1] such that the
+// smallest value becomes 0 and the largest 1. All values become 0 if they are all the same.
+func Normalize(data []float64) (result []float64) {
+	result = make([]float64, len(data))
+	if len(data) == 0 {
+		return
+	}
+	lo, _ := Min(data)
+	hi, _ := Max(data)
+	for i, x := range data {
+		if hi != lo {
+			result[i] = (x - lo) / (hi - lo)
+		} else if math.IsNaN(x) {
+			result[i] = x
+		}
+	}
+	return
+}
+
+// Scale returns a new slice of the given data each multiplied by the given factor
+func Scale(data []float64, factor float64) (result []float64) {
+	result = make([]float64, len(data))
+	for i, x := range data {
+		result[i] = x * factor
+	}
+	return
+}
+
+// sortedCopy returns a sorted copy of the given data
+func sortedCopy(data []float64) (sorted []float64) {
+	sorted = append([]float64{}, data...)
+	sort.Float64s(sorted)
+	return
+}
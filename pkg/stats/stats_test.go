@@ -0,0 +1,243 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var nan = math.NaN()
+
+func TestHasNaN(t *testing.T) {
+	assert.False(t, HasNaN(nil))
+	assert.False(t, HasNaN([]float64{1, 2}))
+	assert.True(t, HasNaN([]float64{1, nan}))
+}
+
+func TestSkipNaN(t *testing.T) {
+	assert.Equal(t, []float64{}, SkipNaN(nil))
+	assert.Equal(t, []float64{1, 3}, SkipNaN([]float64{nan, 1, nan, 3}))
+}
+
+func TestSum(t *testing.T) {
+	assert.Equal(t, 0.0, Sum(nil))
+	assert.Equal(t, 6.0, Sum([]float64{1, 2, 3}))
+	assert.True(t, math.IsNaN(Sum([]float64{1, nan})))
+	assert.True(t, math.IsInf(Sum([]float64{1, math.Inf(1)}), 1))
+
+	// compensated summation
+	assert.Equal(t, 2.0, Sum([]float64{1, 1e100, 1, -1e100}))
+	data := make([]float64, 10)
+	for i := range data {
+		data[i] = 0.1
+	}
+	assert.Equal(t, 1.0, Sum(data))
+}
+
+func TestProduct(t *testing.T) {
+	assert.Equal(t, 1.0, Product(nil))
+	assert.Equal(t, 24.0, Product([]float64{1, 2, 3, 4}))
+	assert.True(t, math.IsNaN(Product([]float64{1, nan})))
+}
+
+func TestMinMax(t *testing.T) {
+	_, err := Min(nil)
+	assert.Equal(t, "empty data set", err.Error())
+	_, err = Max(nil)
+	assert.Equal(t, "empty data set", err.Error())
+
+	val, err := Min([]float64{3, -1, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, -1.0, val)
+
+	val, err = Max([]float64{3, -1, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 3.0, val)
+
+	val, _ = Min([]float64{3, nan, 2})
+	assert.True(t, math.IsNaN(val))
+	val, _ = Max([]float64{3, nan, 2})
+	assert.True(t, math.IsNaN(val))
+}
+
+func TestMean(t *testing.T) {
+	_, err := Mean(nil)
+	assert.NotNil(t, err)
+
+	val, err := Mean([]float64{1, 2, 3, 4})
+	assert.Nil(t, err)
+	assert.Equal(t, 2.5, val)
+
+	val, _ = Mean([]float64{1, nan})
+	assert.True(t, math.IsNaN(val))
+}
+
+func TestMedian(t *testing.T) {
+	_, err := Median(nil)
+	assert.NotNil(t, err)
+
+	val, err := Median([]float64{3, 1, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 2.0, val)
+
+	val, err = Median([]float64{4, 1, 3, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 2.5, val)
+
+	val, _ = Median([]float64{1, nan, 3})
+	assert.True(t, math.IsNaN(val))
+
+	val, _ = Median([]float64{math.MaxFloat64, math.MaxFloat64})
+	assert.Equal(t, math.MaxFloat64, val)
+}
+
+func TestMode(t *testing.T) {
+	assert.Equal(t, []float64{}, Mode(nil))
+	assert.Equal(t, []float64{2}, Mode([]float64{1, 2, 3, 2}))
+	assert.Equal(t, []float64{1, 3}, Mode([]float64{3, 1, 3, 2, 1}))
+	assert.Equal(t, []float64{1, 2, 3}, Mode([]float64{3, 2, 1}))
+	assert.Equal(t, []float64{1}, Mode([]float64{nan, 1, nan, 1}))
+}
+
+func TestVariance(t *testing.T) {
+	_, err := Variance(nil)
+	assert.NotNil(t, err)
+
+	val, err := Variance([]float64{2, 4, 4, 4, 5, 5, 7, 9})
+	assert.Nil(t, err)
+	assert.Equal(t, 4.0, val)
+
+	val, err = StdDev([]float64{2, 4, 4, 4, 5, 5, 7, 9})
+	assert.Nil(t, err)
+	assert.Equal(t, 2.0, val)
+
+	_, err = StdDev(nil)
+	assert.NotNil(t, err)
+
+	// numerically stable for large offsets
+	val, err = Variance([]float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16})
+	assert.Nil(t, err)
+	assert.Equal(t, 22.5, val)
+
+	val, _ = Variance([]float64{1, nan})
+	assert.True(t, math.IsNaN(val))
+}
+
+func TestPercentile(t *testing.T) {
+	data := []float64{4, 1, 3, 2}
+
+	// interpolation methods at the 40th percentile i.e. rank 1.2 between 2 and 3
+	{
+		for method, expected := range map[Interpolation]float64{
+			Linear:   2.2,
+			Lower:    2,
+			Higher:   3,
+			Nearest:  2,
+			Midpoint: 2.5,
+		} {
+			val, err := Percentile(data, 40, method)
+			assert.Nil(t, err)
+			assert.InDelta(t, expected, val, 1e-9, method)
+		}
+	}
+
+	// bounds
+	{
+		val, err := Percentile(data, 0, Linear)
+		assert.Nil(t, err)
+		assert.Equal(t, 1.0, val)
+
+		val, err = Percentile(data, 100, Linear)
+		assert.Nil(t, err)
+		assert.Equal(t, 4.0, val)
+
+		val, err = Percentile([]float64{5}, 90, Linear)
+		assert.Nil(t, err)
+		assert.Equal(t, 5.0, val)
+	}
+
+	// nearest rounds half way to even
+	{
+		val, _ := Percentile([]float64{1, 2, 3}, 25, Nearest)
+		assert.Equal(t, 1.0, val)
+		val, _ = Percentile([]float64{1, 2, 3}, 75, Nearest)
+		assert.Equal(t, 3.0, val)
+	}
+
+	// nan
+	{
+		val, err := Percentile([]float64{1, nan}, 50, Linear)
+		assert.Nil(t, err)
+		assert.True(t, math.IsNaN(val))
+	}
+
+	// invalid
+	{
+		_, err := Percentile(nil, 50, Linear)
+		assert.Equal(t, "empty data set", err.Error())
+		_, err = Percentile(data, 101, Linear)
+		assert.Equal(t, "percentile 101 is out of range [0, 100]", err.Error())
+		_, err = Percentile(data, -1, Linear)
+		assert.NotNil(t, err)
+		_, err = Percentile(data, 50, Interpolation(9))
+		assert.Equal(t, "invalid interpolation method 9", err.Error())
+	}
+
+	// the data is not modified
+	assert.Equal(t, []float64{4, 1, 3, 2}, data)
+}
+
+func TestHistogram(t *testing.T) {
+
+	// equal width buckets with the max in the last bucket
+	{
+		val, err := Histogram([]float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 10}, 5)
+		assert.Nil(t, err)
+		assert.Equal(t, []Bucket{{0, 2, 2}, {2, 4, 2}, {4, 6, 2}, {6, 8, 2}, {8, 10, 2}}, val)
+	}
+
+	// all the same values
+	{
+		val, err := Histogram([]float64{3, 3, 3}, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, []Bucket{{3, 3, 3}, {3, 3, 0}}, val)
+	}
+
+	// empty
+	{
+		val, err := Histogram(nil, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, []Bucket{{}, {}}, val)
+	}
+
+	// invalid
+	{
+		_, err := Histogram([]float64{1}, 0)
+		assert.Equal(t, "invalid number of histogram buckets 0", err.Error())
+		_, err = Histogram([]float64{1, nan}, 2)
+		assert.Equal(t, "failed to create histogram for data containing NaN", err.Error())
+		_, err = Histogram([]float64{1, math.Inf(-1)}, 2)
+		assert.Equal(t, "failed to create histogram for data containing infinity", err.Error())
+	}
+}
+
+func TestCumSum(t *testing.T) {
+	assert.Equal(t, []float64{}, CumSum(nil))
+	assert.Equal(t, []float64{1, 3, 6}, CumSum([]float64{1, 2, 3}))
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, []float64{}, Normalize(nil))
+	assert.Equal(t, []float64{0, 0.25, 1}, Normalize([]float64{2, 3, 6}))
+	assert.Equal(t, []float64{0, 0}, Normalize([]float64{2, 2}))
+
+	val := Normalize([]float64{2, nan})
+	assert.True(t, math.IsNaN(val[0]))
+	assert.True(t, math.IsNaN(val[1]))
+}
+
+func TestScale(t *testing.T) {
+	assert.Equal(t, []float64{}, Scale(nil, 2))
+	assert.Equal(t, []float64{2, 4.5, -6}, Scale([]float64{1, 2.25, -3}, 2))
+}
@@ -0,0 +1,208 @@
+package sys
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/pkg/errors"
+)
+
+// AtomicWriter is an io.WriteCloser that streams to a temporary file in the same directory as its
+// target then replaces the target with it on Close such that readers only ever see the old or the
+// new content, even after a crash or a full disk. On Close the temporary file is synced to disk,
+// given the permissions and, when permitted, the ownership of the existing target, renamed over
+// the target and the directory synced to persist the rename. Symlinks are written through to
+// their target rather than replaced. Abort discards the write instead and is a no-op after Close
+// making it safe to defer.
+type AtomicWriter struct {
+	path   string      // target path with symlinks resolved
+	file   *os.File    // temporary file being written
+	perm   os.FileMode // permissions of the target if it doesn't exist
+	backup bool        // backup the existing target before replacing it
+	done   bool        // closed or aborted
+}
+
+// NewAtomicWriter creates a new AtomicWriter for the given target path.
+//
+// Supported options: BackupOpt and PermsOpt
+func NewAtomicWriter(target string, opts ...*opt.Opt) (w *AtomicWriter, err error) {
+	w = &AtomicWriter{perm: getPermsOpt(opts), backup: getBackupOpt(opts)}
+	if w.path, err = Abs(target); err != nil {
+		return nil, err
+	}
+	if resolved, e := filepath.EvalSymlinks(w.path); e == nil {
+		w.path = resolved
+	}
+	if w.file, err = os.CreateTemp(path.Dir(w.path), "."+path.Base(w.path)+".tmp"); err != nil {
+		err = errors.Wrapf(err, "failed creating temp file for %s", target)
+		return nil, err
+	}
+	return
+}
+
+// Abort discards the write removing the temporary file leaving the target untouched. Does
+// nothing if already closed or aborted.
+func (w *AtomicWriter) Abort() (err error) {
+	if w.done {
+		return
+	}
+	w.done = true
+	w.file.Close()
+	if err = os.Remove(w.file.Name()); err != nil {
+		err = errors.Wrapf(err, "failed removing temp file %s", w.file.Name())
+	}
+	return
+}
+
+// Close commits the write replacing the target with the written content. On failure the
+// temporary file is removed and the target is left untouched. Does nothing if already closed or
+// aborted.
+func (w *AtomicWriter) Close() (err error) {
+	if w.done {
+		return
+	}
+	if err = w.commit(); err != nil {
+		w.Abort()
+	}
+	w.done = true
+	return
+}
+
+// Write writes the given data to the temporary file
+func (w *AtomicWriter) Write(data []byte) (n int, err error) {
+	if w.done {
+		return 0, errors.Errorf("failed writing to closed atomic writer for %s", w.path)
+	}
+	return w.file.Write(data)
+}
+
+// commit syncs the temporary file and renames it over the target
+func (w *AtomicWriter) commit() (err error) {
+	tmp := w.file.Name()
+	if err = w.file.Sync(); err != nil {
+		err = errors.Wrapf(err, "failed syncing temp file %s", tmp)
+		return
+	}
+
+	// Preserve the permissions and ownership of an existing target. Changing ownership requires
+	// privileges so is best effort and done first as it may clear the setuid and setgid bits.
+	// New targets get the requested permissions less the umask as os.OpenFile would apply.
+	mode := w.perm &^ umask()
+	info, e := os.Stat(w.path)
+	exists := e == nil
+	if exists {
+		mode = info.Mode() & (os.ModePerm | os.ModeSetuid | os.ModeSetgid | os.ModeSticky)
+		if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+			w.file.Chown(int(stat.Uid), int(stat.Gid))
+		}
+	}
+	if err = w.file.Chmod(mode); err != nil {
+		err = errors.Wrapf(err, "failed to chmod temp file %s", tmp)
+		return
+	}
+	if err = w.file.Close(); err != nil {
+		err = errors.Wrapf(err, "failed to close temp file %s", tmp)
+		return
+	}
+
+	// Hard linking the backup is safe as the target is replaced rather than modified
+	if exists && w.backup {
+		if err = backupFile(w.path, true); err != nil {
+			return
+		}
+	}
+	if err = os.Rename(tmp, w.path); err != nil {
+		err = errors.Wrapf(err, "failed renaming temp file %s to %s", tmp, w.path)
+		return
+	}
+	return syncDir(path.Dir(w.path))
+}
+
+// backupFile copies the given file if it exists to the same path with a .bak extension replacing
+// any previous backup. Hard links the backup instead when link is true and linking is supported.
+func backupFile(target string, link bool) (err error) {
+	if _, err = os.Stat(target); err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return
+	}
+	bak := target + ".bak"
+	if err = os.Remove(bak); err != nil && !os.IsNotExist(err) {
+		err = errors.Wrapf(err, "failed removing old backup %s", bak)
+		return
+	}
+	if link && os.Link(target, bak) == nil {
+		return nil
+	}
+	if _, err = CopyFile(target, bak); err != nil {
+		err = errors.Wrapf(err, "failed backing up %s", target)
+	}
+	return
+}
+
+// syncDir syncs the given directory to disk persisting renames and new files in it
+func syncDir(dir string) (err error) {
+	var d *os.File
+	if d, err = os.Open(dir); err != nil {
+		err = errors.Wrapf(err, "failed opening directory %s", dir)
+		return
+	}
+	defer d.Close()
+	if err = d.Sync(); err != nil {
+		err = errors.Wrapf(err, "failed syncing directory %s", dir)
+	}
+	return
+}
+
+// umask returns the process umask reading it from /proc where possible as setting the umask to
+// read it races with files being created concurrently.
+func umask() os.FileMode {
+	if data, err := os.ReadFile("/proc/self/status"); err == nil {
+		for _, line := range strings.Split(string(data), "\n") {
+			if val, ok := strings.CutPrefix(line, "Umask:"); ok {
+				if mask, err := strconv.ParseUint(strings.TrimSpace(val), 8, 32); err == nil {
+					return os.FileMode(mask)
+				}
+			}
+		}
+	}
+	mask := syscall.Umask(0)
+	syscall.Umask(mask)
+	return os.FileMode(mask)
+}
+
+// writeAtomic writes the given stream to the given path atomically, see AtomicWriter
+func writeAtomic(target string, reader io.Reader, opts []*opt.Opt) (err error) {
+	var w *AtomicWriter
+	if w, err = NewAtomicWriter(target, opts...); err != nil {
+		return
+	}
+	defer w.Abort()
+	if _, err = io.Copy(w, reader); err != nil {
+		err = errors.Wrap(err, "failed copying stream data")
+		return
+	}
+	return w.Close()
+}
+
+// writeFile writes the given data to the given path atomically when AtomicOpt is true otherwise
+// truncating it in place.
+func writeFile(target string, data []byte, opts []*opt.Opt) (err error) {
+	if getAtomicOpt(opts) {
+		return writeAtomic(target, bytes.NewReader(data), opts)
+	}
+	if getBackupOpt(opts) {
+		if err = backupFile(target, false); err != nil {
+			return
+		}
+	}
+	return os.WriteFile(target, data, getPermsOpt(opts))
+}
@@ -0,0 +1,234 @@
+package sys
+
+import (
+	"errors"
+	"os"
+	"path"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAtomicWriter(t *testing.T) {
+
+	// empty target
+	{
+		_, err := NewAtomicWriter("")
+		assert.Equal(t, "empty string is an invalid path", err.Error())
+	}
+
+	// missing directory
+	{
+		resetTest()
+		_, err := NewAtomicWriter(path.Join(tmpDir, "missing", "file"))
+		assert.True(t, strings.HasPrefix(err.Error(), "failed creating temp file for"))
+	}
+
+	// new file is only visible once closed
+	{
+		resetTest()
+		w, err := NewAtomicWriter(tmpfile, PermsOpt(0600))
+		assert.Nil(t, err)
+		_, err = w.Write([]byte("test"))
+		assert.Nil(t, err)
+		assert.False(t, Exists(tmpfile))
+		assert.Len(t, Paths(tmpDir), 1)
+
+		assert.Nil(t, w.Close())
+		data, err := ReadString(tmpfile)
+		assert.Nil(t, err)
+		assert.Equal(t, "test", data)
+		assert.Equal(t, os.FileMode(0600), Mode(tmpfile).Perm())
+		assert.Equal(t, []string{".tmp"}, atomicNames())
+
+		// closing and aborting again does nothing
+		assert.Nil(t, w.Close())
+		assert.Nil(t, w.Abort())
+		_, err = w.Write([]byte("test"))
+		assert.True(t, strings.HasPrefix(err.Error(), "failed writing to closed atomic writer"))
+	}
+
+	// abort leaves the original untouched
+	{
+		resetTest()
+		assert.Nil(t, WriteString(tmpfile, "old"))
+		w, err := NewAtomicWriter(tmpfile)
+		assert.Nil(t, err)
+		_, err = w.Write([]byte("new"))
+		assert.Nil(t, err)
+		assert.Nil(t, w.Abort())
+		assert.Nil(t, w.Close())
+
+		data, err := ReadString(tmpfile)
+		assert.Nil(t, err)
+		assert.Equal(t, "old", data)
+		assert.Equal(t, []string{".tmp"}, atomicNames())
+	}
+
+	// permissions of the original are preserved
+	{
+		resetTest()
+		assert.Nil(t, WriteStringO(tmpfile, "old", PermsOpt(0640)))
+		assert.Nil(t, os.Chmod(tmpfile, 0640))
+		w, err := NewAtomicWriter(tmpfile, PermsOpt(0600))
+		assert.Nil(t, err)
+		_, err = w.Write([]byte("new"))
+		assert.Nil(t, err)
+		assert.Nil(t, w.Close())
+		assert.Equal(t, os.FileMode(0640), Mode(tmpfile).Perm())
+	}
+
+	// symlinks are written through
+	{
+		resetTest()
+		target := path.Join(tmpDir, "target")
+		assert.Nil(t, WriteString(target, "old"))
+		assert.Nil(t, Symlink(target, tmpfile))
+		w, err := NewAtomicWriter(tmpfile)
+		assert.Nil(t, err)
+		_, err = w.Write([]byte("new"))
+		assert.Nil(t, err)
+		assert.Nil(t, w.Close())
+
+		assert.True(t, IsSymlink(tmpfile))
+		data, err := ReadString(target)
+		assert.Nil(t, err)
+		assert.Equal(t, "new", data)
+	}
+
+	// backups
+	{
+		resetTest()
+		assert.Nil(t, WriteString(tmpfile, "one"))
+		for _, data := range []string{"two", "three"} {
+			w, err := NewAtomicWriter(tmpfile, BackupOpt(true))
+			assert.Nil(t, err)
+			_, err = w.Write([]byte(data))
+			assert.Nil(t, err)
+			assert.Nil(t, w.Close())
+		}
+		data, err := ReadString(tmpfile)
+		assert.Nil(t, err)
+		assert.Equal(t, "three", data)
+		data, err = ReadString(tmpfile + ".bak")
+		assert.Nil(t, err)
+		assert.Equal(t, "two", data)
+	}
+}
+
+func TestAtomicOpt(t *testing.T) {
+	for _, backup := range []bool{false, true} {
+		resetTest()
+
+		// new files
+		assert.Nil(t, WriteBytesO(tmpfile, []byte("bytes"), AtomicOpt(true), BackupOpt(backup), PermsOpt(0600)))
+		data, err := ReadString(tmpfile)
+		assert.Nil(t, err)
+		assert.Equal(t, "bytes", data)
+		assert.Equal(t, os.FileMode(0600), Mode(tmpfile).Perm())
+		assert.False(t, Exists(tmpfile+".bak"))
+
+		// overwrite existing files
+		assert.Nil(t, WriteLinesO(tmpfile, []string{"1", "2"}, AtomicOpt(true), BackupOpt(backup)))
+		data, err = ReadString(tmpfile)
+		assert.Nil(t, err)
+		assert.Equal(t, "1\n2", data)
+		assert.Equal(t, os.FileMode(0600), Mode(tmpfile).Perm())
+
+		assert.Nil(t, WriteStreamO(strings.NewReader("stream"), tmpfile, AtomicOpt(true), BackupOpt(backup)))
+		data, err = ReadString(tmpfile)
+		assert.Nil(t, err)
+		assert.Equal(t, "stream", data)
+
+		assert.Nil(t, WriteStringO(tmpfile, "string", AtomicOpt(true), BackupOpt(backup)))
+		data, err = ReadString(tmpfile)
+		assert.Nil(t, err)
+		assert.Equal(t, "string", data)
+
+		// backups are of the previous content
+		if backup {
+			data, err = ReadString(tmpfile + ".bak")
+			assert.Nil(t, err)
+			assert.Equal(t, "stream", data)
+			assert.Equal(t, []string{".tmp", ".tmp.bak"}, atomicNames())
+		} else {
+			assert.Equal(t, []string{".tmp"}, atomicNames())
+		}
+	}
+
+	// errors leave no temp files behind
+	{
+		resetTest()
+		dstDir, err := MkdirP(path.Join(tmpDir, "dst"))
+		assert.Nil(t, err)
+		err = WriteStreamO(errReader{}, path.Join(dstDir, "file"), AtomicOpt(true))
+		assert.True(t, strings.HasPrefix(err.Error(), "failed writing stream to file"))
+		assert.True(t, strings.HasSuffix(err.Error(), "failed copying stream data: read error"))
+		assert.Len(t, Paths(dstDir), 0)
+	}
+
+	// new files are subject to the umask like non atomic writes
+	{
+		resetTest()
+		mask := syscall.Umask(0027)
+		defer syscall.Umask(mask)
+		assert.Nil(t, WriteStringO(tmpfile, "atomic", AtomicOpt(true), PermsOpt(0666)))
+		assert.Equal(t, os.FileMode(0640), Mode(tmpfile).Perm())
+		assert.Nil(t, WriteStringO(tmpfile+"2", "plain", PermsOpt(0666)))
+		assert.Equal(t, os.FileMode(0640), Mode(tmpfile+"2").Perm())
+
+		// existing files keep their permissions regardless
+		assert.Nil(t, os.Chmod(tmpfile, 0666))
+		assert.Nil(t, WriteStringO(tmpfile, "atomic", AtomicOpt(true)))
+		assert.Equal(t, os.FileMode(0666), Mode(tmpfile).Perm())
+	}
+}
+
+func TestPermsOpt(t *testing.T) {
+	assert.Equal(t, os.FileMode(0644), getPermsOpt(nil))
+	assert.Equal(t, os.FileMode(0600), getPermsOpt([]*opt.Opt{PermsOpt(0600)}))
+	assert.Equal(t, os.FileMode(0600), getPermsOpt([]*opt.Opt{{Key: "perms", Val: os.FileMode(0600)}}))
+	assert.Equal(t, os.FileMode(0600), getPermsOpt([]*opt.Opt{{Key: "perms", Val: uint32(0600)}}))
+	assert.Equal(t, os.FileMode(0600), getPermsOpt([]*opt.Opt{{Key: "perms", Val: 0600}}))
+
+	// the non option based functions still take permissions
+	resetTest()
+	assert.Nil(t, WriteString(tmpfile, "perms", 0600))
+	assert.Equal(t, os.FileMode(0600), Mode(tmpfile).Perm())
+}
+
+func TestBackupOpt(t *testing.T) {
+	resetTest()
+
+	// no original
+	assert.Nil(t, WriteStringO(tmpfile, "one", BackupOpt(true)))
+	assert.False(t, Exists(tmpfile+".bak"))
+
+	// in place writes copy the original
+	assert.Nil(t, WriteStringO(tmpfile, "two", BackupOpt(true)))
+	assert.Nil(t, WriteStreamO(strings.NewReader("three"), tmpfile, BackupOpt(true)))
+	data, err := ReadString(tmpfile)
+	assert.Nil(t, err)
+	assert.Equal(t, "three", data)
+	data, err = ReadString(tmpfile + ".bak")
+	assert.Nil(t, err)
+	assert.Equal(t, "two", data)
+}
+
+// atomicNames returns the names of the files in the temp directory
+func atomicNames() (names []string) {
+	for _, x := range Paths(tmpDir) {
+		names = append(names, path.Base(x))
+	}
+	return
+}
+
+// errReader is an io.Reader that always fails
+type errReader struct{}
+
+func (errReader) Read(p []byte) (int, error) {
+	return 0, errors.New("read error")
+}
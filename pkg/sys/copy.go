@@ -0,0 +1,493 @@
+package sys
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// OverwritePolicy determines how Copy treats destination files that already exist
+type OverwritePolicy int
+
+const (
+	// OverwriteAlways replaces existing destination files
+	OverwriteAlways OverwritePolicy = iota
+
+	// OverwriteError leaves existing destination files untouched and reports them as failed
+	OverwriteError
+
+	// OverwriteNewer replaces existing destination files only when the source is newer
+	OverwriteNewer
+
+	// OverwriteSkip leaves existing destination files untouched
+	OverwriteSkip
+)
+
+// CopyError describes a path that failed to be copied by Copy or Sync
+type CopyError struct {
+	Path string // source path that failed
+	Err  error  // cause of the failure
+}
+
+// Error returns the cause of the failure which names the paths involved
+func (e *CopyError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the cause of the failure
+func (e *CopyError) Unwrap() error {
+	return e.Err
+}
+
+// CopyErrors aggregates every path that failed to be copied by Copy or Sync in the order walked
+type CopyErrors []*CopyError
+
+// Error returns the single failure or a report of all the failures one per line
+func (e CopyErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	lines := make([]string, 0, len(e)+1)
+	lines = append(lines, fmt.Sprintf("%d paths failed to copy", len(e)))
+	for _, err := range e {
+		lines = append(lines, fmt.Sprintf("  %s: %s", err.Path, err.Error()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// CopyProgress reports the progress of a Copy to the ProgressOpt callback. Files counts the files
+// and links processed whether copied, skipped or failed and Bytes their data.
+type CopyProgress struct {
+	Path       string // source path being copied
+	Bytes      int64  // bytes processed so far
+	TotalBytes int64  // bytes to process in total
+	Files      int    // files processed so far
+	TotalFiles int    // files to process in total
+}
+
+// copyKind identifies the action planned for a path
+type copyKind int
+
+const (
+	copyDirKind      copyKind = iota // create a directory
+	copyFileKind                     // copy a file or recreate a file symlink, see CopyFile
+	copySymlinkKind                  // recreate a directory symlink
+	copyHardlinkKind                 // hard link to the copy of an earlier file
+)
+
+// copyEntry is a path planned to be copied
+type copyEntry struct {
+	kind   copyKind
+	info   *FileInfo  // source info with the real source path
+	dst    string     // destination path
+	first  *copyEntry // earliest entry sharing the inode of a hardlink
+	result string     // destination path once copied or skipped
+	err    error      // failure copying the entry
+}
+
+// copyInode identifies a file across hardlinks
+type copyInode struct {
+	dev, ino uint64
+}
+
+// copier plans a Copy by walking the sources then executes the plan
+type copier struct {
+	opts     []*opt.Opt
+	dryrun   bool
+	policy   OverwritePolicy
+	include  []string
+	exclude  []string
+	progress func(CopyProgress)
+
+	entries  []*copyEntry             // planned entries in walk order
+	errs     CopyErrors               // failures walking the sources
+	inodes   map[copyInode]*copyEntry // first entry for each hardlinked inode
+	excluded []string                 // excluded source directories
+	failed   []string                 // destination directories that failed to be created
+
+	mu     sync.Mutex   // guards status and serializes progress callbacks
+	status CopyProgress // progress so far
+}
+
+// newCopier creates a new copier for the given options
+func newCopier(opts []*opt.Opt) *copier {
+	return &copier{
+		opts:     opts,
+		dryrun:   opt.GetDryrunOpt(opts),
+		policy:   getOverwriteOpt(opts),
+		include:  getIncludeOpt(opts),
+		exclude:  getExcludeOpt(opts),
+		progress: getProgressOpt(opts),
+		inodes:   map[copyInode]*copyEntry{},
+	}
+}
+
+// add plans the copy of the given source path to the given destination path. The source path
+// is as named by the walk, which differs from the info path when following links, and rel is
+// that path relative to the source root or empty for the root itself.
+func (c *copier) add(srcPath, rel string, info *FileInfo, dstPath string, kind copyKind) {
+	if rel == "" && kind != copyDirKind {
+		rel = path.Base(srcPath)
+	}
+	for _, dir := range c.excluded {
+		if strings.HasPrefix(srcPath, dir+"/") {
+			return
+		}
+	}
+	if rel != "" && copyMatch(c.exclude, rel) {
+		if kind == copyDirKind {
+			c.excluded = append(c.excluded, srcPath)
+		}
+		return
+	}
+	if kind != copyDirKind && len(c.include) > 0 && !copyMatch(c.include, rel) {
+		return
+	}
+
+	x := &copyEntry{kind: kind, info: info, dst: dstPath}
+	if kind == copyFileKind && getHardlinksOpt(c.opts) && info.Obj.Mode().IsRegular() {
+		var st unix.Stat_t
+		if unix.Lstat(info.Path, &st) == nil && st.Nlink > 1 {
+			id := copyInode{uint64(st.Dev), uint64(st.Ino)}
+			if first, ok := c.inodes[id]; ok {
+				x.kind, x.first = copyHardlinkKind, first
+			} else {
+				c.inodes[id] = x
+			}
+		}
+	}
+	c.entries = append(c.entries, x)
+}
+
+// fail records a failure walking the given source path
+func (c *copier) fail(srcPath string, err error) {
+	c.errs = append(c.errs, &CopyError{Path: srcPath, Err: err})
+}
+
+// run executes the plan returning CopyErrors for every path that failed or nil
+func (c *copier) run(dstRoot string) error {
+	dirs, files, links := c.plan(dstRoot)
+
+	// Directories are created first as files depend on them
+	for _, x := range dirs {
+		if c.parentFailed(x) {
+			continue
+		}
+		if !c.dryrun {
+			if x.err = os.MkdirAll(x.dst, x.info.Mode()); x.err != nil {
+				c.failed = append(c.failed, x.dst)
+				continue
+			}
+			x.err = c.preserve(x.info, x.dst, false)
+		}
+		x.result = x.dst
+	}
+
+	// Files are copied concurrently and hardlinks made once their first file is copied
+	work := make(chan *copyEntry)
+	wg := sync.WaitGroup{}
+	for i := 0; i < getConcurrencyOpt(c.opts); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for x := range work {
+				c.copy(x)
+			}
+		}()
+	}
+	for _, x := range files {
+		work <- x
+	}
+	close(work)
+	wg.Wait()
+	for _, x := range links {
+		c.copy(x)
+	}
+
+	// Directory timestamps are set last, deepest first, as populating them changes them
+	if !c.dryrun && getPreserveTimesOpt(c.opts) {
+		for i := len(dirs) - 1; i >= 0; i-- {
+			if x := dirs[i]; x.err == nil && x.result != "" {
+				x.err = copyTimes(x.info.Path, x.dst)
+			}
+		}
+	}
+
+	errs := c.errs
+	for _, x := range c.entries {
+		if x.err != nil {
+			errs = append(errs, &CopyError{Path: x.info.Path, Err: x.err})
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// advance records the given progress and reports it to the progress callback
+func (c *copier) advance(srcPath string, n int64, file bool) {
+	if c.progress == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.status.Path = srcPath
+	c.status.Bytes += n
+	if file {
+		c.status.Files++
+	}
+	c.progress(c.status)
+}
+
+// copy copies the given file, symlink or hardlink entry
+func (c *copier) copy(x *copyEntry) {
+	size := int64(0)
+	if x.kind == copyFileKind && x.info.Obj.Mode().IsRegular() {
+		size = x.info.Size()
+	}
+	if c.parentFailed(x) {
+		c.advance(x.info.Path, 0, true)
+		return
+	}
+
+	// Hardlinks fall back on copying when their first file wasn't copied
+	if x.kind == copyHardlinkKind && x.first.result == "" {
+		x.kind = copyFileKind
+		c.copy(x)
+		return
+	}
+
+	ok, err := c.overwrite(x)
+	if err != nil || !ok || c.dryrun {
+		x.err = err
+		if err == nil {
+			x.result = x.dst
+		}
+		c.advance(x.info.Path, size, true)
+		return
+	}
+
+	written := int64(0)
+	switch x.kind {
+	case copyFileKind:
+		x.result, x.err = copyFile(x.info.Path, x.dst, func(n int64) {
+			written += n
+			c.advance(x.info.Path, n, false)
+		}, InfoOpt(x.info))
+	case copySymlinkKind:
+		var target string
+		if target, x.err = x.info.SymlinkTarget(); x.err == nil {
+			x.err = os.Symlink(target, x.dst)
+		}
+	case copyHardlinkKind:
+		x.err = os.Link(x.first.result, x.dst)
+	}
+	if x.err != nil {
+		x.result = ""
+	} else if x.kind == copyHardlinkKind {
+		x.result = x.dst
+	} else {
+		if x.kind == copySymlinkKind {
+			x.result = x.dst
+		}
+		x.err = c.preserve(x.info, x.result, getPreserveTimesOpt(c.opts))
+	}
+	c.advance(x.info.Path, size-written, true)
+}
+
+// overwrite applies the overwrite policy to the destination of the given entry returning true if
+// it should be copied or an error for OverwriteError. Makes way for links as they can't be
+// created over existing paths.
+func (c *copier) overwrite(x *copyEntry) (ok bool, err error) {
+	dst := x.dst
+	info, e := os.Lstat(dst)
+	if e == nil && info.IsDir() && x.kind == copyFileKind {
+		dst = path.Join(dst, path.Base(x.info.Path))
+		info, e = os.Lstat(dst)
+	}
+	if e != nil {
+		return true, nil
+	}
+	switch c.policy {
+	case OverwriteError:
+		return false, errors.Errorf("destination %s already exists", dst)
+	case OverwriteNewer:
+		if !x.info.ModTime().After(info.ModTime()) {
+			return false, nil
+		}
+	case OverwriteSkip:
+		return false, nil
+	}
+	if !c.dryrun && !info.IsDir() && (x.kind != copyFileKind || x.info.IsSymlink()) {
+		if err = os.Remove(dst); err != nil {
+			err = errors.Wrapf(err, "failed to remove existing destination %s", dst)
+			return
+		}
+	}
+	return true, nil
+}
+
+// parentFailed returns true if the destination of the given entry is in a directory that
+// failed to be created in which case it isn't attempted.
+func (c *copier) parentFailed(x *copyEntry) bool {
+	for _, dir := range c.failed {
+		if strings.HasPrefix(x.dst, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// plan splits the entries into directories, files and hardlinks and sets the progress totals.
+// When including only some files only the directories leading to them are kept.
+func (c *copier) plan(dstRoot string) (dirs, files, links []*copyEntry) {
+	needed := map[string]bool{}
+	for _, x := range c.entries {
+		switch x.kind {
+		case copyDirKind:
+			continue
+		case copyHardlinkKind:
+			links = append(links, x)
+		default:
+			files = append(files, x)
+		}
+		if x.kind == copyFileKind && x.info.Obj.Mode().IsRegular() {
+			c.status.TotalBytes += x.info.Size()
+		}
+		c.status.TotalFiles++
+		for dir := path.Dir(x.dst); len(dir) >= len(dstRoot) && !needed[dir]; dir = path.Dir(dir) {
+			needed[dir] = true
+		}
+	}
+	for _, x := range c.entries {
+		if x.kind == copyDirKind && (len(c.include) == 0 || needed[x.dst]) {
+			dirs = append(dirs, x)
+		}
+	}
+	return
+}
+
+// preserve copies the metadata selected by the options from the given source to the given
+// destination. Timestamps are only copied if times is true.
+func (c *copier) preserve(info *FileInfo, dst string, times bool) (err error) {
+	if getPreserveOwnerOpt(c.opts) {
+		var st unix.Stat_t
+		if err = unix.Lstat(info.Path, &st); err != nil {
+			return errors.Wrapf(err, "failed to stat %s", info.Path)
+		}
+		if err = os.Lchown(dst, int(st.Uid), int(st.Gid)); err != nil {
+			return errors.Wrapf(err, "failed to chown %s", dst)
+		}
+
+		// Changing ownership may clear the setuid and setgid bits
+		if !info.IsSymlink() {
+			if err = os.Chmod(dst, info.Mode()); err != nil {
+				return errors.Wrapf(err, "failed to chmod %s", dst)
+			}
+		}
+	}
+	if getPreserveXattrsOpt(c.opts) {
+		if err = copyXattrs(info.Path, dst); err != nil {
+			return
+		}
+	}
+	if times {
+		err = copyTimes(info.Path, dst)
+	}
+	return
+}
+
+// copyMatch returns true if the given relative path matches any of the given globs. Globs
+// without a slash match the base name and otherwise the whole relative path.
+func copyMatch(globs []string, rel string) bool {
+	for _, glob := range globs {
+		target := rel
+		if !strings.Contains(glob, "/") {
+			target = path.Base(rel)
+		}
+		if ok, _ := filepath.Match(glob, target); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// copyTimes sets the access and modification times of the given destination to those of the
+// given source without following links.
+func copyTimes(src, dst string) (err error) {
+	var st unix.Stat_t
+	if err = unix.Lstat(src, &st); err != nil {
+		return errors.Wrapf(err, "failed to stat %s", src)
+	}
+	ts := []unix.Timespec{st.Atim, st.Mtim}
+	if err = unix.UtimesNanoAt(unix.AT_FDCWD, dst, ts, unix.AT_SYMLINK_NOFOLLOW); err != nil {
+		err = errors.Wrapf(err, "failed to set times of %s", dst)
+	}
+	return
+}
+
+// copyXattrs copies the extended attributes of the given source to the given destination without
+// following links. Sources on filesystems without extended attributes have none to copy.
+func copyXattrs(src, dst string) (err error) {
+	var names []byte
+	if names, err = xattrRead(func(buf []byte) (int, error) { return unix.Llistxattr(src, buf) }); err != nil {
+		if err == unix.ENOTSUP {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to list extended attributes of %s", src)
+	}
+	for _, name := range bytes.Split(names, []byte{0}) {
+		if len(name) == 0 {
+			continue
+		}
+		var val []byte
+		if val, err = xattrRead(func(buf []byte) (int, error) { return unix.Lgetxattr(src, string(name), buf) }); err != nil {
+			return errors.Wrapf(err, "failed to get extended attribute %s of %s", name, src)
+		}
+		if err = unix.Lsetxattr(dst, string(name), val, 0); err != nil {
+			return errors.Wrapf(err, "failed to set extended attribute %s of %s", name, dst)
+		}
+	}
+	return nil
+}
+
+// xattrRead calls the given extended attribute read first for the size then the data retrying
+// if the data grew in between.
+func xattrRead(read func(buf []byte) (int, error)) (data []byte, err error) {
+	for {
+		var n int
+		if n, err = read(nil); err != nil || n == 0 {
+			return
+		}
+		data = make([]byte, n)
+		if n, err = read(data); err != unix.ERANGE {
+			if err == nil {
+				data = data[:n]
+			}
+			return
+		}
+	}
+}
+
+// copyWriter is an io.Writer reporting the number of bytes of each write to its callback
+type copyWriter struct {
+	w       io.Writer
+	written func(n int64)
+}
+
+// Write writes the given data to the underlying writer reporting the bytes written
+func (w *copyWriter) Write(data []byte) (n int, err error) {
+	n, err = w.w.Write(data)
+	w.written(int64(n))
+	return
+}
@@ -0,0 +1,271 @@
+package sys
+
+import (
+	"errors"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+)
+
+func TestCopyErrors(t *testing.T) {
+
+	// single failure is reported as is
+	{
+		err := CopyErrors{{Path: "/src/a", Err: errors.New("failed a")}}
+		assert.Equal(t, "failed a", err.Error())
+	}
+
+	// multiple failures list every path
+	{
+		err := CopyErrors{
+			{Path: "/src/a", Err: errors.New("failed a")},
+			{Path: "/src/b", Err: errors.New("failed b")},
+		}
+		assert.Equal(t, "2 paths failed to copy\n  /src/a: failed a\n  /src/b: failed b", err.Error())
+	}
+
+	// copy continues past failures
+	{
+		src, dst := copyTree(t)
+		_, err := MkdirP(path.Join(dst, "sub"))
+		assert.Nil(t, err)
+		assert.Nil(t, WriteString(path.Join(dst, "a"), "old"))
+		assert.Nil(t, WriteString(path.Join(dst, "sub/c"), "old"))
+
+		err = Copy(path.Join(src, "*"), dst, OverwriteOpt(OverwriteError))
+		var errs CopyErrors
+		assert.True(t, errors.As(err, &errs))
+		assert.Len(t, errs, 2)
+		assert.Equal(t, path.Join(src, "a"), errs[0].Path)
+		assert.Equal(t, path.Join(src, "sub/c"), errs[1].Path)
+		assert.True(t, strings.HasPrefix(errs[0].Error(), "destination"))
+		assert.True(t, strings.HasSuffix(errs[0].Error(), "already exists"))
+		assert.Equal(t, "b", readTree(t, dst, "b"))
+	}
+}
+
+func TestCopyProgress(t *testing.T) {
+	for _, concurrency := range []int{1, 4} {
+		src, dst := copyTree(t)
+		assert.Nil(t, WriteBytes(path.Join(src, "big"), make([]byte, 100000)))
+
+		var mu sync.Mutex
+		var calls []CopyProgress
+		err := Copy(src, dst, ConcurrencyOpt(concurrency), ProgressOpt(func(p CopyProgress) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls = append(calls, p)
+		}))
+		assert.Nil(t, err)
+		assert.True(t, len(calls) > 4)
+
+		// progress only ever grows to the totals
+		for i := range calls {
+			assert.Equal(t, int64(100003), calls[i].TotalBytes)
+			assert.Equal(t, 4, calls[i].TotalFiles)
+			if i > 0 {
+				assert.True(t, calls[i].Bytes >= calls[i-1].Bytes)
+				assert.True(t, calls[i].Files >= calls[i-1].Files)
+			}
+		}
+		last := calls[len(calls)-1]
+		assert.Equal(t, int64(100003), last.Bytes)
+		assert.Equal(t, 4, last.Files)
+
+		for _, name := range []string{"a", "b", "sub/c"} {
+			assert.Equal(t, path.Base(name), readTree(t, dst, name))
+		}
+		assert.Equal(t, 100000, len(readTree(t, dst, "big")))
+	}
+}
+
+func TestCopyDryrun(t *testing.T) {
+	src, dst := copyTree(t)
+
+	files := 0
+	err := Copy(src, dst, opt.DryrunOpt(true), ProgressOpt(func(p CopyProgress) {
+		files = p.Files
+	}))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, files)
+	assert.False(t, Exists(dst))
+}
+
+func TestCopyFilters(t *testing.T) {
+
+	// exclude names and relative paths skipping everything under excluded dirs
+	{
+		src, dst := copyTree(t)
+		assert.Nil(t, Copy(src, dst, ExcludeOpt("b", "sub")))
+		assert.Equal(t, []string{"a"}, treeNames(t, dst))
+
+		src, dst = copyTree(t)
+		assert.Nil(t, Copy(src, dst, ExcludeOpt("sub/*")))
+		assert.Equal(t, []string{"a", "b", "sub"}, treeNames(t, dst))
+	}
+
+	// include only creates the dirs needed
+	{
+		src, dst := copyTree(t)
+		_, err := MkdirP(path.Join(src, "empty"))
+		assert.Nil(t, err)
+		assert.Nil(t, Copy(src, dst, IncludeOpt("c")))
+		assert.Equal(t, []string{"sub", "sub/c"}, treeNames(t, dst))
+	}
+
+	// single file sources match by name
+	{
+		src, dst := copyTree(t)
+		assert.Nil(t, Copy(path.Join(src, "a"), dst, ExcludeOpt("a")))
+		assert.False(t, Exists(dst))
+	}
+}
+
+func TestCopyHardlinks(t *testing.T) {
+	src, dst := copyTree(t)
+	assert.Nil(t, os.Link(path.Join(src, "a"), path.Join(src, "sub/a")))
+
+	// hardlinks are recreated
+	{
+		assert.Nil(t, Copy(src, dst, HardlinksOpt(true)))
+		assert.True(t, sameFile(t, path.Join(dst, "a"), path.Join(dst, "sub/a")))
+		assert.Equal(t, "a", readTree(t, dst, "sub/a"))
+	}
+
+	// hardlinks are copied by default
+	{
+		assert.Nil(t, RemoveAll(dst))
+		assert.Nil(t, Copy(src, dst))
+		assert.False(t, sameFile(t, path.Join(dst, "a"), path.Join(dst, "sub/a")))
+		assert.Equal(t, "a", readTree(t, dst, "sub/a"))
+	}
+}
+
+func TestCopyOverwrite(t *testing.T) {
+	old := time.Now().Add(-time.Hour)
+	for _, policy := range []OverwritePolicy{OverwriteAlways, OverwriteNewer, OverwriteSkip} {
+		src, dst := copyTree(t)
+		_, err := MkdirP(dst)
+		assert.Nil(t, err)
+		assert.Nil(t, WriteString(path.Join(dst, "a"), "older"))
+		assert.Nil(t, os.Chtimes(path.Join(dst, "a"), old, old))
+		assert.Nil(t, WriteString(path.Join(dst, "b"), "newer"))
+		assert.Nil(t, Symlink("a", path.Join(src, "link")))
+		assert.Nil(t, Symlink("b", path.Join(dst, "link")))
+
+		assert.Nil(t, Copy(path.Join(src, "*"), dst, OverwriteOpt(policy)))
+		target, err := SymlinkTarget(path.Join(dst, "link"))
+		assert.Nil(t, err)
+		switch policy {
+		case OverwriteAlways:
+			assert.Equal(t, "a", readTree(t, dst, "a"))
+			assert.Equal(t, "b", readTree(t, dst, "b"))
+			assert.Equal(t, "a", target)
+		case OverwriteNewer:
+			assert.Equal(t, "a", readTree(t, dst, "a"))
+			assert.Equal(t, "newer", readTree(t, dst, "b"))
+		case OverwriteSkip:
+			assert.Equal(t, "older", readTree(t, dst, "a"))
+			assert.Equal(t, "newer", readTree(t, dst, "b"))
+			assert.Equal(t, "b", target)
+		}
+		assert.Equal(t, "c", readTree(t, dst, "sub/c"))
+	}
+}
+
+func TestCopyPreserve(t *testing.T) {
+	old := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// times
+	{
+		src, dst := copyTree(t)
+		for _, name := range []string{"a", "sub", ""} {
+			assert.Nil(t, os.Chtimes(path.Join(src, name), old, old))
+		}
+		assert.Nil(t, Copy(src, dst, PreserveTimesOpt(true)))
+		for _, name := range []string{"a", "sub", ""} {
+			info, err := os.Stat(path.Join(dst, name))
+			assert.Nil(t, err)
+			assert.True(t, old.Equal(info.ModTime()), name)
+		}
+
+		// not by default
+		assert.Nil(t, RemoveAll(dst))
+		assert.Nil(t, Copy(src, dst))
+		info, err := os.Stat(path.Join(dst, "a"))
+		assert.Nil(t, err)
+		assert.False(t, old.Equal(info.ModTime()))
+	}
+
+	// ownership requires privileges to change
+	if os.Geteuid() == 0 {
+		src, dst := copyTree(t)
+		assert.Nil(t, os.Chown(path.Join(src, "a"), 1234, 1234))
+		assert.Nil(t, Copy(src, dst, PreserveOwnerOpt(true)))
+		info, err := os.Stat(path.Join(dst, "a"))
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(1234), info.Sys().(*syscall.Stat_t).Uid)
+		assert.Equal(t, uint32(1234), info.Sys().(*syscall.Stat_t).Gid)
+	}
+
+	// xattrs where supported by the filesystem
+	src, dst := copyTree(t)
+	if unix.Setxattr(path.Join(src, "a"), "user.test", []byte("value"), 0) == nil {
+		assert.Nil(t, Copy(src, dst, PreserveXattrsOpt(true)))
+		buf := make([]byte, 10)
+		n, err := unix.Getxattr(path.Join(dst, "a"), "user.test", buf)
+		assert.Nil(t, err)
+		assert.Equal(t, "value", string(buf[:n]))
+	}
+}
+
+// copyTree resets the temp directory creating a src tree with files a, b and sub/c containing
+// their names returning its absolute path and that of a dst directory that doesn't exist yet.
+func copyTree(t *testing.T) (src, dst string) {
+	resetTest()
+	var err error
+	src, err = Abs(path.Join(tmpDir, "src"))
+	assert.Nil(t, err)
+	dst, err = Abs(path.Join(tmpDir, "dst"))
+	assert.Nil(t, err)
+	_, err = MkdirP(path.Join(src, "sub"))
+	assert.Nil(t, err)
+	for _, name := range []string{"a", "b", "sub/c"} {
+		assert.Nil(t, WriteString(path.Join(src, name), path.Base(name)))
+	}
+	return
+}
+
+// readTree reads the given file relative to the given directory
+func readTree(t *testing.T, dir, name string) string {
+	data, err := ReadString(path.Join(dir, name))
+	assert.Nil(t, err)
+	return data
+}
+
+// sameFile returns true if the given paths are hardlinks to the same file
+func sameFile(t *testing.T, first, second string) bool {
+	info1, err := os.Stat(first)
+	assert.Nil(t, err)
+	info2, err := os.Stat(second)
+	assert.Nil(t, err)
+	return os.SameFile(info1, info2)
+}
+
+// treeNames returns the paths under the given directory relative to it
+func treeNames(t *testing.T, dir string) (names []string) {
+	paths, err := AllPaths(dir)
+	assert.Nil(t, err)
+	for _, x := range paths[1:] {
+		names = append(names, strings.TrimPrefix(x, dir+"/"))
+	}
+	return
+}
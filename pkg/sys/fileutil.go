@@ -136,6 +136,12 @@ func Chown(path string, uid, gid int, opts ...*opt.Opt) (err error) {
 // * The dst will be a clone of the src if it doesn't exist.
 // * Doesn't follow links by default but can be turned by passing in FollowOpt(true)
 // * Following links will use the link name but replace its content with the target linked to
+// * Include and exclude globs without a slash match names otherwise paths relative to the src
+// * Continues past failures returning CopyErrors listing every path that failed
+//
+// Supported options: ConcurrencyOpt, ExcludeOpt, FollowOpt, HardlinksOpt, IncludeOpt,
+// OverwriteOpt, PreserveOwnerOpt, PreserveTimesOpt, PreserveXattrsOpt, ProgressOpt and
+// opt.DryrunOpt
 func Copy(src, dst string, opts ...*opt.Opt) (err error) {
 	clone := true
 	var sources []string
@@ -172,7 +178,8 @@ func Copy(src, dst string, opts ...*opt.Opt) (err error) {
 		clone = false
 	}
 
-	// Copy all sources to dst
+	// Plan the copy of all sources to dst
+	c := newCopier(opts)
 	for _, root := range sources {
 		// Stack of [target, link] pairs to consume.  This provides the ability
 		// to rename source dirs/files based on the link name and effectively
@@ -180,10 +187,11 @@ func Copy(src, dst string, opts ...*opt.Opt) (err error) {
 		// following links.
 		links := [][]string{}
 
-		// Walk over file structure
-		err = Walk(root, func(srcPath string, srcInfo *FileInfo, e error) error {
+		// Walk over file structure recording failures and moving on
+		Walk(root, func(srcPath string, srcInfo *FileInfo, e error) error {
 			if e != nil {
-				return e
+				c.fail(srcPath, e)
+				return nil
 			}
 			follow := getFollowOpt(opts)
 
@@ -203,14 +211,13 @@ func Copy(src, dst string, opts ...*opt.Opt) (err error) {
 			} else {
 				dstPath = path.Join(dstAbs, TrimShared(srcPath, path.Dir(root)))
 			}
+			rel := strings.TrimPrefix(strings.TrimPrefix(srcPath, root), "/")
 
 			switch {
 
 			// Dirs
 			case srcInfo.IsDir():
-				if e = os.MkdirAll(dstPath, srcInfo.Mode()); e != nil {
-					return e
-				}
+				c.add(srcPath, rel, srcInfo, dstPath, copyDirKind)
 
 			// Links
 			case srcInfo.IsSymlinkDir():
@@ -218,28 +225,23 @@ func Copy(src, dst string, opts ...*opt.Opt) (err error) {
 					// Using EvalSymlinks to get full abs path to actual target for path replacement
 					var target string
 					if target, e = filepath.EvalSymlinks(srcPath); e != nil {
-						return e
+						c.fail(srcPath, e)
+						return nil
 					}
 					links = append(links, []string{target, srcPath})
 				} else {
 					// Re-create link using SymlinkTarget to retain relative links
-					var target string
-					if target, e = srcInfo.SymlinkTarget(); e != nil {
-						return e
-					}
-					if e = os.Symlink(target, dstPath); e != nil {
-						return e
-					}
+					c.add(srcPath, rel, srcInfo, dstPath, copySymlinkKind)
 				}
 
 			// Files
 			default:
-				CopyFile(srcPath, dstPath, InfoOpt(srcInfo))
+				c.add(srcPath, rel, srcInfo, dstPath, copyFileKind)
 			}
 			return nil
 		}, opts...)
 	}
-	return
+	return c.run(dstAbs)
 }
 
 // CopyFile copies a single file from src to dsty, creating destination directories as needed.
@@ -248,6 +250,12 @@ func Copy(src, dst string, opts ...*opt.Opt) (err error) {
 // Supports passing in the FileInfo object directly with FollowOpt(true)
 // Returns the destination path for copied file
 func CopyFile(src, dst string, opts ...*opt.Opt) (result string, err error) {
+	return copyFile(src, dst, nil, opts...)
+}
+
+// copyFile implements CopyFile calling the given written callback, if not nil, with the number
+// of bytes of each write to the destination file.
+func copyFile(src, dst string, written func(n int64), opts ...*opt.Opt) (result string, err error) {
 	var srcPath, dstPath string
 	var srcInfo, srcDirInfo *FileInfo
 
@@ -330,7 +338,11 @@ func CopyFile(src, dst string, opts ...*opt.Opt) (result string, err error) {
 		}
 
 		// Copy srcPath to dstPath
-		if _, err = io.Copy(fw, fr); err != nil {
+		var w io.Writer = fw
+		if written != nil {
+			w = &copyWriter{w: fw, written: written}
+		}
+		if _, err = io.Copy(w, fr); err != nil {
 			err = errors.Wrapf(err, "failed to copy data to file %s", dstPath)
 			if e := fw.Close(); e != nil {
 				err = errors.Wrapf(err, "failed to close file %s", dstPath)
@@ -562,15 +574,19 @@ func Touch(filepath string) (path string, err error) {
 
 // WriteBytes is a pass through to os.WriteFile with default permissions
 func WriteBytes(filepath string, data []byte, perms ...uint32) (err error) {
+	return WriteBytesO(filepath, data, permsOpts(perms)...)
+}
+
+// WriteBytesO is a pass through to os.WriteFile with default permissions. Pass in
+// AtomicOpt(true) to write atomically, see AtomicWriter.
+//
+// Supported options: AtomicOpt, BackupOpt and PermsOpt
+func WriteBytesO(filepath string, data []byte, opts ...*opt.Opt) (err error) {
 	if filepath, err = Abs(filepath); err != nil {
 		return
 	}
 
-	perm := os.FileMode(0644)
-	if len(perms) > 0 {
-		perm = os.FileMode(perms[0])
-	}
-	if err = os.WriteFile(filepath, data, perm); err != nil {
+	if err = writeFile(filepath, data, opts); err != nil {
 		err = errors.Wrapf(err, "failed writing bytes to file %s", filepath)
 		return
 	}
@@ -579,15 +595,19 @@ func WriteBytes(filepath string, data []byte, perms ...uint32) (err error) {
 
 // WriteLines is a pass through to os.WriteFile with default permissions
 func WriteLines(filepath string, lines []string, perms ...uint32) (err error) {
+	return WriteLinesO(filepath, lines, permsOpts(perms)...)
+}
+
+// WriteLinesO is a pass through to os.WriteFile with default permissions. Pass in
+// AtomicOpt(true) to write atomically, see AtomicWriter.
+//
+// Supported options: AtomicOpt, BackupOpt and PermsOpt
+func WriteLinesO(filepath string, lines []string, opts ...*opt.Opt) (err error) {
 	if filepath, err = Abs(filepath); err != nil {
 		return
 	}
 
-	perm := os.FileMode(0644)
-	if len(perms) > 0 {
-		perm = os.FileMode(perms[0])
-	}
-	if err = os.WriteFile(filepath, []byte(strings.Join(lines, "\n")), perm); err != nil {
+	if err = writeFile(filepath, []byte(strings.Join(lines, "\n")), opts); err != nil {
 		err = errors.Wrapf(err, "failed writing lines to file %s", filepath)
 		return
 	}
@@ -597,18 +617,34 @@ func WriteLines(filepath string, lines []string, perms ...uint32) (err error) {
 // WriteStream reads from the io.Reader and writes to the given file using io.Copy
 // thus never filling memory i.e. streaming.  dest will be overwritten if it exists.
 func WriteStream(reader io.Reader, filepath string, perms ...uint32) (err error) {
+	return WriteStreamO(reader, filepath, permsOpts(perms)...)
+}
+
+// WriteStreamO reads from the io.Reader and writes to the given file using io.Copy
+// thus never filling memory i.e. streaming.  dest will be overwritten if it exists.
+// Pass in AtomicOpt(true) to write atomically, see AtomicWriter.
+//
+// Supported options: AtomicOpt, BackupOpt and PermsOpt
+func WriteStreamO(reader io.Reader, filepath string, opts ...*opt.Opt) (err error) {
 	if filepath, err = Abs(filepath); err != nil {
 		return
 	}
 
-	perm := os.FileMode(0644)
-	if len(perms) > 0 {
-		perm = os.FileMode(perms[0])
+	if getAtomicOpt(opts) {
+		if err = writeAtomic(filepath, reader, opts); err != nil {
+			err = errors.Wrapf(err, "failed writing stream to file %s", filepath)
+		}
+		return
+	}
+	if getBackupOpt(opts) {
+		if err = backupFile(filepath, false); err != nil {
+			return
+		}
 	}
 
 	var fw *os.File
 	flags := os.O_CREATE | os.O_TRUNC | os.O_WRONLY
-	if fw, err = os.OpenFile(filepath, flags, perm); err != nil {
+	if fw, err = os.OpenFile(filepath, flags, getPermsOpt(opts)); err != nil {
 		err = errors.Wrapf(err, "failed opening file %s for writing", filepath)
 		return
 	}
@@ -636,15 +672,19 @@ func WriteStream(reader io.Reader, filepath string, perms ...uint32) (err error)
 
 // WriteString is a pass through to os.WriteFile with default permissions
 func WriteString(filepath string, data string, perms ...uint32) (err error) {
+	return WriteStringO(filepath, data, permsOpts(perms)...)
+}
+
+// WriteStringO is a pass through to os.WriteFile with default permissions. Pass in
+// AtomicOpt(true) to write atomically, see AtomicWriter.
+//
+// Supported options: AtomicOpt, BackupOpt and PermsOpt
+func WriteStringO(filepath string, data string, opts ...*opt.Opt) (err error) {
 	if filepath, err = Abs(filepath); err != nil {
 		return
 	}
 
-	perm := os.FileMode(0644)
-	if len(perms) > 0 {
-		perm = os.FileMode(perms[0])
-	}
-	if err = os.WriteFile(filepath, []byte(data), perm); err != nil {
+	if err = writeFile(filepath, []byte(data), opts); err != nil {
 		err = errors.Wrapf(err, "failed writing string to file %s", filepath)
 		return
 	}
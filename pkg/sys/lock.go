@@ -0,0 +1,270 @@
+package sys
+
+import (
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+var (
+	// ErrLocked is returned, possibly wrapped, when a lock is held elsewhere
+	ErrLocked = errors.New("lock is held elsewhere")
+
+	// lockPollMin and lockPollMax bound the interval between attempts when waiting for a lock
+	// with a timeout or context.
+	lockPollMin = 5 * time.Millisecond
+	lockPollMax = 100 * time.Millisecond
+)
+
+// FileLock is an advisory lock guarding a file between cooperating processes and goroutines. The
+// lock is taken on a sidecar lock file at the target path with a .lock extension rather than the
+// target itself so that it survives the target being atomically replaced. Exclusive locks record
+// the PID of their owner in the lock file, see LockOwner. Lock files are left in place on Unlock
+// as removing them would race with waiting lockers. A lock file left by a crashed owner is stale
+// rather than held as the system releases the lock with the process and is simply taken over.
+//
+// By default locks are taken with flock which conflict between different FileLocks even within
+// the same process. FcntlOpt uses POSIX record locks instead which also work over NFS but are
+// owned by the process such that they never conflict within it and are all released when any
+// descriptor for the lock file in the process is closed.
+type FileLock struct {
+	path   string   // path of the lock file
+	file   *os.File // open lock file holding the lock
+	shared bool     // shared read lock rather than exclusive write lock
+	fcntl  bool     // POSIX record lock rather than flock
+}
+
+// Lock acquires an advisory lock for the given target path waiting until it is available, the
+// TimeoutOpt has elapsed or the ContextOpt is done. Errors wrap ErrLocked on timeout and the
+// context error when done. Exclusive by default.
+//
+// Supported options: ContextOpt, FcntlOpt, PermsOpt, SharedOpt and TimeoutOpt
+func Lock(target string, opts ...*opt.Opt) (l *FileLock, err error) {
+	return lock(target, true, opts)
+}
+
+// LockOwner returns the PID of the process holding the exclusive lock for the given target path
+// or 0 if it isn't exclusively locked, including when the lock file is stale. FcntlOpt must match
+// the option used by the owner and with it locks owned by the calling process are not reported.
+//
+// Supported options: FcntlOpt
+func LockOwner(target string, opts ...*opt.Opt) (pid int, err error) {
+	var lockPath string
+	if lockPath, err = getLockPath(target); err != nil {
+		return
+	}
+	var file *os.File
+	if file, err = os.Open(lockPath); err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		} else {
+			err = errors.Wrapf(err, "failed opening lock file %s", lockPath)
+		}
+		return
+	}
+	defer file.Close()
+
+	// fcntl reports the owner directly whereas flock requires probing with a shared lock
+	if getFcntlOpt(opts) {
+		lk := unix.Flock_t{Type: unix.F_RDLCK, Whence: io.SeekStart}
+		if err = unix.FcntlFlock(file.Fd(), unix.F_GETLK, &lk); err != nil {
+			err = errors.Wrapf(err, "failed querying lock file %s", lockPath)
+			return
+		}
+		if lk.Type == unix.F_UNLCK {
+			return
+		}
+		return int(lk.Pid), nil
+	}
+	l := &FileLock{path: lockPath, file: file, shared: true}
+	if err = l.acquire(false); err == nil {
+		unix.Flock(int(file.Fd()), unix.LOCK_UN)
+		return
+	} else if !errors.Is(err, ErrLocked) {
+		return
+	}
+	var data []byte
+	if data, err = io.ReadAll(file); err != nil {
+		err = errors.Wrapf(err, "failed reading lock file %s", lockPath)
+		return
+	}
+	if pid, err = strconv.Atoi(strings.TrimSpace(string(data))); err != nil {
+		err = errors.Wrapf(err, "failed parsing owner of lock file %s", lockPath)
+	}
+	return
+}
+
+// TryLock acquires an advisory lock for the given target path without waiting returning an error
+// wrapping ErrLocked if it is held elsewhere. Exclusive by default.
+//
+// Supported options: FcntlOpt, PermsOpt and SharedOpt
+func TryLock(target string, opts ...*opt.Opt) (l *FileLock, err error) {
+	return lock(target, false, opts)
+}
+
+// WithLock calls the given function while holding an advisory lock for the given target path, see
+// Lock, returning the function's error or the error releasing the lock.
+//
+// Supported options: ContextOpt, FcntlOpt, PermsOpt, SharedOpt and TimeoutOpt
+func WithLock(target string, f func() error, opts ...*opt.Opt) (err error) {
+	var l *FileLock
+	if l, err = Lock(target, opts...); err != nil {
+		return
+	}
+	defer func() {
+		if e := l.Unlock(); err == nil {
+			err = e
+		}
+	}()
+	return f()
+}
+
+// Path returns the path of the lock file
+func (l *FileLock) Path() string {
+	return l.path
+}
+
+// Shared returns true if the lock is a shared read lock rather than an exclusive write lock
+func (l *FileLock) Shared() bool {
+	return l.shared
+}
+
+// Unlock releases the lock. Does nothing if already released making it safe to defer.
+func (l *FileLock) Unlock() (err error) {
+	if l.file == nil {
+		return
+	}
+	file := l.file
+	l.file = nil
+
+	// Clear ownership before releasing the lock so the lock file is never attributed to us after
+	if !l.shared {
+		file.Truncate(0)
+	}
+	if l.fcntl {
+		lk := unix.Flock_t{Type: unix.F_UNLCK, Whence: io.SeekStart}
+		err = unix.FcntlFlock(file.Fd(), unix.F_SETLK, &lk)
+	} else {
+		err = unix.Flock(int(file.Fd()), unix.LOCK_UN)
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "failed releasing lock file %s", l.path)
+		file.Close()
+		return
+	}
+	if err = file.Close(); err != nil {
+		err = errors.Wrapf(err, "failed closing lock file %s", l.path)
+	}
+	return
+}
+
+// acquire attempts to lock the open lock file waiting for it when block is true. Returns
+// ErrLocked if not blocking and the lock is held elsewhere.
+func (l *FileLock) acquire(block bool) (err error) {
+	for {
+		if l.fcntl {
+			lk := unix.Flock_t{Type: unix.F_WRLCK, Whence: io.SeekStart}
+			if l.shared {
+				lk.Type = unix.F_RDLCK
+			}
+			cmd := unix.F_SETLK
+			if block {
+				cmd = unix.F_SETLKW
+			}
+			err = unix.FcntlFlock(l.file.Fd(), cmd, &lk)
+		} else {
+			how := unix.LOCK_EX
+			if l.shared {
+				how = unix.LOCK_SH
+			}
+			if !block {
+				how |= unix.LOCK_NB
+			}
+			err = unix.Flock(int(l.file.Fd()), how)
+		}
+		if err != unix.EINTR {
+			break
+		}
+	}
+	switch err {
+	case nil:
+		return
+	case unix.EWOULDBLOCK, unix.EACCES:
+		return ErrLocked
+	}
+	return errors.Wrapf(err, "failed locking lock file %s", l.path)
+}
+
+// lock acquires an advisory lock for the given target path waiting for it if block is true
+func lock(target string, block bool, opts []*opt.Opt) (l *FileLock, err error) {
+	l = &FileLock{shared: getSharedOpt(opts), fcntl: getFcntlOpt(opts)}
+	if l.path, err = getLockPath(target); err != nil {
+		return nil, err
+	}
+	if l.file, err = os.OpenFile(l.path, os.O_RDWR|os.O_CREATE, getPermsOpt(opts)); err != nil {
+		err = errors.Wrapf(err, "failed opening lock file %s", l.path)
+		return nil, err
+	}
+
+	// Without a timeout or context the system can wait for us otherwise poll with backoff
+	ctx, timeout := getContextOpt(opts), getTimeoutOpt(opts)
+	if !block || (ctx.Done() == nil && timeout <= 0) {
+		err = l.acquire(block)
+	} else {
+		var deadline <-chan time.Time
+		if timeout > 0 {
+			timer := time.NewTimer(timeout)
+			defer timer.Stop()
+			deadline = timer.C
+		}
+		for delay := lockPollMin; ; delay *= 2 {
+			if err = l.acquire(false); err != ErrLocked {
+				break
+			}
+			if delay > lockPollMax {
+				delay = lockPollMax
+			}
+			select {
+			case <-ctx.Done():
+				err = errors.Wrapf(ctx.Err(), "failed waiting for lock file %s", l.path)
+			case <-deadline:
+				err = errors.Wrapf(ErrLocked, "timed out after %v waiting for lock file %s", timeout, l.path)
+			case <-time.After(delay):
+				continue
+			}
+			break
+		}
+	}
+	if err == ErrLocked {
+		err = errors.Wrapf(err, "failed locking lock file %s", l.path)
+	}
+	if err != nil {
+		l.file.Close()
+		return nil, err
+	}
+
+	// Record ownership replacing that of any stale owner
+	if !l.shared {
+		l.file.Truncate(0)
+		if _, err = l.file.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0); err != nil {
+			l.Unlock()
+			err = errors.Wrapf(err, "failed writing owner to lock file %s", l.path)
+			return nil, err
+		}
+	}
+	return
+}
+
+// getLockPath returns the absolute path of the lock file for the given target path
+func getLockPath(target string) (lockPath string, err error) {
+	if lockPath, err = Abs(target); err != nil {
+		return
+	}
+	return lockPath + ".lock", nil
+}
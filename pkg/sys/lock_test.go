@@ -0,0 +1,280 @@
+package sys
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLock(t *testing.T) {
+
+	// empty target
+	{
+		_, err := Lock("")
+		assert.Equal(t, "empty string is an invalid path", err.Error())
+	}
+
+	// missing directory
+	{
+		resetTest()
+		_, err := Lock(tmpDir + "/missing/file")
+		assert.True(t, strings.HasPrefix(err.Error(), "failed opening lock file"))
+	}
+
+	// exclusive locks record their owner and exclude others
+	{
+		resetTest()
+		l, err := Lock(tmpfile)
+		assert.Nil(t, err)
+		assert.False(t, l.Shared())
+		assert.Equal(t, ".tmp.lock", SlicePath(l.Path(), -1, -1))
+		data, err := ReadString(l.Path())
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("%d\n", os.Getpid()), data)
+		pid, err := LockOwner(tmpfile)
+		assert.Nil(t, err)
+		assert.Equal(t, os.Getpid(), pid)
+
+		_, err = TryLock(tmpfile)
+		assert.True(t, errors.Is(err, ErrLocked))
+		_, err = TryLock(tmpfile, SharedOpt(true))
+		assert.True(t, errors.Is(err, ErrLocked))
+
+		// unlocking clears the owner and leaves the lock file
+		assert.Nil(t, l.Unlock())
+		assert.Nil(t, l.Unlock())
+		data, err = ReadString(l.Path())
+		assert.Nil(t, err)
+		assert.Equal(t, "", data)
+		pid, err = LockOwner(tmpfile)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, pid)
+
+		l, err = TryLock(tmpfile)
+		assert.Nil(t, err)
+		assert.Nil(t, l.Unlock())
+	}
+
+	// shared locks exclude only exclusive locks
+	{
+		resetTest()
+		l1, err := Lock(tmpfile, SharedOpt(true))
+		assert.Nil(t, err)
+		assert.True(t, l1.Shared())
+		l2, err := TryLock(tmpfile, SharedOpt(true))
+		assert.Nil(t, err)
+		_, err = TryLock(tmpfile)
+		assert.True(t, errors.Is(err, ErrLocked))
+		pid, err := LockOwner(tmpfile)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, pid)
+
+		assert.Nil(t, l1.Unlock())
+		_, err = TryLock(tmpfile)
+		assert.True(t, errors.Is(err, ErrLocked))
+		assert.Nil(t, l2.Unlock())
+		l, err := TryLock(tmpfile)
+		assert.Nil(t, err)
+		assert.Nil(t, l.Unlock())
+	}
+
+	// waiting for a lock
+	{
+		resetTest()
+		l, err := Lock(tmpfile)
+		assert.Nil(t, err)
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			l.Unlock()
+		}()
+		l2, err := Lock(tmpfile, TimeoutOpt(5*time.Second))
+		assert.Nil(t, err)
+		assert.Nil(t, l2.Unlock())
+	}
+
+	// timeout
+	{
+		resetTest()
+		l, err := Lock(tmpfile)
+		assert.Nil(t, err)
+		start := time.Now()
+		_, err = Lock(tmpfile, TimeoutOpt(50*time.Millisecond))
+		assert.True(t, time.Since(start) >= 50*time.Millisecond)
+		assert.True(t, errors.Is(err, ErrLocked))
+		assert.True(t, strings.HasPrefix(err.Error(), "timed out after 50ms waiting for lock file"))
+		assert.Nil(t, l.Unlock())
+	}
+
+	// context cancellation
+	{
+		resetTest()
+		l, err := Lock(tmpfile)
+		assert.Nil(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			cancel()
+		}()
+		_, err = Lock(tmpfile, ContextOpt(ctx))
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.True(t, strings.HasPrefix(err.Error(), "failed waiting for lock file"))
+		assert.Nil(t, l.Unlock())
+	}
+}
+
+func TestLock_Processes(t *testing.T) {
+	for _, fcntl := range []bool{false, true} {
+		resetTest()
+		helper, stdin := startLockHelper(t, fcntl)
+
+		// locks held by another process exclude us
+		_, err := TryLock(tmpfile, FcntlOpt(fcntl))
+		assert.True(t, errors.Is(err, ErrLocked))
+		_, err = Lock(tmpfile, FcntlOpt(fcntl), TimeoutOpt(20*time.Millisecond))
+		assert.True(t, errors.Is(err, ErrLocked))
+		pid, err := LockOwner(tmpfile, FcntlOpt(fcntl))
+		assert.Nil(t, err)
+		assert.Equal(t, helper.Process.Pid, pid)
+
+		// a crashed owner leaves a stale lock file that is taken over
+		assert.Nil(t, helper.Process.Kill())
+		helper.Wait()
+		stdin.Close()
+		data, err := ReadString(tmpfile + ".lock")
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("%d\n", helper.Process.Pid), data)
+		pid, err = LockOwner(tmpfile, FcntlOpt(fcntl))
+		assert.Nil(t, err)
+		assert.Equal(t, 0, pid)
+
+		l, err := TryLock(tmpfile, FcntlOpt(fcntl))
+		assert.Nil(t, err)
+		data, err = ReadString(tmpfile + ".lock")
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("%d\n", os.Getpid()), data)
+		assert.Nil(t, l.Unlock())
+	}
+}
+
+// TestLockHelperProcess isn't a real test. It holds a lock for TestLock_Processes in a separate
+// process until its stdin is closed or it is killed.
+func TestLockHelperProcess(t *testing.T) {
+	if os.Getenv("N_LOCK_HELPER") == "" {
+		return
+	}
+	fcntl, _ := strconv.ParseBool(os.Getenv("N_LOCK_FCNTL"))
+	l, err := Lock(tmpfile, FcntlOpt(fcntl))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	fmt.Println("locked")
+	bufio.NewReader(os.Stdin).ReadString('\n')
+	l.Unlock()
+	os.Exit(0)
+}
+
+func TestLockOwner(t *testing.T) {
+	resetTest()
+
+	// no lock file
+	pid, err := LockOwner(tmpfile)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, pid)
+
+	// stale lock file
+	assert.Nil(t, WriteString(tmpfile+".lock", "1234\n"))
+	pid, err = LockOwner(tmpfile)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, pid)
+}
+
+func TestWithLock(t *testing.T) {
+
+	// concurrent read modify writes are serialized
+	{
+		resetTest()
+		assert.Nil(t, WriteString(tmpfile, "0"))
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.Nil(t, WithLock(tmpfile, func() error {
+					data, err := ReadString(tmpfile)
+					if err != nil {
+						return err
+					}
+					i, err := strconv.Atoi(data)
+					if err != nil {
+						return err
+					}
+					time.Sleep(time.Millisecond)
+					return WriteStringO(tmpfile, strconv.Itoa(i+1), AtomicOpt(true))
+				}))
+			}()
+		}
+		wg.Wait()
+		data, err := ReadString(tmpfile)
+		assert.Nil(t, err)
+		assert.Equal(t, "20", data)
+	}
+
+	// errors are returned and the lock released
+	{
+		resetTest()
+		err := WithLock(tmpfile, func() error {
+			pid, err := LockOwner(tmpfile)
+			assert.Nil(t, err)
+			assert.Equal(t, os.Getpid(), pid)
+			return errors.New("failed")
+		})
+		assert.Equal(t, "failed", err.Error())
+		pid, err := LockOwner(tmpfile)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, pid)
+	}
+
+	// lock not acquired
+	{
+		resetTest()
+		l, err := Lock(tmpfile)
+		assert.Nil(t, err)
+		called := false
+		err = WithLock(tmpfile, func() error {
+			called = true
+			return nil
+		}, TimeoutOpt(10*time.Millisecond))
+		assert.True(t, errors.Is(err, ErrLocked))
+		assert.False(t, called)
+		assert.Nil(t, l.Unlock())
+	}
+}
+
+// startLockHelper starts TestLockHelperProcess in a separate process and waits for it to acquire
+// its lock returning the command and its stdin.
+func startLockHelper(t *testing.T, fcntl bool) (cmd *exec.Cmd, stdin *os.File) {
+	r, w, err := os.Pipe()
+	assert.Nil(t, err)
+	cmd = exec.Command(os.Args[0], "-test.run=^TestLockHelperProcess$")
+	cmd.Env = append(os.Environ(), "N_LOCK_HELPER=1", fmt.Sprintf("N_LOCK_FCNTL=%t", fcntl))
+	cmd.Stdin = r
+	out, err := cmd.StdoutPipe()
+	assert.Nil(t, err)
+	assert.Nil(t, cmd.Start())
+	r.Close()
+	line, err := bufio.NewReader(out).ReadString('\n')
+	assert.Nil(t, err)
+	assert.Equal(t, "locked\n", line)
+	return cmd, w
+}
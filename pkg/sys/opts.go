@@ -1,6 +1,10 @@
 package sys
 
 import (
+	"context"
+	"os"
+	"time"
+
 	"github.com/phR0ze/n/pkg/opt"
 )
 
@@ -106,3 +110,323 @@ func getRootOpt(opts []*opt.Opt) (result bool) {
 	}
 	return
 }
+
+// AtomicOpt creates a new atomic option with the given value. When true files are written
+// atomically, see AtomicWriter.
+// -------------------------------------------------------------------------------------------------
+func AtomicOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "atomic", Val: val}
+}
+
+// get the atomic option from the options slice defaulting to false
+func getAtomicOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "atomic"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// BackupOpt creates a new backup option with the given value. When true an existing file is
+// copied to the same path with a .bak extension before being overwritten.
+// -------------------------------------------------------------------------------------------------
+func BackupOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "backup", Val: val}
+}
+
+// get the backup option from the options slice defaulting to false
+func getBackupOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "backup"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// PermsOpt creates a new perms option with the given value to use as the permissions of new files
+// -------------------------------------------------------------------------------------------------
+func PermsOpt(val os.FileMode) *opt.Opt {
+	return &opt.Opt{Key: "perms", Val: val}
+}
+
+// get the perms option from the options slice defaulting to 0644
+func getPermsOpt(opts []*opt.Opt) (result os.FileMode) {
+	result = os.FileMode(0644)
+	if o := opt.Get(opts, "perms"); o != nil {
+		switch val := o.Val.(type) {
+		case os.FileMode:
+			result = val
+		case uint32:
+			result = os.FileMode(val)
+		case int:
+			result = os.FileMode(val)
+		}
+	}
+	return
+}
+
+// permsOpts converts the optional permissions of the non option based functions into options
+func permsOpts(perms []uint32) (opts []*opt.Opt) {
+	if len(perms) > 0 {
+		opts = append(opts, PermsOpt(os.FileMode(perms[0])))
+	}
+	return
+}
+
+// ContextOpt creates a new context option with the given value. Waiting for a lock is abandoned
+// when the context is done.
+// -------------------------------------------------------------------------------------------------
+func ContextOpt(val context.Context) *opt.Opt {
+	return &opt.Opt{Key: "context", Val: val}
+}
+
+// get the context option from the options slice defaulting to context.Background()
+func getContextOpt(opts []*opt.Opt) (result context.Context) {
+	result = context.Background()
+	if o := opt.Get(opts, "context"); o != nil {
+		if val, ok := o.Val.(context.Context); ok && val != nil {
+			result = val
+		}
+	}
+	return
+}
+
+// FcntlOpt creates a new fcntl option with the given value. When true locks are POSIX record
+// locks taken with fcntl rather than flock, see FileLock.
+// -------------------------------------------------------------------------------------------------
+func FcntlOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "fcntl", Val: val}
+}
+
+// get the fcntl option from the options slice defaulting to false
+func getFcntlOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "fcntl"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// SharedOpt creates a new shared option with the given value. When true locks are shared read
+// locks rather than exclusive write locks.
+// -------------------------------------------------------------------------------------------------
+func SharedOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "shared", Val: val}
+}
+
+// get the shared option from the options slice defaulting to false
+func getSharedOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "shared"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// TimeoutOpt creates a new timeout option with the given value to limit how long to wait for a
+// lock. Zero or less waits indefinitely.
+// -------------------------------------------------------------------------------------------------
+func TimeoutOpt(val time.Duration) *opt.Opt {
+	return &opt.Opt{Key: "timeout", Val: val}
+}
+
+// get the timeout option from the options slice defaulting to 0
+func getTimeoutOpt(opts []*opt.Opt) (result time.Duration) {
+	if o := opt.Get(opts, "timeout"); o != nil {
+		if val, ok := o.Val.(time.Duration); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// ConcurrencyOpt creates a new concurrency option with the given value for the number of files
+// to copy in parallel.
+// -------------------------------------------------------------------------------------------------
+func ConcurrencyOpt(val int) *opt.Opt {
+	return &opt.Opt{Key: "concurrency", Val: val}
+}
+
+// get the concurrency option from the options slice defaulting to 1
+func getConcurrencyOpt(opts []*opt.Opt) (result int) {
+	result = 1
+	if o := opt.Get(opts, "concurrency"); o != nil {
+		if val, ok := o.Val.(int); ok && val > 0 {
+			result = val
+		}
+	}
+	return
+}
+
+// ExcludeOpt creates a new exclude option with the given globs. Paths matching any of them are
+// not copied along with everything under them, see Copy and Sync.
+// -------------------------------------------------------------------------------------------------
+func ExcludeOpt(val ...string) *opt.Opt {
+	return &opt.Opt{Key: "exclude", Val: val}
+}
+
+// get the exclude option from the options slice defaulting to nil
+func getExcludeOpt(opts []*opt.Opt) (result []string) {
+	if o := opt.Get(opts, "exclude"); o != nil {
+		if val, ok := o.Val.([]string); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// HardlinksOpt creates a new hardlinks option with the given value. When true files hard linked
+// together in the source are copied once and hard linked together in the destination.
+// -------------------------------------------------------------------------------------------------
+func HardlinksOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "hardlinks", Val: val}
+}
+
+// get the hardlinks option from the options slice defaulting to false
+func getHardlinksOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "hardlinks"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// IncludeOpt creates a new include option with the given globs. Only files and links matching
+// any of them are copied, see Copy.
+// -------------------------------------------------------------------------------------------------
+func IncludeOpt(val ...string) *opt.Opt {
+	return &opt.Opt{Key: "include", Val: val}
+}
+
+// get the include option from the options slice defaulting to nil
+func getIncludeOpt(opts []*opt.Opt) (result []string) {
+	if o := opt.Get(opts, "include"); o != nil {
+		if val, ok := o.Val.([]string); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// OverwriteOpt creates a new overwrite option with the given policy for existing destination files
+// -------------------------------------------------------------------------------------------------
+func OverwriteOpt(val OverwritePolicy) *opt.Opt {
+	return &opt.Opt{Key: "overwrite", Val: val}
+}
+
+// get the overwrite option from the options slice defaulting to OverwriteAlways
+func getOverwriteOpt(opts []*opt.Opt) (result OverwritePolicy) {
+	if o := opt.Get(opts, "overwrite"); o != nil {
+		if val, ok := o.Val.(OverwritePolicy); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// PreserveOwnerOpt creates a new preserve owner option with the given value. When true copies
+// are given the ownership of their source which typically requires privileges.
+// -------------------------------------------------------------------------------------------------
+func PreserveOwnerOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "preserve-owner", Val: val}
+}
+
+// get the preserve owner option from the options slice defaulting to false
+func getPreserveOwnerOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "preserve-owner"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// PreserveTimesOpt creates a new preserve times option with the given value. When true copies
+// are given the access and modification times of their source.
+// -------------------------------------------------------------------------------------------------
+func PreserveTimesOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "preserve-times", Val: val}
+}
+
+// get the preserve times option from the options slice defaulting to false
+func getPreserveTimesOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "preserve-times"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// PreserveXattrsOpt creates a new preserve xattrs option with the given value. When true copies
+// are given the extended attributes of their source.
+// -------------------------------------------------------------------------------------------------
+func PreserveXattrsOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "preserve-xattrs", Val: val}
+}
+
+// get the preserve xattrs option from the options slice defaulting to false
+func getPreserveXattrsOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "preserve-xattrs"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// ProgressOpt creates a new progress option with the given callback to report the progress of a
+// copy to. Calls are serialized even when copying in parallel.
+// -------------------------------------------------------------------------------------------------
+func ProgressOpt(val func(CopyProgress)) *opt.Opt {
+	return &opt.Opt{Key: "progress", Val: val}
+}
+
+// get the progress option from the options slice defaulting to nil
+func getProgressOpt(opts []*opt.Opt) (result func(CopyProgress)) {
+	if o := opt.Get(opts, "progress"); o != nil {
+		if val, ok := o.Val.(func(CopyProgress)); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// ChecksumOpt creates a new checksum option with the given value. When true files are compared
+// by content rather than modification time, see Sync.
+// -------------------------------------------------------------------------------------------------
+func ChecksumOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "checksum", Val: val}
+}
+
+// get the checksum option from the options slice defaulting to false
+func getChecksumOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "checksum"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
+
+// DeleteOpt creates a new delete option with the given value. When true destination paths that
+// don't exist in the source are deleted, see Sync.
+// -------------------------------------------------------------------------------------------------
+func DeleteOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "delete", Val: val}
+}
+
+// get the delete option from the options slice defaulting to false
+func getDeleteOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "delete"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"unsafe"
 
+	"github.com/phR0ze/n/pkg/shell"
 	"github.com/pkg/errors"
 	"golang.org/x/sys/unix"
 )
@@ -75,7 +76,10 @@ func ExecExists(target string) (ok bool) {
 	return
 }
 
-// ExecOut executes the given command and returns the output as a string.
+// ExecOut executes the given command and returns the output as a string. The command is split
+// into arguments following POSIX shell quoting rules e.g. `grep 'foo bar' "my file"` runs grep
+// with the two arguments foo bar and my file. Use shell.Quote to safely quote arguments.
+// Supports string interpolation like fmt.Sprintf
 func ExecOut(str string, a ...interface{}) (out string, err error) {
 
 	// Disable creation of .DS_Store ._* files on OSX during file copy
@@ -85,7 +89,11 @@ func ExecOut(str string, a ...interface{}) (out string, err error) {
 
 	// Parse command
 	cmd := fmt.Sprintf(str, a...)
-	pieces := SplitCmd(cmd)
+	var pieces []string
+	if pieces, err = shell.Split(cmd); err != nil {
+		err = errors.Wrap(err, "failed to parse system command")
+		return
+	}
 	if len(pieces) == 0 {
 		err = errors.Errorf("invalid empty command")
 		return
@@ -46,6 +46,20 @@ func TestExecOut(t *testing.T) {
 		expected := "agent\nmech\nnet.go\nnet_test.go\n"
 		assert.Equal(t, expected, result)
 	}
+
+	// Quoted arguments are passed without their quotes
+	{
+		result, err := ExecOut(`printf '[%%s]' 'foo  bar' "it's" a\ b ""`)
+		assert.Nil(t, err)
+		assert.Equal(t, "[foo  bar][it's][a b][]", result)
+	}
+
+	// Unterminated quotes
+	{
+		result, err := ExecOut("echo 'foo")
+		assert.Equal(t, "", result)
+		assert.Equal(t, "failed to parse system command: unterminated single quote at offset 5", err.Error())
+	}
 }
 
 func TestExecPath(t *testing.T) {
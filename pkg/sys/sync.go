@@ -0,0 +1,320 @@
+package sys
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/pkg/errors"
+)
+
+// SyncResult summarizes the actions taken by Sync, or planned with opt.DryrunOpt, as paths
+// relative to the destination with the destination itself as '.'.
+type SyncResult struct {
+	Copied  []string // paths missing from the destination
+	Updated []string // paths that differed in the destination
+	Deleted []string // extraneous paths removed from the destination
+	Skipped []string // paths already up to date
+}
+
+// String returns the number of paths for each action e.g. "copied 1, updated 0, deleted 2, skipped 3"
+func (r *SyncResult) String() string {
+	return fmt.Sprintf("copied %d, updated %d, deleted %d, skipped %d",
+		len(r.Copied), len(r.Updated), len(r.Deleted), len(r.Skipped))
+}
+
+// syncer carries the state of a Sync
+type syncer struct {
+	checksum bool
+	delete   bool
+	dryrun   bool
+	follow   bool
+	exclude  []string
+	result   *SyncResult
+	errs     CopyErrors
+	visiting map[string]bool // real paths of the directories being synced to detect link loops
+}
+
+// Sync makes dst a mirror of src copying only what is missing or differs, see SyncResult.
+// * The dst will be a clone of the src whether it exists or not, like rsync with src/
+// * Files are up to date when their size and modification time match
+// * Files are up to date when their size and content match when passing in ChecksumOpt(true)
+// * Copied files are given the modification time of their source
+// * Extraneous dst paths are only deleted with DeleteOpt(true) and never when excluded
+// * Doesn't follow links by default but can be turned by passing in FollowOpt(true)
+// * Following links will use the link name but replace its content with the target linked to
+// * Exclude globs without a slash match names otherwise paths relative to the src
+// * Continues past failures returning CopyErrors listing every path that failed
+//
+// Supported options: ChecksumOpt, DeleteOpt, ExcludeOpt, FollowOpt and opt.DryrunOpt
+func Sync(src, dst string, opts ...*opt.Opt) (result *SyncResult, err error) {
+	var srcAbs, dstAbs string
+	if srcAbs, err = Abs(src); err != nil {
+		return
+	}
+	if dstAbs, err = Abs(dst); err != nil {
+		return
+	}
+	var info *FileInfo
+	if info, err = Lstat(srcAbs); err != nil {
+		return
+	}
+
+	s := &syncer{
+		checksum: getChecksumOpt(opts),
+		delete:   getDeleteOpt(opts),
+		dryrun:   opt.GetDryrunOpt(opts),
+		follow:   getFollowOpt(opts),
+		exclude:  getExcludeOpt(opts),
+		result:   &SyncResult{},
+		visiting: map[string]bool{},
+	}
+	s.sync(srcAbs, dstAbs, ".", info)
+	result = s.result
+	if len(s.errs) > 0 {
+		err = s.errs
+	}
+	return
+}
+
+// fail records a failure syncing the given source path
+func (s *syncer) fail(src string, err error) {
+	s.errs = append(s.errs, &CopyError{Path: src, Err: err})
+}
+
+// remove removes the given destination path unless in dry run mode
+func (s *syncer) remove(dst string) (err error) {
+	if s.dryrun {
+		return
+	}
+	if err = os.RemoveAll(dst); err != nil {
+		err = errors.Wrapf(err, "failed to remove %s", dst)
+	}
+	return
+}
+
+// sync syncs the given source path to the given destination path dispatching on its type
+func (s *syncer) sync(src, dst, rel string, info *FileInfo) {
+	if info.IsSymlink() && s.follow {
+		target, err := filepath.EvalSymlinks(src)
+		if err != nil {
+			s.fail(src, errors.Wrapf(err, "failed to follow link %s", src))
+			return
+		}
+		if info, err = Lstat(target); err != nil {
+			s.fail(src, err)
+			return
+		}
+	}
+	dstInfo, _ := os.Lstat(dst)
+	switch {
+	case info.IsDir():
+		s.syncDir(src, dst, rel, info, dstInfo)
+	case info.IsSymlink():
+		s.syncLink(src, dst, rel, info, dstInfo)
+	default:
+		s.syncFile(src, dst, rel, info, dstInfo)
+	}
+}
+
+// syncDir syncs the given source directory to the given destination path, which may not exist,
+// recursing on its contents then deleting extraneous destination paths when requested.
+func (s *syncer) syncDir(src, dst, rel string, info *FileInfo, dstInfo os.FileInfo) {
+	if s.visiting[info.Path] {
+		s.fail(src, errors.Errorf("failed to sync %s as it links to a parent directory", src))
+		return
+	}
+	s.visiting[info.Path] = true
+	defer delete(s.visiting, info.Path)
+
+	// Replace anything that isn't a directory and create the directory if needed
+	exists := dstInfo != nil && dstInfo.IsDir()
+	if dstInfo != nil && !exists {
+		if err := s.remove(dst); err != nil {
+			s.fail(src, err)
+			return
+		}
+	}
+	switch {
+	case !exists:
+		if !s.dryrun {
+			if err := os.MkdirAll(dst, info.Mode().Perm()); err != nil {
+				s.fail(src, err)
+				return
+			}
+		}
+		if dstInfo != nil {
+			s.result.Updated = append(s.result.Updated, rel)
+		} else {
+			s.result.Copied = append(s.result.Copied, rel)
+		}
+	case dstInfo.Mode().Perm() != info.Mode().Perm():
+		if !s.dryrun {
+			if err := os.Chmod(dst, info.Mode().Perm()); err != nil {
+				s.fail(src, errors.Wrapf(err, "failed to chmod %s", dst))
+			}
+		}
+		s.result.Updated = append(s.result.Updated, rel)
+	}
+
+	// Sync the contents using the link path when following so names are those of the links
+	names, err := ReadDirnames(info.Path)
+	if err != nil {
+		s.fail(src, err)
+		return
+	}
+	keep := map[string]bool{}
+	for _, name := range names {
+		childRel := name
+		if rel != "." {
+			childRel = path.Join(rel, name)
+		}
+		keep[name] = true
+		if copyMatch(s.exclude, childRel) {
+			continue
+		}
+		child := path.Join(src, name)
+		childInfo, err := Lstat(child)
+		if err != nil {
+			s.fail(child, err)
+			continue
+		}
+		s.sync(child, path.Join(dst, name), childRel, childInfo)
+	}
+
+	// Delete extraneous destination paths leaving excluded paths alone
+	if !s.delete || !exists {
+		return
+	}
+	if names, err = ReadDirnames(dst); err != nil {
+		s.fail(src, err)
+		return
+	}
+	for _, name := range names {
+		childRel := name
+		if rel != "." {
+			childRel = path.Join(rel, name)
+		}
+		if keep[name] || copyMatch(s.exclude, childRel) {
+			continue
+		}
+		if err = s.remove(path.Join(dst, name)); err != nil {
+			s.fail(path.Join(src, name), err)
+			continue
+		}
+		s.result.Deleted = append(s.result.Deleted, childRel)
+	}
+}
+
+// syncFile syncs the given source file to the given destination path, which may not exist,
+// copying it if missing or different and giving it the source's modification time.
+func (s *syncer) syncFile(src, dst, rel string, info *FileInfo, dstInfo os.FileInfo) {
+	if dstInfo != nil && dstInfo.Mode().IsRegular() {
+		same, err := s.same(info, dst, dstInfo)
+		if err != nil {
+			s.fail(src, err)
+			return
+		}
+
+		// Content compared by checksum may only differ in time which is synced quietly
+		if same && !s.dryrun && !info.ModTime().Equal(dstInfo.ModTime()) {
+			if err = copyTimes(info.Path, dst); err != nil {
+				s.fail(src, err)
+				return
+			}
+		}
+		switch {
+		case same && dstInfo.Mode().Perm() == info.Mode().Perm():
+			s.result.Skipped = append(s.result.Skipped, rel)
+			return
+		case same:
+			if !s.dryrun {
+				if err = os.Chmod(dst, info.Mode().Perm()); err != nil {
+					s.fail(src, errors.Wrapf(err, "failed to chmod %s", dst))
+					return
+				}
+			}
+			s.result.Updated = append(s.result.Updated, rel)
+			return
+		}
+	}
+
+	// Replace rather than overwrite as directories and links would be copied into or through and
+	// read only files can't be opened for writing
+	if dstInfo != nil {
+		if err := s.remove(dst); err != nil {
+			s.fail(src, err)
+			return
+		}
+	}
+
+	if !s.dryrun {
+		if _, err := CopyFile(info.Path, dst, InfoOpt(info)); err != nil {
+			s.fail(src, err)
+			return
+		}
+		if err := copyTimes(info.Path, dst); err != nil {
+			s.fail(src, err)
+			return
+		}
+	}
+	if dstInfo != nil {
+		s.result.Updated = append(s.result.Updated, rel)
+	} else {
+		s.result.Copied = append(s.result.Copied, rel)
+	}
+}
+
+// syncLink syncs the given source link to the given destination path, which may not exist,
+// recreating it if missing or linking to a different target.
+func (s *syncer) syncLink(src, dst, rel string, info *FileInfo, dstInfo os.FileInfo) {
+	target, err := info.SymlinkTarget()
+	if err != nil {
+		s.fail(src, err)
+		return
+	}
+	if dstInfo != nil {
+		if dstInfo.Mode()&os.ModeSymlink != 0 {
+			if current, e := os.Readlink(dst); e == nil && current == target {
+				s.result.Skipped = append(s.result.Skipped, rel)
+				return
+			}
+		}
+		if err = s.remove(dst); err != nil {
+			s.fail(src, err)
+			return
+		}
+	}
+	if !s.dryrun {
+		if err = os.Symlink(target, dst); err != nil {
+			s.fail(src, err)
+			return
+		}
+	}
+	if dstInfo != nil {
+		s.result.Updated = append(s.result.Updated, rel)
+	} else {
+		s.result.Copied = append(s.result.Copied, rel)
+	}
+}
+
+// same returns true if the given source file and destination file have the same size and either
+// the same modification time or with ChecksumOpt the same content.
+func (s *syncer) same(info *FileInfo, dst string, dstInfo os.FileInfo) (same bool, err error) {
+	if info.Size() != dstInfo.Size() {
+		return false, nil
+	}
+	if !s.checksum {
+		return info.ModTime().Equal(dstInfo.ModTime()), nil
+	}
+	var srcSum, dstSum string
+	if srcSum, err = MD5(info.Path); err != nil {
+		return
+	}
+	if dstSum, err = MD5(dst); err != nil {
+		return
+	}
+	return srcSum == dstSum, nil
+}
@@ -0,0 +1,214 @@
+package sys
+
+import (
+	"errors"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSync(t *testing.T) {
+
+	// invalid paths
+	{
+		_, err := Sync("", "foo")
+		assert.Equal(t, "empty string is an invalid path", err.Error())
+		_, err = Sync("foo", "")
+		assert.Equal(t, "empty string is an invalid path", err.Error())
+		_, err = Sync(path.Join(tmpDir, "missing"), path.Join(tmpDir, "dst"))
+		assert.True(t, strings.HasPrefix(err.Error(), "failed to execute Lstat against"))
+	}
+
+	// initial sync copies everything then nothing until changed
+	{
+		src, dst := copyTree(t)
+		result, err := Sync(src, dst)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{".", "a", "b", "sub", "sub/c"}, result.Copied)
+		assert.Equal(t, "copied 5, updated 0, deleted 0, skipped 0", result.String())
+		assert.Equal(t, []string{"a", "b", "sub", "sub/c"}, treeNames(t, dst))
+
+		result, err = Sync(src, dst)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "b", "sub/c"}, result.Skipped)
+		assert.Equal(t, "copied 0, updated 0, deleted 0, skipped 3", result.String())
+
+		// changes in size, time and mode are updated
+		assert.Nil(t, WriteString(path.Join(src, "a"), "aa"))
+		future := time.Now().Add(time.Hour)
+		assert.Nil(t, os.Chtimes(path.Join(src, "b"), future, future))
+		assert.Nil(t, os.Chmod(path.Join(src, "sub/c"), 0600))
+		result, err = Sync(src, dst)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "b", "sub/c"}, result.Updated)
+		assert.Equal(t, "aa", readTree(t, dst, "a"))
+		assert.Equal(t, os.FileMode(0600), Mode(path.Join(dst, "sub/c")).Perm())
+	}
+
+	// existing destination is a clone of the source
+	{
+		src, dst := copyTree(t)
+		_, err := MkdirP(dst)
+		assert.Nil(t, err)
+		_, err = Sync(src, dst)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "b", "sub", "sub/c"}, treeNames(t, dst))
+	}
+
+	// types that differ are replaced
+	{
+		src, dst := copyTree(t)
+		_, err := MkdirP(path.Join(dst, "a"))
+		assert.Nil(t, err)
+		assert.Nil(t, WriteString(path.Join(dst, "sub"), "file"))
+		result, err := Sync(src, dst)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "sub"}, result.Updated)
+		assert.Equal(t, "a", readTree(t, dst, "a"))
+		assert.Equal(t, "c", readTree(t, dst, "sub/c"))
+	}
+
+	// single files
+	{
+		src, dst := copyTree(t)
+		result, err := Sync(path.Join(src, "a"), dst)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"."}, result.Copied)
+		assert.Equal(t, "a", readTree(t, dst, ""))
+	}
+}
+
+func TestSyncChecksum(t *testing.T) {
+	src, dst := copyTree(t)
+	_, err := Sync(src, dst)
+	assert.Nil(t, err)
+
+	// same content with a different time is up to date
+	old := time.Now().Add(-time.Hour)
+	assert.Nil(t, os.Chtimes(path.Join(dst, "a"), old, old))
+	result, err := Sync(src, dst, ChecksumOpt(true))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b", "sub/c"}, result.Skipped)
+
+	// different content with the same size and time is updated
+	info, err := os.Stat(path.Join(dst, "b"))
+	assert.Nil(t, err)
+	assert.Nil(t, WriteString(path.Join(dst, "b"), "x"))
+	assert.Nil(t, os.Chtimes(path.Join(dst, "b"), info.ModTime(), info.ModTime()))
+	result, err = Sync(src, dst)
+	assert.Nil(t, err)
+	assert.Empty(t, result.Updated)
+	result, err = Sync(src, dst, ChecksumOpt(true))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b"}, result.Updated)
+	assert.Equal(t, "b", readTree(t, dst, "b"))
+}
+
+func TestSyncDelete(t *testing.T) {
+	src, dst := copyTree(t)
+	_, err := Sync(src, dst)
+	assert.Nil(t, err)
+	assert.Nil(t, WriteString(path.Join(dst, "extra"), "extra"))
+	assert.Nil(t, WriteString(path.Join(dst, "keep.log"), "log"))
+	_, err = MkdirP(path.Join(dst, "sub/dir"))
+	assert.Nil(t, err)
+
+	// extraneous paths are kept by default
+	result, err := Sync(src, dst)
+	assert.Nil(t, err)
+	assert.Empty(t, result.Deleted)
+
+	// excluded paths are never deleted
+	result, err = Sync(src, dst, DeleteOpt(true), ExcludeOpt("*.log"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"sub/dir", "extra"}, result.Deleted)
+	assert.Equal(t, []string{"a", "b", "keep.log", "sub", "sub/c"}, treeNames(t, dst))
+}
+
+func TestSyncDryrun(t *testing.T) {
+	src, dst := copyTree(t)
+	_, err := Sync(src, dst)
+	assert.Nil(t, err)
+	assert.Nil(t, WriteString(path.Join(src, "a"), "aa"))
+	assert.Nil(t, WriteString(path.Join(src, "sub/new"), "new"))
+	assert.Nil(t, WriteString(path.Join(dst, "extra"), "extra"))
+
+	result, err := Sync(src, dst, DeleteOpt(true), opt.DryrunOpt(true))
+	assert.Nil(t, err)
+	assert.Equal(t, &SyncResult{
+		Copied:  []string{"sub/new"},
+		Updated: []string{"a"},
+		Deleted: []string{"extra"},
+		Skipped: []string{"b", "sub/c"},
+	}, result)
+
+	// nothing was changed
+	assert.Equal(t, "a", readTree(t, dst, "a"))
+	assert.Equal(t, []string{"a", "b", "extra", "sub", "sub/c"}, treeNames(t, dst))
+}
+
+func TestSyncExclude(t *testing.T) {
+	src, dst := copyTree(t)
+	result, err := Sync(src, dst, ExcludeOpt("b", "sub/*"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{".", "a", "sub"}, result.Copied)
+	assert.Equal(t, []string{"a", "sub"}, treeNames(t, dst))
+}
+
+func TestSyncLinks(t *testing.T) {
+	src, dst := copyTree(t)
+	assert.Nil(t, Symlink("a", path.Join(src, "link")))
+	assert.Nil(t, Symlink("sub", path.Join(src, "dirlink")))
+
+	// links are recreated by default
+	{
+		result, err := Sync(src, dst)
+		assert.Nil(t, err)
+		assert.Contains(t, result.Copied, "link")
+		target, err := SymlinkTarget(path.Join(dst, "link"))
+		assert.Nil(t, err)
+		assert.Equal(t, "a", target)
+		assert.True(t, IsSymlinkDir(path.Join(dst, "dirlink")))
+
+		result, err = Sync(src, dst)
+		assert.Nil(t, err)
+		assert.Contains(t, result.Skipped, "link")
+		assert.Contains(t, result.Skipped, "dirlink")
+
+		// changed targets are updated
+		assert.Nil(t, os.Remove(path.Join(src, "link")))
+		assert.Nil(t, Symlink("b", path.Join(src, "link")))
+		result, err = Sync(src, dst)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"link"}, result.Updated)
+		assert.Equal(t, "b", readTree(t, dst, "link"))
+	}
+
+	// following links replaces them with their targets using the link names
+	{
+		result, err := Sync(src, dst, FollowOpt(true))
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"dirlink", "link"}, result.Updated)
+		assert.Equal(t, []string{"dirlink/c"}, result.Copied)
+		assert.False(t, IsSymlink(path.Join(dst, "link")))
+		assert.Equal(t, "b", readTree(t, dst, "link"))
+		assert.True(t, IsDir(path.Join(dst, "dirlink")))
+		assert.Equal(t, "c", readTree(t, dst, "dirlink/c"))
+	}
+
+	// loops are reported and skipped
+	{
+		assert.Nil(t, Symlink("..", path.Join(src, "sub/loop")))
+		result, err := Sync(src, dst, FollowOpt(true))
+		var errs CopyErrors
+		assert.True(t, errors.As(err, &errs))
+		assert.Len(t, errs, 2)
+		assert.True(t, strings.HasSuffix(errs[0].Error(), "links to a parent directory"))
+		assert.Contains(t, result.Skipped, "a")
+	}
+}
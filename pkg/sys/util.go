@@ -8,6 +8,9 @@ var gRXSplitCmd = regexp.MustCompile(`'.+'|".+"|\S+`)
 
 // SplitCmd splits this cmd into substrings around spaces taking into account bash like
 // double and single quotes. Unmatched quotes throw and error and empty quotes are removed.
+//
+// Deprecated: SplitCmd keeps the quotes on quoted arguments; use shell.Split which splits
+// commands following POSIX shell rules.
 func SplitCmd(cmd string) (slice []string) {
 	return gRXSplitCmd.FindAllString(cmd, -1)
 }
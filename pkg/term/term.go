@@ -9,6 +9,7 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/phR0ze/n/pkg/uni"
 	"github.com/pkg/errors"
 	"golang.org/x/sys/unix"
 )
@@ -277,11 +278,18 @@ func (tty *TTY) read(opts readOpts) (result string, err error) {
 		// Handle backspaces/deletes
 		case KeyBackSpace, KeyDelete:
 			if len(runes) > 0 {
+				last := runes[len(runes)-1]
 				runes = runes[:len(runes)-1]
 
-				// back up, blot out then back up again
+				// back up, blot out then back up again for each column the rune occupied
 				if opts.echo {
-					tty.outfile.WriteString("\b \b")
+					width := 1
+					if !opts.mask {
+						width = uni.RuneWidth(last)
+					}
+					for i := 0; i < width; i++ {
+						tty.outfile.WriteString("\b \b")
+					}
 				}
 			}
 
@@ -0,0 +1,422 @@
+package time
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Fixed lengths used when calendar units are converted to a time.Duration
+const (
+	fixedDay   = 24 * time.Hour
+	fixedMonth = 30 * fixedDay
+	fixedYear  = 365 * fixedDay
+)
+
+var (
+	gRXISODuration = regexp.MustCompile(`^([+-])?P(?:([\d.,]+)Y)?(?:([\d.,]+)M)?(?:([\d.,]+)W)?(?:([\d.,]+)D)?(?:T(?:([\d.,]+)H)?(?:([\d.,]+)M)?(?:([\d.,]+)S)?)?$`)
+	gRXSpanPart    = regexp.MustCompile(`^(\d+(?:\.\d+)?|\.\d+|an?)\s*([a-zµ]+)`)
+	gRXClock       = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(?::(\d{2}))?\s*(am|pm)?$`)
+
+	// units maps the supported unit names to their span field and multiplier
+	units = map[string]struct {
+		field int
+		mult  float64
+	}{
+		"ns": {spanDur, float64(time.Nanosecond)}, "us": {spanDur, float64(time.Microsecond)},
+		"µs": {spanDur, float64(time.Microsecond)}, "ms": {spanDur, float64(time.Millisecond)},
+		"s": {spanDur, float64(time.Second)}, "sec": {spanDur, float64(time.Second)},
+		"secs": {spanDur, float64(time.Second)}, "second": {spanDur, float64(time.Second)},
+		"seconds": {spanDur, float64(time.Second)}, "m": {spanDur, float64(time.Minute)},
+		"min": {spanDur, float64(time.Minute)}, "mins": {spanDur, float64(time.Minute)},
+		"minute": {spanDur, float64(time.Minute)}, "minutes": {spanDur, float64(time.Minute)},
+		"h": {spanDur, float64(time.Hour)}, "hr": {spanDur, float64(time.Hour)},
+		"hrs": {spanDur, float64(time.Hour)}, "hour": {spanDur, float64(time.Hour)},
+		"hours": {spanDur, float64(time.Hour)}, "d": {spanDays, 1}, "day": {spanDays, 1},
+		"days": {spanDays, 1}, "w": {spanDays, 7}, "wk": {spanDays, 7}, "wks": {spanDays, 7},
+		"week": {spanDays, 7}, "weeks": {spanDays, 7}, "mo": {spanMonths, 1},
+		"month": {spanMonths, 1}, "months": {spanMonths, 1}, "y": {spanYears, 1},
+		"yr": {spanYears, 1}, "yrs": {spanYears, 1}, "year": {spanYears, 1}, "years": {spanYears, 1},
+	}
+
+	weekdays = map[string]time.Weekday{
+		"sunday": time.Sunday, "sun": time.Sunday, "monday": time.Monday, "mon": time.Monday,
+		"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+		"wednesday": time.Wednesday, "wed": time.Wednesday, "thursday": time.Thursday,
+		"thu": time.Thursday, "thurs": time.Thursday, "friday": time.Friday, "fri": time.Friday,
+		"saturday": time.Saturday, "sat": time.Saturday,
+	}
+)
+
+// span fields
+const (
+	spanYears = iota
+	spanMonths
+	spanDays
+	spanDur
+)
+
+// span is an amount of time made up of calendar units and an exact duration
+type span struct {
+	years, months, days float64
+	dur                 time.Duration
+}
+
+// duration converts the span to a time.Duration using fixed length calendar units
+func (s span) duration() (time.Duration, error) {
+	d := s.years*float64(fixedYear) + s.months*float64(fixedMonth) + s.days*float64(fixedDay) + float64(s.dur)
+	if d > math.MaxInt64 || d < math.MinInt64 {
+		return 0, errors.New("duration out of range")
+	}
+	return time.Duration(d), nil
+}
+
+// add the span to the given time using calendar arithmetic for whole years, months and days
+// such that days are always midnight to midnight regardless of daylight saving changes.
+func (s span) add(t time.Time, sign int) time.Time {
+	years, fy := math.Modf(s.years)
+	months, fm := math.Modf(s.months)
+	days, fd := math.Modf(s.days)
+	t = t.AddDate(sign*int(years), sign*int(months), sign*int(days))
+	frac := fy*float64(fixedYear) + fm*float64(fixedMonth) + fd*float64(fixedDay) + float64(s.dur)
+	return t.Add(time.Duration(float64(sign) * frac))
+}
+
+// ParseDuration parses the given duration string which may be any of:
+//   - Go duration syntax as supported by time.ParseDuration e.g. "1h30m" or "-1.5h"
+//   - extended units d, w, mo and y e.g. "1d", "2w" or "1y2mo" optionally spelled out and
+//     separated by spaces e.g. "3 days" or "1 hour 30 minutes"
+//   - ISO 8601 durations e.g. "P1DT2H" or "PT1.5S"
+//
+// Calendar units are converted with fixed lengths i.e. a day is 24h, a week 7 days, a month
+// 30 days and a year 365 days.
+func ParseDuration(s string) (val time.Duration, err error) {
+	if val, err = time.ParseDuration(s); err == nil {
+		return
+	}
+
+	var sp span
+	sign := 1
+	if sp, sign, err = parseSpan(s); err != nil {
+		return
+	}
+	if val, err = sp.duration(); err != nil {
+		err = errors.Wrapf(err, "failed to parse duration %s", s)
+		return
+	}
+	val *= time.Duration(sign)
+	return
+}
+
+// parseSpan parses an extended or ISO 8601 duration string into a span
+func parseSpan(s string) (sp span, sign int, err error) {
+	str := strings.TrimSpace(s)
+	sign = 1
+	if strings.HasPrefix(str, "-") || strings.HasPrefix(str, "+") {
+		if str[0] == '-' {
+			sign = -1
+		}
+		str = strings.TrimSpace(str[1:])
+	}
+
+	// ISO 8601
+	if strings.HasPrefix(str, "P") {
+		match := gRXISODuration.FindStringSubmatch(str)
+		if match == nil || str == "P" || strings.HasSuffix(str, "T") {
+			err = errors.Errorf("failed to parse duration %s", s)
+			return
+		}
+		var vals [7]float64
+		for i, x := range match[2:] {
+			if x == "" {
+				continue
+			}
+			if vals[i], err = strconv.ParseFloat(strings.Replace(x, ",", ".", 1), 64); err != nil {
+				err = errors.Errorf("failed to parse duration %s", s)
+				return
+			}
+		}
+		sp.years, sp.months, sp.days = vals[0], vals[1], vals[2]*7+vals[3]
+		sp.dur = time.Duration(vals[4]*float64(time.Hour) + vals[5]*float64(time.Minute) + vals[6]*float64(time.Second))
+		return
+	}
+
+	// Sequence of number and unit pairs
+	str = strings.ToLower(str)
+	if str == "" {
+		err = errors.Errorf("failed to parse duration %s", s)
+		return
+	}
+	for str != "" {
+		match := gRXSpanPart.FindStringSubmatch(str)
+		if match == nil {
+			err = errors.Errorf("failed to parse duration %s", s)
+			return
+		}
+		unit, ok := units[match[2]]
+		if !ok {
+			err = errors.Errorf("failed to parse duration %s: unknown unit %s", s, match[2])
+			return
+		}
+		n := 1.0
+		if match[1] != "a" && match[1] != "an" {
+			n, _ = strconv.ParseFloat(match[1], 64)
+		}
+		switch unit.field {
+		case spanYears:
+			sp.years += n * unit.mult
+		case spanMonths:
+			sp.months += n * unit.mult
+		case spanDays:
+			sp.days += n * unit.mult
+		default:
+			sp.dur += time.Duration(n * unit.mult)
+		}
+		str = strings.TrimLeft(str[len(match[0]):], " ,")
+		str = strings.TrimPrefix(str, "and ")
+	}
+	return
+}
+
+// FromEpoch converts the given epoch into a time detecting whether it is in seconds,
+// milliseconds, microseconds or nanoseconds by its magnitude. Values below 1e11 are seconds,
+// which covers dates up to the year 5138, below 1e14 milliseconds, below 1e17 microseconds
+// and anything larger nanoseconds. As a result millisecond epochs before March 1973 are
+// treated as seconds.
+func FromEpoch(epoch int64) time.Time {
+	abs := epoch
+	if abs < 0 {
+		abs = -abs
+	}
+	switch {
+	case abs < 1e11:
+		return time.Unix(epoch, 0)
+	case abs < 1e14:
+		return time.UnixMilli(epoch)
+	case abs < 1e17:
+		return time.UnixMicro(epoch)
+	}
+	return time.Unix(0, epoch)
+}
+
+// Parse parses the given human friendly time string relative to the current time. See ParseAt
+// for the supported formats.
+func Parse(s string) (time.Time, error) {
+	return ParseAt(s, time.Now())
+}
+
+// ParseAt parses the given human friendly time string relative to the given time which also
+// provides the location for times that don't specify one. Supported formats are:
+//   - any of the registered layouts e.g. "2024-05-01T10:00" see Layouts and AddLayouts
+//   - "now", "today", "yesterday" and "tomorrow"
+//   - relative expressions e.g. "3 days ago", "in 2h", "an hour ago" or "1 week 2 days ago"
+//   - weekdays e.g. "monday", "next friday" or "last tue". A bare weekday is today or the
+//     next occurrence while next and last never include today
+//   - "next week", "last month", "next year" etc
+//   - a time of day following any of the above or on its own e.g. "tomorrow 9am",
+//     "next monday at 17:30", "noon" or "9:30pm"
+//   - a trailing time zone name, abbreviation or IANA name e.g. "2024-05-01T10:00
+//     Europe/Berlin" or "tomorrow 9am PST" see Zone and AddZone
+//
+// Day based expressions without a time of day are at midnight.
+func ParseAt(s string, now time.Time) (val time.Time, err error) {
+	str := strings.TrimSpace(s)
+	fields := strings.Fields(str)
+	if len(fields) == 0 {
+		err = errors.Errorf("failed to parse time %s", s)
+		return
+	}
+
+	// Trailing time zone
+	loc := now.Location()
+	if len(fields) > 1 {
+		if x, e := Zone(fields[len(fields)-1]); e == nil {
+			loc = x
+			fields = fields[:len(fields)-1]
+		}
+	}
+
+	// Absolute layouts on the whole string first as some include the zone e.g. RFC1123
+	layouts := Layouts()
+	for _, layout := range layouts {
+		if val, err = time.ParseInLocation(layout, str, loc); err == nil {
+			val = fixZone(val)
+			return
+		}
+	}
+	if len(fields) < len(strings.Fields(str)) {
+		str = strings.Join(fields, " ")
+		for _, layout := range layouts {
+			if val, err = time.ParseInLocation(layout, str, loc); err == nil {
+				return
+			}
+		}
+	}
+
+	if val, err = parseRelative(strings.ToLower(str), now.In(loc)); err != nil {
+		err = errors.Errorf("failed to parse time %s", s)
+	}
+	return
+}
+
+// fixZone corrects a time parsed with a zone abbreviation the location doesn't define, which
+// time.Parse records with a zero offset, using the registered zones e.g. "PST"
+func fixZone(t time.Time) time.Time {
+	name, offset := t.Zone()
+	if offset != 0 {
+		return t
+	}
+	if loc, err := Zone(name); err == nil {
+		x := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+		if _, o := x.Zone(); o != 0 {
+			return x
+		}
+	}
+	return t
+}
+
+// parseRelative parses the lower cased natural language expression relative to now
+func parseRelative(str string, now time.Time) (val time.Time, err error) {
+	fields := strings.Fields(strings.ReplaceAll(str, ",", " "))
+
+	// Trailing time of day with optional separate am/pm and a leading at
+	var clock *[3]int
+	if n := len(fields); n > 1 && (fields[n-1] == "am" || fields[n-1] == "pm") {
+		fields = append(fields[:n-2], fields[n-2]+fields[n-1])
+	}
+	if n := len(fields); n > 0 {
+		if c, ok := parseClock(fields[n-1]); ok {
+			clock = &c
+			fields = fields[:n-1]
+			if n := len(fields); n > 0 && fields[n-1] == "at" {
+				fields = fields[:n-1]
+			}
+		}
+	}
+
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	day := true // base is a day rather than an exact time
+	switch n := len(fields); {
+	case n == 0 && clock != nil:
+		val = midnight
+	case n == 1 && fields[0] == "now":
+		val, day = now, false
+	case n == 1 && fields[0] == "today":
+		val = midnight
+	case n == 1 && fields[0] == "yesterday":
+		val = midnight.AddDate(0, 0, -1)
+	case n == 1 && fields[0] == "tomorrow":
+		val = midnight.AddDate(0, 0, 1)
+	case n > 1 && fields[n-1] == "ago":
+		var sp span
+		if sp, err = parseSpanFields(fields[:n-1]); err != nil {
+			return
+		}
+		val, day = sp.add(now, -1), false
+	case n > 1 && fields[0] == "in":
+		var sp span
+		if sp, err = parseSpanFields(fields[1:]); err != nil {
+			return
+		}
+		val, day = sp.add(now, 1), false
+	case n <= 2:
+		modifier, name := "", fields[n-1]
+		if n == 2 {
+			modifier = fields[0]
+		}
+		if wd, ok := weekdays[name]; ok {
+			diff := (int(wd) - int(now.Weekday()) + 7) % 7
+			switch modifier {
+			case "", "this":
+			case "next":
+				if diff == 0 {
+					diff = 7
+				}
+			case "last":
+				diff -= 7
+			default:
+				err = errors.New("invalid weekday modifier")
+				return
+			}
+			val = midnight.AddDate(0, 0, diff)
+			break
+		}
+		sign := map[string]int{"next": 1, "last": -1}[modifier]
+		unit, ok := units[name]
+		if sign == 0 || !ok || unit.field == spanDur {
+			err = errors.New("invalid relative time")
+			return
+		}
+		sp := span{}
+		switch unit.field {
+		case spanYears:
+			sp.years = unit.mult
+		case spanMonths:
+			sp.months = unit.mult
+		default:
+			sp.days = unit.mult
+		}
+		val, day = sp.add(now, sign), false
+	default:
+		err = errors.New("invalid relative time")
+		return
+	}
+
+	// Apply the time of day
+	if clock != nil {
+		val = time.Date(val.Year(), val.Month(), val.Day(), clock[0], clock[1], clock[2], 0, val.Location())
+	} else if day {
+		val = time.Date(val.Year(), val.Month(), val.Day(), 0, 0, 0, 0, val.Location())
+	}
+	return
+}
+
+// parseSpanFields parses the fields as a span
+func parseSpanFields(fields []string) (sp span, err error) {
+	var sign int
+	if sp, sign, err = parseSpan(strings.Join(fields, " ")); err == nil && sign < 0 {
+		err = errors.New("invalid negative relative time")
+	}
+	return
+}
+
+// parseClock parses a time of day e.g. "9am", "9:30pm", "17:30:05", "noon" or "midnight".
+// A bare number isn't considered a time of day.
+func parseClock(str string) (clock [3]int, ok bool) {
+	switch str {
+	case "noon":
+		return [3]int{12, 0, 0}, true
+	case "midnight":
+		return [3]int{0, 0, 0}, true
+	}
+	match := gRXClock.FindStringSubmatch(str)
+	if match == nil || (match[2] == "" && match[4] == "") {
+		return
+	}
+	clock[0], _ = strconv.Atoi(match[1])
+	clock[1], _ = strconv.Atoi(match[2])
+	clock[2], _ = strconv.Atoi(match[3])
+	switch match[4] {
+	case "am", "pm":
+		if clock[0] < 1 || clock[0] > 12 {
+			return
+		}
+		clock[0] %= 12
+		if match[4] == "pm" {
+			clock[0] += 12
+		}
+	default:
+		if clock[0] > 23 {
+			return
+		}
+	}
+	if clock[1] > 59 || clock[2] > 59 {
+		return
+	}
+	ok = true
+	return
+}
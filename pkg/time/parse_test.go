@@ -0,0 +1,192 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuration(t *testing.T) {
+
+	// go duration syntax
+	{
+		val, err := ParseDuration("1h30m")
+		assert.Nil(t, err)
+		assert.Equal(t, 90*time.Minute, val)
+
+		val, err = ParseDuration("-1.5h")
+		assert.Nil(t, err)
+		assert.Equal(t, -90*time.Minute, val)
+	}
+
+	// extended units
+	{
+		for k, v := range map[string]time.Duration{
+			"1d":                 24 * time.Hour,
+			"2w":                 14 * 24 * time.Hour,
+			"1y2mo":              (365 + 60) * 24 * time.Hour,
+			"1d12h30m":           36*time.Hour + 30*time.Minute,
+			"1.5d":               36 * time.Hour,
+			"-1d":                -24 * time.Hour,
+			"3 days":             72 * time.Hour,
+			"1 hour 30 minutes":  90 * time.Minute,
+			"1 day, 2 hours":     26 * time.Hour,
+			"2 hours and 5 mins": 2*time.Hour + 5*time.Minute,
+			"an hour":            time.Hour,
+			"1 Week":             7 * 24 * time.Hour,
+			"500ms 2us":          500*time.Millisecond + 2*time.Microsecond,
+		} {
+			val, err := ParseDuration(k)
+			assert.Nil(t, err, k)
+			assert.Equal(t, v, val, k)
+		}
+	}
+
+	// iso 8601
+	{
+		for k, v := range map[string]time.Duration{
+			"P1D":            24 * time.Hour,
+			"P1DT2H":         26 * time.Hour,
+			"PT1.5S":         1500 * time.Millisecond,
+			"PT0,5S":         500 * time.Millisecond,
+			"PT1H30M":        90 * time.Minute,
+			"P1M":            30 * 24 * time.Hour,
+			"P1Y2M3W4DT5H6M": (365+60+21+4)*24*time.Hour + 5*time.Hour + 6*time.Minute,
+			"-P1D":           -24 * time.Hour,
+		} {
+			val, err := ParseDuration(k)
+			assert.Nil(t, err, k)
+			assert.Equal(t, v, val, k)
+		}
+	}
+
+	// invalid
+	{
+		for _, x := range []string{"", "P", "PT", "P1DT", "P1H", "1x", "d", "1d foo", "1.2.3d"} {
+			_, err := ParseDuration(x)
+			assert.NotNil(t, err, x)
+		}
+		_, err := ParseDuration("1 fortnight")
+		assert.Equal(t, "failed to parse duration 1 fortnight: unknown unit fortnight", err.Error())
+
+		_, err = ParseDuration("1000y")
+		assert.Equal(t, "failed to parse duration 1000y: duration out of range", err.Error())
+	}
+}
+
+func TestFromEpoch(t *testing.T) {
+	expected := time.Date(2019, 9, 18, 11, 58, 56, 0, time.UTC)
+	assert.Equal(t, expected, FromEpoch(1568807936).UTC())
+	assert.Equal(t, expected, FromEpoch(1568807936000).UTC())
+	assert.Equal(t, expected, FromEpoch(1568807936000000).UTC())
+	assert.Equal(t, expected, FromEpoch(1568807936000000000).UTC())
+	assert.Equal(t, expected.Add(123*time.Millisecond), FromEpoch(1568807936123).UTC())
+	assert.Equal(t, time.Unix(0, 0), FromEpoch(0))
+	assert.Equal(t, time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC), FromEpoch(-1).UTC())
+}
+
+func TestParseAt(t *testing.T) {
+	now := time.Date(2024, 5, 15, 14, 30, 0, 0, time.UTC) // Wednesday
+	parse := func(s string) time.Time {
+		val, err := ParseAt(s, now)
+		assert.Nil(t, err, s)
+		return val
+	}
+	date := func(month time.Month, day, hour, min int) time.Time {
+		return time.Date(2024, month, day, hour, min, 0, 0, time.UTC)
+	}
+
+	// absolute layouts
+	{
+		assert.Equal(t, date(5, 1, 10, 0), parse("2024-05-01T10:00"))
+		assert.Equal(t, date(5, 1, 0, 0), parse("2024-05-01"))
+		assert.Equal(t, date(5, 1, 0, 0), parse("May 1, 2024"))
+		assert.Equal(t, date(5, 1, 10, 5), parse("2024-05-01 10:05"))
+	}
+
+	// absolute layouts use the location of now
+	{
+		loc := time.FixedZone("X", 3600)
+		val, err := ParseAt("2024-05-01T10:00", now.In(loc))
+		assert.Nil(t, err)
+		assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 0, loc), val)
+	}
+
+	// trailing zones
+	{
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		assert.Nil(t, err)
+		val := parse("2024-05-01T10:00 Europe/Berlin")
+		assert.Equal(t, time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC), val.UTC())
+		assert.Equal(t, berlin.String(), val.Location().String())
+
+		assert.Equal(t, time.Date(2024, 5, 1, 17, 0, 0, 0, time.UTC), parse("2024-05-01 09:00 PST").UTC())
+		assert.Equal(t, time.Date(2024, 5, 16, 17, 0, 0, 0, time.UTC), parse("tomorrow 9am pst").UTC())
+	}
+
+	// layouts including the zone
+	{
+		assert.Equal(t, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), parse("Mon, 02 Jan 2006 15:04:05 GMT").UTC())
+		assert.Equal(t, time.Date(2006, 1, 2, 15, 4, 0, 0, time.UTC), parse("02 Jan 06 15:04 UTC").UTC())
+		assert.Equal(t, time.Date(2006, 1, 2, 23, 4, 5, 0, time.UTC), parse("Mon, 02 Jan 2006 15:04:05 PST").UTC())
+		assert.Equal(t, time.Date(2006, 1, 2, 22, 4, 5, 0, time.UTC), parse("Monday, 02-Jan-06 15:04:05 MST").UTC())
+		assert.Equal(t, time.Date(2006, 1, 2, 22, 4, 5, 0, time.UTC), parse("Mon Jan  2 15:04:05 MST 2006").UTC())
+		assert.Equal(t, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), parse("Mon Jan  2 15:04:05 UTC 2006").UTC())
+	}
+
+	// now, today, yesterday and tomorrow
+	{
+		assert.Equal(t, now, parse("now"))
+		assert.Equal(t, date(5, 15, 0, 0), parse("today"))
+		assert.Equal(t, date(5, 14, 0, 0), parse("Yesterday"))
+		assert.Equal(t, date(5, 16, 0, 0), parse("tomorrow"))
+		assert.Equal(t, date(5, 16, 9, 0), parse("tomorrow 9am"))
+		assert.Equal(t, date(5, 16, 21, 30), parse("tomorrow at 9:30 pm"))
+		assert.Equal(t, date(5, 14, 12, 0), parse("yesterday noon"))
+	}
+
+	// relative
+	{
+		assert.Equal(t, date(5, 12, 14, 30), parse("3 days ago"))
+		assert.Equal(t, date(5, 15, 13, 30), parse("an hour ago"))
+		assert.Equal(t, date(5, 15, 16, 30), parse("in 2h"))
+		assert.Equal(t, date(5, 6, 14, 30), parse("1 week 2 days ago"))
+		assert.Equal(t, date(3, 15, 14, 30), parse("2 months ago"))
+		assert.Equal(t, date(5, 12, 8, 0), parse("3 days ago at 8am"))
+		assert.Equal(t, date(5, 22, 14, 30), parse("next week"))
+		assert.Equal(t, date(4, 15, 14, 30), parse("last month"))
+		assert.Equal(t, time.Date(2025, 5, 15, 14, 30, 0, 0, time.UTC), parse("next year"))
+	}
+
+	// weekdays
+	{
+		assert.Equal(t, date(5, 15, 0, 0), parse("wednesday"))
+		assert.Equal(t, date(5, 20, 0, 0), parse("monday"))
+		assert.Equal(t, date(5, 20, 9, 0), parse("next monday 9am"))
+		assert.Equal(t, date(5, 22, 0, 0), parse("next wed"))
+		assert.Equal(t, date(5, 13, 0, 0), parse("last monday"))
+		assert.Equal(t, date(5, 8, 0, 0), parse("last wednesday"))
+		assert.Equal(t, date(5, 17, 17, 30), parse("this friday at 17:30"))
+	}
+
+	// time of day only
+	{
+		assert.Equal(t, date(5, 15, 9, 0), parse("9am"))
+		assert.Equal(t, date(5, 15, 0, 0), parse("12am"))
+		assert.Equal(t, date(5, 15, 12, 0), parse("12pm"))
+		assert.Equal(t, date(5, 15, 23, 59), parse("23:59"))
+		assert.Equal(t, date(5, 15, 0, 0), parse("midnight"))
+	}
+
+	// invalid
+	{
+		for _, x := range []string{"", "foo", "13pm", "25:00", "9", "next", "next foo", "someday monday",
+			"3 days", "in 3 foos", "- 3 days ago", "last hour", "at"} {
+			_, err := ParseAt(x, now)
+			assert.NotNil(t, err, x)
+		}
+		_, err := ParseAt("foo", now)
+		assert.Equal(t, "failed to parse time foo", err.Error())
+	}
+}
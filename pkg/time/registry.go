@@ -0,0 +1,117 @@
+package time
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	registryLock sync.RWMutex
+
+	// layouts tried in order when parsing absolute times
+	layouts = []string{
+		time.RFC3339Nano,            // "2006-01-02T15:04:05.999999999Z07:00"
+		time.RFC3339,                // "2006-01-02T15:04:05Z07:00"
+		"2006-01-02T15:04:05",       // ISO 8601 without a zone
+		"2006-01-02T15:04",          // ISO 8601 without seconds or a zone
+		"2006-01-02 15:04:05Z07:00", // RFC 3339 with a space separator
+		"2006-01-02 15:04:05 -0700", // e.g. git log output
+		"2006-01-02 15:04:05",       // time.DateTime
+		"2006-01-02 15:04",          // Date and time without seconds
+		"2006-01-02",                // time.DateOnly
+		time.RFC1123Z,               // "Mon, 02 Jan 2006 15:04:05 -0700"
+		time.RFC1123,                // "Mon, 02 Jan 2006 15:04:05 MST"
+		time.RFC822Z,                // "02 Jan 06 15:04 -0700"
+		time.RFC822,                 // "02 Jan 06 15:04 MST"
+		time.RFC850,                 // "Monday, 02-Jan-06 15:04:05 MST"
+		time.ANSIC,                  // "Mon Jan _2 15:04:05 2006"
+		time.UnixDate,               // "Mon Jan _2 15:04:05 MST 2006"
+		time.RubyDate,               // "Mon Jan 02 15:04:05 -0700 2006"
+		"January 2, 2006 15:04",     // US long date and time
+		"January 2, 2006",           // US long date
+		"January 2 2006",            // US long date without a comma
+		"Jan 2, 2006",               // US short date
+		"Jan 2 2006",                // US short date without a comma
+		"2 January 2006",            // Day Month Year
+		"02 Jan 2006",               // Day Mon Year
+		"01/02/2006 15:04:05",       // US numeric date and time
+		"01/02/2006",                // US numeric date
+		"2006/01/02 15:04:05",       // Year first numeric date and time
+		"2006/01/02",                // Year first numeric date
+		time.StampNano,              // "Jan _2 15:04:05.000000000"
+		time.StampMicro,             // "Jan _2 15:04:05.000000"
+		time.StampMilli,             // "Jan _2 15:04:05.000"
+		time.Stamp,                  // "Jan _2 15:04:05"
+		time.Kitchen,                // "3:04PM"
+	}
+
+	// zones keyed by lower case name or abbreviation
+	zones = map[string]*time.Location{
+		"utc":  time.UTC,
+		"gmt":  time.UTC,
+		"z":    time.UTC,
+		"est":  time.FixedZone("EST", -5*60*60),
+		"edt":  time.FixedZone("EDT", -4*60*60),
+		"cst":  time.FixedZone("CST", -6*60*60),
+		"cdt":  time.FixedZone("CDT", -5*60*60),
+		"mst":  time.FixedZone("MST", -7*60*60),
+		"mdt":  time.FixedZone("MDT", -6*60*60),
+		"pst":  time.FixedZone("PST", -8*60*60),
+		"pdt":  time.FixedZone("PDT", -7*60*60),
+		"cet":  time.FixedZone("CET", 1*60*60),
+		"cest": time.FixedZone("CEST", 2*60*60),
+		"jst":  time.FixedZone("JST", 9*60*60),
+	}
+)
+
+// AddLayouts registers the given layouts to be tried by Parse after the already registered
+// layouts. Layouts use the same reference time as time.Parse.
+func AddLayouts(layout ...string) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	layouts = append(layouts, layout...)
+}
+
+// Layouts returns a copy of the registered layouts in the order they are tried
+func Layouts() []string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	return append([]string{}, layouts...)
+}
+
+// AddZone registers the given location under the given name or abbreviation such that Parse
+// will recognize it as a trailing zone e.g. AddZone("IST", time.FixedZone("IST", 19800)).
+// Names are matched case insensitively.
+func AddZone(name string, loc *time.Location) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	zones[strings.ToLower(name)] = loc
+}
+
+// Zone returns the location for the given registered name or abbreviation falling back on the
+// IANA time zone database for names like "Europe/Berlin" or "Local". IANA locations are
+// registered once loaded to avoid loading them again.
+func Zone(name string) (loc *time.Location, err error) {
+	key := strings.ToLower(name)
+	registryLock.RLock()
+	loc = zones[key]
+	registryLock.RUnlock()
+	if loc != nil {
+		return
+	}
+
+	// Only consult the database for names that look like IANA names
+	if name != "Local" && !strings.Contains(name, "/") {
+		err = errors.Errorf("unknown time zone %s", name)
+		return
+	}
+	if loc, err = time.LoadLocation(name); err != nil {
+		err = errors.Wrapf(err, "unknown time zone %s", name)
+		return
+	}
+	AddZone(name, loc)
+	return
+}
@@ -0,0 +1,72 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddLayouts(t *testing.T) {
+	now := time.Date(2024, 5, 15, 14, 30, 0, 0, time.UTC)
+
+	_, err := ParseAt("15.05.2024", now)
+	assert.NotNil(t, err)
+
+	AddLayouts("02.01.2006")
+	assert.Equal(t, "02.01.2006", Layouts()[len(Layouts())-1])
+	val, err := ParseAt("15.05.2024", now)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC), val)
+
+	// layouts are copied
+	layouts := Layouts()
+	layouts[0] = "foo"
+	assert.NotEqual(t, "foo", Layouts()[0])
+}
+
+func TestAddZone(t *testing.T) {
+	now := time.Date(2024, 5, 15, 14, 30, 0, 0, time.UTC)
+
+	_, err := ParseAt("2024-05-01 10:00 IST", now)
+	assert.NotNil(t, err)
+
+	AddZone("IST", time.FixedZone("IST", 5*3600+1800))
+	val, err := ParseAt("2024-05-01 10:00 ist", now)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2024, 5, 1, 4, 30, 0, 0, time.UTC), val.UTC())
+}
+
+func TestZone(t *testing.T) {
+
+	// registered
+	{
+		loc, err := Zone("utc")
+		assert.Nil(t, err)
+		assert.Equal(t, time.UTC, loc)
+
+		loc, err = Zone("PDT")
+		assert.Nil(t, err)
+		assert.Equal(t, "PDT", loc.String())
+	}
+
+	// iana
+	{
+		loc, err := Zone("America/New_York")
+		assert.Nil(t, err)
+		assert.Equal(t, "America/New_York", loc.String())
+
+		loc, err = Zone("Local")
+		assert.Nil(t, err)
+		assert.Equal(t, time.Local, loc)
+	}
+
+	// unknown
+	{
+		_, err := Zone("foo")
+		assert.Equal(t, "unknown time zone foo", err.Error())
+
+		_, err = Zone("Foo/Bar")
+		assert.Equal(t, "unknown time zone Foo/Bar: unknown time zone Foo/Bar", err.Error())
+	}
+}
@@ -0,0 +1,247 @@
+// Package uni provides unicode text segmentation, display width and normalization.
+//
+// Grapheme clusters are the user perceived characters of a string e.g. an emoji with skin tone
+// modifiers, a flag made from two regional indicators or a letter followed by combining marks.
+// Segmentation follows the extended grapheme cluster rules of UAX #29 excluding the Indic
+// conjunct rule GB9c. Display width follows UAX #11 East Asian Width treating ambiguous
+// characters as narrow. Normalization supports the canonical forms NFC and NFD of UAX #15.
+package uni
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// grapheme cluster break properties
+type gcbProp int
+
+const (
+	gcbOther gcbProp = iota
+	gcbCR
+	gcbLF
+	gcbControl
+	gcbExtend
+	gcbZWJ
+	gcbRegionalIndicator
+	gcbPrepend
+	gcbSpacingMark
+	gcbL
+	gcbV
+	gcbT
+	gcbLV
+	gcbLVT
+)
+
+// Graphemes splits the given string into its extended grapheme clusters
+func Graphemes(s string) (clusters []string) {
+	clusters = []string{}
+	for len(s) > 0 {
+		n := FirstGrapheme(s)
+		clusters = append(clusters, s[:n])
+		s = s[n:]
+	}
+	return
+}
+
+// GraphemeCount returns the number of extended grapheme clusters in the given string
+func GraphemeCount(s string) (cnt int) {
+	for len(s) > 0 {
+		s = s[FirstGrapheme(s):]
+		cnt++
+	}
+	return
+}
+
+// FirstGrapheme returns the length in bytes of the first extended grapheme cluster in the
+// given string or 0 if the string is empty.
+func FirstGrapheme(s string) int {
+	if len(s) == 0 {
+		return 0
+	}
+	r, i := utf8.DecodeRuneInString(s)
+	prev := gcbProperty(r)
+
+	// Track emoji sequences for GB11 and regional indicator pairs for GB12 and GB13
+	emoji, emojiZWJ := isExtPict(r), false
+	ri := 0
+	if prev == gcbRegionalIndicator {
+		ri = 1
+	}
+
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		cur := gcbProperty(r)
+		if graphemeBreak(prev, cur, emojiZWJ && isExtPict(r), ri) {
+			break
+		}
+
+		// Update sequence state
+		switch {
+		case isExtPict(r):
+			emoji, emojiZWJ = true, false
+		case cur == gcbExtend:
+			emojiZWJ = false
+		case cur == gcbZWJ:
+			emoji, emojiZWJ = false, emoji
+		default:
+			emoji, emojiZWJ = false, false
+		}
+		if cur == gcbRegionalIndicator {
+			ri++
+		} else {
+			ri = 0
+		}
+		prev = cur
+		i += size
+	}
+	return i
+}
+
+// graphemeBreak determines if there is a grapheme cluster boundary between the two properties
+func graphemeBreak(prev, cur gcbProp, emojiZWJ bool, ri int) bool {
+	switch {
+	case prev == gcbCR && cur == gcbLF: // GB3
+		return false
+	case prev == gcbCR || prev == gcbLF || prev == gcbControl: // GB4
+		return true
+	case cur == gcbCR || cur == gcbLF || cur == gcbControl: // GB5
+		return true
+	case prev == gcbL && (cur == gcbL || cur == gcbV || cur == gcbLV || cur == gcbLVT): // GB6
+		return false
+	case (prev == gcbLV || prev == gcbV) && (cur == gcbV || cur == gcbT): // GB7
+		return false
+	case (prev == gcbLVT || prev == gcbT) && cur == gcbT: // GB8
+		return false
+	case cur == gcbExtend || cur == gcbZWJ: // GB9
+		return false
+	case cur == gcbSpacingMark: // GB9a
+		return false
+	case prev == gcbPrepend: // GB9b
+		return false
+	case prev == gcbZWJ && emojiZWJ: // GB11
+		return false
+	case prev == gcbRegionalIndicator && cur == gcbRegionalIndicator && ri%2 == 1: // GB12, GB13
+		return false
+	}
+	return true // GB999
+}
+
+// gcbProperty returns the grapheme cluster break property for the given rune
+func gcbProperty(r rune) gcbProp {
+	switch {
+	case r == '\r':
+		return gcbCR
+	case r == '\n':
+		return gcbLF
+	case r == 0x200D:
+		return gcbZWJ
+	case r == 0x200C:
+		return gcbExtend
+	case r < 0x20 || (r >= 0x7F && r <= 0x9F):
+		return gcbControl
+	case r < 0x300:
+		if r == 0xAD {
+			return gcbControl
+		}
+		return gcbOther
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return gcbRegionalIndicator
+	case r >= 0x1F3FB && r <= 0x1F3FF, r >= 0xE0020 && r <= 0xE007F:
+		return gcbExtend
+	}
+
+	// Hangul syllables
+	if p := hangulProperty(r); p != gcbOther {
+		return p
+	}
+
+	switch {
+	case unicode.Is(prependTable, r):
+		return gcbPrepend
+	case unicode.In(r, unicode.Mn, unicode.Me), unicode.Is(otherExtendTable, r):
+		return gcbExtend
+	case unicode.Is(unicode.Mc, r):
+		return gcbSpacingMark
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return gcbControl
+	}
+	return gcbOther
+}
+
+// hangulProperty returns the hangul specific grapheme cluster break property if any
+func hangulProperty(r rune) gcbProp {
+	switch {
+	case (r >= 0x1100 && r <= 0x115F) || (r >= 0xA960 && r <= 0xA97C):
+		return gcbL
+	case (r >= 0x1160 && r <= 0x11A7) || (r >= 0xD7B0 && r <= 0xD7C6):
+		return gcbV
+	case (r >= 0x11A8 && r <= 0x11FF) || (r >= 0xD7CB && r <= 0xD7FB):
+		return gcbT
+	case r >= hangulSBase && r < hangulSBase+hangulSCount:
+		if (r-hangulSBase)%hangulTCount == 0 {
+			return gcbLV
+		}
+		return gcbLVT
+	}
+	return gcbOther
+}
+
+// isExtPict tests if the given rune has the Extended_Pictographic property
+func isExtPict(r rune) bool {
+	return r >= 0xA9 && unicode.Is(extPictTable, r)
+}
+
+// prependTable holds the runes with the grapheme cluster break property Prepend
+var prependTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0600, 0x0605, 1}, {0x06DD, 0x06DD, 1}, {0x070F, 0x070F, 1}, {0x0890, 0x0891, 1},
+		{0x08E2, 0x08E2, 1}, {0x0D4E, 0x0D4E, 1},
+	},
+	R32: []unicode.Range32{
+		{0x110BD, 0x110BD, 1}, {0x110CD, 0x110CD, 1}, {0x111C2, 0x111C3, 1}, {0x1193F, 0x1193F, 1},
+		{0x11941, 0x11941, 1}, {0x11A3A, 0x11A3A, 1}, {0x11A84, 0x11A89, 1}, {0x11D46, 0x11D46, 1},
+		{0x11F02, 0x11F02, 1},
+	},
+}
+
+// otherExtendTable holds the spacing marks with the Other_Grapheme_Extend property
+var otherExtendTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x09BE, 0x09BE, 1}, {0x09D7, 0x09D7, 1}, {0x0B3E, 0x0B3E, 1}, {0x0B57, 0x0B57, 1},
+		{0x0BBE, 0x0BBE, 1}, {0x0BD7, 0x0BD7, 1}, {0x0CC2, 0x0CC2, 1}, {0x0CD5, 0x0CD6, 1},
+		{0x0D3E, 0x0D3E, 1}, {0x0D57, 0x0D57, 1}, {0x0DCF, 0x0DCF, 1}, {0x0DDF, 0x0DDF, 1},
+		{0x1B35, 0x1B35, 1}, {0x302E, 0x302F, 1}, {0xFF9E, 0xFF9F, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1133E, 0x1133E, 1}, {0x11357, 0x11357, 1}, {0x114B0, 0x114B0, 1}, {0x114BD, 0x114BD, 1},
+		{0x115AF, 0x115AF, 1}, {0x11930, 0x11930, 1}, {0x1D165, 0x1D165, 1}, {0x1D16E, 0x1D172, 1},
+	},
+}
+
+// extPictTable holds the runes with the Extended_Pictographic property
+var extPictTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00A9, 0x00A9, 1}, {0x00AE, 0x00AE, 1}, {0x203C, 0x203C, 1}, {0x2049, 0x2049, 1},
+		{0x2122, 0x2122, 1}, {0x2139, 0x2139, 1}, {0x2194, 0x2199, 1}, {0x21A9, 0x21AA, 1},
+		{0x231A, 0x231B, 1}, {0x2328, 0x2328, 1}, {0x2388, 0x2388, 1}, {0x23CF, 0x23CF, 1},
+		{0x23E9, 0x23F3, 1}, {0x23F8, 0x23FA, 1}, {0x24C2, 0x24C2, 1}, {0x25AA, 0x25AB, 1},
+		{0x25B6, 0x25B6, 1}, {0x25C0, 0x25C0, 1}, {0x25FB, 0x25FE, 1}, {0x2600, 0x2605, 1},
+		{0x2607, 0x2612, 1}, {0x2614, 0x2685, 1}, {0x2690, 0x2705, 1}, {0x2708, 0x2712, 1},
+		{0x2714, 0x2714, 1}, {0x2716, 0x2716, 1}, {0x271D, 0x271D, 1}, {0x2721, 0x2721, 1},
+		{0x2728, 0x2728, 1}, {0x2733, 0x2734, 1}, {0x2744, 0x2744, 1}, {0x2747, 0x2747, 1},
+		{0x274C, 0x274C, 1}, {0x274E, 0x274E, 1}, {0x2753, 0x2755, 1}, {0x2757, 0x2757, 1},
+		{0x2763, 0x2767, 1}, {0x2795, 0x2797, 1}, {0x27A1, 0x27A1, 1}, {0x27B0, 0x27B0, 1},
+		{0x27BF, 0x27BF, 1}, {0x2934, 0x2935, 1}, {0x2B05, 0x2B07, 1}, {0x2B1B, 0x2B1C, 1},
+		{0x2B50, 0x2B50, 1}, {0x2B55, 0x2B55, 1}, {0x3030, 0x3030, 1}, {0x303D, 0x303D, 1},
+		{0x3297, 0x3297, 1}, {0x3299, 0x3299, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1F000, 0x1F0FF, 1}, {0x1F10D, 0x1F10F, 1}, {0x1F12F, 0x1F12F, 1}, {0x1F16C, 0x1F171, 1},
+		{0x1F17E, 0x1F17F, 1}, {0x1F18E, 0x1F18E, 1}, {0x1F191, 0x1F19A, 1}, {0x1F1AD, 0x1F1E5, 1},
+		{0x1F201, 0x1F20F, 1}, {0x1F21A, 0x1F21A, 1}, {0x1F22F, 0x1F22F, 1}, {0x1F232, 0x1F23A, 1},
+		{0x1F23C, 0x1F23F, 1}, {0x1F249, 0x1F3FA, 1}, {0x1F400, 0x1F53D, 1}, {0x1F546, 0x1F64F, 1},
+		{0x1F680, 0x1F6FF, 1}, {0x1F774, 0x1F77F, 1}, {0x1F7D5, 0x1F7FF, 1}, {0x1F80C, 0x1F80F, 1},
+		{0x1F848, 0x1F84F, 1}, {0x1F85A, 0x1F85F, 1}, {0x1F888, 0x1F88F, 1}, {0x1F8AE, 0x1F8FF, 1},
+		{0x1F90C, 0x1F93A, 1}, {0x1F93C, 0x1F945, 1}, {0x1F947, 0x1FAFF, 1}, {0x1FC00, 0x1FFFD, 1},
+	},
+}
@@ -0,0 +1,40 @@
+package uni
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphemes(t *testing.T) {
+	for _, x := range []struct {
+		str      string
+		clusters []string
+	}{
+		{"", []string{}},
+		{"abc", []string{"a", "b", "c"}},
+		{"\r\n\n", []string{"\r\n", "\n"}},                     // GB3, GB4
+		{"e\u0301x", []string{"e\u0301", "x"}},                 // GB9 combining mark
+		{"\u1100\u1161\u11a8", []string{"\u1100\u1161\u11a8"}}, // GB6, GB7 hangul jamo
+		{"가\u11a8각", []string{"가\u11a8", "각"}},                 // GB7, GB8 hangul syllables
+		{"क\u093f", []string{"क\u093f"}},                       // GB9a spacing mark
+		{"\u0600١", []string{"\u0600١"}},                       // GB9b prepend
+		{"👍🏽!", []string{"👍🏽", "!"}},                           // emoji modifier
+		{"👨\u200d👩\u200d👧", []string{"👨\u200d👩\u200d👧"}},       // GB11 zwj sequence
+		{"a\u200d👩", []string{"a\u200d", "👩"}},                 // zwj not after emoji
+		{"🇺🇸🇫🇷🇩", []string{"🇺🇸", "🇫🇷", "🇩"}},                   // GB12, GB13 flags
+		{"❤\ufe0f", []string{"❤\ufe0f"}},                       // variation selector
+		{"a\u00adb", []string{"a", "\u00ad", "b"}},             // control
+		{"🏳\ufe0f\u200d🌈x", []string{"🏳\ufe0f\u200d🌈", "x"}},   // extend before zwj
+		{"日本語", []string{"日", "本", "語"}},
+	} {
+		assert.Equal(t, x.clusters, Graphemes(x.str), x.str)
+		assert.Equal(t, len(x.clusters), GraphemeCount(x.str), x.str)
+	}
+}
+
+func TestFirstGrapheme(t *testing.T) {
+	assert.Equal(t, 0, FirstGrapheme(""))
+	assert.Equal(t, 3, FirstGrapheme("e\u0301x"))
+	assert.Equal(t, 8, FirstGrapheme("🇺🇸🇫🇷"))
+}
@@ -0,0 +1,158 @@
+package uni
+
+import (
+	"sort"
+)
+
+// Hangul syllable constants from the Unicode standard section 3.12
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// decomposition is a single level canonical decomposition of r into a and optionally b
+type decomposition struct {
+	r, a, b  rune
+	excluded bool // excluded from canonical composition
+}
+
+// classRange is a range of runes sharing the same canonical combining class
+type classRange struct {
+	lo, hi rune
+	class  uint8
+}
+
+var (
+	decompMap  map[rune]decomposition
+	composeMap map[[2]rune]rune
+)
+
+func init() {
+	decompMap = make(map[rune]decomposition, len(canonicalDecomp))
+	composeMap = make(map[[2]rune]rune, len(canonicalDecomp))
+	for _, d := range canonicalDecomp {
+		decompMap[d.r] = d
+		if d.b != 0 && !d.excluded {
+			composeMap[[2]rune{d.a, d.b}] = d.r
+		}
+	}
+}
+
+// CombiningClass returns the canonical combining class of the given rune
+func CombiningClass(r rune) uint8 {
+	i := sort.Search(len(combiningClasses), func(i int) bool {
+		return combiningClasses[i].hi >= r
+	})
+	if i < len(combiningClasses) && combiningClasses[i].lo <= r {
+		return combiningClasses[i].class
+	}
+	return 0
+}
+
+// NFD returns the canonical decomposition normalization form of the given string
+func NFD(s string) string {
+	return string(decompose([]rune(s)))
+}
+
+// NFC returns the canonical composition normalization form of the given string
+func NFC(s string) string {
+	return string(compose(decompose([]rune(s))))
+}
+
+// decompose fully decomposes the runes then puts combining marks in canonical order
+func decompose(runes []rune) (result []rune) {
+	result = make([]rune, 0, len(runes))
+	for _, r := range runes {
+		result = decomposeRune(result, r)
+	}
+
+	// Canonical ordering: stable sort each run of non starters by combining class
+	for i := 0; i < len(result); {
+		if CombiningClass(result[i]) == 0 {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(result) && CombiningClass(result[j]) != 0 {
+			j++
+		}
+		run := result[i:j]
+		sort.SliceStable(run, func(x, y int) bool {
+			return CombiningClass(run[x]) < CombiningClass(run[y])
+		})
+		i = j
+	}
+	return
+}
+
+// decomposeRune appends the full canonical decomposition of r to result
+func decomposeRune(result []rune, r rune) []rune {
+	if r >= hangulSBase && r < hangulSBase+hangulSCount {
+		s := r - hangulSBase
+		result = append(result, hangulLBase+s/hangulNCount, hangulVBase+(s%hangulNCount)/hangulTCount)
+		if t := s % hangulTCount; t != 0 {
+			result = append(result, hangulTBase+t)
+		}
+		return result
+	}
+	if d, ok := decompMap[r]; ok {
+		result = decomposeRune(result, d.a)
+		if d.b != 0 {
+			result = decomposeRune(result, d.b)
+		}
+		return result
+	}
+	return append(result, r)
+}
+
+// compose canonically composes the decomposed runes in place
+func compose(runes []rune) []rune {
+	if len(runes) == 0 {
+		return runes
+	}
+	starterPos, starter := 0, runes[0]
+	lastClass := int(CombiningClass(starter))
+	if lastClass != 0 {
+		lastClass = 256 // no starter yet so nothing can compose
+	}
+	pos := 1
+	for i := 1; i < len(runes); i++ {
+		r := runes[i]
+		class := int(CombiningClass(r))
+		if composite, ok := composePair(starter, r); ok && (lastClass < class || lastClass == 0) {
+			runes[starterPos] = composite
+			starter = composite
+			continue
+		}
+		if class == 0 {
+			starterPos, starter = pos, r
+		}
+		lastClass = class
+		runes[pos] = r
+		pos++
+	}
+	return runes[:pos]
+}
+
+// composePair returns the primary composite for the two runes if one exists
+func composePair(a, b rune) (rune, bool) {
+
+	// Hangul LV and LVT syllables
+	if a >= hangulLBase && a < hangulLBase+hangulLCount && b >= hangulVBase && b < hangulVBase+hangulVCount {
+		return hangulSBase + ((a-hangulLBase)*hangulVCount+(b-hangulVBase))*hangulTCount, true
+	}
+	if a >= hangulSBase && a < hangulSBase+hangulSCount && (a-hangulSBase)%hangulTCount == 0 &&
+		b > hangulTBase && b < hangulTBase+hangulTCount {
+		return a + (b - hangulTBase), true
+	}
+
+	r, ok := composeMap[[2]rune{a, b}]
+	return r, ok
+}
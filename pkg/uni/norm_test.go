@@ -0,0 +1,38 @@
+package uni
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombiningClass(t *testing.T) {
+	assert.Equal(t, uint8(0), CombiningClass('a'))
+	assert.Equal(t, uint8(230), CombiningClass('\u0301'))
+	assert.Equal(t, uint8(220), CombiningClass('\u0323'))
+	assert.Equal(t, uint8(9), CombiningClass('\u094d'))
+}
+
+func TestNFD(t *testing.T) {
+	assert.Equal(t, "", NFD(""))
+	assert.Equal(t, "\u0061\u0062\u0063", NFD("\u0061\u0062\u0063"))
+	assert.Equal(t, "\u0065\u0301", NFD("\u00e9"))
+	assert.Equal(t, "\u0041\u030a", NFD("\u212b"))                   // singleton angstrom sign
+	assert.Equal(t, "\u0073\u0323\u0307", NFD("\u1e69"))             // multi level decomposition
+	assert.Equal(t, "\u0073\u0323\u0307", NFD("\u0073\u0307\u0323")) // canonical ordering
+	assert.Equal(t, "\u1100\u1161\u11a8", NFD("\uac01"))             // hangul LVT
+	assert.Equal(t, "\u1100\u1161", NFD("\uac00"))                   // hangul LV
+}
+
+func TestNFC(t *testing.T) {
+	assert.Equal(t, "", NFC(""))
+	assert.Equal(t, "\u0061\u0062\u0063", NFC("\u0061\u0062\u0063"))
+	assert.Equal(t, "\u00e9", NFC("\u0065\u0301"))
+	assert.Equal(t, "\u00c5", NFC("\u212b"))                   // singleton angstrom sign
+	assert.Equal(t, "\u1e69", NFC("\u0073\u0307\u0323"))       // reordered then composed
+	assert.Equal(t, "\u1e0d\u0307", NFC("\u0064\u0307\u0323")) // composes with the first mark after reordering
+	assert.Equal(t, "\uac01", NFC("\u1100\u1161\u11a8"))       // hangul
+	assert.Equal(t, "\u0915\u093c", NFC("\u0958"))             // composition exclusion stays decomposed
+	assert.Equal(t, "\u0301\u0061", NFC("\u0301\u0061"))       // leading non starter
+	assert.Equal(t, "\u00e1\u0301", NFC("\u0061\u0301\u0301")) // second mark is blocked
+}
@@ -0,0 +1,42 @@
+package uni
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuneWidth(t *testing.T) {
+	assert.Equal(t, 0, RuneWidth(0))
+	assert.Equal(t, 0, RuneWidth('\t'))
+	assert.Equal(t, 1, RuneWidth('a'))
+	assert.Equal(t, 1, RuneWidth('é'))
+	assert.Equal(t, 0, RuneWidth('\u0301'))
+	assert.Equal(t, 0, RuneWidth('\u200d'))
+	assert.Equal(t, 0, RuneWidth('\u200b'))
+	assert.Equal(t, 0, RuneWidth('\u1161'))
+	assert.Equal(t, 2, RuneWidth('日'))
+	assert.Equal(t, 2, RuneWidth('가'))
+	assert.Equal(t, 2, RuneWidth('Ａ'))
+	assert.Equal(t, 2, RuneWidth('😀'))
+	assert.Equal(t, 1, RuneWidth('❤'))
+	assert.Equal(t, 1, RuneWidth('｡'))
+}
+
+func TestGraphemeWidth(t *testing.T) {
+	assert.Equal(t, 0, GraphemeWidth(""))
+	assert.Equal(t, 1, GraphemeWidth("e\u0301"))
+	assert.Equal(t, 2, GraphemeWidth("❤\ufe0f"))
+	assert.Equal(t, 2, GraphemeWidth("👨\u200d👩\u200d👧"))
+	assert.Equal(t, 2, GraphemeWidth("🇺🇸"))
+	assert.Equal(t, 1, GraphemeWidth("🇺"))
+	assert.Equal(t, 2, GraphemeWidth("\u1100\u1161\u11a8"))
+}
+
+func TestWidth(t *testing.T) {
+	assert.Equal(t, 0, Width(""))
+	assert.Equal(t, 5, Width("hello"))
+	assert.Equal(t, 6, Width("日本語"))
+	assert.Equal(t, 7, Width("a👍🏽🇺🇸e\u0301x"))
+	assert.Equal(t, 4, Width("👨\u200d👩\u200d👧👍"))
+}
@@ -0,0 +1,684 @@
+package n
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// Query is a SQL like query over the maps of a SliceOfMap supporting projections, filters,
+// aggregates, grouping, ordering and limits. Queries are built with NewQuery or parsed from a
+// SQL subset with ParseQuery and can be run against any number of slices.
+//
+//	NewQuery().Select("team", "count(*) as total").Where(`age > 3d`).GroupBy("team").
+//	    OrderBy("total desc").Limit(10).Run(slice)
+type Query struct {
+	fields  []*queryField // projected fields, all fields when empty
+	where   []*Expr       // filters that must all match
+	groupBy []string      // selectors of the values to group by
+	orderBy []*queryOrder // ordering of the resulting rows
+	limit   int           // maximum number of resulting rows, -1 for unlimited
+	offset  int           // number of resulting rows to skip
+	err     error         // first error encountered while building the query
+}
+
+// queryField is a projected field optionally aggregated over a group of rows
+type queryField struct {
+	name     string // key of the field in the resulting rows
+	selector string // selector of the value, "*" for all fields
+	agg      string // aggregate function or empty for none
+}
+
+// queryRow is a resulting row along with the first source row it was projected from
+type queryRow struct {
+	row    *StringMap // resulting row
+	source *StringMap // first source row or nil for an empty group
+}
+
+// queryOrder is a field to order the resulting rows by
+type queryOrder struct {
+	selector string // key or selector of the value in the resulting rows
+	desc     bool   // order descending
+}
+
+// NewQuery creates a new empty query that selects all fields of all rows
+func NewQuery() *Query {
+	return &Query{limit: -1}
+}
+
+// ParseQuery parses the given SQL subset into a new Query. Errors are returned as *ExprError with
+// the position of the error in the query.
+//
+//	SELECT field [AS name], ... [FROM .] [WHERE expr] [GROUP BY selector, ...]
+//	    [ORDER BY field [ASC|DESC], ...] [LIMIT n [OFFSET n]]
+//
+// Fields are jq type selectors as used by Object.Query e.g. `name` or `meta.labels.app`, `*` for
+// all fields or one of the aggregates count(*), count(selector), sum, avg, min and max. When
+// grouping or aggregating every other field must be grouped by, see GroupBy. The WHERE clause is
+// a filter expression, see CompileExpr. Keywords are case insensitive.
+//
+//	select team, count(*) as total from . where age > 3d group by team order by total desc
+func ParseQuery(src string) (query *Query, err error) {
+	p := &queryParser{exprParser: &exprParser{src: src}}
+	if err = p.next(); err != nil {
+		return
+	}
+	return p.query()
+}
+
+// GroupBy groups the rows by the values at the given selectors. Each group results in a single
+// row with aggregates computed over the rows of the group. Selected fields and orderings that
+// aren't aggregates must be grouped by, or be the name of a selected field for orderings, as
+// their values would otherwise be taken from an arbitrary row of the group. RunE returns an
+// error for those that aren't.
+func (q *Query) GroupBy(selectors ...string) *Query {
+	q.groupBy = append(q.groupBy, selectors...)
+	return q
+}
+
+// Limit limits the number of resulting rows to the given number, -1 for unlimited
+func (q *Query) Limit(n int) *Query {
+	q.limit = n
+	return q
+}
+
+// Offset skips the given number of resulting rows
+func (q *Query) Offset(n int) *Query {
+	q.offset = n
+	return q
+}
+
+// OrderBy orders the resulting rows by the given fields e.g. "name" or "total desc" where each
+// field is either the name of a field in the resulting rows or a selector of a value in the rows
+// they were projected from. Missing values are ordered first.
+func (q *Query) OrderBy(fields ...string) *Query {
+	for _, field := range fields {
+		q.parse(field, func(p *queryParser) (err error) {
+			var order *queryOrder
+			if order, err = p.order(); err == nil {
+				q.orderBy = append(q.orderBy, order)
+			}
+			return
+		})
+	}
+	return q
+}
+
+// Run runs the query against the given slice returning the resulting rows as a new SliceOfMap.
+// Returns an empty SliceOfMap on error, see RunE to get the error.
+func (q *Query) Run(slice *SliceOfMap) *SliceOfMap {
+	result, err := q.RunE(slice)
+	if err != nil {
+		return NewSliceOfMapV()
+	}
+	return result
+}
+
+// RunE runs the query against the given slice returning the resulting rows as a new SliceOfMap.
+// The given slice is not modified and the resulting rows share no values with it.
+func (q *Query) RunE(slice *SliceOfMap) (result *SliceOfMap, err error) {
+	if q.err != nil {
+		err = q.err
+		return
+	}
+	if err = q.validate(); err != nil {
+		return
+	}
+	result = NewSliceOfMapV()
+
+	// Filter
+	rows := []*StringMap{}
+	if slice != nil {
+		for _, row := range *slice {
+			if q.match(row) {
+				rows = append(rows, row)
+			}
+		}
+	}
+
+	// Group and project keeping the first row of each group for ordering
+	var groups [][]*StringMap
+	if len(q.groupBy) > 0 || q.aggregates() {
+		groups = q.group(rows)
+	} else {
+		for _, row := range rows {
+			groups = append(groups, []*StringMap{row})
+		}
+	}
+	projected := make([]queryRow, 0, len(groups))
+	for _, group := range groups {
+		var row *StringMap
+		if row, err = q.project(group); err != nil {
+			result = nil
+			return
+		}
+		projected = append(projected, queryRow{row: row})
+		if len(group) > 0 {
+			projected[len(projected)-1].source = group[0]
+		}
+	}
+
+	// Order and limit
+	if len(q.orderBy) > 0 {
+		sort.SliceStable(projected, func(i, j int) bool { return q.less(projected[i], projected[j]) })
+	}
+	for _, row := range projected {
+		*result = append(*result, row.row)
+	}
+	start := min(max(q.offset, 0), len(*result))
+	end := len(*result)
+	if q.limit >= 0 {
+		end = min(start+q.limit, end)
+	}
+	*result = (*result)[start:end]
+	return
+}
+
+// Select projects the given fields into the resulting rows e.g. "name", "meta.app as app" or
+// "count(*) as total", see ParseQuery for the supported fields.
+func (q *Query) Select(fields ...string) *Query {
+	for _, field := range fields {
+		q.parse(field, func(p *queryParser) (err error) {
+			var f *queryField
+			if f, err = p.field(); err == nil {
+				q.fields = append(q.fields, f)
+			}
+			return
+		})
+	}
+	return q
+}
+
+// Where filters the rows with the given filter expression, see CompileExpr. Multiple filters
+// must all match.
+func (q *Query) Where(src string) *Query {
+	expr, err := CompileExpr(src)
+	if err != nil {
+		q.fail(err)
+	} else {
+		q.where = append(q.where, expr)
+	}
+	return q
+}
+
+// aggregates tests if any of the fields are aggregates
+func (q *Query) aggregates() bool {
+	for _, field := range q.fields {
+		if field.agg != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// fail records the given error if it's the first
+func (q *Query) fail(err error) {
+	if q.err == nil {
+		q.err = err
+	}
+}
+
+// group splits the given rows into groups by the group by values keeping the order in which the
+// groups were first seen. Without group by fields all rows are a single group.
+func (q *Query) group(rows []*StringMap) (groups [][]*StringMap) {
+	if len(q.groupBy) == 0 {
+		return [][]*StringMap{rows}
+	}
+	keys := [][]interface{}{}
+	index := map[uint64][]int{}
+	for _, row := range rows {
+		key := make([]interface{}, 0, len(q.groupBy))
+		for _, selector := range q.groupBy {
+			key = append(key, (&exprPath{selector: selector}).eval(row))
+		}
+		hash := Hash(key)
+		found := false
+		for _, i := range index[hash] {
+			if DeepEqual(keys[i], key) {
+				groups[i] = append(groups[i], row)
+				found = true
+				break
+			}
+		}
+		if !found {
+			index[hash] = append(index[hash], len(groups))
+			keys = append(keys, key)
+			groups = append(groups, []*StringMap{row})
+		}
+	}
+	return
+}
+
+// less orders the given resulting rows by the order by fields
+func (q *Query) less(a, b queryRow) bool {
+	for _, order := range q.orderBy {
+		c := compareQueryValues(order.value(a), order.value(b))
+		if c != 0 {
+			return c < 0 != order.desc
+		}
+	}
+	return false
+}
+
+// match tests if the given row matches all the filters
+func (q *Query) match(row *StringMap) bool {
+	for _, expr := range q.where {
+		if !expr.Match(row) {
+			return false
+		}
+	}
+	return true
+}
+
+// parse parses the given builder argument with the given parse function recording any errors
+func (q *Query) parse(src string, parse func(p *queryParser) error) {
+	p := &queryParser{exprParser: &exprParser{src: src}}
+	err := p.next()
+	if err == nil {
+		err = parse(p)
+	}
+	if err == nil && p.tok.kind != exprEOF {
+		err = p.errorf(p.tok.pos, "unexpected %s", p.tok)
+	}
+	if err != nil {
+		q.fail(err)
+	}
+}
+
+// project creates a resulting row from the given group of rows with aggregates computed over
+// the whole group and other fields, which are grouped by and so the same, taken from its first row
+func (q *Query) project(group []*StringMap) (row *StringMap, err error) {
+	row = NewStringMapV()
+	var first *StringMap
+	if len(group) > 0 {
+		first = group[0]
+	}
+	if len(q.fields) == 0 {
+		if first != nil {
+			row = DeepCopy(first).(*StringMap)
+		}
+		return
+	}
+	for _, field := range q.fields {
+		switch {
+		case field.agg != "":
+			var val interface{}
+			if val, err = field.aggregate(group); err != nil {
+				return
+			}
+			row.Set(field.name, val)
+		case first == nil:
+			row.Set(field.name, nil)
+		case field.selector == "*":
+			for _, item := range *first {
+				row.Set(item.Key, DeepCopy(item.Value))
+			}
+		default:
+			row.Set(field.name, DeepCopy((&exprPath{selector: field.selector}).eval(first)))
+		}
+	}
+	return
+}
+
+// validate checks that when grouping or aggregating every field and ordering is aggregated or
+// grouped by, or for orderings the name of a selected field, as otherwise its value would be
+// taken from an arbitrary row of the group
+func (q *Query) validate() error {
+	if len(q.groupBy) == 0 && !q.aggregates() {
+		return nil
+	}
+	grouped := map[string]bool{}
+	for _, selector := range q.groupBy {
+		grouped[strings.TrimPrefix(selector, ".")] = true
+	}
+	if len(q.fields) == 0 {
+		return errors.New("all fields can't be selected when grouping, select grouped by fields or aggregates")
+	}
+	names := map[string]bool{}
+	for _, field := range q.fields {
+		names[field.name] = true
+		if field.agg == "" && !grouped[strings.TrimPrefix(field.selector, ".")] {
+			return errors.Errorf("field %s must be grouped by or aggregated", field.selector)
+		}
+	}
+	for _, order := range q.orderBy {
+		if !names[order.selector] && !grouped[strings.TrimPrefix(order.selector, ".")] {
+			return errors.Errorf("order by %s must be a selected field or grouped by", order.selector)
+		}
+	}
+	return nil
+}
+
+// aggregate computes the field's aggregate function over the given group of rows skipping
+// missing values
+func (f *queryField) aggregate(group []*StringMap) (result interface{}, err error) {
+	vals := []interface{}{}
+	for _, row := range group {
+		if f.selector == "*" {
+			vals = append(vals, row)
+		} else if val := (&exprPath{selector: f.selector}).eval(row); val != nil {
+			vals = append(vals, val)
+		}
+	}
+
+	switch f.agg {
+	case "count":
+		return len(vals), nil
+
+	case "sum", "avg":
+		ints := true
+		var sum float64
+		for _, val := range vals {
+			var x float64
+			if x, err = ToFloat64E(val); err != nil {
+				err = errors.Errorf("%s: invalid number %v", f.name, val)
+				return
+			}
+			sum += x
+			switch reflect.ValueOf(val).Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			default:
+				ints = false
+			}
+		}
+		switch {
+		case f.agg == "sum" && ints:
+			return int(sum), nil
+		case f.agg == "sum":
+			return sum, nil
+		case len(vals) > 0:
+			return sum / float64(len(vals)), nil
+		}
+		return nil, nil
+
+	default:
+		for _, val := range vals {
+			if result == nil {
+				result = val
+				continue
+			}
+			c, ok := exprCompareValues(val, result)
+			if !ok {
+				err = errors.Errorf("%s: can't compare %v and %v", f.name, result, val)
+				return
+			}
+			if f.agg == "min" && c < 0 || f.agg == "max" && c > 0 {
+				result = val
+			}
+		}
+		return DeepCopy(result), nil
+	}
+}
+
+// value returns the value to order the given resulting row by looking up the field in the
+// resulting row before falling back on its source row
+func (o *queryOrder) value(row queryRow) interface{} {
+	if row.row.Exists(o.selector) {
+		return row.row.Get(o.selector).O()
+	}
+	if row.source == nil {
+		return nil
+	}
+	return (&exprPath{selector: o.selector}).eval(row.source)
+}
+
+// compareQueryValues orders the given values with missing values first and values that can't
+// be ordered considered equal
+func compareQueryValues(x, y interface{}) int {
+	switch {
+	case x == nil && y == nil:
+		return 0
+	case x == nil:
+		return -1
+	case y == nil:
+		return 1
+	}
+	c, _ := exprCompareValues(x, y)
+	return c
+}
+
+// Parser
+//--------------------------------------------------------------------------------------------------
+
+// queryParser is a recursive descent parser for queries reusing the expression lexer
+type queryParser struct {
+	*exprParser
+}
+
+// query := "select" field ("," field)* ("from" ".")? ("where" expr)?
+//
+//	("group" "by" ident ("," ident)*)? ("order" "by" order ("," order)*)?
+//	("limit" int ("offset" int)?)?
+func (p *queryParser) query() (query *Query, err error) {
+	query = NewQuery()
+	if err = p.expect("select"); err != nil {
+		return nil, err
+	}
+	if err = p.list(func() (err error) {
+		var field *queryField
+		if field, err = p.field(); err == nil {
+			query.fields = append(query.fields, field)
+		}
+		return
+	}); err != nil {
+		return nil, err
+	}
+
+	if p.keyword("from") {
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+		if p.tok.kind != exprIdent || p.tok.text != "." {
+			return nil, p.errorf(p.tok.pos, "expected \".\" but found %s", p.tok)
+		}
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.keyword("where") {
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+		start := p.tok.pos
+		var root exprNode
+		if root, err = p.or(); err != nil {
+			return nil, err
+		}
+		query.where = append(query.where, &Expr{src: strings.TrimSpace(p.src[start:p.tok.pos]), root: root})
+	}
+
+	if p.keyword("group") {
+		if err = p.by(); err != nil {
+			return nil, err
+		}
+		if err = p.list(func() (err error) {
+			var selector string
+			if selector, err = p.ident(); err == nil {
+				query.groupBy = append(query.groupBy, selector)
+			}
+			return
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.keyword("order") {
+		if err = p.by(); err != nil {
+			return nil, err
+		}
+		if err = p.list(func() (err error) {
+			var order *queryOrder
+			if order, err = p.order(); err == nil {
+				query.orderBy = append(query.orderBy, order)
+			}
+			return
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.keyword("limit") {
+		if query.limit, err = p.count(); err != nil {
+			return nil, err
+		}
+		if p.keyword("offset") {
+			if query.offset, err = p.count(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if p.tok.kind != exprEOF {
+		return nil, p.errorf(p.tok.pos, "unexpected %s", p.tok)
+	}
+	return
+}
+
+// next advances to the next token lexing "*" for all fields and count(*) as the expression
+// language has no use for it
+func (p *queryParser) next() (err error) {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+	if strings.HasPrefix(p.src[p.pos:], "*") {
+		p.tok = exprToken{kind: exprOp, pos: p.pos, text: "*"}
+		p.pos++
+		return
+	}
+	return p.exprParser.next()
+}
+
+// by advances over the current keyword expecting it to be followed by "by"
+func (p *queryParser) by() (err error) {
+	if err = p.next(); err != nil {
+		return
+	}
+	return p.expect("by")
+}
+
+// count advances over the current keyword returning the non negative integer following it
+func (p *queryParser) count() (n int, err error) {
+	keyword := strings.ToLower(p.tok.text)
+	if err = p.next(); err != nil {
+		return
+	}
+	val, ok := p.tok.val.(int)
+	if p.tok.kind != exprValue || !ok || val < 0 {
+		err = p.errorf(p.tok.pos, "expected %s count but found %s", keyword, p.tok)
+		return
+	}
+	return val, p.next()
+}
+
+// expect advances over the given keyword or returns an error if it isn't the current token
+func (p *queryParser) expect(word string) (err error) {
+	if !p.keyword(word) {
+		return p.errorf(p.tok.pos, "expected %q but found %s", word, p.tok)
+	}
+	return p.next()
+}
+
+// field := "*" | agg "(" ("*" | ident) ")" ("as" name)? | ident ("as" name)?
+func (p *queryParser) field() (field *queryField, err error) {
+	if p.is("*") {
+		return &queryField{name: "*", selector: "*"}, p.next()
+	}
+
+	pos := p.tok.pos
+	var selector string
+	if selector, err = p.ident(); err != nil {
+		return
+	}
+	field = &queryField{name: strings.TrimPrefix(selector, "."), selector: selector}
+
+	// Aggregates
+	if p.is("(") {
+		field.agg = strings.ToLower(selector)
+		switch field.agg {
+		case "count", "sum", "avg", "min", "max":
+		default:
+			return nil, p.errorf(pos, "unknown function %q", selector)
+		}
+		if err = p.next(); err != nil {
+			return
+		}
+		if p.is("*") && field.agg == "count" {
+			field.selector = "*"
+			if err = p.next(); err != nil {
+				return
+			}
+		} else if field.selector, err = p.ident(); err != nil {
+			return
+		}
+		if !p.is(")") {
+			return nil, p.errorf(p.tok.pos, "expected \")\" but found %s", p.tok)
+		}
+		field.name = fmt.Sprintf("%s(%s)", field.agg, field.selector)
+		if err = p.next(); err != nil {
+			return
+		}
+	}
+
+	// Alias
+	if p.keyword("as") {
+		if err = p.next(); err != nil {
+			return
+		}
+		name, ok := p.tok.val.(string)
+		switch {
+		case p.tok.kind == exprIdent:
+			field.name = p.tok.text
+		case p.tok.kind == exprValue && ok:
+			field.name = name
+		default:
+			return nil, p.errorf(p.tok.pos, "expected name but found %s", p.tok)
+		}
+		err = p.next()
+	}
+	return
+}
+
+// ident advances over the current token returning it if it's an identifier
+func (p *queryParser) ident() (text string, err error) {
+	if p.tok.kind != exprIdent {
+		err = p.errorf(p.tok.pos, "expected field but found %s", p.tok)
+		return
+	}
+	text = p.tok.text
+	return text, p.next()
+}
+
+// keyword tests if the current token is the given keyword ignoring case
+func (p *queryParser) keyword(word string) bool {
+	return p.tok.kind == exprIdent && strings.EqualFold(p.tok.text, word)
+}
+
+// list parses a comma separated list of elements with the given element parser
+func (p *queryParser) list(elem func() error) (err error) {
+	for {
+		if err = elem(); err != nil || !p.is(",") {
+			return
+		}
+		if err = p.next(); err != nil {
+			return
+		}
+	}
+}
+
+// order := ident ("asc" | "desc")?
+func (p *queryParser) order() (order *queryOrder, err error) {
+	order = &queryOrder{}
+	if order.selector, err = p.ident(); err != nil {
+		return
+	}
+	if p.keyword("asc") || p.keyword("desc") {
+		order.desc = p.keyword("desc")
+		err = p.next()
+	}
+	return
+}
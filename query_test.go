@@ -0,0 +1,314 @@
+package n
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// queryRows returns rows for testing queries
+func queryRows() *SliceOfMap {
+	return NewSliceOfMapV(
+		"{name: a, team: red, score: 3, age: 5d, meta: {app: web}}",
+		"{name: b, team: blue, score: 10.5, age: 1d, meta: {app: db}}",
+		"{name: c, team: red, score: 4, age: 2d}",
+	)
+}
+
+// NewQuery
+// --------------------------------------------------------------------------------------------------
+func ExampleNewQuery() {
+	rows := NewSliceOfMapV("{name: a, team: red}", "{name: b, team: blue}", "{name: c, team: red}")
+	fmt.Println(NewQuery().Select("team", "count(*) as total").GroupBy("team").OrderBy("total desc").Run(rows))
+	// Output: [&[{team red} {total 2}] &[{team blue} {total 1}]]
+}
+
+func TestNewQuery(t *testing.T) {
+
+	// selects all fields of all rows
+	{
+		rows := queryRows()
+		result := NewQuery().Run(rows)
+		assert.Equal(t, rows, result)
+		assert.False(t, result.At(0).O() == rows.At(0).O())
+	}
+
+	// nil and empty slices
+	{
+		assert.Equal(t, NewSliceOfMapV(), NewQuery().Run(nil))
+		assert.Equal(t, NewSliceOfMapV(), NewQuery().Select("name").Run(NewSliceOfMapV()))
+	}
+}
+
+// ParseQuery
+// --------------------------------------------------------------------------------------------------
+func ExampleParseQuery() {
+	query, _ := ParseQuery("select name where score > 3 order by name desc")
+	fmt.Println(query.Run(NewSliceOfMapV("{name: a, score: 5}", "{name: b, score: 1}", "{name: c, score: 4}")))
+	// Output: [&[{name c}] &[{name a}]]
+}
+
+func TestParseQuery(t *testing.T) {
+
+	// all clauses
+	{
+		query, err := ParseQuery("SELECT name, meta.app AS app FROM . WHERE score > 3 ORDER BY name DESC LIMIT 1 OFFSET 1")
+		assert.Nil(t, err)
+		assert.Equal(t, NewSliceOfMapV("{name: b, app: db}"), query.Run(queryRows()))
+	}
+
+	// keywords in the where expression are case insensitive
+	{
+		query, err := ParseQuery(`SELECT name FROM . WHERE age > 2d AND team == "red" OR NOT score < 10 ORDER BY name`)
+		assert.Nil(t, err)
+		assert.Equal(t, NewSliceOfMapV("{name: a}", "{name: b}"), query.Run(queryRows()))
+	}
+
+	// aggregates
+	{
+		query, err := ParseQuery("select team, count(*) as total, sum(score), avg(score), min(name), max(name) from . group by team")
+		assert.Nil(t, err)
+		assert.Equal(t, []map[string]interface{}{
+			{"team": "red", "total": 2, "sum(score)": 7, "avg(score)": 3.5, "min(name)": "a", "max(name)": "c"},
+			{"team": "blue", "total": 1, "sum(score)": 10.5, "avg(score)": 10.5, "min(name)": "b", "max(name)": "b"},
+		}, query.Run(queryRows()).G())
+	}
+
+	// errors with positions
+	for src, msg := range map[string]string{
+		"":                           "expected \"select\" but found end of expression at column 1",
+		"select":                     "expected field but found end of expression at column 7",
+		"select name,":               "expected field but found end of expression at column 13",
+		"select foo(x)":              "unknown function \"foo\" at column 8",
+		"select sum(*)":              "expected field but found \"*\" at column 12",
+		"select count(x":             "expected \")\" but found end of expression at column 15",
+		"select name as":             "expected name but found end of expression at column 15",
+		"select name from x":         "expected \".\" but found \"x\" at column 18",
+		"select name where":          "expected value but found end of expression at column 18",
+		"select name where a * 2":    "unexpected character '*' at column 21",
+		"select name group team":     "expected \"by\" but found \"team\" at column 19",
+		"select name order by 1":     "expected field but found \"1\" at column 22",
+		"select name limit -1":       "expected limit count but found \"-1\" at column 19",
+		"select name limit 1 offset": "expected offset count but found end of expression at column 27",
+		"select name foo":            "unexpected \"foo\" at column 13",
+	} {
+		_, err := ParseQuery(src)
+		assert.Equal(t, msg, err.Error(), src)
+		assert.IsType(t, &ExprError{}, err)
+	}
+}
+
+// Query_GroupBy
+// --------------------------------------------------------------------------------------------------
+func ExampleQuery_GroupBy() {
+	rows := NewSliceOfMapV("{name: a, team: red}", "{name: b, team: blue}", "{name: c, team: red}")
+	fmt.Println(NewQuery().Select("team", "count(*)").GroupBy("team").Run(rows))
+	// Output: [&[{team red} {count(*) 2}] &[{team blue} {count(*) 1}]]
+}
+
+func TestQuery_GroupBy(t *testing.T) {
+
+	// groups keep the order they were first seen
+	{
+		result := NewQuery().Select("team").GroupBy("team").Run(queryRows())
+		assert.Equal(t, NewSliceOfMapV("{team: red}", "{team: blue}"), result)
+	}
+
+	// fields that aren't grouped by or aggregated would come from an arbitrary row
+	{
+		_, err := NewQuery().Select("name", "team").GroupBy("team").RunE(queryRows())
+		assert.Equal(t, "field name must be grouped by or aggregated", err.Error())
+
+		_, err = NewQuery().Select("name", "count(*)").RunE(queryRows())
+		assert.Equal(t, "field name must be grouped by or aggregated", err.Error())
+
+		_, err = NewQuery().Select("*", "count(*)").GroupBy("team").RunE(queryRows())
+		assert.Equal(t, "field * must be grouped by or aggregated", err.Error())
+
+		_, err = NewQuery().GroupBy("team").RunE(queryRows())
+		assert.Equal(t, "all fields can't be selected when grouping, select grouped by fields or aggregates", err.Error())
+
+		_, err = NewQuery().Select("team").GroupBy("team").OrderBy("name").RunE(queryRows())
+		assert.Equal(t, "order by name must be a selected field or grouped by", err.Error())
+
+		query, err := ParseQuery("select name, count(*) from . group by team")
+		assert.Nil(t, err)
+		_, err = query.RunE(queryRows())
+		assert.Equal(t, "field name must be grouped by or aggregated", err.Error())
+	}
+
+	// multiple and nested selectors with missing values grouped together
+	{
+		result := NewQuery().Select("team", "meta.app as app", "count(*)").GroupBy("team", "meta.app").Run(queryRows())
+		assert.Equal(t, []map[string]interface{}{
+			{"team": "red", "app": "web", "count(*)": 1},
+			{"team": "blue", "app": "db", "count(*)": 1},
+			{"team": "red", "app": nil, "count(*)": 1},
+		}, result.G())
+	}
+
+	// aggregates without group by are computed over all rows
+	{
+		result := NewQuery().Select("count(*)", "count(meta)", "sum(score)", "max(score)").Run(queryRows())
+		assert.Equal(t, []map[string]interface{}{{"count(*)": 3, "count(meta)": 2, "sum(score)": 17.5, "max(score)": 10.5}}, result.G())
+
+		result = NewQuery().Select("count(*)", "avg(score)", "min(score)").Where("score > 100").Run(queryRows())
+		assert.Equal(t, []map[string]interface{}{{"count(*)": 0, "avg(score)": nil, "min(score)": nil}}, result.G())
+	}
+
+	// aggregate errors
+	{
+		_, err := NewQuery().Select("sum(name)").RunE(queryRows())
+		assert.Equal(t, "sum(name): invalid number a", err.Error())
+
+		_, err = NewQuery().Select("max(meta)").RunE(queryRows())
+		assert.Equal(t, "max(meta): can't compare [{app web}] and [{app db}]", err.Error())
+	}
+}
+
+// Query_Limit
+// --------------------------------------------------------------------------------------------------
+func ExampleQuery_Limit() {
+	rows := NewSliceOfMapV("{name: a}", "{name: b}", "{name: c}")
+	fmt.Println(NewQuery().Limit(2).Run(rows))
+	// Output: [&[{name a}] &[{name b}]]
+}
+
+func TestQuery_Limit(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, exprNames(NewQuery().Limit(5).Run(queryRows())))
+	assert.Equal(t, []string{"a", "b", "c"}, exprNames(NewQuery().Limit(-1).Run(queryRows())))
+	assert.Equal(t, 0, NewQuery().Limit(0).Run(queryRows()).Len())
+}
+
+// Query_Offset
+// --------------------------------------------------------------------------------------------------
+func ExampleQuery_Offset() {
+	rows := NewSliceOfMapV("{name: a}", "{name: b}", "{name: c}")
+	fmt.Println(NewQuery().Offset(1).Limit(1).Run(rows))
+	// Output: [&[{name b}]]
+}
+
+func TestQuery_Offset(t *testing.T) {
+	assert.Equal(t, []string{"b", "c"}, exprNames(NewQuery().Offset(1).Run(queryRows())))
+	assert.Equal(t, []string{"a", "b", "c"}, exprNames(NewQuery().Offset(-1).Run(queryRows())))
+	assert.Equal(t, 0, NewQuery().Offset(5).Run(queryRows()).Len())
+}
+
+// Query_OrderBy
+// --------------------------------------------------------------------------------------------------
+func ExampleQuery_OrderBy() {
+	rows := NewSliceOfMapV("{name: a, score: 2}", "{name: b, score: 3}", "{name: c, score: 1}")
+	fmt.Println(NewQuery().Select("name").OrderBy("score desc").Run(rows))
+	// Output: [&[{name b}] &[{name a}] &[{name c}]]
+}
+
+func TestQuery_OrderBy(t *testing.T) {
+
+	// by names and selectors of the resulting rows with missing values first
+	{
+		assert.Equal(t, []string{"a", "c", "b"}, exprNames(NewQuery().OrderBy("score").Run(queryRows())))
+		assert.Equal(t, []string{"c", "b", "a"}, exprNames(NewQuery().OrderBy("meta.app", "name DESC").Run(queryRows())))
+		assert.Equal(t, []string{"b", "c", "a"}, exprNames(NewQuery().Select("name", "score as s").OrderBy("s desc", "name asc").Run(queryRows())))
+	}
+
+	// by fields of the source rows that weren't selected
+	{
+		assert.Equal(t, []string{"b", "c", "a"}, exprNames(NewQuery().Select("name").OrderBy("score desc").Run(queryRows())))
+		result := NewQuery().Select("count(*)").GroupBy("team").OrderBy("team").Run(queryRows())
+		assert.Equal(t, []map[string]interface{}{{"count(*)": 1}, {"count(*)": 2}}, result.G())
+	}
+
+	// errors
+	{
+		_, err := NewQuery().OrderBy("name up").RunE(queryRows())
+		assert.Equal(t, "unexpected \"up\" at column 6", err.Error())
+	}
+}
+
+// Query_Run
+// --------------------------------------------------------------------------------------------------
+func ExampleQuery_Run() {
+	rows := NewSliceOfMapV("{name: a, score: 5}", "{name: b, score: 1}")
+	fmt.Println(NewQuery().Select("name").Where("score > 3").Run(rows))
+	// Output: [&[{name a}]]
+}
+
+func TestQuery_Run(t *testing.T) {
+
+	// errors return an empty slice
+	assert.Equal(t, NewSliceOfMapV(), NewQuery().Where("score >").Run(queryRows()))
+
+	// the query can be run multiple times
+	query := NewQuery().Select("name").Where(`team == "red"`)
+	assert.Equal(t, []string{"a", "c"}, exprNames(query.Run(queryRows())))
+	assert.Equal(t, []string{"d"}, exprNames(query.Run(NewSliceOfMapV("{name: d, team: red}"))))
+}
+
+// Query_RunE
+// --------------------------------------------------------------------------------------------------
+func ExampleQuery_RunE() {
+	_, err := NewQuery().Select("count(*").RunE(NewSliceOfMapV())
+	fmt.Println(err)
+	// Output: expected ")" but found end of expression at column 8
+}
+
+func TestQuery_RunE(t *testing.T) {
+
+	// the slice isn't modified and shares no values with the result
+	{
+		rows := queryRows()
+		result, err := NewQuery().Select("name", "meta").RunE(rows)
+		assert.Nil(t, err)
+		result.At(0).ToStringMap().Get("meta").ToStringMap().Set("app", "foo")
+		assert.Equal(t, queryRows(), rows)
+	}
+
+	// the first error is returned
+	{
+		result, err := NewQuery().Select("foo(x)").Where("score >").RunE(queryRows())
+		assert.Nil(t, result)
+		assert.Equal(t, "unknown function \"foo\" at column 1", err.Error())
+	}
+}
+
+// Query_Select
+// --------------------------------------------------------------------------------------------------
+func ExampleQuery_Select() {
+	rows := NewSliceOfMapV("{name: a, meta: {app: web}}")
+	fmt.Println(NewQuery().Select("name as id", "meta.app").Run(rows))
+	// Output: [&[{id a} {meta.app web}]]
+}
+
+func TestQuery_Select(t *testing.T) {
+
+	// fields, nested fields, aliases and missing fields
+	{
+		result := NewQuery().Select("name", ".meta.app", `team as "the team"`, "missing").Limit(1).Run(queryRows())
+		assert.Equal(t, []map[string]interface{}{{"name": "a", "meta.app": "web", "the team": "red", "missing": nil}}, result.G())
+	}
+
+	// all fields with extra fields overriding them
+	{
+		result := NewQuery().Select("*", "meta.app as name").Limit(1).Run(queryRows())
+		assert.Equal(t, []map[string]interface{}{
+			{"name": "web", "team": "red", "score": 3, "age": "5d", "meta": map[string]interface{}{"app": "web"}},
+		}, result.G())
+	}
+}
+
+// Query_Where
+// --------------------------------------------------------------------------------------------------
+func ExampleQuery_Where() {
+	rows := NewSliceOfMapV("{name: a, score: 5}", "{name: b, score: 1}", "{name: c, score: 4}")
+	fmt.Println(NewQuery().Select("name").Where("score > 3").Where(`name != "a"`).Run(rows))
+	// Output: [&[{name c}]]
+}
+
+func TestQuery_Where(t *testing.T) {
+	assert.Equal(t, []string{"a", "c"}, exprNames(NewQuery().Where(`team == "red"`).Run(queryRows())))
+	assert.Equal(t, []string{"c"}, exprNames(NewQuery().Where(`team == "red"`).Where("age < 3d").Run(queryRows())))
+
+	_, err := NewQuery().Where("team ==").RunE(queryRows())
+	assert.Equal(t, "expected value but found end of expression at column 8", err.Error())
+}
@@ -0,0 +1,396 @@
+package n
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/phR0ze/n/pkg/enc/unit"
+	"github.com/pkg/errors"
+)
+
+// Reader reads typed values out of a map by selector for consuming configuration. Rather than
+// failing on the first problem or silently yielding zero values every missing, mistyped or invalid
+// value is recorded with its selector so that all the problems can be reported at once by Err.
+// Values that can't be read return the given default or the zero value of their type.
+//
+//	r := m.Reader()
+//	port := r.Range(1, 65535).Int(".port", 8080)
+//	host := r.Required().Match(`^[a-z.]+$`).String(".host")
+//	if err := r.Err(); err != nil {
+//		return err
+//	}
+//
+// The modifiers Required, Range and Match apply only to the next value read.
+type Reader struct {
+	m    *StringMap
+	errs ReaderErrors
+	next readerMods
+}
+
+// readerMods are the modifiers that apply to the next value read
+type readerMods struct {
+	required bool        // value must exist
+	min, max interface{} // inclusive bounds of the value
+	pattern  string      // regular expression the value must match
+}
+
+// ReaderError describes a value that failed to be read by a Reader
+type ReaderError struct {
+	Selector string // selector of the value
+	Msg      string // description of the failure
+}
+
+// Error returns the selector of the value with the description of the failure
+func (e *ReaderError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Selector, e.Msg)
+}
+
+// ReaderErrors aggregates the values that failed to be read by a Reader in the order read
+type ReaderErrors []*ReaderError
+
+// Error returns a report of all the values that failed to be read one per line
+func (e ReaderErrors) Error() string {
+	lines := make([]string, 0, len(e)+1)
+	if len(e) == 1 {
+		lines = append(lines, "1 invalid value")
+	} else {
+		lines = append(lines, fmt.Sprintf("%d invalid values", len(e)))
+	}
+	for _, err := range e {
+		lines = append(lines, "  "+err.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// NewReader creates a new *Reader over the given map or YAML/JSON string, see ToStringMap for the
+// supported types. A value that can't be converted is recorded as an error at selector '.'.
+func NewReader(obj interface{}) *Reader {
+	r := &Reader{}
+	var err error
+	if r.m, err = ToStringMapE(obj); err != nil {
+		r.m = NewStringMapV()
+		r.fail(".", "expected map but got %s", readerDesc(obj))
+	}
+	return r
+}
+
+// Bool reads the value at the given selector as a bool returning the given default or false if
+// missing or invalid.
+func (r *Reader) Bool(selector string, def ...bool) (val bool) {
+	if len(def) > 0 {
+		val = def[0]
+	}
+	x, _, ok := r.read(selector)
+	if !ok {
+		return
+	}
+	v, err := ToBoolE(x)
+	if err != nil {
+		r.fail(selector, "expected bool but got %s", readerDesc(x))
+		return
+	}
+	return v
+}
+
+// Duration reads the value at the given selector as a time.Duration, see ToDurationE, returning
+// the given default or 0 if missing or invalid. Supports Range with duration bounds e.g. "1s".
+func (r *Reader) Duration(selector string, def ...time.Duration) (val time.Duration) {
+	if len(def) > 0 {
+		val = def[0]
+	}
+	x, mods, ok := r.read(selector)
+	if !ok {
+		return
+	}
+	v, err := ToDurationE(x)
+	if err != nil {
+		r.fail(selector, "expected duration but got %s", readerDesc(x))
+		return
+	}
+	if !r.inRange(selector, mods, float64(v), v, func(bound interface{}) (float64, error) {
+		b, e := ToDurationE(bound)
+		return float64(b), e
+	}) {
+		return
+	}
+	return v
+}
+
+// Enum reads the value at the given selector as a string that must be one of the given values
+// returning the given default or "" if missing or invalid.
+func (r *Reader) Enum(selector string, values []string, def ...string) (val string) {
+	if len(def) > 0 {
+		val = def[0]
+	}
+	v, ok := r.str(selector)
+	if !ok {
+		return
+	}
+	for i := range values {
+		if v == values[i] {
+			return v
+		}
+	}
+	r.fail(selector, "%q is not one of %s", v, strings.Join(values, ", "))
+	return
+}
+
+// Err returns the aggregated ReaderErrors for all the values that failed to be read or nil if
+// there were none.
+func (r *Reader) Err() error {
+	if len(r.errs) == 0 {
+		return nil
+	}
+	return r.errs
+}
+
+// Float reads the value at the given selector as a float64 returning the given default or 0 if
+// missing or invalid. Supports Range.
+func (r *Reader) Float(selector string, def ...float64) (val float64) {
+	if len(def) > 0 {
+		val = def[0]
+	}
+	x, mods, ok := r.read(selector)
+	if !ok {
+		return
+	}
+	v, err := ToFloat64E(x)
+	if err != nil {
+		r.fail(selector, "expected float but got %s", readerDesc(x))
+		return
+	}
+	if !r.inRange(selector, mods, v, v, ToFloat64E) {
+		return
+	}
+	return v
+}
+
+// Int reads the value at the given selector as an int returning the given default or 0 if
+// missing or invalid. Floats with a fractional part are invalid rather than truncated. Supports
+// Range.
+func (r *Reader) Int(selector string, def ...int) (val int) {
+	if len(def) > 0 {
+		val = def[0]
+	}
+	x, mods, ok := r.read(selector)
+	if !ok {
+		return
+	}
+	v, err := readerInt(x)
+	if err != nil {
+		r.fail(selector, "expected int but got %s", readerDesc(x))
+		return
+	}
+	if !r.inRange(selector, mods, float64(v), int(v), func(bound interface{}) (float64, error) {
+		b, e := readerInt(bound)
+		return float64(b), e
+	}) {
+		return
+	}
+	return int(v)
+}
+
+// Match requires the next value read by String or Strs to match the given regular expression.
+func (r *Reader) Match(pattern string) *Reader {
+	r.next.pattern = pattern
+	return r
+}
+
+// Range requires the next value read by Duration, Float, Int or Size to be within the given
+// inclusive bounds, which are converted to the type being read. A nil bound is unbounded.
+func (r *Reader) Range(min, max interface{}) *Reader {
+	r.next.min, r.next.max = min, max
+	return r
+}
+
+// Required requires the next value read to exist. Missing values are otherwise not an error.
+func (r *Reader) Required() *Reader {
+	r.next.required = true
+	return r
+}
+
+// Size reads the value at the given selector as a number of bytes, see unit.ParseSize, returning
+// the given default or 0 if missing or invalid. Supports Range with size bounds e.g. "1MiB".
+func (r *Reader) Size(selector string, def ...int64) (val int64) {
+	if len(def) > 0 {
+		val = def[0]
+	}
+	x, mods, ok := r.read(selector)
+	if !ok {
+		return
+	}
+	v, err := readerSize(x)
+	if err != nil {
+		r.fail(selector, "expected size but got %s", readerDesc(x))
+		return
+	}
+	if !r.inRange(selector, mods, float64(v), unit.HumanBase2(v), func(bound interface{}) (float64, error) {
+		b, e := readerSize(bound)
+		return float64(b), e
+	}) {
+		return
+	}
+	return v
+}
+
+// String reads the value at the given selector as a string returning the given default or "" if
+// missing or invalid. Supports Match.
+func (r *Reader) String(selector string, def ...string) (val string) {
+	if len(def) > 0 {
+		val = def[0]
+	}
+	if v, ok := r.str(selector); ok {
+		val = v
+	}
+	return
+}
+
+// Strs reads the value at the given selector as a []string returning the given default or nil if
+// missing or invalid. Supports Match for each element.
+func (r *Reader) Strs(selector string, def ...string) (val []string) {
+	if len(def) > 0 {
+		val = def
+	}
+	x, mods, ok := r.read(selector)
+	if !ok {
+		return
+	}
+	v, err := ToStrsE(x)
+	if err != nil {
+		r.fail(selector, "expected strings but got %s", readerDesc(x))
+		return
+	}
+	for i := range v {
+		if !r.matches(fmt.Sprintf("%s.[%d]", selector, i), mods, v[i]) {
+			return
+		}
+	}
+	return v
+}
+
+// fail records an error for the value at the given selector
+func (r *Reader) fail(selector, format string, args ...interface{}) {
+	r.errs = append(r.errs, &ReaderError{Selector: selector, Msg: fmt.Sprintf(format, args...)})
+}
+
+// inRange tests if the given value is within the bounds of the given modifiers converted with the
+// given conversion recording an error if not. The display is the value as shown in errors.
+func (r *Reader) inRange(selector string, mods readerMods, val float64, display interface{},
+	conv func(bound interface{}) (float64, error)) bool {
+	bounds := [2]float64{}
+	for i, bound := range []interface{}{mods.min, mods.max} {
+		if bound == nil {
+			continue
+		}
+		var err error
+		if bounds[i], err = conv(bound); err != nil {
+			r.fail(selector, "invalid range bound %s", readerDesc(bound))
+			return false
+		}
+	}
+	switch {
+	case mods.min != nil && mods.max != nil && (val < bounds[0] || val > bounds[1]):
+		r.fail(selector, "%v is not between %v and %v", display, mods.min, mods.max)
+	case mods.min != nil && val < bounds[0]:
+		r.fail(selector, "%v is less than %v", display, mods.min)
+	case mods.max != nil && val > bounds[1]:
+		r.fail(selector, "%v is greater than %v", display, mods.max)
+	default:
+		return true
+	}
+	return false
+}
+
+// matches tests if the given value matches the pattern of the given modifiers recording an error
+// if not.
+func (r *Reader) matches(selector string, mods readerMods, val string) bool {
+	if mods.pattern == "" {
+		return true
+	}
+	exp, err := regexp.Compile(mods.pattern)
+	if err != nil {
+		r.fail(selector, "invalid pattern %q", mods.pattern)
+		return false
+	}
+	if !exp.MatchString(val) {
+		r.fail(selector, "%q does not match %s", val, mods.pattern)
+		return false
+	}
+	return true
+}
+
+// read returns the value at the given selector and the modifiers for it resetting them for the
+// next read. Returns false if the value is missing, recording an error if it was required, or the
+// selector is invalid.
+func (r *Reader) read(selector string) (val interface{}, mods readerMods, ok bool) {
+	mods, r.next = r.next, readerMods{}
+	if r.m.Len() != 0 {
+		obj, err := r.m.QueryE(selector)
+		if err != nil {
+			r.fail(selector, err.Error())
+			return
+		}
+		val = obj.O()
+	}
+	if val == nil {
+		if mods.required {
+			r.fail(selector, "missing required value")
+		}
+		return
+	}
+	return val, mods, true
+}
+
+// str reads the value at the given selector as a string returning false if missing or invalid
+func (r *Reader) str(selector string) (val string, ok bool) {
+	x, mods, ok := r.read(selector)
+	if !ok {
+		return
+	}
+	switch x.(type) {
+	case Str, *Str, []byte:
+	default:
+		switch reflect.ValueOf(x).Kind() {
+		case reflect.Map, reflect.Slice, reflect.Array:
+			r.fail(selector, "expected string but got %s", readerDesc(x))
+			return "", false
+		}
+	}
+	val = ToString(x)
+	return val, r.matches(selector, mods, val)
+}
+
+// readerDesc describes the given value for errors e.g. string "foo"
+func readerDesc(obj interface{}) string {
+	if x, ok := obj.(string); ok {
+		return fmt.Sprintf("string %q", x)
+	}
+	return fmt.Sprintf("%T %v", obj, obj)
+}
+
+// readerInt converts the given value into an integer rejecting floats with a fractional part
+// rather than truncating them e.g. 8080.9
+func readerInt(obj interface{}) (val int64, err error) {
+	switch x := DeReference(obj).(type) {
+	case float32:
+		return readerInt(float64(x))
+	case float64:
+		if x != math.Trunc(x) || x < math.MinInt64 || x >= math.MaxInt64 {
+			err = errors.Errorf("%v is not an integer", x)
+			return
+		}
+	}
+	return ToInt64E(obj)
+}
+
+// readerSize converts the given value into a number of bytes
+func readerSize(obj interface{}) (val int64, err error) {
+	if x, ok := obj.(string); ok {
+		return unit.ParseSize(x)
+	}
+	return readerInt(obj)
+}
@@ -0,0 +1,376 @@
+package n
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/phR0ze/n/pkg/enc/unit"
+	"github.com/stretchr/testify/assert"
+)
+
+// readerConfig returns a new Reader over a test configuration
+func readerConfig() *Reader {
+	return NewReader(`port: 80
+host: example.com
+debug: true
+ratio: 0.5
+timeout: 30s
+cache: 10MiB
+level: info
+tags: [web, db]
+db:
+  name: app
+  replicas:
+    - host: db1
+    - host: db2
+`)
+}
+
+// NewReader
+// --------------------------------------------------------------------------------------------------
+func ExampleNewReader() {
+	r := NewReader("port: 70000\n")
+	port := r.Range(1, 65535).Int(".port", 8080)
+	host := r.Required().String(".host")
+	fmt.Printf("%d %q\n%v", port, host, r.Err())
+	// Output: 8080 ""
+	// 2 invalid values
+	//   .port: 70000 is not between 1 and 65535
+	//   .host: missing required value
+}
+
+func TestNewReader(t *testing.T) {
+
+	// maps
+	{
+		assert.Equal(t, 1, NewReader(map[string]interface{}{"a": 1}).Int("a"))
+		assert.Equal(t, 1, NewReader(NewStringMapV(map[string]interface{}{"a": 1})).Int("a"))
+		assert.Equal(t, 1, NewReader(NewPersistentMapV(map[string]interface{}{"a": 1})).Int("a"))
+	}
+
+	// empty
+	{
+		r := NewReader(nil)
+		assert.Equal(t, 1, r.Int("a", 1))
+		assert.Nil(t, r.Err())
+	}
+
+	// invalid
+	{
+		r := NewReader(1)
+		assert.Equal(t, 2, r.Int("a", 2))
+		assert.Equal(t, "1 invalid value\n  .: expected map but got int 1", r.Err().Error())
+	}
+}
+
+// Bool
+// --------------------------------------------------------------------------------------------------
+func ExampleReader_Bool() {
+	r := NewReader("debug: true\n")
+	fmt.Println(r.Bool(".debug"), r.Bool(".verbose", true))
+	// Output: true true
+}
+
+func TestReader_Bool(t *testing.T) {
+	r := readerConfig()
+	assert.Equal(t, true, r.Bool(".debug"))
+	assert.Equal(t, false, r.Bool(".missing"))
+	assert.Equal(t, true, r.Bool(".missing", true))
+	assert.Equal(t, true, r.Bool(".host", true))
+	assert.Equal(t, `.host: expected bool but got string "example.com"`, r.errs[0].Error())
+}
+
+// Duration
+// --------------------------------------------------------------------------------------------------
+func ExampleReader_Duration() {
+	r := NewReader("timeout: 30s\n")
+	fmt.Println(r.Range("1s", "1m").Duration(".timeout", time.Second))
+	// Output: 30s
+}
+
+func TestReader_Duration(t *testing.T) {
+
+	// valid
+	{
+		r := readerConfig()
+		assert.Equal(t, 30*time.Second, r.Duration(".timeout"))
+		assert.Equal(t, time.Minute, r.Duration(".missing", time.Minute))
+		assert.Equal(t, 30*time.Second, r.Range(time.Second, nil).Duration(".timeout"))
+		assert.Nil(t, r.Err())
+	}
+
+	// invalid
+	{
+		r := readerConfig()
+		assert.Equal(t, time.Minute, r.Duration(".host", time.Minute))
+		assert.Equal(t, time.Duration(0), r.Range("1m", "1h").Duration(".timeout"))
+		assert.Equal(t, time.Duration(0), r.Range(nil, "10s").Duration(".timeout"))
+		assert.Equal(t, time.Duration(0), r.Range("foo", nil).Duration(".timeout"))
+		assert.Equal(t, ReaderErrors{
+			{Selector: ".host", Msg: `expected duration but got string "example.com"`},
+			{Selector: ".timeout", Msg: "30s is not between 1m and 1h"},
+			{Selector: ".timeout", Msg: "30s is greater than 10s"},
+			{Selector: ".timeout", Msg: `invalid range bound string "foo"`},
+		}, r.Err())
+	}
+}
+
+// Enum
+// --------------------------------------------------------------------------------------------------
+func ExampleReader_Enum() {
+	r := NewReader("level: info\n")
+	fmt.Println(r.Enum(".level", []string{"debug", "info", "warn"}))
+	// Output: info
+}
+
+func TestReader_Enum(t *testing.T) {
+	levels := []string{"debug", "info", "warn"}
+	r := readerConfig()
+	assert.Equal(t, "info", r.Enum(".level", levels))
+	assert.Equal(t, "warn", r.Enum(".missing", levels, "warn"))
+	assert.Equal(t, "warn", r.Enum(".host", levels, "warn"))
+	assert.Equal(t, "", r.Enum(".tags", levels))
+	assert.Equal(t, ReaderErrors{
+		{Selector: ".host", Msg: `"example.com" is not one of debug, info, warn`},
+		{Selector: ".tags", Msg: "expected string but got []interface {} [web db]"},
+	}, r.Err())
+}
+
+// Err
+// --------------------------------------------------------------------------------------------------
+func ExampleReader_Err() {
+	r := NewReader("port: http\n")
+	r.Int(".port")
+	r.Required().String(".host")
+	fmt.Println(r.Err())
+	// Output: 2 invalid values
+	//   .port: expected int but got string "http"
+	//   .host: missing required value
+}
+
+func TestReader_Err(t *testing.T) {
+
+	// none
+	{
+		r := readerConfig()
+		r.Int(".port")
+		assert.Nil(t, r.Err())
+	}
+
+	// aggregated in order
+	{
+		r := readerConfig()
+		r.Required().Int(".missing")
+		r.Int(".host")
+		r.Int(".db.replicas.[foo]")
+		err := r.Err()
+		assert.Len(t, err, 3)
+		assert.Equal(t, ".missing", err.(ReaderErrors)[0].Selector)
+		assert.Equal(t, ".host", err.(ReaderErrors)[1].Selector)
+		assert.Equal(t, "invalid array index selector [foo]", err.(ReaderErrors)[2].Msg)
+	}
+}
+
+// Float
+// --------------------------------------------------------------------------------------------------
+func ExampleReader_Float() {
+	r := NewReader("ratio: 0.5\n")
+	fmt.Println(r.Range(0, 1).Float(".ratio"))
+	// Output: 0.5
+}
+
+func TestReader_Float(t *testing.T) {
+	r := readerConfig()
+	assert.Equal(t, 0.5, r.Float(".ratio"))
+	assert.Equal(t, 80.0, r.Float(".port"))
+	assert.Equal(t, 1.5, r.Float(".missing", 1.5))
+	assert.Equal(t, 1.5, r.Range(1, nil).Float(".ratio", 1.5))
+	assert.Equal(t, 1.5, r.Float(".host", 1.5))
+	assert.Equal(t, ReaderErrors{
+		{Selector: ".ratio", Msg: "0.5 is less than 1"},
+		{Selector: ".host", Msg: `expected float but got string "example.com"`},
+	}, r.Err())
+}
+
+// Int
+// --------------------------------------------------------------------------------------------------
+func ExampleReader_Int() {
+	r := NewReader("port: 80\n")
+	fmt.Println(r.Int(".port", 8080), r.Int(".admin", 8081))
+	// Output: 80 8081
+}
+
+func TestReader_Int(t *testing.T) {
+
+	// valid
+	{
+		r := readerConfig()
+		assert.Equal(t, 80, r.Int(".port"))
+		assert.Equal(t, 80, r.Int("port"))
+		assert.Equal(t, 0, r.Int(".missing"))
+		assert.Equal(t, 8080, r.Int(".missing", 8080))
+		assert.Equal(t, 80, r.Range(1, 65535).Int(".port"))
+		assert.Equal(t, 80, r.Range(80, 80).Int(".port"))
+		assert.Nil(t, r.Err())
+	}
+
+	// invalid
+	{
+		r := readerConfig()
+		assert.Equal(t, 8080, r.Int(".host", 8080))
+		assert.Equal(t, 8080, r.Range(1024, 65535).Int(".port", 8080))
+		assert.Equal(t, 8080, r.Range(nil, 79).Int(".port", 8080))
+		assert.Equal(t, ReaderErrors{
+			{Selector: ".host", Msg: `expected int but got string "example.com"`},
+			{Selector: ".port", Msg: "80 is not between 1024 and 65535"},
+			{Selector: ".port", Msg: "80 is greater than 79"},
+		}, r.Err())
+	}
+
+	// floats and numeric strings with a fractional part aren't truncated
+	{
+		r := NewReader("port: 8080.9\nadmin: \"8081.5\"\nworkers: 4.0\n")
+		assert.Equal(t, 80, r.Int(".port", 80))
+		assert.Equal(t, 81, r.Int(".admin", 81))
+		assert.Equal(t, 4, r.Int(".workers"))
+		assert.Equal(t, ReaderErrors{
+			{Selector: ".port", Msg: "expected int but got float64 8080.9"},
+			{Selector: ".admin", Msg: `expected int but got string "8081.5"`},
+		}, r.Err())
+	}
+
+	// modifiers only apply to the next read
+	{
+		r := readerConfig()
+		r.Range(1024, nil).Required().Int(".port")
+		assert.Equal(t, 80, r.Int(".port"))
+		assert.Equal(t, 0, r.Int(".missing"))
+		assert.Len(t, r.Err(), 1)
+	}
+}
+
+// Match
+// --------------------------------------------------------------------------------------------------
+func ExampleReader_Match() {
+	r := NewReader("host: example.com\n")
+	fmt.Println(r.Match(`^[a-z.]+$`).String(".host"))
+	// Output: example.com
+}
+
+func TestReader_Match(t *testing.T) {
+	r := readerConfig()
+	assert.Equal(t, "example.com", r.Match(`\.com$`).String(".host"))
+	assert.Equal(t, "localhost", r.Match(`\.org$`).String(".host", "localhost"))
+	assert.Equal(t, "", r.Match(`(`).String(".host"))
+	assert.Equal(t, ReaderErrors{
+		{Selector: ".host", Msg: `"example.com" does not match \.org$`},
+		{Selector: ".host", Msg: `invalid pattern "("`},
+	}, r.Err())
+}
+
+// Required
+// --------------------------------------------------------------------------------------------------
+func ExampleReader_Required() {
+	r := NewReader("port: 80\n")
+	r.Required().String(".host")
+	fmt.Println(r.Err())
+	// Output: 1 invalid value
+	//   .host: missing required value
+}
+
+func TestReader_Required(t *testing.T) {
+	r := readerConfig()
+	assert.Equal(t, "app", r.Required().String(".db.name"))
+	assert.Equal(t, "db2", r.Required().String(".db.replicas.[-1].host"))
+	assert.Nil(t, r.Err())
+
+	assert.Equal(t, "bar", r.Required().String(".db.foo", "bar"))
+	assert.Equal(t, false, r.Required().Bool(".verbose"))
+	assert.Equal(t, ReaderErrors{
+		{Selector: ".db.foo", Msg: "missing required value"},
+		{Selector: ".verbose", Msg: "missing required value"},
+	}, r.Err())
+
+	// empty maps
+	{
+		r := NewReader(nil)
+		r.Required().Int(".port")
+		assert.Equal(t, "1 invalid value\n  .port: missing required value", r.Err().Error())
+	}
+}
+
+// Size
+// --------------------------------------------------------------------------------------------------
+func ExampleReader_Size() {
+	r := NewReader("cache: 10MiB\n")
+	fmt.Println(r.Range("1MiB", "1GiB").Size(".cache"))
+	// Output: 10485760
+}
+
+func TestReader_Size(t *testing.T) {
+	r := readerConfig()
+	assert.Equal(t, int64(10*unit.MiB), r.Size(".cache"))
+	assert.Equal(t, int64(80), r.Size(".port"))
+	assert.Equal(t, int64(unit.KiB), r.Size(".missing", unit.KiB))
+	assert.Equal(t, int64(unit.KiB), r.Range(nil, "1MB").Size(".cache", unit.KiB))
+	assert.Equal(t, int64(unit.KiB), r.Size(".host", unit.KiB))
+	assert.Equal(t, ReaderErrors{
+		{Selector: ".cache", Msg: "10 MiB is greater than 1MB"},
+		{Selector: ".host", Msg: `expected size but got string "example.com"`},
+	}, r.Err())
+
+	// floats with a fractional part aren't truncated
+	{
+		r := NewReader("cache: 1024.5\nbuffer: 4096.0\n")
+		assert.Equal(t, int64(unit.KiB), r.Size(".cache", unit.KiB))
+		assert.Equal(t, int64(4096), r.Size(".buffer"))
+		assert.Equal(t, ReaderErrors{
+			{Selector: ".cache", Msg: "expected size but got float64 1024.5"},
+		}, r.Err())
+	}
+}
+
+// String
+// --------------------------------------------------------------------------------------------------
+func ExampleReader_String() {
+	r := NewReader("host: example.com\n")
+	fmt.Println(r.String(".host", "localhost"))
+	// Output: example.com
+}
+
+func TestReader_String(t *testing.T) {
+	r := readerConfig()
+	assert.Equal(t, "example.com", r.String(".host"))
+	assert.Equal(t, "80", r.String(".port"))
+	assert.Equal(t, "localhost", r.String(".missing", "localhost"))
+	assert.Equal(t, "", r.String(".db"))
+	assert.Equal(t, "", r.String(".db.replicas"))
+	assert.Equal(t, ReaderErrors{
+		{Selector: ".db", Msg: "expected string but got yaml.MapSlice [{name app} {replicas [[{host db1}] [{host db2}]]}]"},
+		{Selector: ".db.replicas", Msg: "expected string but got []interface {} [[{host db1}] [{host db2}]]"},
+	}, r.Err())
+}
+
+// Strs
+// --------------------------------------------------------------------------------------------------
+func ExampleReader_Strs() {
+	r := NewReader("tags: [web, db]\n")
+	fmt.Println(r.Strs(".tags"))
+	// Output: [web db]
+}
+
+func TestReader_Strs(t *testing.T) {
+	r := readerConfig()
+	assert.Equal(t, []string{"web", "db"}, r.Strs(".tags"))
+	assert.Equal(t, []string{"example.com"}, r.Strs(".host"))
+	assert.Equal(t, []string{"a", "b"}, r.Strs(".missing", "a", "b"))
+	assert.Equal(t, []string(nil), r.Strs(".missing"))
+	assert.Equal(t, []string{"web", "db"}, r.Match(`^[a-z]+$`).Strs(".tags"))
+	assert.Nil(t, r.Err())
+
+	assert.Equal(t, []string{"a"}, r.Match(`^w`).Strs(".tags", "a"))
+	assert.Equal(t, ReaderErrors{
+		{Selector: ".tags.[1]", Msg: `"db" does not match ^w`},
+	}, r.Err())
+}
@@ -1,6 +1,10 @@
 package n
 
 import (
+	"math/rand"
+	"reflect"
+
+	"github.com/phR0ze/n/pkg/opt"
 	yaml "github.com/phR0ze/yaml/v2"
 	"github.com/pkg/errors"
 )
@@ -10,89 +14,162 @@ import (
 // instance being operated on.  'new Slice' refers to a copy of the slice based on a new
 // underlying Array.
 type ISlice interface {
-	A() string                                         // A is an alias to String for brevity
-	All(elems ...interface{}) bool                     // All tests if this Slice is not empty or optionally if it contains all of the given variadic elements.
-	AllS(slice interface{}) bool                       // AnyS tests if this Slice contains all of the given Slice's elements.
-	Any(elems ...interface{}) bool                     // Any tests if this Slice is not empty or optionally if it contains any of the given variadic elements.
-	AnyS(slice interface{}) bool                       // AnyS tests if this Slice contains any of the given Slice's elements.
-	AnyW(sel func(O) bool) bool                        // AnyW tests if this Slice contains any that match the lambda selector.
-	Append(elem interface{}) ISlice                    // Append an element to the end of this Slice and returns a reference to this Slice.
-	AppendV(elems ...interface{}) ISlice               // AppendV appends the variadic elements to the end of this Slice and returns a reference to this Slice.
-	At(i int) (elem *Object)                           // At returns the element at the given index location. Allows for negative notation.
-	Clear() ISlice                                     // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
-	Concat(slice interface{}) (new ISlice)             // Concat returns a new Slice by appending the given Slice to this Slice using variadic expansion.
-	ConcatM(slice interface{}) ISlice                  // ConcatM modifies this Slice by appending the given Slice using variadic expansion and returns a reference to this Slice.
-	Copy(indices ...int) (new ISlice)                  // Copy returns a new Slice with the indicated range of elements copied from this Slice.
-	Count(elem interface{}) (cnt int)                  // Count the number of elements in this Slice equal to the given element.
-	CountW(sel func(O) bool) (cnt int)                 // CountW counts the number of elements in this Slice that match the lambda selector.
-	Drop(indices ...int) ISlice                        // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
-	DropAt(i int) ISlice                               // DropAt modifies this Slice to delete the element at the given index location. Allows for negative notation.
-	DropFirst() ISlice                                 // DropFirst modifies this Slice to delete the first element and returns a reference to this Slice.
-	DropFirstN(n int) ISlice                           // DropFirstN modifies this Slice to delete the first n elements and returns a reference to this Slice.
-	DropLast() ISlice                                  // DropLast modifies this Slice to delete the last element and returns a reference to this Slice.
-	DropLastN(n int) ISlice                            // DropLastN modifies thi Slice to delete the last n elements and returns a reference to this Slice.
-	DropW(sel func(O) bool) ISlice                     // DropW modifies this Slice to delete the elements that match the lambda selector and returns a reference to this Slice.
-	Each(action func(O)) ISlice                        // Each calls the given lambda once for each element in this Slice, passing in that element
-	EachE(action func(O) error) (ISlice, error)        // EachE calls the given lambda once for each element in this Slice, passing in that element
-	EachI(action func(int, O)) ISlice                  // EachI calls the given lambda once for each element in this Slice, passing in the index and element
-	EachIE(action func(int, O) error) (ISlice, error)  // EachIE calls the given lambda once for each element in this Slice, passing in the index and element
-	EachR(action func(O)) ISlice                       // EachR calls the given lambda once for each element in this Slice in reverse, passing in that element
-	EachRE(action func(O) error) (ISlice, error)       // EachRE calls the given lambda once for each element in this Slice in reverse, passing in that element
-	EachRI(action func(int, O)) ISlice                 // EachRI calls the given lambda once for each element in this Slice in reverse, passing in that element
-	EachRIE(action func(int, O) error) (ISlice, error) // EachRIE calls the given lambda once for each element in this Slice in reverse, passing in that element
-	Empty() bool                                       // Empty tests if this Slice is empty.
-	First() (elem *Object)                             // First returns the first element in this Slice as Object.
-	FirstN(n int) ISlice                               // FirstN returns the first n elements in this slice as a Slice reference to the original.
-	InterSlice() bool                                  // Generic returns true if the underlying implementation uses reflection
-	Index(elem interface{}) (loc int)                  // Index returns the index of the first element in this Slice where element == elem
-	Insert(i int, elem interface{}) ISlice             // Insert modifies this Slice to insert the given element(s) before the element with the given index.
-	Join(separator ...string) (str *Object)            // Join converts each element into a string then joins them together using the given separator or comma by default.
-	Last() (elem *Object)                              // Last returns the last element in this Slice as an Object.
-	LastN(n int) ISlice                                // LastN returns the last n elements in this Slice as a Slice reference to the original.
-	Len() int                                          // Len returns the number of elements in this Slice.
-	Less(i, j int) bool                                // Less returns true if the element indexed by i is less than the element indexed by j.
-	Nil() bool                                         // Nil tests if this Slice is nil.
-	Map(mod func(O) O) ISlice                          // Map creates a new slice with the modified elements from the lambda.
-	O() interface{}                                    // O returns the underlying data structure as is.
-	Pair() (first, second *Object)                     // Pair simply returns the first and second Slice elements as Objects.
-	Pop() (elem *Object)                               // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
-	PopN(n int) (new ISlice)                           // PopN modifies this Slice to remove the last n elements and returns the removed elements as a new Slice.
-	Prepend(elem interface{}) ISlice                   // Prepend modifies this Slice to add the given element at the begining and returns a reference to this Slice.
-	RefSlice() bool                                    // RefSlice returns true if the underlying implementation is a RefSlice
-	Reverse() (new ISlice)                             // Reverse returns a new Slice with the order of the elements reversed.
-	ReverseM() ISlice                                  // ReverseM modifies this Slice reversing the order of the elements and returns a reference to this Slice.
-	S() (slice *StringSlice)                           // S is an alias to ToStringSlice
-	Select(sel func(O) bool) (new ISlice)              // Select creates a new slice with the elements that match the lambda selector.
-	Set(i int, elems interface{}) ISlice               // Set the element(s) at the given index location to the given element(s). Allows for negative notation.
-	SetE(i int, elems interface{}) (ISlice, error)     // SetE the element(s) at the given index location to the given element(s). Allows for negative notation.
-	Shift() (elem *Object)                             // Shift modifies this Slice to remove the first element and returns the removed element as an Object.
-	ShiftN(n int) (new ISlice)                         // ShiftN modifies this Slice to remove the first n elements and returns the removed elements as a new Slice.
-	Single() bool                                      // Single reports true if there is only one element in this Slice.
-	Slice(indices ...int) ISlice                       // Slice returns a range of elements from this Slice as a Slice reference to the original. Allows for negative notation.
-	Sort() (new ISlice)                                // Sort returns a new Slice with sorted elements.
-	SortM() ISlice                                     // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
-	SortReverse() (new ISlice)                         // SortReverse returns a new Slice sorting the elements in reverse.
-	SortReverseM() ISlice                              // SortReverseM modifies this Slice sorting the elements in reverse and returns a reference to this Slice.
-	String() string                                    // String returns a string representation of this Slice, implements the Stringer interface
-	Swap(i, j int)                                     // Swap modifies this Slice swapping the indicated elements.
-	Take(indices ...int) (new ISlice)                  // Take modifies this Slice removing the indicated range of elements from this Slice and returning them as a new Slice.
-	TakeAt(i int) (elem *Object)                       // TakeAt modifies this Slice removing the elemement at the given index location and returns the removed element as an Object.
-	TakeW(sel func(O) bool) (new ISlice)               // TakeW modifies this Slice removing the elements that match the lambda selector and returns them as a new Slice.
-	ToInts() (slice []int)                             // ToInts converts the given slice into a native []int type
-	ToIntSlice() (slice *IntSlice)                     // ToIntSlice converts the given slice into a *IntSlice
-	ToInterSlice() (slice []interface{})               // ToInterSlice converts the given slice to a generic []interface{} slice
-	ToStrs() (slice []string)                          // ToStrs converts the underlying slice into a []string slice
-	ToStringSlice() (slice *StringSlice)               // ToStringSlice converts the underlying slice into a *StringSlice
-	Union(slice interface{}) (new ISlice)              // Union returns a new Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
-	UnionM(slice interface{}) ISlice                   // UnionM modifies this Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
-	Uniq() (new ISlice)                                // Uniq returns a new Slice with all non uniq elements removed while preserving element order.
-	UniqM() ISlice                                     // UniqM modifies this Slice to remove all non uniq elements while preserving element order.
+	A() string                                                                   // A is an alias to String for brevity
+	All(elems ...interface{}) bool                                               // All tests if this Slice is not empty or optionally if it contains all of the given variadic elements.
+	AllS(slice interface{}) bool                                                 // AnyS tests if this Slice contains all of the given Slice's elements.
+	Any(elems ...interface{}) bool                                               // Any tests if this Slice is not empty or optionally if it contains any of the given variadic elements.
+	AnyS(slice interface{}) bool                                                 // AnyS tests if this Slice contains any of the given Slice's elements.
+	AnyW(sel func(O) bool) bool                                                  // AnyW tests if this Slice contains any that match the lambda selector.
+	Append(elem interface{}) ISlice                                              // Append an element to the end of this Slice and returns a reference to this Slice.
+	AppendV(elems ...interface{}) ISlice                                         // AppendV appends the variadic elements to the end of this Slice and returns a reference to this Slice.
+	At(i int) (elem *Object)                                                     // At returns the element at the given index location. Allows for negative notation.
+	BinarySearch(elem interface{}) (i int, found bool)                           // BinarySearch searches this sorted Slice for the given element returning its index or insertion index and whether it was found.
+	BinarySearchWith(elem interface{}, cmp func(a, b O) int) (i int, found bool) // BinarySearchWith searches this Slice sorted by the given comparison for the given element.
+	Cartesian(slices ...interface{}) (tuples []ISlice)                           // Cartesian returns the cartesian product of this Slice and the given slices as new tuple Slices.
+	Chunk(n int) (chunks []ISlice)                                               // Chunk returns this Slice split into new Slices of n consecutive elements.
+	Clear() ISlice                                                               // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
+	Combinations(n int) (combos []ISlice)                                        // Combinations returns new Slices of all combinations of n elements of this Slice.
+	Concat(slice interface{}) (new ISlice)                                       // Concat returns a new Slice by appending the given Slice to this Slice using variadic expansion.
+	ConcatM(slice interface{}) ISlice                                            // ConcatM modifies this Slice by appending the given Slice using variadic expansion and returns a reference to this Slice.
+	Copy(indices ...int) (new ISlice)                                            // Copy returns a new Slice with the indicated range of elements copied from this Slice.
+	Count(elem interface{}) (cnt int)                                            // Count the number of elements in this Slice equal to the given element.
+	CountW(sel func(O) bool) (cnt int)                                           // CountW counts the number of elements in this Slice that match the lambda selector.
+	DeepCopy() (new ISlice)                                                      // DeepCopy returns a new Slice with all elements recursively copied from this Slice.
+	DeepEqual(obj interface{}, opts ...*opt.Opt) bool                            // DeepEqual tests if this Slice is structurally equal to the given obj.
+	Drop(indices ...int) ISlice                                                  // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
+	DropAt(i int) ISlice                                                         // DropAt modifies this Slice to delete the element at the given index location. Allows for negative notation.
+	DropFirst() ISlice                                                           // DropFirst modifies this Slice to delete the first element and returns a reference to this Slice.
+	DropFirstN(n int) ISlice                                                     // DropFirstN modifies this Slice to delete the first n elements and returns a reference to this Slice.
+	DropLast() ISlice                                                            // DropLast modifies this Slice to delete the last element and returns a reference to this Slice.
+	DropLastN(n int) ISlice                                                      // DropLastN modifies thi Slice to delete the last n elements and returns a reference to this Slice.
+	DropW(sel func(O) bool) ISlice                                               // DropW modifies this Slice to delete the elements that match the lambda selector and returns a reference to this Slice.
+	Each(action func(O)) ISlice                                                  // Each calls the given lambda once for each element in this Slice, passing in that element
+	EachE(action func(O) error) (ISlice, error)                                  // EachE calls the given lambda once for each element in this Slice, passing in that element
+	EachI(action func(int, O)) ISlice                                            // EachI calls the given lambda once for each element in this Slice, passing in the index and element
+	EachIE(action func(int, O) error) (ISlice, error)                            // EachIE calls the given lambda once for each element in this Slice, passing in the index and element
+	EachR(action func(O)) ISlice                                                 // EachR calls the given lambda once for each element in this Slice in reverse, passing in that element
+	EachRE(action func(O) error) (ISlice, error)                                 // EachRE calls the given lambda once for each element in this Slice in reverse, passing in that element
+	EachRI(action func(int, O)) ISlice                                           // EachRI calls the given lambda once for each element in this Slice in reverse, passing in that element
+	EachRIE(action func(int, O) error) (ISlice, error)                           // EachRIE calls the given lambda once for each element in this Slice in reverse, passing in that element
+	EachSlice(n int, action func(ISlice)) ISlice                                 // EachSlice calls the given lambda once for each new Slice of n consecutive elements of this Slice.
+	Empty() bool                                                                 // Empty tests if this Slice is empty.
+	First() (elem *Object)                                                       // First returns the first element in this Slice as Object.
+	FirstN(n int) ISlice                                                         // FirstN returns the first n elements in this slice as a Slice reference to the original.
+	Flatten() (new ISlice)                                                       // Flatten returns a new Slice with the elements of any nested slices expanded in place recursively.
+	Hash() uint64                                                                // Hash returns a stable structural hash of this Slice.
+	InterSlice() bool                                                            // Generic returns true if the underlying implementation uses reflection
+	Index(elem interface{}) (loc int)                                            // Index returns the index of the first element in this Slice where element == elem
+	Inject(reducer func(acc, elem O) O, init ...interface{}) (acc *Object)       // Inject is an alias to Reduce
+	Insert(i int, elem interface{}) ISlice                                       // Insert modifies this Slice to insert the given element(s) before the element with the given index.
+	IsSorted() bool                                                              // IsSorted tests if the elements of this Slice are sorted in ascending order.
+	IsSortedWith(cmp func(a, b O) int) bool                                      // IsSortedWith tests if the elements of this Slice are sorted according to the given comparison.
+	Join(separator ...string) (str *Object)                                      // Join converts each element into a string then joins them together using the given separator or comma by default.
+	Last() (elem *Object)                                                        // Last returns the last element in this Slice as an Object.
+	LastN(n int) ISlice                                                          // LastN returns the last n elements in this Slice as a Slice reference to the original.
+	Len() int                                                                    // Len returns the number of elements in this Slice.
+	Less(i, j int) bool                                                          // Less returns true if the element indexed by i is less than the element indexed by j.
+	Nil() bool                                                                   // Nil tests if this Slice is nil.
+	Map(mod func(O) O) ISlice                                                    // Map creates a new slice with the modified elements from the lambda.
+	O() interface{}                                                              // O returns the underlying data structure as is.
+	Pair() (first, second *Object)                                               // Pair simply returns the first and second Slice elements as Objects.
+	Partition(sel func(O) bool) (match, rest ISlice)                             // Partition returns the elements of this Slice that match the lambda selector and those that don't as new Slices.
+	Permutations(n int) (perms []ISlice)                                         // Permutations returns new Slices of all ordered arrangements of n elements of this Slice.
+	Pop() (elem *Object)                                                         // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
+	PopN(n int) (new ISlice)                                                     // PopN modifies this Slice to remove the last n elements and returns the removed elements as a new Slice.
+	Prepend(elem interface{}) ISlice                                             // Prepend modifies this Slice to add the given element at the begining and returns a reference to this Slice.
+	Reduce(reducer func(acc, elem O) O, init ...interface{}) (acc *Object)       // Reduce combines the elements of this Slice into a single value using the given lambda.
+	RefSlice() bool                                                              // RefSlice returns true if the underlying implementation is a RefSlice
+	Reverse() (new ISlice)                                                       // Reverse returns a new Slice with the order of the elements reversed.
+	ReverseM() ISlice                                                            // ReverseM modifies this Slice reversing the order of the elements and returns a reference to this Slice.
+	Rotate(n int) (new ISlice)                                                   // Rotate returns a new Slice with the elements rotated n places to the left or right for negative n.
+	RotateM(n int) ISlice                                                        // RotateM modifies this Slice rotating the elements n places to the left or right for negative n.
+	S() (slice *StringSlice)                                                     // S is an alias to ToStringSlice
+	Sample(n int, rng ...*rand.Rand) (new ISlice)                                // Sample returns a new Slice of n unique elements chosen at random from this Slice.
+	Scan(reducer func(acc, elem O) O, init ...interface{}) (new ISlice)          // Scan returns a new Slice of the accumulated values from each step of reducing this Slice.
+	Select(sel func(O) bool) (new ISlice)                                        // Select creates a new slice with the elements that match the lambda selector.
+	Set(i int, elems interface{}) ISlice                                         // Set the element(s) at the given index location to the given element(s). Allows for negative notation.
+	SetE(i int, elems interface{}) (ISlice, error)                               // SetE the element(s) at the given index location to the given element(s). Allows for negative notation.
+	Shift() (elem *Object)                                                       // Shift modifies this Slice to remove the first element and returns the removed element as an Object.
+	ShiftN(n int) (new ISlice)                                                   // ShiftN modifies this Slice to remove the first n elements and returns the removed elements as a new Slice.
+	Shuffle(rng ...*rand.Rand) (new ISlice)                                      // Shuffle returns a new Slice with the elements in random order.
+	ShuffleM(rng ...*rand.Rand) ISlice                                           // ShuffleM modifies this Slice putting the elements in random order and returns a reference to this Slice.
+	Single() bool                                                                // Single reports true if there is only one element in this Slice.
+	Slice(indices ...int) ISlice                                                 // Slice returns a range of elements from this Slice as a Slice reference to the original. Allows for negative notation.
+	Sort() (new ISlice)                                                          // Sort returns a new Slice with sorted elements.
+	SortBy(key func(O) O) (new ISlice)                                           // SortBy returns a new Slice stably sorted in ascending order by the keys the lambda selects.
+	SortByDesc(key func(O) O) (new ISlice)                                       // SortByDesc returns a new Slice stably sorted in descending order by the keys the lambda selects.
+	SortByDescM(key func(O) O) ISlice                                            // SortByDescM modifies this Slice stably sorting in descending order by the keys the lambda selects.
+	SortByM(key func(O) O) ISlice                                                // SortByM modifies this Slice stably sorting in ascending order by the keys the lambda selects.
+	SortM() ISlice                                                               // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
+	SortReverse() (new ISlice)                                                   // SortReverse returns a new Slice sorting the elements in reverse.
+	SortReverseM() ISlice                                                        // SortReverseM modifies this Slice sorting the elements in reverse and returns a reference to this Slice.
+	SortWith(cmp func(a, b O) int) (new ISlice)                                  // SortWith returns a new Slice stably sorted according to the given comparison.
+	SortWithM(cmp func(a, b O) int) ISlice                                       // SortWithM modifies this Slice stably sorting according to the given comparison.
+	String() string                                                              // String returns a string representation of this Slice, implements the Stringer interface
+	Swap(i, j int)                                                               // Swap modifies this Slice swapping the indicated elements.
+	Take(indices ...int) (new ISlice)                                            // Take modifies this Slice removing the indicated range of elements from this Slice and returning them as a new Slice.
+	TakeAt(i int) (elem *Object)                                                 // TakeAt modifies this Slice removing the elemement at the given index location and returns the removed element as an Object.
+	TakeW(sel func(O) bool) (new ISlice)                                         // TakeW modifies this Slice removing the elements that match the lambda selector and returns them as a new Slice.
+	ToInts() (slice []int)                                                       // ToInts converts the given slice into a native []int type
+	ToIntSlice() (slice *IntSlice)                                               // ToIntSlice converts the given slice into a *IntSlice
+	ToInterSlice() (slice []interface{})                                         // ToInterSlice converts the given slice to a generic []interface{} slice
+	ToStrs() (slice []string)                                                    // ToStrs converts the underlying slice into a []string slice
+	ToStringSlice() (slice *StringSlice)                                         // ToStringSlice converts the underlying slice into a *StringSlice
+	Union(slice interface{}) (new ISlice)                                        // Union returns a new Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
+	UnionM(slice interface{}) ISlice                                             // UnionM modifies this Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
+	Uniq() (new ISlice)                                                          // Uniq returns a new Slice with all non uniq elements removed while preserving element order.
+	UniqM() ISlice                                                               // UniqM modifies this Slice to remove all non uniq elements while preserving element order.
+	Window(n, step int) (windows []ISlice)                                       // Window returns new Slices of n consecutive elements of this Slice starting every step elements.
+	Zip(slices ...interface{}) (tuples []ISlice)                                 // Zip returns new tuple Slices of the elements at the same index in this Slice and each of the given slices.
+}
+
+// ISliceView is the read only subset of ISlice implemented by every Slice type including the
+// immutable PersistentVector. Methods returning a 'new Slice' return a mutable copy.
+type ISliceView interface {
+	A() string                                                                   // A is an alias to String for brevity
+	All(elems ...interface{}) bool                                               // All tests if this Slice is not empty or optionally if it contains all of the given variadic elements.
+	AllS(slice interface{}) bool                                                 // AnyS tests if this Slice contains all of the given Slice's elements.
+	Any(elems ...interface{}) bool                                               // Any tests if this Slice is not empty or optionally if it contains any of the given variadic elements.
+	AnyS(slice interface{}) bool                                                 // AnyS tests if this Slice contains any of the given Slice's elements.
+	AnyW(sel func(O) bool) bool                                                  // AnyW tests if this Slice contains any that match the lambda selector.
+	At(i int) (elem *Object)                                                     // At returns the element at the given index location. Allows for negative notation.
+	BinarySearch(elem interface{}) (i int, found bool)                           // BinarySearch searches this sorted Slice for the given element returning its index and whether it was found.
+	BinarySearchWith(elem interface{}, cmp func(a, b O) int) (i int, found bool) // BinarySearchWith searches this Slice sorted by the given comparison for the given element.
+	Copy(indices ...int) (new ISlice)                                            // Copy returns a new Slice with the indicated range of elements copied from this Slice.
+	Count(elem interface{}) (cnt int)                                            // Count the number of elements in this Slice equal to the given element.
+	CountW(sel func(O) bool) (cnt int)                                           // CountW counts the number of elements in this Slice that match the lambda selector.
+	DeepEqual(obj interface{}, opts ...*opt.Opt) bool                            // DeepEqual tests if this Slice is structurally equal to the given obj.
+	Empty() bool                                                                 // Empty tests if this Slice is empty.
+	First() (elem *Object)                                                       // First returns the first element in this Slice as Object.
+	Hash() uint64                                                                // Hash returns a stable structural hash of this Slice.
+	Index(elem interface{}) (loc int)                                            // Index returns the index of the first element in this Slice where elem == elem
+	Inject(reducer func(acc, elem O) O, init ...interface{}) (acc *Object)       // Inject is an alias to Reduce
+	IsSorted() bool                                                              // IsSorted tests if the elements of this Slice are sorted in ascending order.
+	IsSortedWith(cmp func(a, b O) int) bool                                      // IsSortedWith tests if the elements of this Slice are sorted according to the given comparison.
+	Join(separator ...string) (str *Object)                                      // Join converts each element into a string then joins them together using the given separator or comma by default.
+	Last() (elem *Object)                                                        // Last returns the last element in this Slice as an Object.
+	Len() int                                                                    // Len returns the number of elements in this Slice.
+	Less(i, j int) bool                                                          // Less returns true if the element indexed by i is less than the element indexed by j.
+	Nil() bool                                                                   // Nil tests if this Slice is nil.
+	O() interface{}                                                              // O returns the underlying data structure as is.
+	Pair() (first, second *Object)                                               // Pair simply returns the first and second Slice elements as Objects.
+	Reduce(reducer func(acc, elem O) O, init ...interface{}) (acc *Object)       // Reduce combines the elements of this Slice into a single value using the reducer.
+	S() (slice *StringSlice)                                                     // S is an alias to ToStringSlice
+	Single() bool                                                                // Single reports true if there is only one element in this Slice.
+	String() string                                                              // String returns a string representation of this Slice, implements the Stringer interface
+	ToInts() (slice []int)                                                       // ToInts converts the given slice into a native []int type
+	ToIntSlice() (slice *IntSlice)                                               // ToIntSlice converts the given slice into a *IntSlice
+	ToInterSlice() (slice []interface{})                                         // ToInterSlice converts the given slice to a generic []interface{} slice
+	ToStrs() (slice []string)                                                    // ToStrs converts the underlying slice into a []string slice
+	ToStringSlice() (slice *StringSlice)                                         // ToStringSlice converts the underlying slice into a *StringSlice
 }
 
 // Slice provides a generic way to work with Slice types. It does this by wrapping Go types
 // directly for optimized types thus avoiding reflection processing overhead and making a plethora
-// of Slice methods available. Non optimized types will fall back on reflection to generically
-// handle the type incurring the full 10x reflection processing overhead.
+// of Slice methods available. Types with a registered converter, see RegisterConverter, or that
+// implement encoding.TextMarshaler are converted into the optimized type they convert to. Non
+// optimized types will fall back on reflection to generically handle the type incurring the
+// full 10x reflection processing overhead.
 //
 // Optimized: []int, []string, StrSlice
 func Slice(obj interface{}) (new ISlice) {
@@ -127,10 +204,12 @@ func Slice(obj interface{}) (new ISlice) {
 			case *yaml.MapSlice, *map[string]interface{}, *map[string]string:
 				new = ToSliceOfMap(*x)
 
-			// RefSlice
+			// Registered converters or RefSlice
 			// ---------------------------------------------------------------------------------------------
 			default:
-				new = NewRefSlice(obj)
+				if new = convertSlice(*x, item); new == nil {
+					new = NewRefSlice(obj)
+				}
 			}
 		} else {
 			new = x
@@ -166,10 +245,12 @@ func Slice(obj interface{}) (new ISlice) {
 	case *SliceOfMap, *map[string]interface{}, *map[string]string, *[]map[string]interface{}, *[]map[string]string:
 		new = ToSliceOfMap(o)
 
-	// RefSlice
+	// Registered converters or RefSlice
 	// ---------------------------------------------------------------------------------------------
 	default:
-		new = NewRefSlice(obj)
+		if new = convertSlice(obj, obj); new == nil {
+			new = NewRefSlice(obj)
+		}
 	}
 	return
 }
@@ -206,10 +287,12 @@ func NewSliceV(elems ...interface{}) (new ISlice) {
 		case *Char, *rune, *byte:
 			new = ToStr(elems)
 
-		// RefSlice
+		// Registered converters or RefSlice
 		// -----------------------------------------------------------------------------------------
 		default:
-			new = NewRefSliceV(elems...)
+			if new = convertSlice(elems, elems[0]); new == nil {
+				new = NewRefSliceV(elems...)
+			}
 		}
 	}
 	return
@@ -291,3 +374,289 @@ func absIndices(l int, indices ...int) (i int, j int, err error) {
 
 	return
 }
+
+// cartesian returns the cartesian product of the given Slice and other slices as tuples
+func cartesian(p ISlice, slices []interface{}) (tuples []ISlice) {
+	product := [][]interface{}{{}}
+	for _, set := range elements(p, slices) {
+		next := make([][]interface{}, 0, len(product)*len(set))
+		for _, tuple := range product {
+			for _, elem := range set {
+				next = append(next, append(append(make([]interface{}, 0, len(tuple)+1), tuple...), elem))
+			}
+		}
+		product = next
+	}
+	tuples = make([]ISlice, len(product))
+	for i := range product {
+		tuples[i] = NewInterSlice(product[i])
+	}
+	return
+}
+
+// chunk returns the given Slice split into consecutive new Slices of n elements with the last
+// chunk containing the remaining elements
+func chunk(p ISlice, n int) (chunks []ISlice) {
+	chunks = []ISlice{}
+	if n <= 0 {
+		return
+	}
+	for i, l := 0, p.Len(); i < l; i += n {
+		chunks = append(chunks, p.Copy(i, min(i+n, l)-1))
+	}
+	return
+}
+
+// combinations returns all combinations of n elements of the given Slice in index order
+func combinations(p ISlice, n int) (combos []ISlice) {
+	combos = []ISlice{}
+	l := p.Len()
+	if n < 0 || n > l {
+		return
+	}
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	for {
+		combos = append(combos, pick(p, indices))
+
+		// Advance the right most index that hasn't reached its last position and reset the
+		// indices following it to their lowest positions
+		i := n - 1
+		for i >= 0 && indices[i] == l-n+i {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		indices[i]++
+		for j := i + 1; j < n; j++ {
+			indices[j] = indices[j-1] + 1
+		}
+	}
+}
+
+// elements returns the elements of the given Slice followed by the elements of each of the other
+// slices as []interface{} slices
+func elements(p ISlice, slices []interface{}) (sets [][]interface{}) {
+	sets = make([][]interface{}, 0, len(slices)+1)
+	for i := -1; i < len(slices); i++ {
+		slice := p
+		if i >= 0 {
+			var ok bool
+			if slice, ok = slices[i].(ISlice); !ok {
+				slice = Slice(slices[i])
+			}
+		}
+		set := make([]interface{}, slice.Len())
+		for j := range set {
+			set[j] = slice.At(j).O()
+		}
+		sets = append(sets, set)
+	}
+	return
+}
+
+// flatten appends the given object to the given leaves recursively expanding any slices
+// except for strings, bytes, runes and maps which are considered to be single values.
+func flatten(obj interface{}, leaves []interface{}) []interface{} {
+	switch x := obj.(type) {
+	case nil, string, []byte, []rune, Str, *Str, yaml.MapSlice, *StringMap:
+		return append(leaves, x)
+	case ISlice:
+		for i := 0; i < x.Len(); i++ {
+			leaves = flatten(x.At(i).O(), leaves)
+		}
+		return leaves
+	}
+	if v := reflect.ValueOf(obj); v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		for i := 0; i < v.Len(); i++ {
+			leaves = flatten(v.Index(i).Interface(), leaves)
+		}
+		return leaves
+	}
+	return append(leaves, obj)
+}
+
+// intn returns a random int in the range [0, n) using the optional random number generator
+func intn(rng []*rand.Rand, n int) int {
+	if len(rng) > 0 && rng[0] != nil {
+		return rng[0].Intn(n)
+	}
+	return rand.Intn(n)
+}
+
+// partition returns the elements of the given Slice that match the lambda selector and those
+// that don't as new Slices
+func partition(p ISlice, sel func(O) bool) (match, rest ISlice) {
+	return p.Select(sel), p.Select(func(x O) bool { return !sel(x) })
+}
+
+// permutations returns all ordered arrangements of n elements of the given Slice in index order
+func permutations(p ISlice, n int) (perms []ISlice) {
+	perms = []ISlice{}
+	l := p.Len()
+	if n < 0 || n > l {
+		return
+	}
+	indices, used := make([]int, 0, n), make([]bool, l)
+	var permute func()
+	permute = func() {
+		if len(indices) == n {
+			perms = append(perms, pick(p, indices))
+			return
+		}
+		for i := 0; i < l; i++ {
+			if !used[i] {
+				used[i], indices = true, append(indices, i)
+				permute()
+				used[i], indices = false, indices[:len(indices)-1]
+			}
+		}
+	}
+	permute()
+	return
+}
+
+// pick returns a new Slice of the same type as the given Slice with the indicated elements
+func pick(p ISlice, indices []int) (new ISlice) {
+	new = p.Copy(0, 0).Clear()
+	for _, i := range indices {
+		new.Append(p.At(i).O())
+	}
+	return
+}
+
+// reduce combines the elements of the given Slice into a single value by calling the lambda
+// with the accumulated value and each element in turn. The first element is used as the initial
+// value if one isn't given.
+func reduce(p ISlice, reducer func(acc, elem O) O, init []interface{}) (acc *Object) {
+	acc = &Object{}
+	i, l := 0, p.Len()
+	if len(init) > 0 {
+		acc.o = init[0]
+	} else if l > 0 {
+		acc.o, i = p.At(0).O(), 1
+	}
+	for ; i < l; i++ {
+		acc.o = reducer(acc.o, p.At(i).O())
+	}
+	return
+}
+
+// reverseRange reverses the order of the elements in the range [i, j) of the given Slice
+func reverseRange(p ISlice, i, j int) {
+	for j--; i < j; i, j = i+1, j-1 {
+		p.Swap(i, j)
+	}
+}
+
+// rotateM rotates the elements of the given Slice in place n places to the left or to the right
+// for negative n such that the element at index n becomes the first element.
+func rotateM(p ISlice, n int) {
+	l := p.Len()
+	if l < 2 {
+		return
+	}
+	if n %= l; n < 0 {
+		n += l
+	}
+	reverseRange(p, 0, n)
+	reverseRange(p, n, l)
+	reverseRange(p, 0, l)
+}
+
+// sample returns a new Slice of n random unique elements of the given Slice using the optional
+// random number generator. All elements are returned in random order if n is larger than the Slice.
+func sample(p ISlice, n int, rng []*rand.Rand) (new ISlice) {
+	new = p.Copy()
+	l := new.Len()
+	if n = min(n, l); n <= 0 {
+		return new.Clear()
+	}
+
+	// Partial Fisher-Yates shuffle selecting the first n elements
+	for i := 0; i < n; i++ {
+		new.Swap(i, i+intn(rng, l-i))
+	}
+	return new.Copy(0, n-1)
+}
+
+// scan returns a new Slice of the accumulated values from each step of reducing the given Slice
+// converted into an optimized Slice type if possible. See reduce.
+func scan(p ISlice, reducer func(acc, elem O) O, init []interface{}) (new ISlice) {
+	var acc interface{}
+	i, l := 0, p.Len()
+	results := make([]interface{}, 0, l)
+	if len(init) > 0 {
+		acc = init[0]
+	} else if l > 0 {
+		acc, i = p.At(0).O(), 1
+		results = append(results, acc)
+	}
+	for ; i < l; i++ {
+		acc = reducer(acc, p.At(i).O())
+		results = append(results, acc)
+	}
+	if len(results) == 0 {
+		return p.Copy().Clear()
+	}
+
+	// Maps are kept as a SliceOfMap when all the accumulated values are maps
+	if _, ok := p.(*SliceOfMap); ok {
+		if slice, err := ToSliceOfMapE(results); err == nil {
+			return slice
+		}
+	}
+
+	// Accumulated values of mixed types can only be held by an InterSlice
+	for i := range results {
+		if reflect.TypeOf(results[i]) != reflect.TypeOf(results[0]) {
+			return NewInterSlice(results)
+		}
+	}
+	return Slice(results)
+}
+
+// shuffleM shuffles the elements of the given Slice in place using the optional random number
+// generator.
+func shuffleM(p ISlice, rng []*rand.Rand) {
+	if len(rng) > 0 && rng[0] != nil {
+		rng[0].Shuffle(p.Len(), p.Swap)
+	} else {
+		rand.Shuffle(p.Len(), p.Swap)
+	}
+}
+
+// window returns new Slices of n consecutive elements of the given Slice starting every step
+// elements. Only full windows are returned.
+func window(p ISlice, n, step int) (windows []ISlice) {
+	windows = []ISlice{}
+	if n <= 0 || step <= 0 {
+		return
+	}
+	for i, l := 0, p.Len(); i+n <= l; i += step {
+		windows = append(windows, p.Copy(i, i+n-1))
+	}
+	return
+}
+
+// zip returns tuples of the elements at the same index in the given Slice and other slices
+// stopping at the shortest slice.
+func zip(p ISlice, slices []interface{}) (tuples []ISlice) {
+	sets := elements(p, slices)
+	l := len(sets[0])
+	for i := range sets {
+		l = min(l, len(sets[i]))
+	}
+	tuples = make([]ISlice, l)
+	for i := range tuples {
+		tuple := make([]interface{}, len(sets))
+		for j := range sets {
+			tuple[j] = sets[j][i]
+		}
+		tuples[i] = NewInterSlice(tuple)
+	}
+	return
+}
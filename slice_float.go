@@ -2,9 +2,14 @@ package n
 
 import (
 	"fmt"
+	"math/rand"
 	"sort"
 	"strings"
 
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/phR0ze/n/pkg/enc/msgpack"
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/phR0ze/n/pkg/stats"
 	"github.com/pkg/errors"
 )
 
@@ -149,6 +154,43 @@ func (p *FloatSlice) At(i int) (elem *Object) {
 	return
 }
 
+// BinarySearch searches this Slice, which must be sorted in ascending order e.g. with Sort, for
+// the given element returning the index it was found at or the index it would be inserted at to
+// keep this Slice sorted and whether it was found.
+func (p *FloatSlice) BinarySearch(elem interface{}) (i int, found bool) {
+	if p == nil {
+		return 0, false
+	}
+	x, err := ToFloat64E(elem)
+	if err != nil {
+		return 0, false
+	}
+	i = sort.SearchFloat64s(*p, x)
+	return i, i < len(*p) && (*p)[i] == x
+}
+
+// BinarySearchWith searches this Slice, which must be sorted according to the given comparison, for
+// the given element returning the index it was found at or the index it would be inserted at to
+// keep this Slice sorted and whether it was found. The comparison is given each element of this
+// Slice and the element being searched for e.g. SortWith and BinarySearchWith may share an Ordering.
+func (p *FloatSlice) BinarySearchWith(elem interface{}, cmp func(a, b O) int) (i int, found bool) {
+	return binarySearch(p.Len(), func(i int) O { return (*p)[i] }, elem, cmp)
+}
+
+// Cartesian returns the cartesian product of this Slice and the given slices as new tuple Slices
+// of every combination of one element from each slice e.g. [1 2].Cartesian([a b]) returns
+// [[1 a] [1 b] [2 a] [2 b]]. Tuples are InterSlices as the slices may be of different types.
+func (p *FloatSlice) Cartesian(slices ...interface{}) (tuples []ISlice) {
+	return cartesian(p, slices)
+}
+
+// Chunk returns this Slice split into new Slices of n consecutive elements with the last Slice
+// containing the remaining elements e.g. [1 2 3 4 5].Chunk(2) returns [[1 2] [3 4] [5]].
+// Returns no Slices if n is not positive.
+func (p *FloatSlice) Chunk(n int) (chunks []ISlice) {
+	return chunk(p, n)
+}
+
 // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
 func (p *FloatSlice) Clear() ISlice {
 	if p == nil {
@@ -159,6 +201,13 @@ func (p *FloatSlice) Clear() ISlice {
 	return p
 }
 
+// Combinations returns new Slices of all combinations of n elements of this Slice in the order
+// the elements occur e.g. [1 2 3].Combinations(2) returns [[1 2] [1 3] [2 3]]. Returns no Slices
+// if n is negative or larger than this Slice.
+func (p *FloatSlice) Combinations(n int) (combos []ISlice) {
+	return combinations(p, n)
+}
+
 // Concat returns a new Slice by appending the given Slice to this Slice using variadic expansion.
 // Supports FloatSlice, *FloatSlice, []float64 or *[]float64
 func (p *FloatSlice) Concat(slice interface{}) (new ISlice) {
@@ -222,6 +271,24 @@ func (p *FloatSlice) CountW(sel func(O) bool) (cnt int) {
 	return
 }
 
+// CumSum returns a new Slice of the running totals of the elements in this Slice.
+func (p *FloatSlice) CumSum() (new *FloatSlice) {
+	return ToFloatSlice(stats.CumSum(p.G()))
+}
+
+// DeepCopy returns a new Slice with all elements recursively copied from this Slice. As the
+// elements are values this is the same as Copy.
+func (p *FloatSlice) DeepCopy() (new ISlice) {
+	return p.Copy()
+}
+
+// DeepEqual tests if this Slice is structurally equal to the given obj, see DeepEqual.
+//
+// Supported options: IgnoreOrderOpt, IgnorePathsOpt and NumericOpt
+func (p *FloatSlice) DeepEqual(obj interface{}, opts ...*opt.Opt) bool {
+	return DeepEqual(p, obj, opts...)
+}
+
 // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
 // Expects nothing, in which case everything is dropped, or two indices i and j, in which case positive and
 // negative notation is supported and uses an inclusive behavior such that DropAt(0, -1) includes index -1
@@ -404,6 +471,15 @@ func (p *FloatSlice) EachRIE(action func(int, O) error) (ISlice, error) {
 	return p, err
 }
 
+// EachSlice calls the given lambda once for each new Slice of n consecutive elements of this
+// Slice the same as Chunk and returns a reference to this Slice.
+func (p *FloatSlice) EachSlice(n int, action func(ISlice)) ISlice {
+	for _, slice := range chunk(p, n) {
+		action(slice)
+	}
+	return p
+}
+
 // Empty tests if this Slice is empty.
 func (p *FloatSlice) Empty() bool {
 	if p == nil || len(*p) == 0 {
@@ -427,11 +503,41 @@ func (p *FloatSlice) FirstN(n int) ISlice {
 	return p.Slice(0, abs(n)-1)
 }
 
+// Flatten returns a new Slice with the elements of any nested slices expanded in place. This
+// Slice can't contain nested slices so this is the same as Copy.
+func (p *FloatSlice) Flatten() (new ISlice) {
+	return p.Copy()
+}
+
 // G returns the underlying data structure as a builtin Go type
 func (p *FloatSlice) G() []float64 {
 	return p.O().([]float64)
 }
 
+// HasNaN tests if any of the elements in this Slice are NaN.
+func (p *FloatSlice) HasNaN() bool {
+	return stats.HasNaN(p.G())
+}
+
+// Hash returns a stable structural hash of this Slice, see Hash.
+func (p *FloatSlice) Hash() uint64 {
+	return Hash(p)
+}
+
+// Histogram counts the elements in this Slice into the given number of equal width buckets
+// spanning the smallest to largest element.
+func (p *FloatSlice) Histogram(buckets int) (histogram []stats.Bucket) {
+	histogram, _ = p.HistogramE(buckets)
+	return
+}
+
+// HistogramE counts the elements in this Slice into the given number of equal width buckets
+// spanning the smallest to largest element. Returns an error for an invalid number of buckets
+// or if this Slice contains NaN or infinity.
+func (p *FloatSlice) HistogramE(buckets int) (histogram []stats.Bucket, err error) {
+	return stats.Histogram(p.G(), buckets)
+}
+
 // Index returns the index of the first element in this Slice where element == elem
 // Returns a -1 if the element was not not found.
 func (p *FloatSlice) Index(elem interface{}) (loc int) {
@@ -449,6 +555,11 @@ func (p *FloatSlice) Index(elem interface{}) (loc int) {
 	return
 }
 
+// Inject is an alias to Reduce
+func (p *FloatSlice) Inject(reducer func(acc, elem O) O, init ...interface{}) (acc *Object) {
+	return reduce(p, reducer, init)
+}
+
 // Insert modifies this Slice to insert the given elements before the element(s) with the given index.
 // Negative indices count backwards from the end of the slice, where -1 is the last element. If a
 // negative index is used, the given element will be inserted after that element, so using an index
@@ -487,6 +598,19 @@ func (p *FloatSlice) InterSlice() bool {
 	return false
 }
 
+// IsSorted tests if the elements of this Slice are sorted in ascending order
+func (p *FloatSlice) IsSorted() bool {
+	if p == nil {
+		return true
+	}
+	return sort.Float64sAreSorted(*p)
+}
+
+// IsSortedWith tests if the elements of this Slice are sorted according to the given comparison
+func (p *FloatSlice) IsSortedWith(cmp func(a, b O) int) bool {
+	return isSorted(p.Len(), func(i int) O { return (*p)[i] }, cmp)
+}
+
 // Join converts each element into a string then joins them together using the given separator or comma by default.
 func (p *FloatSlice) Join(separator ...string) (str *Object) {
 	if p == nil || len(*p) == 0 {
@@ -557,6 +681,79 @@ func (p *FloatSlice) Map(mod func(O) O) ISlice {
 	return slice
 }
 
+// MarshalJSON implements the json.Marshaler interface encoding this Slice as a json array.
+func (p *FloatSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.O())
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface encoding this Slice as a msgpack array.
+func (p *FloatSlice) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(p.O())
+}
+
+// MarshalYAML implements the yaml.Marshaler interface encoding this Slice as a yaml sequence.
+func (p *FloatSlice) MarshalYAML() (interface{}, error) {
+	return p.O(), nil
+}
+
+// Max returns the largest element in this Slice, NaN if this Slice contains NaN or 0 if empty.
+func (p *FloatSlice) Max() (max float64) {
+	max, _ = p.MaxE()
+	return
+}
+
+// MaxE returns the largest element in this Slice or an error if empty or this Slice contains NaN.
+func (p *FloatSlice) MaxE() (max float64, err error) {
+	max, err = stats.Max(p.G())
+	return max, errNaN(p.G(), err)
+}
+
+// Mean returns the arithmetic mean of the elements in this Slice, NaN if this Slice contains
+// NaN or 0 if empty.
+func (p *FloatSlice) Mean() (mean float64) {
+	mean, _ = p.MeanE()
+	return
+}
+
+// MeanE returns the arithmetic mean of the elements in this Slice or an error if empty or this
+// Slice contains NaN.
+func (p *FloatSlice) MeanE() (mean float64, err error) {
+	mean, err = stats.Mean(p.G())
+	return mean, errNaN(p.G(), err)
+}
+
+// Median returns the middle element of this Slice or the mean of the two middle elements for
+// an even number of elements, NaN if this Slice contains NaN or 0 if empty.
+func (p *FloatSlice) Median() (median float64) {
+	median, _ = p.MedianE()
+	return
+}
+
+// MedianE returns the middle element of this Slice or the mean of the two middle elements for
+// an even number of elements or an error if empty or this Slice contains NaN.
+func (p *FloatSlice) MedianE() (median float64, err error) {
+	median, err = stats.Median(p.G())
+	return median, errNaN(p.G(), err)
+}
+
+// Min returns the smallest element in this Slice, NaN if this Slice contains NaN or 0 if empty.
+func (p *FloatSlice) Min() (min float64) {
+	min, _ = p.MinE()
+	return
+}
+
+// MinE returns the smallest element in this Slice or an error if empty or this Slice contains NaN.
+func (p *FloatSlice) MinE() (min float64, err error) {
+	min, err = stats.Min(p.G())
+	return min, errNaN(p.G(), err)
+}
+
+// Mode returns a new Slice of the most frequent elements in this Slice in ascending order
+// ignoring NaN elements.
+func (p *FloatSlice) Mode() (new *FloatSlice) {
+	return ToFloatSlice(stats.Mode(p.G()))
+}
+
 // Nil tests if this Slice is nil
 func (p *FloatSlice) Nil() bool {
 	if p == nil {
@@ -565,6 +762,12 @@ func (p *FloatSlice) Nil() bool {
 	return false
 }
 
+// Normalize returns a new Slice of the elements in this Slice rescaled to the range [0, 1]
+// such that the smallest element becomes 0 and the largest 1.
+func (p *FloatSlice) Normalize() (new *FloatSlice) {
+	return ToFloatSlice(stats.Normalize(p.G()))
+}
+
 // O returns the underlying data structure as is
 func (p *FloatSlice) O() interface{} {
 	if p == nil {
@@ -588,6 +791,35 @@ func (p *FloatSlice) Pair() (first, second *Object) {
 	return
 }
 
+// Partition returns the elements of this Slice that match the lambda selector and the elements
+// that don't as new Slices preserving element order.
+func (p *FloatSlice) Partition(sel func(O) bool) (match, rest ISlice) {
+	return partition(p, sel)
+}
+
+// Percentile returns the p-th percentile of the elements in this Slice where p is between 0 and
+// 100, NaN if this Slice contains NaN or 0 if empty. Percentiles falling between two elements
+// are linearly interpolated unless another interpolation method is given.
+func (p *FloatSlice) Percentile(percent float64, method ...stats.Interpolation) (val float64) {
+	val, _ = p.PercentileE(percent, method...)
+	return
+}
+
+// PercentileE returns the p-th percentile of the elements in this Slice where p is between 0
+// and 100 or an error if empty, p is out of range or this Slice contains NaN. Percentiles falling
+// between two elements are linearly interpolated unless another interpolation method is given.
+func (p *FloatSlice) PercentileE(percent float64, method ...stats.Interpolation) (val float64, err error) {
+	val, err = stats.Percentile(p.G(), percent, append(method, stats.Linear)[0])
+	return val, errNaN(p.G(), err)
+}
+
+// Permutations returns new Slices of all ordered arrangements of n elements of this Slice e.g.
+// [1 2 3].Permutations(2) returns [[1 2] [1 3] [2 1] [2 3] [3 1] [3 2]]. Returns no Slices if n
+// is negative or larger than this Slice.
+func (p *FloatSlice) Permutations(n int) (perms []ISlice) {
+	return permutations(p, n)
+}
+
 // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
 func (p *FloatSlice) Pop() (elem *Object) {
 	elem = p.Last()
@@ -610,6 +842,25 @@ func (p *FloatSlice) Prepend(elem interface{}) ISlice {
 	return p.Insert(0, elem)
 }
 
+// Product returns the product of the elements in this Slice or 1 if empty.
+func (p *FloatSlice) Product() (product float64) {
+	product, _ = p.ProductE()
+	return
+}
+
+// ProductE returns the product of the elements in this Slice or 1 if empty and an error if
+// this Slice contains NaN.
+func (p *FloatSlice) ProductE() (product float64, err error) {
+	return stats.Product(p.G()), errNaN(p.G(), nil)
+}
+
+// Reduce combines the elements of this Slice into a single value by calling the given lambda
+// with the accumulated value and each element in turn, returning the final accumulated value.
+// The first element is used as the initial value if one isn't given.
+func (p *FloatSlice) Reduce(reducer func(acc, elem O) O, init ...interface{}) (acc *Object) {
+	return reduce(p, reducer, init)
+}
+
 // RefSlice returns true if the underlying implementation is a RefSlice
 func (p *FloatSlice) RefSlice() bool {
 	return false
@@ -634,11 +885,48 @@ func (p *FloatSlice) ReverseM() ISlice {
 	return p
 }
 
+// Rotate returns a new Slice with the elements rotated n places to the left or to the right for
+// negative n such that the element at index n becomes the first element.
+func (p *FloatSlice) Rotate(n int) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().RotateM(n)
+}
+
+// RotateM modifies this Slice rotating the elements n places to the left or to the right for
+// negative n and returns a reference to this Slice. See Rotate.
+func (p *FloatSlice) RotateM(n int) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	rotateM(p, n)
+	return p
+}
+
 // S is an alias to ToStringSlice
 func (p *FloatSlice) S() (slice *StringSlice) {
 	return ToStringSlice(p.O())
 }
 
+// Sample returns a new Slice of n unique elements chosen at random from this Slice using the
+// optional random number generator e.g. rand.New(rand.NewSource(seed)) for repeatable results.
+// All elements are returned in random order if n is larger than this Slice.
+func (p *FloatSlice) Sample(n int, rng ...*rand.Rand) (new ISlice) {
+	return sample(p, n, rng)
+}
+
+// Scale returns a new Slice of the elements in this Slice each multiplied by the given factor.
+func (p *FloatSlice) Scale(factor float64) (new *FloatSlice) {
+	return ToFloatSlice(stats.Scale(p.G(), factor))
+}
+
+// Scan returns a new Slice of the accumulated values from each step of reducing this Slice e.g.
+// running totals. The new Slice is converted into an optimized Slice type if possible. See Reduce.
+func (p *FloatSlice) Scan(reducer func(acc, elem O) O, init ...interface{}) (new ISlice) {
+	return scan(p, reducer, init)
+}
+
 // Select creates a new slice with the elements that match the lambda selector.
 func (p *FloatSlice) Select(sel func(O) bool) (new ISlice) {
 	slice := NewFloatSliceV()
@@ -700,11 +988,35 @@ func (p *FloatSlice) ShiftN(n int) (new ISlice) {
 	return
 }
 
+// Shuffle returns a new Slice with the elements in random order using the optional random
+// number generator e.g. rand.New(rand.NewSource(seed)) for repeatable results.
+func (p *FloatSlice) Shuffle(rng ...*rand.Rand) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().ShuffleM(rng...)
+}
+
+// ShuffleM modifies this Slice putting the elements in random order using the optional random
+// number generator and returns a reference to this Slice. See Shuffle.
+func (p *FloatSlice) ShuffleM(rng ...*rand.Rand) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	shuffleM(p, rng)
+	return p
+}
+
 // Single reports true if there is only one element in this Slice.
 func (p *FloatSlice) Single() bool {
 	return p.Len() == 1
 }
 
+// SkipNaN returns a new Slice of the elements in this Slice with any NaN elements removed.
+func (p *FloatSlice) SkipNaN() (new *FloatSlice) {
+	return ToFloatSlice(stats.SkipNaN(p.G()))
+}
+
 // Slice returns a range of elements from this Slice as a Slice reference to the original. Allows for negative notation.
 // Expects nothing, in which case everything is included, or two indices i and j, in which case an inclusive behavior
 // is used such that Slice(0, -1) includes index -1 as opposed to Go's exclusive behavior. Out of bounds indices will
@@ -736,6 +1048,47 @@ func (p *FloatSlice) Sort() (new ISlice) {
 	return p.Copy().SortM()
 }
 
+// SortBy returns a new Slice with the elements sorted in ascending order by the keys the given
+// lambda selects from them compared with Compare. The sort is stable and the lambda is called
+// once per element.
+func (p *FloatSlice) SortBy(key func(O) O) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByM(key)
+}
+
+// SortByDesc returns a new Slice with the elements sorted in descending order by the keys the
+// given lambda selects from them compared with Compare. The sort is stable.
+func (p *FloatSlice) SortByDesc(key func(O) O) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByDescM(key)
+}
+
+// SortByDescM modifies this Slice sorting the elements in descending order by the keys the
+// given lambda selects from them compared with Compare and returns a reference to this Slice.
+// The sort is stable.
+func (p *FloatSlice) SortByDescM(key func(O) O) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return key((*p)[i]) }, func(a, b O) int { return Compare(b, a) })
+	return p
+}
+
+// SortByM modifies this Slice sorting the elements in ascending order by the keys the given
+// lambda selects from them compared with Compare and returns a reference to this Slice. The sort
+// is stable and the lambda is called once per element.
+func (p *FloatSlice) SortByM(key func(O) O) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return key((*p)[i]) }, Compare)
+	return p
+}
+
 // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
 func (p *FloatSlice) SortM() ISlice {
 	if p == nil || len(*p) < 2 {
@@ -762,6 +1115,40 @@ func (p *FloatSlice) SortReverseM() ISlice {
 	return p
 }
 
+// SortWith returns a new Slice with the elements sorted according to the given comparison which
+// returns a negative number when a sorts before b, zero when equal and a positive number when a
+// sorts after b e.g. CompareNatural or an Ordering's Compare. The sort is stable.
+func (p *FloatSlice) SortWith(cmp func(a, b O) int) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortWithM(cmp)
+}
+
+// SortWithM modifies this Slice sorting the elements according to the given comparison and
+// returns a reference to this Slice. See SortWith. The sort is stable.
+func (p *FloatSlice) SortWithM(cmp func(a, b O) int) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return (*p)[i] }, cmp)
+	return p
+}
+
+// StdDev returns the population standard deviation of the elements in this Slice, NaN if this
+// Slice contains NaN or 0 if empty.
+func (p *FloatSlice) StdDev() (stddev float64) {
+	stddev, _ = p.StdDevE()
+	return
+}
+
+// StdDevE returns the population standard deviation of the elements in this Slice or an error
+// if empty or this Slice contains NaN.
+func (p *FloatSlice) StdDevE() (stddev float64, err error) {
+	stddev, err = stats.StdDev(p.G())
+	return stddev, errNaN(p.G(), err)
+}
+
 // Returns a string representation of this Slice, implements the Stringer interface
 func (p *FloatSlice) String() string {
 	var builder strings.Builder
@@ -778,6 +1165,17 @@ func (p *FloatSlice) String() string {
 	return builder.String()
 }
 
+// Sum returns the sum of the elements in this Slice or NaN if this Slice contains NaN.
+func (p *FloatSlice) Sum() (sum float64) {
+	sum, _ = p.SumE()
+	return
+}
+
+// SumE returns the sum of the elements in this Slice and an error if this Slice contains NaN.
+func (p *FloatSlice) SumE() (sum float64, err error) {
+	return stats.Sum(p.G()), errNaN(p.G(), nil)
+}
+
 // Swap modifies this Slice swapping the indicated elements.
 func (p *FloatSlice) Swap(i, j int) {
 	if p == nil || len(*p) < 2 || i < 0 || j < 0 || i >= len(*p) || j >= len(*p) {
@@ -892,3 +1290,69 @@ func (p *FloatSlice) UniqM() ISlice {
 	}
 	return p
 }
+
+// UnmarshalJSON implements the json.Unmarshaler interface decoding a json array into this Slice.
+func (p *FloatSlice) UnmarshalJSON(data []byte) (err error) {
+	x := []float64{}
+	if err = json.Unmarshal(data, &x); err != nil {
+		return
+	}
+	*p = FloatSlice(x)
+	return
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface decoding a msgpack array into this Slice.
+func (p *FloatSlice) UnmarshalMsgpack(data []byte) (err error) {
+	x := []float64{}
+	if err = msgpack.Unmarshal(data, &x); err != nil {
+		return
+	}
+	*p = FloatSlice(x)
+	return
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface decoding a yaml sequence into this Slice.
+func (p *FloatSlice) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	x := []float64{}
+	if err = unmarshal(&x); err != nil {
+		return
+	}
+	*p = FloatSlice(x)
+	return
+}
+
+// Variance returns the population variance of the elements in this Slice, NaN if this Slice
+// contains NaN or 0 if empty.
+func (p *FloatSlice) Variance() (variance float64) {
+	variance, _ = p.VarianceE()
+	return
+}
+
+// VarianceE returns the population variance of the elements in this Slice or an error if empty
+// or this Slice contains NaN.
+func (p *FloatSlice) VarianceE() (variance float64, err error) {
+	variance, err = stats.Variance(p.G())
+	return variance, errNaN(p.G(), err)
+}
+
+// errNaN returns the given error or an error if the given slice contains NaN
+func errNaN(slice []float64, err error) error {
+	if err == nil && stats.HasNaN(slice) {
+		err = errors.New("slice contains NaN")
+	}
+	return err
+}
+
+// Window returns new Slices of n consecutive elements of this Slice starting every step elements
+// e.g. [1 2 3 4].Window(2, 1) returns [[1 2] [2 3] [3 4]]. Only full windows are returned and no
+// Slices are returned if n or step are not positive.
+func (p *FloatSlice) Window(n, step int) (windows []ISlice) {
+	return window(p, n, step)
+}
+
+// Zip returns new tuple Slices of the elements at the same index in this Slice and each of the
+// given slices e.g. [1 2].Zip([a b]) returns [[1 a] [2 b]]. Stops at the end of the shortest
+// slice. Tuples are InterSlices as the slices may be of different types.
+func (p *FloatSlice) Zip(slices ...interface{}) (tuples []ISlice) {
+	return zip(p, slices)
+}
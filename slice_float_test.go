@@ -2,8 +2,13 @@ package n
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
 	"testing"
 
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/phR0ze/n/pkg/stats"
+	yaml "github.com/phR0ze/yaml/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -654,6 +659,162 @@ func TestFloatSlice_At(t *testing.T) {
 	}
 }
 
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_BinarySearch() {
+	slice := NewFloatSliceV(1, 3, 5)
+	fmt.Println(slice.BinarySearch(3.0))
+	// Output: 1 true
+}
+
+func TestFloatSlice_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		i, found := slice.BinarySearch(3.0)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = NewFloatSliceV().BinarySearch(3.0)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		slice := NewFloatSliceV(1, 3, 5)
+		i, found := slice.BinarySearch(1)
+		assert.Equal(t, 0, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearch(3.0)
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+	}
+
+	// not found returns the insertion index
+	{
+		slice := NewFloatSliceV(1, 3, 5)
+		i, found := slice.BinarySearch(4.5)
+		assert.Equal(t, 2, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch(6)
+		assert.Equal(t, 3, i)
+		assert.False(t, found)
+	}
+
+	// converts the element
+	{
+		slice := NewFloatSliceV(1, 3, 5)
+		i, found := slice.BinarySearch("5")
+		assert.Equal(t, 2, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearch("foo")
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// BinarySearchWith
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_BinarySearchWith() {
+	slice := NewFloatSliceV(5, 3, 1)
+	fmt.Println(slice.BinarySearchWith(3.0, func(a, b O) int { return Compare(b, a) }))
+	// Output: 1 true
+}
+
+func TestFloatSlice_BinarySearchWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		i, found := slice.BinarySearchWith(3.0, Compare)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// descending
+	{
+		slice := NewFloatSliceV(5, 3, 1)
+		i, found := slice.BinarySearchWith(3.0, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearchWith(4.5, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 1, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearchWith(6, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// Cartesian
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Cartesian() {
+	slice := NewFloatSliceV(1.0, 2.0)
+	fmt.Println(slice.Cartesian([]string{"x", "y"}))
+	// Output: [[1 x] [1 y] [2 x] [2 y]]
+}
+
+func TestFloatSlice_Cartesian(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, []ISlice{}, slice.Cartesian([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewFloatSliceV().Cartesian([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewFloatSliceV(1.0).Cartesian([]string{}))
+	}
+
+	// no slices
+	assert.Equal(t, []ISlice{NewInterSliceV(1.0), NewInterSliceV(2.0)}, NewFloatSliceV(1.0, 2.0).Cartesian())
+
+	// multiple slices
+	{
+		tuples := NewFloatSliceV(1.0, 2.0).Cartesian([]string{"x", "y"}, NewIntSliceV(7))
+		assert.Equal(t, []ISlice{NewInterSliceV(1.0, "x", 7), NewInterSliceV(1.0, "y", 7),
+			NewInterSliceV(2.0, "x", 7), NewInterSliceV(2.0, "y", 7)}, tuples)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Chunk() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	fmt.Println(slice.Chunk(2))
+	// Output: [[1.000000 2.000000] [3.000000 4.000000] [5.000000]]
+}
+
+func TestFloatSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, []ISlice{}, slice.Chunk(2))
+		assert.Equal(t, []ISlice{}, NewFloatSliceV().Chunk(2))
+	}
+
+	// invalid size
+	assert.Equal(t, []ISlice{}, NewFloatSliceV(1.0, 2.0).Chunk(0))
+	assert.Equal(t, []ISlice{}, NewFloatSliceV(1.0, 2.0).Chunk(-1))
+
+	// chunks
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0, 2.0), NewFloatSliceV(3.0, 4.0), NewFloatSliceV(5.0)}, slice.Chunk(2))
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)}, slice.Chunk(5))
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)}, slice.Chunk(10))
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0), NewFloatSliceV(2.0), NewFloatSliceV(3.0), NewFloatSliceV(4.0), NewFloatSliceV(5.0)}, slice.Chunk(1))
+	}
+
+	// chunks are copies
+	{
+		slice := NewFloatSliceV(1.0, 2.0)
+		chunks := slice.Chunk(1)
+		chunks[0].Set(0, 3.0)
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0), slice)
+	}
+}
+
 // Clear
 //--------------------------------------------------------------------------------------------------
 
@@ -681,6 +842,39 @@ func TestFloatSlice_Clear(t *testing.T) {
 	}
 }
 
+// Combinations
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Combinations() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0)
+	fmt.Println(slice.Combinations(2))
+	// Output: [[1.000000 2.000000] [1.000000 3.000000] [2.000000 3.000000]]
+}
+
+func TestFloatSlice_Combinations(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, []ISlice{}, slice.Combinations(1))
+		assert.Equal(t, []ISlice{}, NewFloatSliceV().Combinations(1))
+	}
+
+	// out of range
+	assert.Equal(t, []ISlice{}, NewFloatSliceV(1.0, 2.0).Combinations(-1))
+	assert.Equal(t, []ISlice{}, NewFloatSliceV(1.0, 2.0).Combinations(3))
+
+	// combinations
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0)
+		assert.Equal(t, 1, len(slice.Combinations(0)))
+		assert.Equal(t, 0, slice.Combinations(0)[0].Len())
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0), NewFloatSliceV(2.0), NewFloatSliceV(3.0), NewFloatSliceV(4.0)}, slice.Combinations(1))
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0, 2.0), NewFloatSliceV(1.0, 3.0), NewFloatSliceV(1.0, 4.0), NewFloatSliceV(2.0, 3.0), NewFloatSliceV(2.0, 4.0), NewFloatSliceV(3.0, 4.0)}, slice.Combinations(2))
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0, 2.0, 3.0), NewFloatSliceV(1.0, 2.0, 4.0), NewFloatSliceV(1.0, 3.0, 4.0), NewFloatSliceV(2.0, 3.0, 4.0)}, slice.Combinations(3))
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0, 2.0, 3.0, 4.0)}, slice.Combinations(4))
+	}
+}
+
 // Concat
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Concat_Go(t *testing.B) {
@@ -1046,6 +1240,78 @@ func TestFloatSlice_CountW(t *testing.T) {
 	assert.Equal(t, 1, NewFloatSliceV(1, 2, 3).CountW(func(x O) bool { return ExB(x.(float64) == 4 || x.(float64) == 3) }))
 }
 
+// CumSum
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_CumSum() {
+	fmt.Println(NewFloatSliceV(1.5, 2.5, 3).CumSum())
+	// Output: [1.500000 4.000000 7.000000]
+}
+
+func TestFloatSlice_CumSum(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, NewFloatSliceV(), slice.CumSum())
+
+	original := NewFloatSliceV(1.0, -2.0, 3.0)
+	assert.Equal(t, NewFloatSliceV(1.0, -1.0, 2.0), original.CumSum())
+	assert.Equal(t, NewFloatSliceV(1.0, -2.0, 3.0), original)
+}
+
+// DeepCopy
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_DeepCopy() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0)
+	fmt.Println(slice.DeepCopy())
+	// Output: [1.000000 2.000000 3.000000]
+}
+
+func TestFloatSlice_DeepCopy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, NewFloatSliceV(), slice.DeepCopy())
+	}
+
+	// copies are independent of the original
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0)
+		copy := slice.DeepCopy()
+		assert.Equal(t, slice, copy)
+		copy.Set(0, 3.0)
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0, 3.0), slice)
+	}
+}
+
+// DeepEqual
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_DeepEqual() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0)
+	fmt.Println(slice.DeepEqual([]float64{3.0, 2.0, 1.0}, IgnoreOrderOpt(true)))
+	// Output: true
+}
+
+func TestFloatSlice_DeepEqual(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.True(t, slice.DeepEqual(nil))
+		assert.False(t, slice.DeepEqual(NewFloatSliceV(1.0)))
+		assert.True(t, NewFloatSliceV().DeepEqual([]float64{}))
+	}
+
+	// compare with options
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0)
+		assert.True(t, slice.DeepEqual(NewFloatSliceV(1.0, 2.0, 3.0)))
+		assert.True(t, slice.DeepEqual([]float64{1.0, 2.0, 3.0}))
+		assert.False(t, slice.DeepEqual([]float64{3.0, 2.0, 1.0}))
+		assert.True(t, slice.DeepEqual([]float64{3.0, 2.0, 1.0}, IgnoreOrderOpt(true)))
+		assert.False(t, slice.DeepEqual([]float64{1.0, 2.0, 1.0}))
+		assert.True(t, slice.DeepEqual([]float64{1.0, 2.0, 1.0}, IgnorePathsOpt("[2]")))
+	}
+}
+
 // Drop
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Drop_Go(t *testing.B) {
@@ -1821,6 +2087,36 @@ func TestFloatSlice_EachRIE(t *testing.T) {
 	}
 }
 
+// EachSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_EachSlice() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	slice.EachSlice(2, func(x ISlice) {
+		fmt.Print(x)
+	})
+	// Output: [1.000000 2.000000][3.000000 4.000000][5.000000]
+}
+
+func TestFloatSlice_EachSlice(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		called := false
+		slice.EachSlice(2, func(x ISlice) { called = true })
+		NewFloatSliceV().EachSlice(2, func(x ISlice) { called = true })
+		assert.False(t, called)
+	}
+
+	// slices
+	{
+		slices := []ISlice{}
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0), slice.EachSlice(2, func(x ISlice) { slices = append(slices, x) }))
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0, 2.0), NewFloatSliceV(3.0, 4.0), NewFloatSliceV(5.0)}, slices)
+	}
+}
+
 // Empty
 //--------------------------------------------------------------------------------------------------
 func ExampleFloatSlice_Empty() {
@@ -1926,6 +2222,33 @@ func TestFloatSlice_FirstN(t *testing.T) {
 	assert.Equal(t, NewFloatSliceV(1, 2), NewFloatSliceV(1, 2, 3).FirstN(2))
 }
 
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Flatten() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0)
+	fmt.Println(slice.Flatten())
+	// Output: [1.000000 2.000000 3.000000]
+}
+
+func TestFloatSlice_Flatten(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, NewFloatSliceV(), slice.Flatten())
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().Flatten())
+	}
+
+	// copy
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0)
+		flat := slice.Flatten()
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0, 3.0), flat)
+		flat.Set(0, 0.0)
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0, 3.0), slice)
+	}
+}
+
 // G
 //--------------------------------------------------------------------------------------------------
 func ExampleFloatSlice_G() {
@@ -1946,6 +2269,56 @@ func ExampleFloatSlice_Generic() {
 	// Output: false
 }
 
+// HasNaN
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_HasNaN() {
+	fmt.Println(NewFloatSliceV(1.0, math.NaN()).HasNaN())
+	// Output: true
+}
+
+func TestFloatSlice_HasNaN(t *testing.T) {
+	var slice *FloatSlice
+	assert.False(t, slice.HasNaN())
+	assert.False(t, NewFloatSliceV(1.0, math.Inf(1)).HasNaN())
+	assert.True(t, NewFloatSliceV(1.0, math.NaN()).HasNaN())
+}
+
+// Hash
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Hash() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0)
+	fmt.Println(slice.Hash() == NewFloatSliceV(1.0, 2.0, 3.0).Hash())
+	// Output: true
+}
+
+func TestFloatSlice_Hash(t *testing.T) {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0)
+	assert.Equal(t, slice.Hash(), slice.DeepCopy().Hash())
+	assert.Equal(t, slice.Hash(), Hash([]float64{1.0, 2.0, 3.0}))
+	assert.NotEqual(t, slice.Hash(), NewFloatSliceV(3.0, 2.0, 1.0).Hash())
+}
+
+// Histogram
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Histogram() {
+	fmt.Println(NewFloatSliceV(0.5, 1.0, 1.5, 2.5).Histogram(2))
+	// Output: [{0.5 1.5 2} {1.5 2.5 2}]
+}
+
+func TestFloatSlice_Histogram(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, []stats.Bucket{{}}, slice.Histogram(1))
+
+	assert.Equal(t, []stats.Bucket{{Min: 0, Max: 1, Count: 1}, {Min: 1, Max: 2, Count: 0}, {Min: 2, Max: 3, Count: 2}}, NewFloatSliceV(0.0, 2.0, 3.0).Histogram(3))
+
+	_, err := NewFloatSliceV(1.0, math.NaN()).HistogramE(2)
+	assert.Equal(t, "failed to create histogram for data containing NaN", err.Error())
+	assert.Nil(t, NewFloatSliceV(1.0, math.NaN()).Histogram(2))
+
+	// skip NaN
+	assert.Equal(t, []stats.Bucket{{Min: 1, Max: 1, Count: 1}}, NewFloatSliceV(1.0, math.NaN()).SkipNaN().Histogram(1))
+}
+
 // Index
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Index_Go(t *testing.B) {
@@ -1990,6 +2363,27 @@ func TestFloatSlice_Index(t *testing.T) {
 	}
 }
 
+// Inject
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Inject() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	fmt.Println(slice.Inject(func(acc, x O) O { return acc.(float64) + x.(float64) }, 10.0))
+	// Output: 25
+}
+
+func TestFloatSlice_Inject(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 10.0, slice.Inject(func(acc, x O) O { return acc.(float64) + x.(float64) }, 10.0).O())
+		assert.Equal(t, 10.0, NewFloatSliceV().Inject(func(acc, x O) O { return acc.(float64) + x.(float64) }, 10.0).O())
+	}
+
+	// inject
+	assert.Equal(t, 25.0, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Inject(func(acc, x O) O { return acc.(float64) + x.(float64) }, 10.0).O())
+}
+
 // Insert
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Insert_Go(t *testing.B) {
@@ -2120,6 +2514,59 @@ func TestFloatSlice_Insert(t *testing.T) {
 	}
 }
 
+// IsSorted
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_IsSorted() {
+	slice := NewFloatSliceV(1, 3, 5)
+	fmt.Println(slice.IsSorted())
+	// Output: true
+}
+
+func TestFloatSlice_IsSorted(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.True(t, slice.IsSorted())
+		assert.True(t, NewFloatSliceV().IsSorted())
+	}
+
+	// sorted
+	assert.True(t, NewFloatSliceV(1, 3, 5).IsSorted())
+	assert.True(t, NewFloatSliceV(1, 3, 5).SortReverse().Reverse().IsSorted())
+
+	// not sorted
+	assert.False(t, NewFloatSliceV(5, 3, 1).IsSorted())
+	assert.False(t, NewFloatSliceV(3, -1, 2.5, -3).IsSorted())
+}
+
+// IsSortedWith
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_IsSortedWith() {
+	slice := NewFloatSliceV(5, 3, 1)
+	fmt.Println(slice.IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+	// Output: true
+}
+
+func TestFloatSlice_IsSortedWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.True(t, slice.IsSortedWith(Compare))
+		assert.True(t, NewFloatSliceV().IsSortedWith(Compare))
+	}
+
+	// sorted
+	assert.True(t, NewFloatSliceV(1, 3, 5).IsSortedWith(Compare))
+	assert.True(t, NewFloatSliceV(5, 3, 1).IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+	assert.True(t, NewFloatSliceV(3, -1, 2.5, -3).SortBy(func(x O) O { return math.Abs(x.(float64)) }).IsSortedWith(By(func(x O) O { return math.Abs(x.(float64)) }).Compare))
+
+	// not sorted
+	assert.False(t, NewFloatSliceV(5, 3, 1).IsSortedWith(Compare))
+	assert.False(t, NewFloatSliceV(1, 3, 5).IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+}
+
 // Join
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Join_Go(t *testing.B) {
@@ -2306,63 +2753,255 @@ func TestFloatSlice_Less(t *testing.T) {
 	assert.Equal(t, true, NewFloatSliceV(0, 1, 2).Less(1, 2))
 }
 
-// Nil
+// MarshalJSON
 //--------------------------------------------------------------------------------------------------
-func ExampleFloatSlice_Nil() {
-	var slice *FloatSlice
-	fmt.Println(slice.Nil())
-	// Output: true
-}
+func TestFloatSlice_MarshalJSON(t *testing.T) {
 
-func TestFloatSlice_Nil(t *testing.T) {
-	var slice *FloatSlice
-	assert.True(t, slice.Nil())
-	assert.False(t, NewFloatSliceV().Nil())
-	assert.False(t, NewFloatSliceV(1, 2, 3).Nil())
-}
+	// round trip
+	{
+		data, err := NewFloatSliceV(1.5, -2.0, 3.25).MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, `[1.5,-2,3.25]`, string(data))
 
-// O
-//--------------------------------------------------------------------------------------------------
-func ExampleFloatSlice_O() {
-	fmt.Println(NewFloatSliceV(1, 2, 3))
-	// Output: [1.000000 2.000000 3.000000]
-}
+		x := NewFloatSliceV()
+		assert.Nil(t, x.UnmarshalJSON(data))
+		assert.Equal(t, NewFloatSliceV(1.5, -2.0, 3.25), x)
+	}
 
-func TestFloatSlice_O(t *testing.T) {
-	assert.Equal(t, []float64{}, (*FloatSlice)(nil).O())
-	assert.Equal(t, []float64{}, NewFloatSliceV().O())
-	assert.Equal(t, NewFloatSliceV(1, 2, 3), NewFloatSliceV(1, 2, 3))
+	// as a field in a struct
+	{
+		type config struct {
+			Data *FloatSlice `json:"data"`
+		}
+		data, err := json.Marshal(config{Data: NewFloatSliceV(1.5, -2.0, 3.25)})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"data":[1.5,-2,3.25]}`, string(data))
+
+		var c config
+		assert.Nil(t, json.Unmarshal(data, &c))
+		assert.Equal(t, NewFloatSliceV(1.5, -2.0, 3.25), c.Data)
+	}
+
+	// invalid
+	assert.NotNil(t, NewFloatSliceV().UnmarshalJSON([]byte(`["a"]`)))
 }
 
-// Pair
+// MarshalMsgpack
 //--------------------------------------------------------------------------------------------------
+func TestFloatSlice_MarshalMsgpack(t *testing.T) {
+	data, err := NewFloatSliceV(1.5, -2.0, 3.25).MarshalMsgpack()
+	assert.Nil(t, err)
 
-func ExampleFloatSlice_Pair() {
-	slice := NewFloatSliceV(1, 2)
-	first, second := slice.Pair()
-	fmt.Println(first, second)
-	// Output: 1 2
+	slice := NewFloatSliceV()
+	assert.Nil(t, slice.UnmarshalMsgpack(data))
+	assert.Equal(t, []float64{1.5, -2.0, 3.25}, slice.O())
 }
 
-func TestFloatSlice_Pair(t *testing.T) {
+// MarshalYAML
+//--------------------------------------------------------------------------------------------------
+func TestFloatSlice_MarshalYAML(t *testing.T) {
 
-	// nil
+	// round trip
 	{
-		first, second := (*FloatSlice)(nil).Pair()
-		assert.Equal(t, Obj(nil), first)
-		assert.Equal(t, Obj(nil), second)
+		data, err := yaml.Marshal(NewFloatSliceV(1.5, -2.0, 3.25))
+		assert.Nil(t, err)
+		assert.Equal(t, "- 1.5\n- -2\n- 3.25\n", string(data))
+
+		x := NewFloatSliceV()
+		assert.Nil(t, yaml.Unmarshal(data, x))
+		assert.Equal(t, NewFloatSliceV(1.5, -2.0, 3.25), x)
 	}
 
-	// two values
+	// as a field in a struct
 	{
-		first, second := NewFloatSliceV(1, 2).Pair()
-		assert.Equal(t, Obj(1.0), first)
-		assert.Equal(t, Obj(2.0), second)
+		type config struct {
+			Data *FloatSlice `yaml:"data"`
+		}
+		data, err := yaml.Marshal(config{Data: NewFloatSliceV(1.5, -2.0, 3.25)})
+		assert.Nil(t, err)
+
+		var c config
+		assert.Nil(t, yaml.Unmarshal(data, &c))
+		assert.Equal(t, NewFloatSliceV(1.5, -2.0, 3.25), c.Data)
 	}
 
-	// one value
-	{
-		first, second := NewFloatSliceV(1).Pair()
+	// invalid
+	assert.NotNil(t, yaml.Unmarshal([]byte("a: 1"), NewFloatSliceV()))
+}
+
+
+// Max
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Max() {
+	fmt.Println(NewFloatSliceV(1.5, 3.5, 2.5).Max())
+	// Output: 3.5
+}
+
+func TestFloatSlice_Max(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, 0.0, slice.Max())
+	_, err := slice.MaxE()
+	assert.Equal(t, "empty data set", err.Error())
+
+	assert.Equal(t, math.Inf(1), NewFloatSliceV(1.0, math.Inf(1)).Max())
+
+	// NaN policies
+	nans := NewFloatSliceV(1.0, math.NaN(), 3.0)
+	assert.True(t, math.IsNaN(nans.Max()))
+	_, err = nans.MaxE()
+	assert.Equal(t, "slice contains NaN", err.Error())
+	assert.Equal(t, 3.0, nans.SkipNaN().Max())
+}
+
+// Mean
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Mean() {
+	fmt.Println(NewFloatSliceV(1.0, 2.0, 4.5).Mean())
+	// Output: 2.5
+}
+
+func TestFloatSlice_Mean(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, 0.0, slice.Mean())
+	_, err := slice.MeanE()
+	assert.Equal(t, "empty data set", err.Error())
+
+	// NaN policies
+	nans := NewFloatSliceV(1.0, math.NaN(), 3.0)
+	assert.True(t, math.IsNaN(nans.Mean()))
+	val, err := nans.MeanE()
+	assert.Equal(t, "slice contains NaN", err.Error())
+	assert.True(t, math.IsNaN(val))
+	assert.Equal(t, 2.0, nans.SkipNaN().Mean())
+}
+
+// Median
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Median() {
+	fmt.Println(NewFloatSliceV(4.0, 1.0, 3.0, 2.0).Median())
+	// Output: 2.5
+}
+
+func TestFloatSlice_Median(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, 0.0, slice.Median())
+
+	assert.Equal(t, 2.0, NewFloatSliceV(3.0, 1.0, 2.0).Median())
+
+	// NaN policies
+	nans := NewFloatSliceV(1.0, math.NaN(), 3.0)
+	assert.True(t, math.IsNaN(nans.Median()))
+	_, err := nans.MedianE()
+	assert.Equal(t, "slice contains NaN", err.Error())
+	assert.Equal(t, 2.0, nans.SkipNaN().Median())
+}
+
+// Min
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Min() {
+	fmt.Println(NewFloatSliceV(1.5, 3.5, 2.5).Min())
+	// Output: 1.5
+}
+
+func TestFloatSlice_Min(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, 0.0, slice.Min())
+	_, err := slice.MinE()
+	assert.Equal(t, "empty data set", err.Error())
+
+	assert.Equal(t, math.Inf(-1), NewFloatSliceV(1.0, math.Inf(-1)).Min())
+
+	// NaN policies
+	nans := NewFloatSliceV(1.0, math.NaN(), 3.0)
+	assert.True(t, math.IsNaN(nans.Min()))
+	_, err = nans.MinE()
+	assert.Equal(t, "slice contains NaN", err.Error())
+	assert.Equal(t, 1.0, nans.SkipNaN().Min())
+}
+
+// Mode
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Mode() {
+	fmt.Println(NewFloatSliceV(1.5, 2.0, 1.5).Mode())
+	// Output: [1.500000]
+}
+
+func TestFloatSlice_Mode(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, NewFloatSliceV(), slice.Mode())
+	assert.Equal(t, NewFloatSliceV(1.0, 2.0), NewFloatSliceV(2.0, 1.0, math.NaN(), math.NaN()).Mode())
+}
+
+// Nil
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Nil() {
+	var slice *FloatSlice
+	fmt.Println(slice.Nil())
+	// Output: true
+}
+
+func TestFloatSlice_Nil(t *testing.T) {
+	var slice *FloatSlice
+	assert.True(t, slice.Nil())
+	assert.False(t, NewFloatSliceV().Nil())
+	assert.False(t, NewFloatSliceV(1, 2, 3).Nil())
+}
+
+// Normalize
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Normalize() {
+	fmt.Println(NewFloatSliceV(-1.0, 0.0, 3.0).Normalize())
+	// Output: [0.000000 0.250000 1.000000]
+}
+
+func TestFloatSlice_Normalize(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, NewFloatSliceV(), slice.Normalize())
+	assert.Equal(t, NewFloatSliceV(0.0, 0.5, 1.0), NewFloatSliceV(1.0, 1.5, 2.0).Normalize())
+}
+
+// O
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_O() {
+	fmt.Println(NewFloatSliceV(1, 2, 3))
+	// Output: [1.000000 2.000000 3.000000]
+}
+
+func TestFloatSlice_O(t *testing.T) {
+	assert.Equal(t, []float64{}, (*FloatSlice)(nil).O())
+	assert.Equal(t, []float64{}, NewFloatSliceV().O())
+	assert.Equal(t, NewFloatSliceV(1, 2, 3), NewFloatSliceV(1, 2, 3))
+}
+
+// Pair
+//--------------------------------------------------------------------------------------------------
+
+func ExampleFloatSlice_Pair() {
+	slice := NewFloatSliceV(1, 2)
+	first, second := slice.Pair()
+	fmt.Println(first, second)
+	// Output: 1 2
+}
+
+func TestFloatSlice_Pair(t *testing.T) {
+
+	// nil
+	{
+		first, second := (*FloatSlice)(nil).Pair()
+		assert.Equal(t, Obj(nil), first)
+		assert.Equal(t, Obj(nil), second)
+	}
+
+	// two values
+	{
+		first, second := NewFloatSliceV(1, 2).Pair()
+		assert.Equal(t, Obj(1.0), first)
+		assert.Equal(t, Obj(2.0), second)
+	}
+
+	// one value
+	{
+		first, second := NewFloatSliceV(1).Pair()
 		assert.Equal(t, Obj(1.0), first)
 		assert.Equal(t, Obj(nil), second)
 	}
@@ -2375,6 +3014,97 @@ func TestFloatSlice_Pair(t *testing.T) {
 	}
 }
 
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Partition() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	fmt.Println(slice.Partition(func(x O) bool { return int(x.(float64))%2 == 1 }))
+	// Output: [1.000000 3.000000 5.000000] [2.000000 4.000000]
+}
+
+func TestFloatSlice_Partition(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		match, rest := slice.Partition(func(x O) bool { return int(x.(float64))%2 == 1 })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// partition
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		match, rest := slice.Partition(func(x O) bool { return int(x.(float64))%2 == 1 })
+		assert.Equal(t, NewFloatSliceV(1.0, 3.0, 5.0), match)
+		assert.Equal(t, NewFloatSliceV(2.0, 4.0), rest)
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0), slice)
+	}
+
+	// all or nothing
+	{
+		match, rest := NewFloatSliceV(1.0, 2.0).Partition(func(x O) bool { return true })
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0), match)
+		assert.Equal(t, 0, rest.Len())
+	}
+}
+
+// Percentile
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Percentile() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0)
+	fmt.Println(slice.Percentile(50), slice.Percentile(50, stats.Higher))
+	// Output: 2.5 3
+}
+
+func TestFloatSlice_Percentile(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, 0.0, slice.Percentile(50))
+
+	assert.InDelta(t, 0.19, NewFloatSliceV(0.1, 0.2).Percentile(90), 1e-9)
+
+	// NaN policies
+	nans := NewFloatSliceV(1.0, math.NaN(), 3.0)
+	assert.True(t, math.IsNaN(nans.Percentile(50)))
+	_, err := nans.PercentileE(50)
+	assert.Equal(t, "slice contains NaN", err.Error())
+	assert.Equal(t, 3.0, nans.SkipNaN().Percentile(100))
+
+	_, err = NewFloatSliceV(1.0).PercentileE(-5)
+	assert.Equal(t, "percentile -5 is out of range [0, 100]", err.Error())
+}
+
+// Permutations
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Permutations() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0)
+	fmt.Println(slice.Permutations(2))
+	// Output: [[1.000000 2.000000] [1.000000 3.000000] [2.000000 1.000000] [2.000000 3.000000] [3.000000 1.000000] [3.000000 2.000000]]
+}
+
+func TestFloatSlice_Permutations(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, []ISlice{}, slice.Permutations(1))
+		assert.Equal(t, []ISlice{}, NewFloatSliceV().Permutations(1))
+	}
+
+	// out of range
+	assert.Equal(t, []ISlice{}, NewFloatSliceV(1.0, 2.0).Permutations(-1))
+	assert.Equal(t, []ISlice{}, NewFloatSliceV(1.0, 2.0).Permutations(3))
+
+	// permutations
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0)
+		assert.Equal(t, 1, len(slice.Permutations(0)))
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0), NewFloatSliceV(2.0), NewFloatSliceV(3.0)}, slice.Permutations(1))
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0, 2.0), NewFloatSliceV(1.0, 3.0), NewFloatSliceV(2.0, 1.0), NewFloatSliceV(2.0, 3.0), NewFloatSliceV(3.0, 1.0), NewFloatSliceV(3.0, 2.0)}, slice.Permutations(2))
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0, 2.0, 3.0), NewFloatSliceV(1.0, 3.0, 2.0), NewFloatSliceV(2.0, 1.0, 3.0), NewFloatSliceV(2.0, 3.0, 1.0), NewFloatSliceV(3.0, 1.0, 2.0), NewFloatSliceV(3.0, 2.0, 1.0)}, slice.Permutations(3))
+	}
+}
+
 // Pop
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Pop_Go(t *testing.B) {
@@ -2526,6 +3256,52 @@ func TestFloatSlice_Prepend(t *testing.T) {
 	}
 }
 
+// Product
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Product() {
+	fmt.Println(NewFloatSliceV(1.5, 2.0, 3.0).Product())
+	// Output: 9
+}
+
+func TestFloatSlice_Product(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, 1.0, slice.Product())
+
+	nans := NewFloatSliceV(2.0, math.NaN())
+	assert.True(t, math.IsNaN(nans.Product()))
+	_, err := nans.ProductE()
+	assert.Equal(t, "slice contains NaN", err.Error())
+	assert.Equal(t, 2.0, nans.SkipNaN().Product())
+}
+
+// Reduce
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Reduce() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	fmt.Println(slice.Reduce(func(acc, x O) O { return acc.(float64) + x.(float64) }))
+	// Output: 15
+}
+
+func TestFloatSlice_Reduce(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Nil(t, slice.Reduce(func(acc, x O) O { return acc.(float64) + x.(float64) }).O())
+		assert.Nil(t, NewFloatSliceV().Reduce(func(acc, x O) O { return acc.(float64) + x.(float64) }).O())
+		assert.Equal(t, 10.0, NewFloatSliceV().Reduce(func(acc, x O) O { return acc.(float64) + x.(float64) }, 10.0).O())
+	}
+
+	// single element
+	assert.Equal(t, 1.0, NewFloatSliceV(1.0).Reduce(func(acc, x O) O { return acc.(float64) + x.(float64) }).O())
+
+	// first element is the initial value
+	assert.Equal(t, 15.0, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Reduce(func(acc, x O) O { return acc.(float64) + x.(float64) }).O())
+
+	// initial value
+	assert.Equal(t, 25.0, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Reduce(func(acc, x O) O { return acc.(float64) + x.(float64) }, 10.0).O())
+}
+
 // Reverse
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Reverse_Go(t *testing.B) {
@@ -2626,6 +3402,161 @@ func TestFloatSlice_ReverseM(t *testing.T) {
 	}
 }
 
+// Rotate
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Rotate() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	fmt.Println(slice.Rotate(2))
+	// Output: [3.000000 4.000000 5.000000 1.000000 2.000000]
+}
+
+func TestFloatSlice_Rotate(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, NewFloatSliceV(), slice.Rotate(1))
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().Rotate(1))
+	}
+
+	// rotate
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0), slice.Rotate(0))
+		assert.Equal(t, NewFloatSliceV(2.0, 3.0, 4.0, 5.0, 1.0), slice.Rotate(1))
+		assert.Equal(t, NewFloatSliceV(3.0, 4.0, 5.0, 1.0, 2.0), slice.Rotate(2))
+		assert.Equal(t, NewFloatSliceV(3.0, 4.0, 5.0, 1.0, 2.0), slice.Rotate(7))
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0), slice.Rotate(5))
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0), slice)
+	}
+
+	// negative
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		assert.Equal(t, NewFloatSliceV(5.0, 1.0, 2.0, 3.0, 4.0), slice.Rotate(-1))
+		assert.Equal(t, NewFloatSliceV(4.0, 5.0, 1.0, 2.0, 3.0), slice.Rotate(-7))
+	}
+}
+
+// RotateM
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_RotateM() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	fmt.Println(slice.RotateM(-1))
+	// Output: [5.000000 1.000000 2.000000 3.000000 4.000000]
+}
+
+func TestFloatSlice_RotateM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, (*FloatSlice)(nil), slice.RotateM(1))
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().RotateM(1))
+	}
+
+	// rotate
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		assert.Equal(t, NewFloatSliceV(3.0, 4.0, 5.0, 1.0, 2.0), slice.RotateM(2))
+		assert.Equal(t, NewFloatSliceV(3.0, 4.0, 5.0, 1.0, 2.0), slice)
+		assert.Equal(t, NewFloatSliceV(2.0, 3.0, 4.0, 5.0, 1.0), slice.RotateM(-1))
+		assert.Equal(t, NewFloatSliceV(2.0, 3.0, 4.0, 5.0, 1.0), slice)
+	}
+}
+
+// Sample
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Sample() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	fmt.Println(slice.Sample(2, rand.New(rand.NewSource(1))).Len())
+	// Output: 2
+}
+
+func TestFloatSlice_Sample(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0, slice.Sample(2).Len())
+		assert.Equal(t, 0, NewFloatSliceV().Sample(2).Len())
+	}
+
+	// invalid size
+	assert.Equal(t, 0, NewFloatSliceV(1.0, 2.0).Sample(0).Len())
+	assert.Equal(t, 0, NewFloatSliceV(1.0, 2.0).Sample(-1).Len())
+
+	// unique elements
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		sample := slice.Sample(3)
+		assert.Equal(t, 3, sample.Len())
+		sample.Each(func(x O) {
+			assert.Equal(t, 1, sample.CountW(func(y O) bool { return Compare(x, y) == 0 }))
+			assert.True(t, slice.AnyW(func(y O) bool { return Compare(x, y) == 0 }))
+		})
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0), slice)
+	}
+
+	// all elements
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		sample := slice.Sample(10)
+		assert.Equal(t, 5, sample.Len())
+		assert.True(t, sample.All(1.0, 2.0, 3.0, 4.0, 5.0))
+	}
+
+	// seeded
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		assert.Equal(t, slice.Sample(3, rand.New(rand.NewSource(2))), slice.Sample(3, rand.New(rand.NewSource(2))))
+	}
+}
+
+// Scale
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Scale() {
+	fmt.Println(NewFloatSliceV(1.0, 2.5).Scale(2))
+	// Output: [2.000000 5.000000]
+}
+
+func TestFloatSlice_Scale(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, NewFloatSliceV(), slice.Scale(2))
+
+	original := NewFloatSliceV(1.0, -2.0)
+	assert.Equal(t, NewFloatSliceV(0.5, -1.0), original.Scale(0.5))
+	assert.Equal(t, NewFloatSliceV(1.0, -2.0), original)
+}
+
+// Scan
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Scan() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	fmt.Println(slice.Scan(func(acc, x O) O { return acc.(float64) + x.(float64) }))
+	// Output: [1.000000 3.000000 6.000000 10.000000 15.000000]
+}
+
+func TestFloatSlice_Scan(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0, slice.Scan(func(acc, x O) O { return acc.(float64) + x.(float64) }).Len())
+		assert.Equal(t, 0, NewFloatSliceV().Scan(func(acc, x O) O { return acc.(float64) + x.(float64) }, 10.0).Len())
+	}
+
+	// first element is the initial value
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		assert.Equal(t, NewFloatSliceV(1.0, 3.0, 6.0, 10.0, 15.0), slice.Scan(func(acc, x O) O { return acc.(float64) + x.(float64) }))
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0), slice)
+	}
+
+	// initial value
+	assert.Equal(t, NewFloatSliceV(11.0, 13.0, 16.0, 20.0, 25.0), NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Scan(func(acc, x O) O { return acc.(float64) + x.(float64) }, 10.0))
+}
+
 // Select
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Select_Go(t *testing.B) {
@@ -2961,6 +3892,66 @@ func TestFloatSlice_ShiftN(t *testing.T) {
 	}
 }
 
+// Shuffle
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Shuffle() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	fmt.Println(slice.Shuffle().Len())
+	// Output: 5
+}
+
+func TestFloatSlice_Shuffle(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, NewFloatSliceV(), slice.Shuffle())
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().Shuffle())
+	}
+
+	// shuffle
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		shuffled := slice.Shuffle()
+		assert.Equal(t, 5, shuffled.Len())
+		assert.True(t, shuffled.All(1.0, 2.0, 3.0, 4.0, 5.0))
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0), slice)
+	}
+
+	// seeded
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		assert.Equal(t, slice.Shuffle(rand.New(rand.NewSource(2))), slice.Shuffle(rand.New(rand.NewSource(2))))
+	}
+}
+
+// ShuffleM
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_ShuffleM() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	fmt.Println(slice.ShuffleM().Len())
+	// Output: 5
+}
+
+func TestFloatSlice_ShuffleM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, (*FloatSlice)(nil), slice.ShuffleM())
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().ShuffleM())
+	}
+
+	// shuffle
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		shuffled := slice.ShuffleM(rand.New(rand.NewSource(2)))
+		assert.Equal(t, slice, shuffled)
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).ShuffleM(rand.New(rand.NewSource(2))), slice)
+		assert.True(t, slice.All(1.0, 2.0, 3.0, 4.0, 5.0))
+	}
+}
+
 // Single
 //--------------------------------------------------------------------------------------------------
 
@@ -2977,6 +3968,23 @@ func TestFloatSlice_ShiftN(t *testing.T) {
 // 	assert.Equal(t, false, NewFloatSliceV(1, 2).Single())
 // }
 
+// SkipNaN
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_SkipNaN() {
+	fmt.Println(NewFloatSliceV(1.0, math.NaN(), 2.0).SkipNaN())
+	// Output: [1.000000 2.000000]
+}
+
+func TestFloatSlice_SkipNaN(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, NewFloatSliceV(), slice.SkipNaN())
+	assert.Equal(t, NewFloatSliceV(), NewFloatSliceV(math.NaN()).SkipNaN())
+
+	original := NewFloatSliceV(math.NaN(), 1.0)
+	assert.Equal(t, NewFloatSliceV(1.0), original.SkipNaN())
+	assert.Equal(t, 2, original.Len())
+}
+
 // Slice
 //--------------------------------------------------------------------------------------------------
 func BenchmarkFloatSlice_Slice_Go(t *testing.B) {
@@ -3124,6 +4132,116 @@ func TestFloatSlice_Sort(t *testing.T) {
 	}
 }
 
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_SortBy() {
+	slice := NewFloatSliceV(3, -1, 2.5, -3)
+	fmt.Println(slice.SortBy(func(x O) O { return math.Abs(x.(float64)) }))
+	// Output: [-1.000000 2.500000 3.000000 -3.000000]
+}
+
+func TestFloatSlice_SortBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, NewFloatSliceV(), slice.SortBy(func(x O) O { return math.Abs(x.(float64)) }))
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().SortBy(func(x O) O { return math.Abs(x.(float64)) }))
+	}
+
+	// new Slice
+	{
+		slice := NewFloatSliceV(3, -1, 2.5, -3)
+		sorted := slice.SortBy(func(x O) O { return math.Abs(x.(float64)) })
+		assert.Equal(t, NewFloatSliceV(-1, 2.5, 3, -3), sorted)
+		assert.Equal(t, NewFloatSliceV(3, -1, 2.5, -3), slice)
+	}
+
+	// stable
+	{
+		slice := NewFloatSliceV(3, -1, 2.5, -3)
+		assert.Equal(t, NewFloatSliceV(3, -1, 2.5, -3), slice.SortBy(func(x O) O { return 0 }))
+	}
+}
+
+// SortByDesc
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_SortByDesc() {
+	slice := NewFloatSliceV(3, -1, 2.5, -3)
+	fmt.Println(slice.SortByDesc(func(x O) O { return math.Abs(x.(float64)) }))
+	// Output: [3.000000 -3.000000 2.500000 -1.000000]
+}
+
+func TestFloatSlice_SortByDesc(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, NewFloatSliceV(), slice.SortByDesc(func(x O) O { return math.Abs(x.(float64)) }))
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().SortByDesc(func(x O) O { return math.Abs(x.(float64)) }))
+	}
+
+	// new Slice
+	{
+		slice := NewFloatSliceV(3, -1, 2.5, -3)
+		sorted := slice.SortByDesc(func(x O) O { return math.Abs(x.(float64)) })
+		assert.Equal(t, NewFloatSliceV(3, -3, 2.5, -1), sorted)
+		assert.Equal(t, NewFloatSliceV(3, -1, 2.5, -3), slice)
+	}
+}
+
+// SortByDescM
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_SortByDescM() {
+	slice := NewFloatSliceV(3, -1, 2.5, -3)
+	fmt.Println(slice.SortByDescM(func(x O) O { return math.Abs(x.(float64)) }))
+	// Output: [3.000000 -3.000000 2.500000 -1.000000]
+}
+
+func TestFloatSlice_SortByDescM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, (*FloatSlice)(nil), slice.SortByDescM(func(x O) O { return math.Abs(x.(float64)) }))
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().SortByDescM(func(x O) O { return math.Abs(x.(float64)) }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewFloatSliceV(3, -1, 2.5, -3)
+		sorted := slice.SortByDescM(func(x O) O { return math.Abs(x.(float64)) })
+		assert.Equal(t, NewFloatSliceV(3, -3, 2.5, -1), sorted)
+		assert.Equal(t, NewFloatSliceV(3, -3, 2.5, -1), slice)
+	}
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_SortByM() {
+	slice := NewFloatSliceV(3, -1, 2.5, -3)
+	fmt.Println(slice.SortByM(func(x O) O { return math.Abs(x.(float64)) }))
+	// Output: [-1.000000 2.500000 3.000000 -3.000000]
+}
+
+func TestFloatSlice_SortByM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, (*FloatSlice)(nil), slice.SortByM(func(x O) O { return math.Abs(x.(float64)) }))
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().SortByM(func(x O) O { return math.Abs(x.(float64)) }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewFloatSliceV(3, -1, 2.5, -3)
+		sorted := slice.SortByM(func(x O) O { return math.Abs(x.(float64)) })
+		assert.Equal(t, NewFloatSliceV(-1, 2.5, 3, -3), sorted)
+		assert.Equal(t, NewFloatSliceV(-1, 2.5, 3, -3), slice)
+	}
+}
+
 // SortM
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_SortM_Go(t *testing.B) {
@@ -3244,6 +4362,83 @@ func TestFloatSlice_SortReverseM(t *testing.T) {
 	}
 }
 
+// SortWith
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_SortWith() {
+	slice := NewFloatSliceV(3, -1, 2.5, -3)
+	fmt.Println(slice.SortWith(func(a, b O) int { return Compare(b, a) }))
+	// Output: [3.000000 2.500000 -1.000000 -3.000000]
+}
+
+func TestFloatSlice_SortWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, NewFloatSliceV(), slice.SortWith(func(a, b O) int { return Compare(b, a) }))
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().SortWith(func(a, b O) int { return Compare(b, a) }))
+	}
+
+	// new Slice
+	{
+		slice := NewFloatSliceV(3, -1, 2.5, -3)
+		sorted := slice.SortWith(func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, NewFloatSliceV(3, 2.5, -1, -3), sorted)
+		assert.Equal(t, NewFloatSliceV(3, -1, 2.5, -3), slice)
+	}
+
+	// ordering
+	{
+		slice := NewFloatSliceV(3, -1, 2.5, -3)
+		assert.Equal(t, NewFloatSliceV(-1, 2.5, 3, -3), slice.SortWith(By(func(x O) O { return math.Abs(x.(float64)) }).Compare))
+		assert.Equal(t, NewFloatSliceV(3, -3, 2.5, -1), slice.SortWith(ByDesc(func(x O) O { return math.Abs(x.(float64)) }).Compare))
+	}
+}
+
+// SortWithM
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_SortWithM() {
+	slice := NewFloatSliceV(3, -1, 2.5, -3)
+	fmt.Println(slice.SortWithM(func(a, b O) int { return Compare(b, a) }))
+	// Output: [3.000000 2.500000 -1.000000 -3.000000]
+}
+
+func TestFloatSlice_SortWithM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, (*FloatSlice)(nil), slice.SortWithM(func(a, b O) int { return Compare(b, a) }))
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().SortWithM(func(a, b O) int { return Compare(b, a) }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewFloatSliceV(3, -1, 2.5, -3)
+		sorted := slice.SortWithM(func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, NewFloatSliceV(3, 2.5, -1, -3), sorted)
+		assert.Equal(t, NewFloatSliceV(3, 2.5, -1, -3), slice)
+	}
+}
+
+// StdDev
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_StdDev() {
+	fmt.Println(NewFloatSliceV(1.0, 3.0).StdDev())
+	// Output: 1
+}
+
+func TestFloatSlice_StdDev(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, 0.0, slice.StdDev())
+
+	nans := NewFloatSliceV(1.0, math.NaN(), 3.0)
+	assert.True(t, math.IsNaN(nans.StdDev()))
+	_, err := nans.StdDevE()
+	assert.Equal(t, "slice contains NaN", err.Error())
+	assert.Equal(t, 1.0, nans.SkipNaN().StdDev())
+}
+
 // String
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_String_Go(t *testing.B) {
@@ -3287,6 +4482,28 @@ func TestFloatSlice_String(t *testing.T) {
 	}
 }
 
+// Sum
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Sum() {
+	fmt.Println(NewFloatSliceV(1.5, 2.0, 3.0).Sum())
+	// Output: 6.5
+}
+
+func TestFloatSlice_Sum(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, 0.0, slice.Sum())
+
+	// compensated summation
+	assert.Equal(t, 0.6, NewFloatSliceV(0.1, 0.2, 0.3).Sum())
+
+	// NaN policies
+	nans := NewFloatSliceV(1.0, math.NaN(), 3.0)
+	assert.True(t, math.IsNaN(nans.Sum()))
+	_, err := nans.SumE()
+	assert.Equal(t, "slice contains NaN", err.Error())
+	assert.Equal(t, 4.0, nans.SkipNaN().Sum())
+}
+
 // Swap
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Swap_Go(t *testing.B) {
@@ -3971,3 +5188,84 @@ func TestFloatSlice_UniqM(t *testing.T) {
 		assert.Equal(t, NewFloatSliceV(1, 2, 3, 4), uniq)
 	}
 }
+
+// Variance
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Variance() {
+	fmt.Println(NewFloatSliceV(1.0, 3.0).Variance())
+	// Output: 1
+}
+
+func TestFloatSlice_Variance(t *testing.T) {
+	var slice *FloatSlice
+	assert.Equal(t, 0.0, slice.Variance())
+	_, err := slice.VarianceE()
+	assert.Equal(t, "empty data set", err.Error())
+
+	nans := NewFloatSliceV(1.0, math.NaN(), 3.0)
+	assert.True(t, math.IsNaN(nans.Variance()))
+	_, err = nans.VarianceE()
+	assert.Equal(t, "slice contains NaN", err.Error())
+	assert.Equal(t, 1.0, nans.SkipNaN().Variance())
+}
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Window() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0)
+	fmt.Println(slice.Window(2, 1))
+	// Output: [[1.000000 2.000000] [2.000000 3.000000] [3.000000 4.000000]]
+}
+
+func TestFloatSlice_Window(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, []ISlice{}, slice.Window(2, 1))
+		assert.Equal(t, []ISlice{}, NewFloatSliceV().Window(2, 1))
+	}
+
+	// invalid size or step
+	assert.Equal(t, []ISlice{}, NewFloatSliceV(1.0, 2.0).Window(0, 1))
+	assert.Equal(t, []ISlice{}, NewFloatSliceV(1.0, 2.0).Window(1, 0))
+	assert.Equal(t, []ISlice{}, NewFloatSliceV(1.0, 2.0).Window(3, 1))
+
+	// windows
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0, 2.0), NewFloatSliceV(2.0, 3.0), NewFloatSliceV(3.0, 4.0), NewFloatSliceV(4.0, 5.0)}, slice.Window(2, 1))
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0, 2.0, 3.0), NewFloatSliceV(3.0, 4.0, 5.0)}, slice.Window(3, 2))
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0, 2.0), NewFloatSliceV(4.0, 5.0)}, slice.Window(2, 3))
+		assert.Equal(t, []ISlice{NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)}, slice.Window(5, 1))
+	}
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Zip() {
+	slice := NewFloatSliceV(1.0, 2.0)
+	fmt.Println(slice.Zip([]string{"x", "y"}))
+	// Output: [[1 x] [2 y]]
+}
+
+func TestFloatSlice_Zip(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, []ISlice{}, slice.Zip([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewFloatSliceV().Zip([]string{"x"}))
+	}
+
+	// no slices
+	assert.Equal(t, []ISlice{NewInterSliceV(1.0), NewInterSliceV(2.0)}, NewFloatSliceV(1.0, 2.0).Zip())
+
+	// stops at the shortest slice
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0)
+		assert.Equal(t, []ISlice{NewInterSliceV(1.0, "x", 7), NewInterSliceV(2.0, "y", 8)},
+			slice.Zip([]string{"x", "y", "z"}, NewIntSliceV(7, 8)))
+		assert.Equal(t, []ISlice{}, slice.Zip([]int{}))
+	}
+}
@@ -2,10 +2,15 @@ package n
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
 	"sort"
 	"strings"
 	"testing"
 
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/phR0ze/n/pkg/stats"
+	yaml "github.com/phR0ze/yaml/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -657,6 +662,162 @@ func TestIntSlice_At(t *testing.T) {
 	}
 }
 
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_BinarySearch() {
+	slice := NewIntSliceV(1, 3, 5)
+	fmt.Println(slice.BinarySearch(3))
+	// Output: 1 true
+}
+
+func TestIntSlice_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		i, found := slice.BinarySearch(3)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = NewIntSliceV().BinarySearch(3)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		slice := NewIntSliceV(1, 3, 5)
+		i, found := slice.BinarySearch(1)
+		assert.Equal(t, 0, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearch(3)
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+	}
+
+	// not found returns the insertion index
+	{
+		slice := NewIntSliceV(1, 3, 5)
+		i, found := slice.BinarySearch(4)
+		assert.Equal(t, 2, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch(6)
+		assert.Equal(t, 3, i)
+		assert.False(t, found)
+	}
+
+	// converts the element
+	{
+		slice := NewIntSliceV(1, 3, 5)
+		i, found := slice.BinarySearch("5")
+		assert.Equal(t, 2, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearch("foo")
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// BinarySearchWith
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_BinarySearchWith() {
+	slice := NewIntSliceV(5, 3, 1)
+	fmt.Println(slice.BinarySearchWith(3, func(a, b O) int { return Compare(b, a) }))
+	// Output: 1 true
+}
+
+func TestIntSlice_BinarySearchWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		i, found := slice.BinarySearchWith(3, Compare)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// descending
+	{
+		slice := NewIntSliceV(5, 3, 1)
+		i, found := slice.BinarySearchWith(3, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearchWith(4, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 1, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearchWith(6, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// Cartesian
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Cartesian() {
+	slice := NewIntSliceV(1, 2)
+	fmt.Println(slice.Cartesian([]string{"x", "y"}))
+	// Output: [[1 x] [1 y] [2 x] [2 y]]
+}
+
+func TestIntSlice_Cartesian(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, []ISlice{}, slice.Cartesian([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewIntSliceV().Cartesian([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewIntSliceV(1).Cartesian([]string{}))
+	}
+
+	// no slices
+	assert.Equal(t, []ISlice{NewInterSliceV(1), NewInterSliceV(2)}, NewIntSliceV(1, 2).Cartesian())
+
+	// multiple slices
+	{
+		tuples := NewIntSliceV(1, 2).Cartesian([]string{"x", "y"}, NewIntSliceV(7))
+		assert.Equal(t, []ISlice{NewInterSliceV(1, "x", 7), NewInterSliceV(1, "y", 7),
+			NewInterSliceV(2, "x", 7), NewInterSliceV(2, "y", 7)}, tuples)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Chunk() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Chunk(2))
+	// Output: [[1 2] [3 4] [5]]
+}
+
+func TestIntSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, []ISlice{}, slice.Chunk(2))
+		assert.Equal(t, []ISlice{}, NewIntSliceV().Chunk(2))
+	}
+
+	// invalid size
+	assert.Equal(t, []ISlice{}, NewIntSliceV(1, 2).Chunk(0))
+	assert.Equal(t, []ISlice{}, NewIntSliceV(1, 2).Chunk(-1))
+
+	// chunks
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, []ISlice{NewIntSliceV(1, 2), NewIntSliceV(3, 4), NewIntSliceV(5)}, slice.Chunk(2))
+		assert.Equal(t, []ISlice{NewIntSliceV(1, 2, 3, 4, 5)}, slice.Chunk(5))
+		assert.Equal(t, []ISlice{NewIntSliceV(1, 2, 3, 4, 5)}, slice.Chunk(10))
+		assert.Equal(t, []ISlice{NewIntSliceV(1), NewIntSliceV(2), NewIntSliceV(3), NewIntSliceV(4), NewIntSliceV(5)}, slice.Chunk(1))
+	}
+
+	// chunks are copies
+	{
+		slice := NewIntSliceV(1, 2)
+		chunks := slice.Chunk(1)
+		chunks[0].Set(0, 3)
+		assert.Equal(t, NewIntSliceV(1, 2), slice)
+	}
+}
+
 // Clear
 //--------------------------------------------------------------------------------------------------
 
@@ -684,6 +845,39 @@ func TestIntSlice_Clear(t *testing.T) {
 	}
 }
 
+// Combinations
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Combinations() {
+	slice := NewIntSliceV(1, 2, 3)
+	fmt.Println(slice.Combinations(2))
+	// Output: [[1 2] [1 3] [2 3]]
+}
+
+func TestIntSlice_Combinations(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, []ISlice{}, slice.Combinations(1))
+		assert.Equal(t, []ISlice{}, NewIntSliceV().Combinations(1))
+	}
+
+	// out of range
+	assert.Equal(t, []ISlice{}, NewIntSliceV(1, 2).Combinations(-1))
+	assert.Equal(t, []ISlice{}, NewIntSliceV(1, 2).Combinations(3))
+
+	// combinations
+	{
+		slice := NewIntSliceV(1, 2, 3, 4)
+		assert.Equal(t, 1, len(slice.Combinations(0)))
+		assert.Equal(t, 0, slice.Combinations(0)[0].Len())
+		assert.Equal(t, []ISlice{NewIntSliceV(1), NewIntSliceV(2), NewIntSliceV(3), NewIntSliceV(4)}, slice.Combinations(1))
+		assert.Equal(t, []ISlice{NewIntSliceV(1, 2), NewIntSliceV(1, 3), NewIntSliceV(1, 4), NewIntSliceV(2, 3), NewIntSliceV(2, 4), NewIntSliceV(3, 4)}, slice.Combinations(2))
+		assert.Equal(t, []ISlice{NewIntSliceV(1, 2, 3), NewIntSliceV(1, 2, 4), NewIntSliceV(1, 3, 4), NewIntSliceV(2, 3, 4)}, slice.Combinations(3))
+		assert.Equal(t, []ISlice{NewIntSliceV(1, 2, 3, 4)}, slice.Combinations(4))
+	}
+}
+
 // Concat
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Concat_Go(t *testing.B) {
@@ -1051,6 +1245,93 @@ func TestIntSlice_CountW(t *testing.T) {
 	assert.Equal(t, 1, NewIntSliceV(1, 2, 3).CountW(func(x O) bool { return ExB(x.(int) == 4 || x.(int) == 3) }))
 }
 
+// CumSum
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_CumSum() {
+	fmt.Println(NewIntSliceV(1, 2, 3).CumSum())
+	// Output: [1 3 6]
+}
+
+func TestIntSlice_CumSum(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, NewIntSliceV(), slice.CumSum())
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().CumSum())
+	}
+
+	// running totals
+	{
+		slice := NewIntSliceV(1, -2, 3)
+		assert.Equal(t, NewIntSliceV(1, -1, 2), slice.CumSum())
+		assert.Equal(t, NewIntSliceV(1, -2, 3), slice)
+	}
+
+	// overflow
+	{
+		val, err := NewIntSliceV(math.MaxInt, 1).CumSumE()
+		assert.Equal(t, "integer overflow", err.Error())
+		assert.Equal(t, math.MaxInt, (*val)[0])
+	}
+}
+
+// DeepCopy
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_DeepCopy() {
+	slice := NewIntSliceV(1, 2, 3)
+	fmt.Println(slice.DeepCopy())
+	// Output: [1 2 3]
+}
+
+func TestIntSlice_DeepCopy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, NewIntSliceV(), slice.DeepCopy())
+	}
+
+	// copies are independent of the original
+	{
+		slice := NewIntSliceV(1, 2, 3)
+		copy := slice.DeepCopy()
+		assert.Equal(t, slice, copy)
+		copy.Set(0, 3)
+		assert.Equal(t, NewIntSliceV(1, 2, 3), slice)
+	}
+}
+
+// DeepEqual
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_DeepEqual() {
+	slice := NewIntSliceV(1, 2, 3)
+	fmt.Println(slice.DeepEqual([]int{3, 2, 1}, IgnoreOrderOpt(true)))
+	// Output: true
+}
+
+func TestIntSlice_DeepEqual(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.True(t, slice.DeepEqual(nil))
+		assert.False(t, slice.DeepEqual(NewIntSliceV(1)))
+		assert.True(t, NewIntSliceV().DeepEqual([]int{}))
+	}
+
+	// compare with options
+	{
+		slice := NewIntSliceV(1, 2, 3)
+		assert.True(t, slice.DeepEqual(NewIntSliceV(1, 2, 3)))
+		assert.True(t, slice.DeepEqual([]int{1, 2, 3}))
+		assert.False(t, slice.DeepEqual([]int{3, 2, 1}))
+		assert.True(t, slice.DeepEqual([]int{3, 2, 1}, IgnoreOrderOpt(true)))
+		assert.False(t, slice.DeepEqual([]int{1, 2, 1}))
+		assert.True(t, slice.DeepEqual([]int{1, 2, 1}, IgnorePathsOpt("[2]")))
+	}
+}
+
 // Drop
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Drop_Go(t *testing.B) {
@@ -1826,6 +2107,36 @@ func TestIntSlice_EachRIE(t *testing.T) {
 	}
 }
 
+// EachSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_EachSlice() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	slice.EachSlice(2, func(x ISlice) {
+		fmt.Print(x)
+	})
+	// Output: [1 2][3 4][5]
+}
+
+func TestIntSlice_EachSlice(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		called := false
+		slice.EachSlice(2, func(x ISlice) { called = true })
+		NewIntSliceV().EachSlice(2, func(x ISlice) { called = true })
+		assert.False(t, called)
+	}
+
+	// slices
+	{
+		slices := []ISlice{}
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewIntSliceV(1, 2, 3, 4, 5), slice.EachSlice(2, func(x ISlice) { slices = append(slices, x) }))
+		assert.Equal(t, []ISlice{NewIntSliceV(1, 2), NewIntSliceV(3, 4), NewIntSliceV(5)}, slices)
+	}
+}
+
 // Empty
 //--------------------------------------------------------------------------------------------------
 func ExampleIntSlice_Empty() {
@@ -1931,6 +2242,33 @@ func TestIntSlice_FirstN(t *testing.T) {
 	assert.Equal(t, NewIntSliceV(1, 2), NewIntSliceV(1, 2, 3).FirstN(2))
 }
 
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Flatten() {
+	slice := NewIntSliceV(1, 2, 3)
+	fmt.Println(slice.Flatten())
+	// Output: [1 2 3]
+}
+
+func TestIntSlice_Flatten(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, NewIntSliceV(), slice.Flatten())
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().Flatten())
+	}
+
+	// copy
+	{
+		slice := NewIntSliceV(1, 2, 3)
+		flat := slice.Flatten()
+		assert.Equal(t, NewIntSliceV(1, 2, 3), flat)
+		flat.Set(0, 0)
+		assert.Equal(t, NewIntSliceV(1, 2, 3), slice)
+	}
+}
+
 // G
 //--------------------------------------------------------------------------------------------------
 func ExampleIntSlice_G() {
@@ -1951,6 +2289,38 @@ func ExampleIntSlice_Generic() {
 	// Output: false
 }
 
+// Hash
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Hash() {
+	slice := NewIntSliceV(1, 2, 3)
+	fmt.Println(slice.Hash() == NewIntSliceV(1, 2, 3).Hash())
+	// Output: true
+}
+
+func TestIntSlice_Hash(t *testing.T) {
+	slice := NewIntSliceV(1, 2, 3)
+	assert.Equal(t, slice.Hash(), slice.DeepCopy().Hash())
+	assert.Equal(t, slice.Hash(), Hash([]int{1, 2, 3}))
+	assert.NotEqual(t, slice.Hash(), NewIntSliceV(3, 2, 1).Hash())
+}
+
+// Histogram
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Histogram() {
+	fmt.Println(NewIntSliceV(1, 2, 2, 3, 4, 5).Histogram(2))
+	// Output: [{1 3 3} {3 5 3}]
+}
+
+func TestIntSlice_Histogram(t *testing.T) {
+	var slice *IntSlice
+	assert.Equal(t, []stats.Bucket{{}, {}}, slice.Histogram(2))
+
+	assert.Equal(t, []stats.Bucket{{Min: 0, Max: 5, Count: 2}, {Min: 5, Max: 10, Count: 3}}, NewIntSliceV(0, 4, 5, 9, 10).Histogram(2))
+
+	_, err := NewIntSliceV(1).HistogramE(0)
+	assert.Equal(t, "invalid number of histogram buckets 0", err.Error())
+}
+
 // Index
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Index_Go(t *testing.B) {
@@ -1995,6 +2365,27 @@ func TestIntSlice_Index(t *testing.T) {
 	}
 }
 
+// Inject
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Inject() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Inject(func(acc, x O) O { return acc.(int) + x.(int) }, 10))
+	// Output: 25
+}
+
+func TestIntSlice_Inject(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 10, slice.Inject(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+		assert.Equal(t, 10, NewIntSliceV().Inject(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+	}
+
+	// inject
+	assert.Equal(t, 25, NewIntSliceV(1, 2, 3, 4, 5).Inject(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+}
+
 // Insert
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Insert_Go(t *testing.B) {
@@ -2125,6 +2516,59 @@ func TestIntSlice_Insert(t *testing.T) {
 	}
 }
 
+// IsSorted
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_IsSorted() {
+	slice := NewIntSliceV(1, 3, 5)
+	fmt.Println(slice.IsSorted())
+	// Output: true
+}
+
+func TestIntSlice_IsSorted(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.True(t, slice.IsSorted())
+		assert.True(t, NewIntSliceV().IsSorted())
+	}
+
+	// sorted
+	assert.True(t, NewIntSliceV(1, 3, 5).IsSorted())
+	assert.True(t, NewIntSliceV(1, 3, 5).SortReverse().Reverse().IsSorted())
+
+	// not sorted
+	assert.False(t, NewIntSliceV(5, 3, 1).IsSorted())
+	assert.False(t, NewIntSliceV(3, -1, 2, -3).IsSorted())
+}
+
+// IsSortedWith
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_IsSortedWith() {
+	slice := NewIntSliceV(5, 3, 1)
+	fmt.Println(slice.IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+	// Output: true
+}
+
+func TestIntSlice_IsSortedWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.True(t, slice.IsSortedWith(Compare))
+		assert.True(t, NewIntSliceV().IsSortedWith(Compare))
+	}
+
+	// sorted
+	assert.True(t, NewIntSliceV(1, 3, 5).IsSortedWith(Compare))
+	assert.True(t, NewIntSliceV(5, 3, 1).IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+	assert.True(t, NewIntSliceV(3, -1, 2, -3).SortBy(func(x O) O { return x.(int) * x.(int) }).IsSortedWith(By(func(x O) O { return x.(int) * x.(int) }).Compare))
+
+	// not sorted
+	assert.False(t, NewIntSliceV(5, 3, 1).IsSortedWith(Compare))
+	assert.False(t, NewIntSliceV(1, 3, 5).IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+}
+
 // Join
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Join_Go(t *testing.B) {
@@ -2311,45 +2755,228 @@ func TestIntSlice_Less(t *testing.T) {
 	assert.Equal(t, true, NewIntSliceV(0, 1, 2).Less(1, 2))
 }
 
-// Nil
+// MarshalJSON
 //--------------------------------------------------------------------------------------------------
-func ExampleIntSlice_Nil() {
-	var slice *IntSlice
-	fmt.Println(slice.Nil())
-	// Output: true
-}
+func TestIntSlice_MarshalJSON(t *testing.T) {
 
-func TestIntSlice_Nil(t *testing.T) {
-	var slice *IntSlice
-	assert.True(t, slice.Nil())
-	assert.False(t, NewIntSliceV().Nil())
-	assert.False(t, NewIntSliceV(1, 2, 3).Nil())
-}
+	// round trip
+	{
+		data, err := NewIntSliceV(1, -2, 300).MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, `[1,-2,300]`, string(data))
 
-// O
-//--------------------------------------------------------------------------------------------------
-func ExampleIntSlice_O() {
-	fmt.Println(NewIntSliceV(1, 2, 3))
-	// Output: [1 2 3]
-}
+		x := NewIntSliceV()
+		assert.Nil(t, x.UnmarshalJSON(data))
+		assert.Equal(t, NewIntSliceV(1, -2, 300), x)
+	}
 
-func TestIntSlice_O(t *testing.T) {
-	assert.Equal(t, []int{}, (*IntSlice)(nil).O())
-	assert.Equal(t, []int{}, NewIntSliceV().O())
-	assert.Equal(t, NewIntSliceV(1, 2, 3), NewIntSliceV(1, 2, 3))
-}
+	// as a field in a struct
+	{
+		type config struct {
+			Data *IntSlice `json:"data"`
+		}
+		data, err := json.Marshal(config{Data: NewIntSliceV(1, -2, 300)})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"data":[1,-2,300]}`, string(data))
 
-// Pair
-//--------------------------------------------------------------------------------------------------
+		var c config
+		assert.Nil(t, json.Unmarshal(data, &c))
+		assert.Equal(t, NewIntSliceV(1, -2, 300), c.Data)
+	}
 
-func ExampleIntSlice_Pair() {
-	slice := NewIntSliceV(1, 2)
-	first, second := slice.Pair()
-	fmt.Println(first, second)
-	// Output: 1 2
+	// invalid
+	assert.NotNil(t, NewIntSliceV().UnmarshalJSON([]byte(`"a"`)))
 }
 
-func TestIntSlice_Pair(t *testing.T) {
+// MarshalMsgpack
+//--------------------------------------------------------------------------------------------------
+func TestIntSlice_MarshalMsgpack(t *testing.T) {
+	data, err := NewIntSliceV(1, -2, 300).MarshalMsgpack()
+	assert.Nil(t, err)
+
+	slice := NewIntSliceV()
+	assert.Nil(t, slice.UnmarshalMsgpack(data))
+	assert.Equal(t, []int{1, -2, 300}, slice.O())
+
+	// nil
+	var nilSlice *IntSlice
+	data, err = nilSlice.MarshalMsgpack()
+	assert.Nil(t, err)
+	assert.Nil(t, slice.UnmarshalMsgpack(data))
+	assert.Equal(t, []int{}, slice.O())
+
+	// invalid
+	assert.NotNil(t, slice.UnmarshalMsgpack([]byte{0xa1, 'a'}))
+}
+
+// MarshalYAML
+//--------------------------------------------------------------------------------------------------
+func TestIntSlice_MarshalYAML(t *testing.T) {
+
+	// round trip
+	{
+		data, err := yaml.Marshal(NewIntSliceV(1, -2, 300))
+		assert.Nil(t, err)
+		assert.Equal(t, "- 1\n- -2\n- 300\n", string(data))
+
+		x := NewIntSliceV()
+		assert.Nil(t, yaml.Unmarshal(data, x))
+		assert.Equal(t, NewIntSliceV(1, -2, 300), x)
+	}
+
+	// as a field in a struct
+	{
+		type config struct {
+			Data *IntSlice `yaml:"data"`
+		}
+		data, err := yaml.Marshal(config{Data: NewIntSliceV(1, -2, 300)})
+		assert.Nil(t, err)
+
+		var c config
+		assert.Nil(t, yaml.Unmarshal(data, &c))
+		assert.Equal(t, NewIntSliceV(1, -2, 300), c.Data)
+	}
+
+	// invalid
+	assert.NotNil(t, yaml.Unmarshal([]byte("a: 1"), NewIntSliceV()))
+}
+
+
+// Max
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Max() {
+	fmt.Println(NewIntSliceV(3, 5, 1).Max())
+	// Output: 5
+}
+
+func TestIntSlice_Max(t *testing.T) {
+	var slice *IntSlice
+	assert.Equal(t, 0, slice.Max())
+	_, err := slice.MaxE()
+	assert.Equal(t, "empty data set", err.Error())
+
+	assert.Equal(t, -1, NewIntSliceV(-3, -1, -2).Max())
+	assert.Equal(t, math.MaxInt, NewIntSliceV(1, math.MaxInt).Max())
+}
+
+// Mean
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Mean() {
+	fmt.Println(NewIntSliceV(1, 2, 3, 4).Mean())
+	// Output: 2.5
+}
+
+func TestIntSlice_Mean(t *testing.T) {
+	var slice *IntSlice
+	assert.Equal(t, 0.0, slice.Mean())
+	_, err := slice.MeanE()
+	assert.Equal(t, "empty data set", err.Error())
+
+	assert.Equal(t, 2.0, NewIntSliceV(1, 2, 3).Mean())
+
+	// no overflow
+	assert.Equal(t, float64(math.MaxInt), NewIntSliceV(math.MaxInt, math.MaxInt).Mean())
+}
+
+// Median
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Median() {
+	fmt.Println(NewIntSliceV(5, 1, 3).Median())
+	// Output: 3
+}
+
+func TestIntSlice_Median(t *testing.T) {
+	var slice *IntSlice
+	assert.Equal(t, 0.0, slice.Median())
+	_, err := slice.MedianE()
+	assert.Equal(t, "empty data set", err.Error())
+
+	assert.Equal(t, 2.5, NewIntSliceV(4, 1, 3, 2).Median())
+}
+
+// Min
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Min() {
+	fmt.Println(NewIntSliceV(3, 5, 1).Min())
+	// Output: 1
+}
+
+func TestIntSlice_Min(t *testing.T) {
+	var slice *IntSlice
+	assert.Equal(t, 0, slice.Min())
+	_, err := slice.MinE()
+	assert.Equal(t, "empty data set", err.Error())
+
+	assert.Equal(t, -3, NewIntSliceV(-3, -1, -2).Min())
+	assert.Equal(t, math.MinInt, NewIntSliceV(1, math.MinInt).Min())
+}
+
+// Mode
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Mode() {
+	fmt.Println(NewIntSliceV(3, 1, 3, 2, 1).Mode())
+	// Output: [1 3]
+}
+
+func TestIntSlice_Mode(t *testing.T) {
+	var slice *IntSlice
+	assert.Equal(t, NewIntSliceV(), slice.Mode())
+	assert.Equal(t, NewIntSliceV(2), NewIntSliceV(1, 2, 2, 3).Mode())
+}
+
+// Nil
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Nil() {
+	var slice *IntSlice
+	fmt.Println(slice.Nil())
+	// Output: true
+}
+
+func TestIntSlice_Nil(t *testing.T) {
+	var slice *IntSlice
+	assert.True(t, slice.Nil())
+	assert.False(t, NewIntSliceV().Nil())
+	assert.False(t, NewIntSliceV(1, 2, 3).Nil())
+}
+
+// Normalize
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Normalize() {
+	fmt.Println(NewIntSliceV(2, 3, 6).Normalize())
+	// Output: [0.000000 0.250000 1.000000]
+}
+
+func TestIntSlice_Normalize(t *testing.T) {
+	var slice *IntSlice
+	assert.Equal(t, NewFloatSliceV(), slice.Normalize())
+	assert.Equal(t, NewFloatSliceV(0.0, 0.5, 1.0), NewIntSliceV(10, 15, 20).Normalize())
+	assert.Equal(t, NewFloatSliceV(0.0, 0.0), NewIntSliceV(7, 7).Normalize())
+}
+
+// O
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_O() {
+	fmt.Println(NewIntSliceV(1, 2, 3))
+	// Output: [1 2 3]
+}
+
+func TestIntSlice_O(t *testing.T) {
+	assert.Equal(t, []int{}, (*IntSlice)(nil).O())
+	assert.Equal(t, []int{}, NewIntSliceV().O())
+	assert.Equal(t, NewIntSliceV(1, 2, 3), NewIntSliceV(1, 2, 3))
+}
+
+// Pair
+//--------------------------------------------------------------------------------------------------
+
+func ExampleIntSlice_Pair() {
+	slice := NewIntSliceV(1, 2)
+	first, second := slice.Pair()
+	fmt.Println(first, second)
+	// Output: 1 2
+}
+
+func TestIntSlice_Pair(t *testing.T) {
 
 	// nil
 	{
@@ -2380,6 +3007,98 @@ func TestIntSlice_Pair(t *testing.T) {
 	}
 }
 
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Partition() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Partition(func(x O) bool { return x.(int)%2 == 1 }))
+	// Output: [1 3 5] [2 4]
+}
+
+func TestIntSlice_Partition(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		match, rest := slice.Partition(func(x O) bool { return x.(int)%2 == 1 })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// partition
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		match, rest := slice.Partition(func(x O) bool { return x.(int)%2 == 1 })
+		assert.Equal(t, NewIntSliceV(1, 3, 5), match)
+		assert.Equal(t, NewIntSliceV(2, 4), rest)
+		assert.Equal(t, NewIntSliceV(1, 2, 3, 4, 5), slice)
+	}
+
+	// all or nothing
+	{
+		match, rest := NewIntSliceV(1, 2).Partition(func(x O) bool { return true })
+		assert.Equal(t, NewIntSliceV(1, 2), match)
+		assert.Equal(t, 0, rest.Len())
+	}
+}
+
+// Percentile
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Percentile() {
+	slice := NewIntSliceV(1, 2, 3, 4)
+	fmt.Println(slice.Percentile(40), slice.Percentile(40, stats.Nearest))
+	// Output: 2.2 2
+}
+
+func TestIntSlice_Percentile(t *testing.T) {
+	var slice *IntSlice
+	assert.Equal(t, 0.0, slice.Percentile(50))
+	_, err := slice.PercentileE(50)
+	assert.Equal(t, "empty data set", err.Error())
+
+	latencies := NewIntSliceV(12, 15, 11, 90, 14, 13, 16, 12, 11, 200)
+	assert.Equal(t, 13.5, latencies.Percentile(50))
+	assert.InDelta(t, 101.0, latencies.Percentile(90), 1e-9)
+	assert.Equal(t, 90.0, latencies.Percentile(90, stats.Lower))
+	assert.Equal(t, 200.0, latencies.Percentile(90, stats.Higher))
+	assert.Equal(t, 145.0, latencies.Percentile(90, stats.Midpoint))
+	assert.Equal(t, 200.0, latencies.Percentile(100))
+
+	_, err = latencies.PercentileE(101)
+	assert.Equal(t, "percentile 101 is out of range [0, 100]", err.Error())
+}
+
+// Permutations
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Permutations() {
+	slice := NewIntSliceV(1, 2, 3)
+	fmt.Println(slice.Permutations(2))
+	// Output: [[1 2] [1 3] [2 1] [2 3] [3 1] [3 2]]
+}
+
+func TestIntSlice_Permutations(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, []ISlice{}, slice.Permutations(1))
+		assert.Equal(t, []ISlice{}, NewIntSliceV().Permutations(1))
+	}
+
+	// out of range
+	assert.Equal(t, []ISlice{}, NewIntSliceV(1, 2).Permutations(-1))
+	assert.Equal(t, []ISlice{}, NewIntSliceV(1, 2).Permutations(3))
+
+	// permutations
+	{
+		slice := NewIntSliceV(1, 2, 3)
+		assert.Equal(t, 1, len(slice.Permutations(0)))
+		assert.Equal(t, []ISlice{NewIntSliceV(1), NewIntSliceV(2), NewIntSliceV(3)}, slice.Permutations(1))
+		assert.Equal(t, []ISlice{NewIntSliceV(1, 2), NewIntSliceV(1, 3), NewIntSliceV(2, 1), NewIntSliceV(2, 3), NewIntSliceV(3, 1), NewIntSliceV(3, 2)}, slice.Permutations(2))
+		assert.Equal(t, []ISlice{NewIntSliceV(1, 2, 3), NewIntSliceV(1, 3, 2), NewIntSliceV(2, 1, 3), NewIntSliceV(2, 3, 1), NewIntSliceV(3, 1, 2), NewIntSliceV(3, 2, 1)}, slice.Permutations(3))
+	}
+}
+
 // Pop
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Pop_Go(t *testing.B) {
@@ -2531,6 +3250,50 @@ func TestIntSlice_Prepend(t *testing.T) {
 	}
 }
 
+// Product
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Product() {
+	fmt.Println(NewIntSliceV(2, 3, 4).Product())
+	// Output: 24
+}
+
+func TestIntSlice_Product(t *testing.T) {
+	var slice *IntSlice
+	assert.Equal(t, 1, slice.Product())
+	assert.Equal(t, -6, NewIntSliceV(1, -2, 3).Product())
+
+	_, err := NewIntSliceV(math.MaxInt, 2).ProductE()
+	assert.Equal(t, "integer overflow", err.Error())
+}
+
+// Reduce
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Reduce() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Reduce(func(acc, x O) O { return acc.(int) + x.(int) }))
+	// Output: 15
+}
+
+func TestIntSlice_Reduce(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Nil(t, slice.Reduce(func(acc, x O) O { return acc.(int) + x.(int) }).O())
+		assert.Nil(t, NewIntSliceV().Reduce(func(acc, x O) O { return acc.(int) + x.(int) }).O())
+		assert.Equal(t, 10, NewIntSliceV().Reduce(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+	}
+
+	// single element
+	assert.Equal(t, 1, NewIntSliceV(1).Reduce(func(acc, x O) O { return acc.(int) + x.(int) }).O())
+
+	// first element is the initial value
+	assert.Equal(t, 15, NewIntSliceV(1, 2, 3, 4, 5).Reduce(func(acc, x O) O { return acc.(int) + x.(int) }).O())
+
+	// initial value
+	assert.Equal(t, 25, NewIntSliceV(1, 2, 3, 4, 5).Reduce(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+}
+
 // Reverse
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Reverse_Go(t *testing.B) {
@@ -2631,6 +3394,164 @@ func TestIntSlice_ReverseM(t *testing.T) {
 	}
 }
 
+// Rotate
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Rotate() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Rotate(2))
+	// Output: [3 4 5 1 2]
+}
+
+func TestIntSlice_Rotate(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, NewIntSliceV(), slice.Rotate(1))
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().Rotate(1))
+	}
+
+	// rotate
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewIntSliceV(1, 2, 3, 4, 5), slice.Rotate(0))
+		assert.Equal(t, NewIntSliceV(2, 3, 4, 5, 1), slice.Rotate(1))
+		assert.Equal(t, NewIntSliceV(3, 4, 5, 1, 2), slice.Rotate(2))
+		assert.Equal(t, NewIntSliceV(3, 4, 5, 1, 2), slice.Rotate(7))
+		assert.Equal(t, NewIntSliceV(1, 2, 3, 4, 5), slice.Rotate(5))
+		assert.Equal(t, NewIntSliceV(1, 2, 3, 4, 5), slice)
+	}
+
+	// negative
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewIntSliceV(5, 1, 2, 3, 4), slice.Rotate(-1))
+		assert.Equal(t, NewIntSliceV(4, 5, 1, 2, 3), slice.Rotate(-7))
+	}
+}
+
+// RotateM
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_RotateM() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.RotateM(-1))
+	// Output: [5 1 2 3 4]
+}
+
+func TestIntSlice_RotateM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, (*IntSlice)(nil), slice.RotateM(1))
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().RotateM(1))
+	}
+
+	// rotate
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewIntSliceV(3, 4, 5, 1, 2), slice.RotateM(2))
+		assert.Equal(t, NewIntSliceV(3, 4, 5, 1, 2), slice)
+		assert.Equal(t, NewIntSliceV(2, 3, 4, 5, 1), slice.RotateM(-1))
+		assert.Equal(t, NewIntSliceV(2, 3, 4, 5, 1), slice)
+	}
+}
+
+// Sample
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Sample() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Sample(2, rand.New(rand.NewSource(1))).Len())
+	// Output: 2
+}
+
+func TestIntSlice_Sample(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0, slice.Sample(2).Len())
+		assert.Equal(t, 0, NewIntSliceV().Sample(2).Len())
+	}
+
+	// invalid size
+	assert.Equal(t, 0, NewIntSliceV(1, 2).Sample(0).Len())
+	assert.Equal(t, 0, NewIntSliceV(1, 2).Sample(-1).Len())
+
+	// unique elements
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		sample := slice.Sample(3)
+		assert.Equal(t, 3, sample.Len())
+		sample.Each(func(x O) {
+			assert.Equal(t, 1, sample.CountW(func(y O) bool { return Compare(x, y) == 0 }))
+			assert.True(t, slice.AnyW(func(y O) bool { return Compare(x, y) == 0 }))
+		})
+		assert.Equal(t, NewIntSliceV(1, 2, 3, 4, 5), slice)
+	}
+
+	// all elements
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		sample := slice.Sample(10)
+		assert.Equal(t, 5, sample.Len())
+		assert.True(t, sample.All(1, 2, 3, 4, 5))
+	}
+
+	// seeded
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, slice.Sample(3, rand.New(rand.NewSource(2))), slice.Sample(3, rand.New(rand.NewSource(2))))
+	}
+}
+
+// Scale
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Scale() {
+	fmt.Println(NewIntSliceV(1, 2, 3).Scale(10))
+	// Output: [10 20 30]
+}
+
+func TestIntSlice_Scale(t *testing.T) {
+	var slice *IntSlice
+	assert.Equal(t, NewIntSliceV(), slice.Scale(2))
+
+	original := NewIntSliceV(1, -2)
+	assert.Equal(t, NewIntSliceV(-3, 6), original.Scale(-3))
+	assert.Equal(t, NewIntSliceV(1, -2), original)
+
+	_, err := NewIntSliceV(1, math.MinInt).ScaleE(-1)
+	assert.Equal(t, "integer overflow", err.Error())
+}
+
+// Scan
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Scan() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Scan(func(acc, x O) O { return acc.(int) + x.(int) }))
+	// Output: [1 3 6 10 15]
+}
+
+func TestIntSlice_Scan(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0, slice.Scan(func(acc, x O) O { return acc.(int) + x.(int) }).Len())
+		assert.Equal(t, 0, NewIntSliceV().Scan(func(acc, x O) O { return acc.(int) + x.(int) }, 10).Len())
+	}
+
+	// first element is the initial value
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewIntSliceV(1, 3, 6, 10, 15), slice.Scan(func(acc, x O) O { return acc.(int) + x.(int) }))
+		assert.Equal(t, NewIntSliceV(1, 2, 3, 4, 5), slice)
+	}
+
+	// initial value
+	assert.Equal(t, NewIntSliceV(11, 13, 16, 20, 25), NewIntSliceV(1, 2, 3, 4, 5).Scan(func(acc, x O) O { return acc.(int) + x.(int) }, 10))
+}
+
 // Select
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Select_Go(t *testing.B) {
@@ -2966,6 +3887,66 @@ func TestIntSlice_ShiftN(t *testing.T) {
 	}
 }
 
+// Shuffle
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Shuffle() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Shuffle().Len())
+	// Output: 5
+}
+
+func TestIntSlice_Shuffle(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, NewIntSliceV(), slice.Shuffle())
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().Shuffle())
+	}
+
+	// shuffle
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		shuffled := slice.Shuffle()
+		assert.Equal(t, 5, shuffled.Len())
+		assert.True(t, shuffled.All(1, 2, 3, 4, 5))
+		assert.Equal(t, NewIntSliceV(1, 2, 3, 4, 5), slice)
+	}
+
+	// seeded
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, slice.Shuffle(rand.New(rand.NewSource(2))), slice.Shuffle(rand.New(rand.NewSource(2))))
+	}
+}
+
+// ShuffleM
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_ShuffleM() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.ShuffleM().Len())
+	// Output: 5
+}
+
+func TestIntSlice_ShuffleM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, (*IntSlice)(nil), slice.ShuffleM())
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().ShuffleM())
+	}
+
+	// shuffle
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		shuffled := slice.ShuffleM(rand.New(rand.NewSource(2)))
+		assert.Equal(t, slice, shuffled)
+		assert.Equal(t, NewIntSliceV(1, 2, 3, 4, 5).ShuffleM(rand.New(rand.NewSource(2))), slice)
+		assert.True(t, slice.All(1, 2, 3, 4, 5))
+	}
+}
+
 // Single
 //--------------------------------------------------------------------------------------------------
 
@@ -3129,6 +4110,116 @@ func TestIntSlice_Sort(t *testing.T) {
 	}
 }
 
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_SortBy() {
+	slice := NewIntSliceV(3, -1, 2, -3)
+	fmt.Println(slice.SortBy(func(x O) O { return x.(int) * x.(int) }))
+	// Output: [-1 2 3 -3]
+}
+
+func TestIntSlice_SortBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, NewIntSliceV(), slice.SortBy(func(x O) O { return x.(int) * x.(int) }))
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().SortBy(func(x O) O { return x.(int) * x.(int) }))
+	}
+
+	// new Slice
+	{
+		slice := NewIntSliceV(3, -1, 2, -3)
+		sorted := slice.SortBy(func(x O) O { return x.(int) * x.(int) })
+		assert.Equal(t, NewIntSliceV(-1, 2, 3, -3), sorted)
+		assert.Equal(t, NewIntSliceV(3, -1, 2, -3), slice)
+	}
+
+	// stable
+	{
+		slice := NewIntSliceV(3, -1, 2, -3)
+		assert.Equal(t, NewIntSliceV(3, -1, 2, -3), slice.SortBy(func(x O) O { return 0 }))
+	}
+}
+
+// SortByDesc
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_SortByDesc() {
+	slice := NewIntSliceV(3, -1, 2, -3)
+	fmt.Println(slice.SortByDesc(func(x O) O { return x.(int) * x.(int) }))
+	// Output: [3 -3 2 -1]
+}
+
+func TestIntSlice_SortByDesc(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, NewIntSliceV(), slice.SortByDesc(func(x O) O { return x.(int) * x.(int) }))
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().SortByDesc(func(x O) O { return x.(int) * x.(int) }))
+	}
+
+	// new Slice
+	{
+		slice := NewIntSliceV(3, -1, 2, -3)
+		sorted := slice.SortByDesc(func(x O) O { return x.(int) * x.(int) })
+		assert.Equal(t, NewIntSliceV(3, -3, 2, -1), sorted)
+		assert.Equal(t, NewIntSliceV(3, -1, 2, -3), slice)
+	}
+}
+
+// SortByDescM
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_SortByDescM() {
+	slice := NewIntSliceV(3, -1, 2, -3)
+	fmt.Println(slice.SortByDescM(func(x O) O { return x.(int) * x.(int) }))
+	// Output: [3 -3 2 -1]
+}
+
+func TestIntSlice_SortByDescM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, (*IntSlice)(nil), slice.SortByDescM(func(x O) O { return x.(int) * x.(int) }))
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().SortByDescM(func(x O) O { return x.(int) * x.(int) }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewIntSliceV(3, -1, 2, -3)
+		sorted := slice.SortByDescM(func(x O) O { return x.(int) * x.(int) })
+		assert.Equal(t, NewIntSliceV(3, -3, 2, -1), sorted)
+		assert.Equal(t, NewIntSliceV(3, -3, 2, -1), slice)
+	}
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_SortByM() {
+	slice := NewIntSliceV(3, -1, 2, -3)
+	fmt.Println(slice.SortByM(func(x O) O { return x.(int) * x.(int) }))
+	// Output: [-1 2 3 -3]
+}
+
+func TestIntSlice_SortByM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, (*IntSlice)(nil), slice.SortByM(func(x O) O { return x.(int) * x.(int) }))
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().SortByM(func(x O) O { return x.(int) * x.(int) }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewIntSliceV(3, -1, 2, -3)
+		sorted := slice.SortByM(func(x O) O { return x.(int) * x.(int) })
+		assert.Equal(t, NewIntSliceV(-1, 2, 3, -3), sorted)
+		assert.Equal(t, NewIntSliceV(-1, 2, 3, -3), slice)
+	}
+}
+
 // SortM
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_SortM_Go(t *testing.B) {
@@ -3249,6 +4340,82 @@ func TestIntSlice_SortReverseM(t *testing.T) {
 	}
 }
 
+// SortWith
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_SortWith() {
+	slice := NewIntSliceV(3, -1, 2, -3)
+	fmt.Println(slice.SortWith(func(a, b O) int { return Compare(b, a) }))
+	// Output: [3 2 -1 -3]
+}
+
+func TestIntSlice_SortWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, NewIntSliceV(), slice.SortWith(func(a, b O) int { return Compare(b, a) }))
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().SortWith(func(a, b O) int { return Compare(b, a) }))
+	}
+
+	// new Slice
+	{
+		slice := NewIntSliceV(3, -1, 2, -3)
+		sorted := slice.SortWith(func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, NewIntSliceV(3, 2, -1, -3), sorted)
+		assert.Equal(t, NewIntSliceV(3, -1, 2, -3), slice)
+	}
+
+	// ordering
+	{
+		slice := NewIntSliceV(3, -1, 2, -3)
+		assert.Equal(t, NewIntSliceV(-1, 2, 3, -3), slice.SortWith(By(func(x O) O { return x.(int) * x.(int) }).Compare))
+		assert.Equal(t, NewIntSliceV(3, -3, 2, -1), slice.SortWith(ByDesc(func(x O) O { return x.(int) * x.(int) }).Compare))
+	}
+}
+
+// SortWithM
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_SortWithM() {
+	slice := NewIntSliceV(3, -1, 2, -3)
+	fmt.Println(slice.SortWithM(func(a, b O) int { return Compare(b, a) }))
+	// Output: [3 2 -1 -3]
+}
+
+func TestIntSlice_SortWithM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, (*IntSlice)(nil), slice.SortWithM(func(a, b O) int { return Compare(b, a) }))
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().SortWithM(func(a, b O) int { return Compare(b, a) }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewIntSliceV(3, -1, 2, -3)
+		sorted := slice.SortWithM(func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, NewIntSliceV(3, 2, -1, -3), sorted)
+		assert.Equal(t, NewIntSliceV(3, 2, -1, -3), slice)
+	}
+}
+
+// StdDev
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_StdDev() {
+	fmt.Println(NewIntSliceV(2, 4, 4, 4, 5, 5, 7, 9).StdDev())
+	// Output: 2
+}
+
+func TestIntSlice_StdDev(t *testing.T) {
+	var slice *IntSlice
+	assert.Equal(t, 0.0, slice.StdDev())
+	_, err := slice.StdDevE()
+	assert.Equal(t, "empty data set", err.Error())
+
+	assert.Equal(t, 0.0, NewIntSliceV(3).StdDev())
+	assert.Equal(t, 0.5, NewIntSliceV(1, 2).StdDev())
+}
+
 // String
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_String_Go(t *testing.B) {
@@ -3292,6 +4459,32 @@ func TestIntSlice_String(t *testing.T) {
 	}
 }
 
+// Sum
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Sum() {
+	fmt.Println(NewIntSliceV(1, 2, 3).Sum())
+	// Output: 6
+}
+
+func TestIntSlice_Sum(t *testing.T) {
+	var slice *IntSlice
+	assert.Equal(t, 0, slice.Sum())
+	assert.Equal(t, 0, NewIntSliceV().Sum())
+	assert.Equal(t, 2, NewIntSliceV(1, -2, 3).Sum())
+
+	// overflow wraps around unless checked
+	{
+		assert.Equal(t, math.MinInt, NewIntSliceV(math.MaxInt, 1).Sum())
+		val, err := NewIntSliceV(math.MaxInt, 1).SumE()
+		assert.Equal(t, "integer overflow", err.Error())
+		assert.Equal(t, math.MinInt, val)
+
+		val, err = NewIntSliceV(math.MaxInt, -1, 1).SumE()
+		assert.Nil(t, err)
+		assert.Equal(t, math.MaxInt, val)
+	}
+}
+
 // Swap
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Swap_Go(t *testing.B) {
@@ -3976,3 +5169,80 @@ func TestIntSlice_UniqM(t *testing.T) {
 		assert.Equal(t, NewIntSliceV(1, 2, 3, 4), uniq)
 	}
 }
+
+// Variance
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Variance() {
+	fmt.Println(NewIntSliceV(2, 4, 4, 4, 5, 5, 7, 9).Variance())
+	// Output: 4
+}
+
+func TestIntSlice_Variance(t *testing.T) {
+	var slice *IntSlice
+	assert.Equal(t, 0.0, slice.Variance())
+	_, err := slice.VarianceE()
+	assert.Equal(t, "empty data set", err.Error())
+
+	assert.Equal(t, 0.25, NewIntSliceV(1, 2).Variance())
+}
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Window() {
+	slice := NewIntSliceV(1, 2, 3, 4)
+	fmt.Println(slice.Window(2, 1))
+	// Output: [[1 2] [2 3] [3 4]]
+}
+
+func TestIntSlice_Window(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, []ISlice{}, slice.Window(2, 1))
+		assert.Equal(t, []ISlice{}, NewIntSliceV().Window(2, 1))
+	}
+
+	// invalid size or step
+	assert.Equal(t, []ISlice{}, NewIntSliceV(1, 2).Window(0, 1))
+	assert.Equal(t, []ISlice{}, NewIntSliceV(1, 2).Window(1, 0))
+	assert.Equal(t, []ISlice{}, NewIntSliceV(1, 2).Window(3, 1))
+
+	// windows
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, []ISlice{NewIntSliceV(1, 2), NewIntSliceV(2, 3), NewIntSliceV(3, 4), NewIntSliceV(4, 5)}, slice.Window(2, 1))
+		assert.Equal(t, []ISlice{NewIntSliceV(1, 2, 3), NewIntSliceV(3, 4, 5)}, slice.Window(3, 2))
+		assert.Equal(t, []ISlice{NewIntSliceV(1, 2), NewIntSliceV(4, 5)}, slice.Window(2, 3))
+		assert.Equal(t, []ISlice{NewIntSliceV(1, 2, 3, 4, 5)}, slice.Window(5, 1))
+	}
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Zip() {
+	slice := NewIntSliceV(1, 2)
+	fmt.Println(slice.Zip([]string{"x", "y"}))
+	// Output: [[1 x] [2 y]]
+}
+
+func TestIntSlice_Zip(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, []ISlice{}, slice.Zip([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewIntSliceV().Zip([]string{"x"}))
+	}
+
+	// no slices
+	assert.Equal(t, []ISlice{NewInterSliceV(1), NewInterSliceV(2)}, NewIntSliceV(1, 2).Zip())
+
+	// stops at the shortest slice
+	{
+		slice := NewIntSliceV(1, 2, 3)
+		assert.Equal(t, []ISlice{NewInterSliceV(1, "x", 7), NewInterSliceV(2, "y", 8)},
+			slice.Zip([]string{"x", "y", "z"}, NewIntSliceV(7, 8)))
+		assert.Equal(t, []ISlice{}, slice.Zip([]int{}))
+	}
+}
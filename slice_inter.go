@@ -2,9 +2,14 @@ package n
 
 import (
 	"fmt"
+	"math/rand"
 	"sort"
 	"strings"
 
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/phR0ze/n/pkg/enc/msgpack"
+	yaml_enc "github.com/phR0ze/n/pkg/enc/yaml"
+	"github.com/phR0ze/n/pkg/opt"
 	"github.com/pkg/errors"
 )
 
@@ -152,6 +157,43 @@ func (p *InterSlice) At(i int) (elem *Object) {
 	return
 }
 
+// BinarySearch searches this Slice, which must be sorted in ascending order e.g. with Sort, for
+// the given element returning the index it was found at or the index it would be inserted at to
+// keep this Slice sorted and whether it was found.
+// Supports optimized Slice types or Go types that can be converted into an optimized Slice type.
+func (p *InterSlice) BinarySearch(elem interface{}) (i int, found bool) {
+	if p == nil || len(*p) == 0 {
+		return 0, false
+	}
+	slice := Slice(*p)
+	if slice.RefSlice() {
+		panic(fmt.Sprintf("unsupported comparable type '%v'", fmt.Sprintf("%T", *p)))
+	}
+	return slice.BinarySearch(elem)
+}
+
+// BinarySearchWith searches this Slice, which must be sorted according to the given comparison, for
+// the given element returning the index it was found at or the index it would be inserted at to
+// keep this Slice sorted and whether it was found. The comparison is given each element of this
+// Slice and the element being searched for e.g. SortWith and BinarySearchWith may share an Ordering.
+func (p *InterSlice) BinarySearchWith(elem interface{}, cmp func(a, b O) int) (i int, found bool) {
+	return binarySearch(p.Len(), func(i int) O { return (*p)[i] }, elem, cmp)
+}
+
+// Cartesian returns the cartesian product of this Slice and the given slices as new tuple Slices
+// of every combination of one element from each slice e.g. [1 2].Cartesian([a b]) returns
+// [[1 a] [1 b] [2 a] [2 b]]. Tuples are InterSlices as the slices may be of different types.
+func (p *InterSlice) Cartesian(slices ...interface{}) (tuples []ISlice) {
+	return cartesian(p, slices)
+}
+
+// Chunk returns this Slice split into new Slices of n consecutive elements with the last Slice
+// containing the remaining elements e.g. [1 2 3 4 5].Chunk(2) returns [[1 2] [3 4] [5]].
+// Returns no Slices if n is not positive.
+func (p *InterSlice) Chunk(n int) (chunks []ISlice) {
+	return chunk(p, n)
+}
+
 // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
 func (p *InterSlice) Clear() ISlice {
 	if p == nil {
@@ -162,6 +204,13 @@ func (p *InterSlice) Clear() ISlice {
 	return p
 }
 
+// Combinations returns new Slices of all combinations of n elements of this Slice in the order
+// the elements occur e.g. [1 2 3].Combinations(2) returns [[1 2] [1 3] [2 3]]. Returns no Slices
+// if n is negative or larger than this Slice.
+func (p *InterSlice) Combinations(n int) (combos []ISlice) {
+	return combinations(p, n)
+}
+
 // Concat returns a new Slice by appending the given Slice to this Slice using variadic expansion.
 // Supports InterSlice, *InterSlice, []int or *[]int
 func (p *InterSlice) Concat(slice interface{}) (new ISlice) {
@@ -222,6 +271,22 @@ func (p *InterSlice) CountW(sel func(O) bool) (cnt int) {
 	return
 }
 
+// DeepCopy returns a new Slice with all elements recursively copied from this Slice such that
+// nested maps and slices are no longer shared with this Slice, see DeepCopy.
+func (p *InterSlice) DeepCopy() (new ISlice) {
+	if p == nil || len(*p) == 0 {
+		return NewInterSliceV()
+	}
+	return DeepCopy(p).(*InterSlice)
+}
+
+// DeepEqual tests if this Slice is structurally equal to the given obj, see DeepEqual.
+//
+// Supported options: IgnoreOrderOpt, IgnorePathsOpt and NumericOpt
+func (p *InterSlice) DeepEqual(obj interface{}, opts ...*opt.Opt) bool {
+	return DeepEqual(p, obj, opts...)
+}
+
 // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
 // Expects nothing, in which case everything is dropped, or two indices i and j, in which case positive and
 // negative notation is supported and uses an inclusive behavior such that DropAt(0, -1) includes index -1
@@ -404,6 +469,15 @@ func (p *InterSlice) EachRIE(action func(int, O) error) (ISlice, error) {
 	return p, err
 }
 
+// EachSlice calls the given lambda once for each new Slice of n consecutive elements of this
+// Slice the same as Chunk and returns a reference to this Slice.
+func (p *InterSlice) EachSlice(n int, action func(ISlice)) ISlice {
+	for _, slice := range chunk(p, n) {
+		action(slice)
+	}
+	return p
+}
+
 // Empty tests if this Slice is empty.
 func (p *InterSlice) Empty() bool {
 	if p == nil || len(*p) == 0 {
@@ -427,6 +501,12 @@ func (p *InterSlice) FirstN(n int) ISlice {
 	return p.Slice(0, abs(n)-1)
 }
 
+// Flatten returns a new Slice with the elements of any nested slices e.g. []interface{} or ISlice
+// expanded in place recursively. Strings, bytes, runes and maps are not expanded.
+func (p *InterSlice) Flatten() (new ISlice) {
+	return NewInterSlice(flatten(p, []interface{}{}))
+}
+
 // G returns the underlying Go type as is
 func (p *InterSlice) G() []interface{} {
 	if p == nil {
@@ -435,6 +515,11 @@ func (p *InterSlice) G() []interface{} {
 	return []interface{}(*p)
 }
 
+// Hash returns a stable structural hash of this Slice, see Hash.
+func (p *InterSlice) Hash() uint64 {
+	return Hash(p)
+}
+
 // Index returns the index of the first element in this Slice where element == elem
 // Returns a -1 if the element was not not found.
 func (p *InterSlice) Index(elem interface{}) (loc int) {
@@ -450,6 +535,11 @@ func (p *InterSlice) Index(elem interface{}) (loc int) {
 	return
 }
 
+// Inject is an alias to Reduce
+func (p *InterSlice) Inject(reducer func(acc, elem O) O, init ...interface{}) (acc *Object) {
+	return reduce(p, reducer, init)
+}
+
 // Insert modifies this Slice to insert the given element before the element with the given index.
 // Negative indices count backwards from the end of the slice, where -1 is the last element. If a
 // negative index is used, the given element will be inserted after that element, so using an index
@@ -487,6 +577,24 @@ func (p *InterSlice) InterSlice() bool {
 	return true
 }
 
+// IsSorted tests if the elements of this Slice are sorted in ascending order.
+// Supports optimized Slice types or Go types that can be converted into an optimized Slice type.
+func (p *InterSlice) IsSorted() bool {
+	if p == nil || len(*p) == 0 {
+		return true
+	}
+	slice := Slice(*p)
+	if slice.RefSlice() {
+		panic(fmt.Sprintf("unsupported comparable type '%v'", fmt.Sprintf("%T", *p)))
+	}
+	return slice.IsSorted()
+}
+
+// IsSortedWith tests if the elements of this Slice are sorted according to the given comparison
+func (p *InterSlice) IsSortedWith(cmp func(a, b O) int) bool {
+	return isSorted(p.Len(), func(i int) O { return (*p)[i] }, cmp)
+}
+
 // Join converts each element into a string then joins them together using the given separator or comma by default.
 func (p *InterSlice) Join(separator ...string) (str *Object) {
 	if p == nil || len(*p) == 0 {
@@ -566,6 +674,22 @@ func (p *InterSlice) Map(mod func(O) O) ISlice {
 	return slice
 }
 
+// MarshalJSON implements the json.Marshaler interface encoding this Slice as a json array.
+// Ordered maps are encoded as json objects preserving their key order.
+func (p *InterSlice) MarshalJSON() ([]byte, error) {
+	return json.MarshalOrdered(p.O())
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface encoding this Slice as a msgpack array.
+func (p *InterSlice) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(p.O())
+}
+
+// MarshalYAML implements the yaml.Marshaler interface encoding this Slice as a yaml sequence.
+func (p *InterSlice) MarshalYAML() (interface{}, error) {
+	return p.O(), nil
+}
+
 // Nil tests if this Slice is nil
 func (p *InterSlice) Nil() bool {
 	if p == nil {
@@ -597,6 +721,19 @@ func (p *InterSlice) Pair() (first, second *Object) {
 	return
 }
 
+// Partition returns the elements of this Slice that match the lambda selector and the elements
+// that don't as new Slices preserving element order.
+func (p *InterSlice) Partition(sel func(O) bool) (match, rest ISlice) {
+	return partition(p, sel)
+}
+
+// Permutations returns new Slices of all ordered arrangements of n elements of this Slice e.g.
+// [1 2 3].Permutations(2) returns [[1 2] [1 3] [2 1] [2 3] [3 1] [3 2]]. Returns no Slices if n
+// is negative or larger than this Slice.
+func (p *InterSlice) Permutations(n int) (perms []ISlice) {
+	return permutations(p, n)
+}
+
 // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
 func (p *InterSlice) Pop() (elem *Object) {
 	elem = p.Last()
@@ -619,6 +756,13 @@ func (p *InterSlice) Prepend(elem interface{}) ISlice {
 	return p.Insert(0, elem)
 }
 
+// Reduce combines the elements of this Slice into a single value by calling the given lambda
+// with the accumulated value and each element in turn, returning the final accumulated value.
+// The first element is used as the initial value if one isn't given.
+func (p *InterSlice) Reduce(reducer func(acc, elem O) O, init ...interface{}) (acc *Object) {
+	return reduce(p, reducer, init)
+}
+
 // RefSlice returns true if the underlying implementation is a RefSlice
 func (p *InterSlice) RefSlice() bool {
 	return false
@@ -643,11 +787,43 @@ func (p *InterSlice) ReverseM() ISlice {
 	return p
 }
 
+// Rotate returns a new Slice with the elements rotated n places to the left or to the right for
+// negative n such that the element at index n becomes the first element.
+func (p *InterSlice) Rotate(n int) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().RotateM(n)
+}
+
+// RotateM modifies this Slice rotating the elements n places to the left or to the right for
+// negative n and returns a reference to this Slice. See Rotate.
+func (p *InterSlice) RotateM(n int) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	rotateM(p, n)
+	return p
+}
+
 // S is an alias to ToStringSlice
 func (p *InterSlice) S() (slice *StringSlice) {
 	return ToStringSlice(p.O())
 }
 
+// Sample returns a new Slice of n unique elements chosen at random from this Slice using the
+// optional random number generator e.g. rand.New(rand.NewSource(seed)) for repeatable results.
+// All elements are returned in random order if n is larger than this Slice.
+func (p *InterSlice) Sample(n int, rng ...*rand.Rand) (new ISlice) {
+	return sample(p, n, rng)
+}
+
+// Scan returns a new Slice of the accumulated values from each step of reducing this Slice e.g.
+// running totals. The new Slice is converted into an optimized Slice type if possible. See Reduce.
+func (p *InterSlice) Scan(reducer func(acc, elem O) O, init ...interface{}) (new ISlice) {
+	return scan(p, reducer, init)
+}
+
 // Select creates a new slice with the elements that match the lambda selector.
 func (p *InterSlice) Select(sel func(O) bool) (new ISlice) {
 	slice := NewInterSliceV()
@@ -706,6 +882,25 @@ func (p *InterSlice) ShiftN(n int) (new ISlice) {
 	return
 }
 
+// Shuffle returns a new Slice with the elements in random order using the optional random
+// number generator e.g. rand.New(rand.NewSource(seed)) for repeatable results.
+func (p *InterSlice) Shuffle(rng ...*rand.Rand) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().ShuffleM(rng...)
+}
+
+// ShuffleM modifies this Slice putting the elements in random order using the optional random
+// number generator and returns a reference to this Slice. See Shuffle.
+func (p *InterSlice) ShuffleM(rng ...*rand.Rand) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	shuffleM(p, rng)
+	return p
+}
+
 // Single reports true if there is only one element in this Slice.
 func (p *InterSlice) Single() bool {
 	return p.Len() == 1
@@ -743,6 +938,47 @@ func (p *InterSlice) Sort() (new ISlice) {
 	return p.Copy().SortM()
 }
 
+// SortBy returns a new Slice with the elements sorted in ascending order by the keys the given
+// lambda selects from them compared with Compare. The sort is stable and the lambda is called
+// once per element.
+func (p *InterSlice) SortBy(key func(O) O) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByM(key)
+}
+
+// SortByDesc returns a new Slice with the elements sorted in descending order by the keys the
+// given lambda selects from them compared with Compare. The sort is stable.
+func (p *InterSlice) SortByDesc(key func(O) O) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByDescM(key)
+}
+
+// SortByDescM modifies this Slice sorting the elements in descending order by the keys the
+// given lambda selects from them compared with Compare and returns a reference to this Slice.
+// The sort is stable.
+func (p *InterSlice) SortByDescM(key func(O) O) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return key((*p)[i]) }, func(a, b O) int { return Compare(b, a) })
+	return p
+}
+
+// SortByM modifies this Slice sorting the elements in ascending order by the keys the given
+// lambda selects from them compared with Compare and returns a reference to this Slice. The sort
+// is stable and the lambda is called once per element.
+func (p *InterSlice) SortByM(key func(O) O) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return key((*p)[i]) }, Compare)
+	return p
+}
+
 // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
 // Supports optimized Slice types or Go types that can be converted into an optimized Slice type.
 func (p *InterSlice) SortM() ISlice {
@@ -772,6 +1008,26 @@ func (p *InterSlice) SortReverseM() ISlice {
 	return p
 }
 
+// SortWith returns a new Slice with the elements sorted according to the given comparison which
+// returns a negative number when a sorts before b, zero when equal and a positive number when a
+// sorts after b e.g. CompareNatural or an Ordering's Compare. The sort is stable.
+func (p *InterSlice) SortWith(cmp func(a, b O) int) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortWithM(cmp)
+}
+
+// SortWithM modifies this Slice sorting the elements according to the given comparison and
+// returns a reference to this Slice. See SortWith. The sort is stable.
+func (p *InterSlice) SortWithM(cmp func(a, b O) int) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return (*p)[i] }, cmp)
+	return p
+}
+
 // Returns a string representation of this Slice, implements the Stringer interface
 func (p *InterSlice) String() string {
 	var builder strings.Builder
@@ -883,3 +1139,61 @@ func (p *InterSlice) Uniq() (new ISlice) {
 func (p *InterSlice) UniqM() ISlice {
 	panic("NOT IMPLEMENTED")
 }
+
+// UnmarshalJSON implements the json.Unmarshaler interface decoding a json array into this Slice.
+// Maps are decoded as ordered yaml.MapSlice values to match the StringMap representation.
+func (p *InterSlice) UnmarshalJSON(data []byte) (err error) {
+	var x interface{}
+	if x, err = json.UnmarshalOrdered(data); err != nil {
+		return
+	}
+	return p.setOrdered(x)
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface decoding a msgpack array into this Slice.
+// Maps are decoded as ordered yaml.MapSlice values to match the StringMap representation.
+func (p *InterSlice) UnmarshalMsgpack(data []byte) (err error) {
+	x := []interface{}{}
+	if err = msgpack.UnmarshalOrdered(data, &x); err != nil {
+		return
+	}
+	*p = InterSlice(x)
+	return
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface decoding a yaml sequence into this Slice.
+// Maps are decoded as ordered yaml.MapSlice values to match the StringMap representation.
+func (p *InterSlice) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	x := &yaml_enc.Ordered{}
+	if err = unmarshal(x); err != nil {
+		return
+	}
+	return p.setOrdered(x.Value)
+}
+
+// Window returns new Slices of n consecutive elements of this Slice starting every step elements
+// e.g. [1 2 3 4].Window(2, 1) returns [[1 2] [2 3] [3 4]]. Only full windows are returned and no
+// Slices are returned if n or step are not positive.
+func (p *InterSlice) Window(n, step int) (windows []ISlice) {
+	return window(p, n, step)
+}
+
+// Zip returns new tuple Slices of the elements at the same index in this Slice and each of the
+// given slices e.g. [1 2].Zip([a b]) returns [[1 a] [2 b]]. Stops at the end of the shortest
+// slice. Tuples are InterSlices as the slices may be of different types.
+func (p *InterSlice) Zip(slices ...interface{}) (tuples []ISlice) {
+	return zip(p, slices)
+}
+
+// setOrdered sets this Slice to the given decoded sequence
+func (p *InterSlice) setOrdered(obj interface{}) (err error) {
+	switch x := obj.(type) {
+	case []interface{}:
+		*p = InterSlice(x)
+	case nil:
+		*p = InterSlice{}
+	default:
+		err = errors.Errorf("can't unmarshal %T into InterSlice", obj)
+	}
+	return
+}
@@ -2,8 +2,10 @@ package n
 
 import (
 	"fmt"
+	"math/rand"
 	"testing"
 
+	yaml "github.com/phR0ze/yaml/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -634,6 +636,151 @@ func TestInterSlice_At(t *testing.T) {
 	}
 }
 
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_BinarySearch() {
+	slice := NewInterSliceV(1, 3, 5)
+	fmt.Println(slice.BinarySearch(3))
+	// Output: 1 true
+}
+
+func TestInterSlice_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		i, found := slice.BinarySearch(3)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = NewInterSliceV().BinarySearch(3)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		slice := NewInterSliceV(1, 3, 5)
+		i, found := slice.BinarySearch(1)
+		assert.Equal(t, 0, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearch(3)
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+	}
+
+	// not found returns the insertion index
+	{
+		slice := NewInterSliceV(1, 3, 5)
+		i, found := slice.BinarySearch(4)
+		assert.Equal(t, 2, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch(6)
+		assert.Equal(t, 3, i)
+		assert.False(t, found)
+	}
+}
+
+// BinarySearchWith
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_BinarySearchWith() {
+	slice := NewInterSliceV(5, 3, 1)
+	fmt.Println(slice.BinarySearchWith(3, func(a, b O) int { return Compare(b, a) }))
+	// Output: 1 true
+}
+
+func TestInterSlice_BinarySearchWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		i, found := slice.BinarySearchWith(3, Compare)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// descending
+	{
+		slice := NewInterSliceV(5, 3, 1)
+		i, found := slice.BinarySearchWith(3, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearchWith(4, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 1, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearchWith(6, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// Cartesian
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Cartesian() {
+	slice := NewInterSliceV(1, 2)
+	fmt.Println(slice.Cartesian([]string{"x", "y"}))
+	// Output: [[1 x] [1 y] [2 x] [2 y]]
+}
+
+func TestInterSlice_Cartesian(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, []ISlice{}, slice.Cartesian([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewInterSliceV().Cartesian([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewInterSliceV(1).Cartesian([]string{}))
+	}
+
+	// no slices
+	assert.Equal(t, []ISlice{NewInterSliceV(1), NewInterSliceV(2)}, NewInterSliceV(1, 2).Cartesian())
+
+	// multiple slices
+	{
+		tuples := NewInterSliceV(1, 2).Cartesian([]string{"x", "y"}, NewIntSliceV(7))
+		assert.Equal(t, []ISlice{NewInterSliceV(1, "x", 7), NewInterSliceV(1, "y", 7),
+			NewInterSliceV(2, "x", 7), NewInterSliceV(2, "y", 7)}, tuples)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Chunk() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Chunk(2))
+	// Output: [[1 2] [3 4] [5]]
+}
+
+func TestInterSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, []ISlice{}, slice.Chunk(2))
+		assert.Equal(t, []ISlice{}, NewInterSliceV().Chunk(2))
+	}
+
+	// invalid size
+	assert.Equal(t, []ISlice{}, NewInterSliceV(1, 2).Chunk(0))
+	assert.Equal(t, []ISlice{}, NewInterSliceV(1, 2).Chunk(-1))
+
+	// chunks
+	{
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, []ISlice{NewInterSliceV(1, 2), NewInterSliceV(3, 4), NewInterSliceV(5)}, slice.Chunk(2))
+		assert.Equal(t, []ISlice{NewInterSliceV(1, 2, 3, 4, 5)}, slice.Chunk(5))
+		assert.Equal(t, []ISlice{NewInterSliceV(1, 2, 3, 4, 5)}, slice.Chunk(10))
+		assert.Equal(t, []ISlice{NewInterSliceV(1), NewInterSliceV(2), NewInterSliceV(3), NewInterSliceV(4), NewInterSliceV(5)}, slice.Chunk(1))
+	}
+
+	// chunks are copies
+	{
+		slice := NewInterSliceV(1, 2)
+		chunks := slice.Chunk(1)
+		chunks[0].Set(0, 3)
+		assert.Equal(t, NewInterSliceV(1, 2), slice)
+	}
+}
+
 // Clear
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Clear() {
@@ -695,6 +842,39 @@ func TestInterSlice_Clear(t *testing.T) {
 	}
 }
 
+// Combinations
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Combinations() {
+	slice := NewInterSliceV(1, 2, 3)
+	fmt.Println(slice.Combinations(2))
+	// Output: [[1 2] [1 3] [2 3]]
+}
+
+func TestInterSlice_Combinations(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, []ISlice{}, slice.Combinations(1))
+		assert.Equal(t, []ISlice{}, NewInterSliceV().Combinations(1))
+	}
+
+	// out of range
+	assert.Equal(t, []ISlice{}, NewInterSliceV(1, 2).Combinations(-1))
+	assert.Equal(t, []ISlice{}, NewInterSliceV(1, 2).Combinations(3))
+
+	// combinations
+	{
+		slice := NewInterSliceV(1, 2, 3, 4)
+		assert.Equal(t, 1, len(slice.Combinations(0)))
+		assert.Equal(t, 0, slice.Combinations(0)[0].Len())
+		assert.Equal(t, []ISlice{NewInterSliceV(1), NewInterSliceV(2), NewInterSliceV(3), NewInterSliceV(4)}, slice.Combinations(1))
+		assert.Equal(t, []ISlice{NewInterSliceV(1, 2), NewInterSliceV(1, 3), NewInterSliceV(1, 4), NewInterSliceV(2, 3), NewInterSliceV(2, 4), NewInterSliceV(3, 4)}, slice.Combinations(2))
+		assert.Equal(t, []ISlice{NewInterSliceV(1, 2, 3), NewInterSliceV(1, 2, 4), NewInterSliceV(1, 3, 4), NewInterSliceV(2, 3, 4)}, slice.Combinations(3))
+		assert.Equal(t, []ISlice{NewInterSliceV(1, 2, 3, 4)}, slice.Combinations(4))
+	}
+}
+
 // Concat
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Concat() {
@@ -1107,6 +1287,67 @@ func TestInterSlice_CountW(t *testing.T) {
 	assert.Equal(t, 1, NewInterSliceV(1, 2, 3).CountW(func(x O) bool { return ExB(x.(int) == 4 || x.(int) == 3) }))
 }
 
+// DeepCopy
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_DeepCopy() {
+	slice := NewInterSliceV(1, []int{2})
+	copy := slice.DeepCopy()
+	copy.At(1).O().([]int)[0] = 3
+	fmt.Println(slice.O(), copy.O())
+	// Output: [1 [2]] [1 [3]]
+}
+
+func TestInterSlice_DeepCopy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, NewInterSliceV(), slice.DeepCopy())
+		assert.Equal(t, NewInterSliceV(), NewInterSliceV().DeepCopy())
+	}
+
+	// nested slices and maps aren't shared
+	{
+		slice := NewInterSliceV(1, []int{2}, map[string]interface{}{"a": 3})
+		copy := slice.DeepCopy()
+		assert.Equal(t, slice, copy)
+		copy.At(1).O().([]int)[0] = 4
+		copy.At(2).O().(map[string]interface{})["a"] = 5
+		assert.Equal(t, NewInterSliceV(1, []int{2}, map[string]interface{}{"a": 3}), slice)
+		assert.Equal(t, NewInterSliceV(1, []int{4}, map[string]interface{}{"a": 5}), copy)
+	}
+}
+
+// DeepEqual
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_DeepEqual() {
+	slice := NewInterSliceV(1, "2", 3.0)
+	fmt.Println(slice.DeepEqual([]interface{}{3.0, "2", 1}, IgnoreOrderOpt(true)))
+	// Output: true
+}
+
+func TestInterSlice_DeepEqual(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.True(t, slice.DeepEqual(nil))
+		assert.False(t, slice.DeepEqual(NewInterSliceV(1)))
+		assert.True(t, NewInterSliceV().DeepEqual([]interface{}{}))
+	}
+
+	// compare with options
+	{
+		slice := NewInterSliceV(1, "2", 3.0)
+		assert.True(t, slice.DeepEqual(NewInterSliceV(1, "2", 3.0)))
+		assert.True(t, slice.DeepEqual([]interface{}{1, "2", 3.0}))
+		assert.False(t, slice.DeepEqual([]interface{}{3.0, "2", 1}))
+		assert.True(t, slice.DeepEqual([]interface{}{3.0, "2", 1}, IgnoreOrderOpt(true)))
+		assert.False(t, slice.DeepEqual([]interface{}{1, "2", 1}))
+		assert.True(t, slice.DeepEqual([]interface{}{1, "2", 1}, IgnorePathsOpt("[2]")))
+	}
+}
+
 // Drop
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Drop() {
@@ -2015,6 +2256,36 @@ func TestInterSlice_EachRIE(t *testing.T) {
 	}
 }
 
+// EachSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_EachSlice() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	slice.EachSlice(2, func(x ISlice) {
+		fmt.Print(x.O())
+	})
+	// Output: [1 2][3 4][5]
+}
+
+func TestInterSlice_EachSlice(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		called := false
+		slice.EachSlice(2, func(x ISlice) { called = true })
+		NewInterSliceV().EachSlice(2, func(x ISlice) { called = true })
+		assert.False(t, called)
+	}
+
+	// slices
+	{
+		slices := []ISlice{}
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewInterSliceV(1, 2, 3, 4, 5), slice.EachSlice(2, func(x ISlice) { slices = append(slices, x) }))
+		assert.Equal(t, []ISlice{NewInterSliceV(1, 2), NewInterSliceV(3, 4), NewInterSliceV(5)}, slices)
+	}
+}
+
 // Empty
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Empty() {
@@ -2130,6 +2401,58 @@ func TestInterSlice_FirstN(t *testing.T) {
 	}
 }
 
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Flatten() {
+	slice := NewInterSliceV(1, []interface{}{2, []int{3, 4}}, NewStringSliceV("5"))
+	fmt.Println(slice.Flatten())
+	// Output: [1 2 3 4 5]
+}
+
+func TestInterSlice_Flatten(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, NewInterSliceV(), slice.Flatten())
+		assert.Equal(t, NewInterSliceV(), NewInterSliceV().Flatten())
+	}
+
+	// not nested
+	{
+		slice := NewInterSliceV(1, "2", nil)
+		assert.Equal(t, NewInterSliceV(1, "2", nil), slice.Flatten())
+	}
+
+	// nested
+	{
+		slice := NewInterSliceV(1, []interface{}{2, []interface{}{3, []int{4}}}, NewIntSliceV(5, 6), [2]int{7, 8})
+		assert.Equal(t, NewInterSliceV(1, 2, 3, 4, 5, 6, 7, 8), slice.Flatten())
+		assert.Equal(t, 4, slice.Len())
+	}
+
+	// strings, bytes, runes and maps are not expanded
+	{
+		slice := NewInterSliceV([]interface{}{"ab", []byte("cd"), []rune("ef"), A("gh"), map[string]interface{}{"i": 1}})
+		assert.Equal(t, NewInterSliceV("ab", []byte("cd"), []rune("ef"), A("gh"), map[string]interface{}{"i": 1}), slice.Flatten())
+	}
+}
+
+// Hash
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Hash() {
+	slice := NewInterSliceV(1, "2", 3.0)
+	fmt.Println(slice.Hash() == NewInterSliceV(1, "2", 3.0).Hash())
+	// Output: true
+}
+
+func TestInterSlice_Hash(t *testing.T) {
+	slice := NewInterSliceV(1, "2", 3.0)
+	assert.Equal(t, slice.Hash(), slice.DeepCopy().Hash())
+	assert.Equal(t, slice.Hash(), Hash([]interface{}{1, "2", 3.0}))
+	assert.NotEqual(t, slice.Hash(), NewInterSliceV(3.0, "2", 1).Hash())
+}
+
 // InterSlice
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_InterSlice() {
@@ -2160,6 +2483,27 @@ func TestInterSlice_Index(t *testing.T) {
 	assert.Equal(t, -1, NewInterSliceV(1, 2, 3).Index(5))
 }
 
+// Inject
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Inject() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Inject(func(acc, x O) O { return acc.(int) + x.(int) }, 10))
+	// Output: 25
+}
+
+func TestInterSlice_Inject(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, 10, slice.Inject(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+		assert.Equal(t, 10, NewInterSliceV().Inject(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+	}
+
+	// inject
+	assert.Equal(t, 25, NewInterSliceV(1, 2, 3, 4, 5).Inject(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+}
+
 // Insert
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Insert() {
@@ -2273,6 +2617,59 @@ func TestInterSlice_Insert_MixedType(t *testing.T) {
 	assert.Equal(t, []interface{}{"2", 1}, slice.G())
 }
 
+// IsSorted
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_IsSorted() {
+	slice := NewInterSliceV(1, 3, 5)
+	fmt.Println(slice.IsSorted())
+	// Output: true
+}
+
+func TestInterSlice_IsSorted(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.True(t, slice.IsSorted())
+		assert.True(t, NewInterSliceV().IsSorted())
+	}
+
+	// sorted
+	assert.True(t, NewInterSliceV(1, 3, 5).IsSorted())
+	assert.True(t, NewInterSliceV(1, 3, 5).SortReverse().Reverse().IsSorted())
+
+	// not sorted
+	assert.False(t, NewInterSliceV(5, 3, 1).IsSorted())
+	assert.False(t, NewInterSliceV(3, -1, 2, -3).IsSorted())
+}
+
+// IsSortedWith
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_IsSortedWith() {
+	slice := NewInterSliceV(5, 3, 1)
+	fmt.Println(slice.IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+	// Output: true
+}
+
+func TestInterSlice_IsSortedWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.True(t, slice.IsSortedWith(Compare))
+		assert.True(t, NewInterSliceV().IsSortedWith(Compare))
+	}
+
+	// sorted
+	assert.True(t, NewInterSliceV(1, 3, 5).IsSortedWith(Compare))
+	assert.True(t, NewInterSliceV(5, 3, 1).IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+	assert.True(t, NewInterSliceV(3, -1, 2, -3).SortBy(func(x O) O { return x.(int) * x.(int) }).IsSortedWith(By(func(x O) O { return x.(int) * x.(int) }).Compare))
+
+	// not sorted
+	assert.False(t, NewInterSliceV(5, 3, 1).IsSortedWith(Compare))
+	assert.False(t, NewInterSliceV(1, 3, 5).IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+}
+
 // Join
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Join() {
@@ -2465,6 +2862,60 @@ func TestInterSlice_Map(t *testing.T) {
 	}
 }
 
+// MarshalJSON
+//--------------------------------------------------------------------------------------------------
+func TestInterSlice_MarshalJSON(t *testing.T) {
+
+	// preserves map key order
+	{
+		slice := NewInterSliceV(1, 3.5, "four", true, nil, M().Add("b", 1).Add("a", 2))
+		data, err := slice.MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, `[1,3.5,"four",true,null,{"b":1,"a":2}]`, string(data))
+
+		x := NewInterSliceV()
+		assert.Nil(t, x.UnmarshalJSON(data))
+		assert.Equal(t, []interface{}{1, 3.5, "four", true, nil, yaml.MapSlice{{Key: "b", Value: 1}, {Key: "a", Value: 2}}}, x.O())
+	}
+
+	// invalid
+	assert.Equal(t, "can't unmarshal yaml.MapSlice into InterSlice", NewInterSliceV().UnmarshalJSON([]byte(`{"a":1}`)).Error())
+}
+
+// MarshalMsgpack
+//--------------------------------------------------------------------------------------------------
+func TestInterSlice_MarshalMsgpack(t *testing.T) {
+	data, err := NewInterSliceV(1, uint(2), 3.5, "four", []byte{5}, M().Add("b", 1).Add("a", 2)).MarshalMsgpack()
+	assert.Nil(t, err)
+
+	slice := NewInterSliceV()
+	assert.Nil(t, slice.UnmarshalMsgpack(data))
+	assert.Equal(t, []interface{}{1, uint(2), 3.5, "four", []byte{5},
+		yaml.MapSlice{{Key: "b", Value: 1}, {Key: "a", Value: 2}}}, slice.O())
+}
+
+// MarshalYAML
+//--------------------------------------------------------------------------------------------------
+func TestInterSlice_MarshalYAML(t *testing.T) {
+
+	// preserves map key order at every depth
+	{
+		slice := NewInterSliceV(1, "two", M().Add("b", 1).Add("a", []interface{}{M().Add("d", 1).Add("c", 2)}))
+		data, err := yaml.Marshal(slice)
+		assert.Nil(t, err)
+		assert.Equal(t, "- 1\n- two\n- b: 1\n  a:\n  - d: 1\n    c: 2\n", string(data))
+
+		x := NewInterSliceV()
+		assert.Nil(t, yaml.Unmarshal(data, x))
+		assert.Equal(t, []interface{}{1, "two", yaml.MapSlice{{Key: "b", Value: 1},
+			{Key: "a", Value: []interface{}{yaml.MapSlice{{Key: "d", Value: 1}, {Key: "c", Value: 2}}}}}}, x.O())
+	}
+
+	// invalid
+	assert.NotNil(t, yaml.Unmarshal([]byte("a: 1"), NewInterSliceV()))
+}
+
+
 // Nil
 //--------------------------------------------------------------------------------------------------
 func TestInterSlice_Nil(t *testing.T) {
@@ -2549,6 +3000,72 @@ func TestInterSlice_Pair(t *testing.T) {
 	}
 }
 
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Partition() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Partition(func(x O) bool { return x.(int)%2 == 1 }))
+	// Output: [1 3 5] [2 4]
+}
+
+func TestInterSlice_Partition(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		match, rest := slice.Partition(func(x O) bool { return x.(int)%2 == 1 })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// partition
+	{
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		match, rest := slice.Partition(func(x O) bool { return x.(int)%2 == 1 })
+		assert.Equal(t, NewInterSliceV(1, 3, 5), match)
+		assert.Equal(t, NewInterSliceV(2, 4), rest)
+		assert.Equal(t, NewInterSliceV(1, 2, 3, 4, 5), slice)
+	}
+
+	// all or nothing
+	{
+		match, rest := NewInterSliceV(1, 2).Partition(func(x O) bool { return true })
+		assert.Equal(t, NewInterSliceV(1, 2), match)
+		assert.Equal(t, 0, rest.Len())
+	}
+}
+
+// Permutations
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Permutations() {
+	slice := NewInterSliceV(1, 2, 3)
+	fmt.Println(slice.Permutations(2))
+	// Output: [[1 2] [1 3] [2 1] [2 3] [3 1] [3 2]]
+}
+
+func TestInterSlice_Permutations(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, []ISlice{}, slice.Permutations(1))
+		assert.Equal(t, []ISlice{}, NewInterSliceV().Permutations(1))
+	}
+
+	// out of range
+	assert.Equal(t, []ISlice{}, NewInterSliceV(1, 2).Permutations(-1))
+	assert.Equal(t, []ISlice{}, NewInterSliceV(1, 2).Permutations(3))
+
+	// permutations
+	{
+		slice := NewInterSliceV(1, 2, 3)
+		assert.Equal(t, 1, len(slice.Permutations(0)))
+		assert.Equal(t, []ISlice{NewInterSliceV(1), NewInterSliceV(2), NewInterSliceV(3)}, slice.Permutations(1))
+		assert.Equal(t, []ISlice{NewInterSliceV(1, 2), NewInterSliceV(1, 3), NewInterSliceV(2, 1), NewInterSliceV(2, 3), NewInterSliceV(3, 1), NewInterSliceV(3, 2)}, slice.Permutations(2))
+		assert.Equal(t, []ISlice{NewInterSliceV(1, 2, 3), NewInterSliceV(1, 3, 2), NewInterSliceV(2, 1, 3), NewInterSliceV(2, 3, 1), NewInterSliceV(3, 1, 2), NewInterSliceV(3, 2, 1)}, slice.Permutations(3))
+	}
+}
+
 // Pop
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Pop() {
@@ -2678,6 +3195,34 @@ func TestInterSlice_Prepend(t *testing.T) {
 	}
 }
 
+// Reduce
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Reduce() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Reduce(func(acc, x O) O { return acc.(int) + x.(int) }))
+	// Output: 15
+}
+
+func TestInterSlice_Reduce(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Nil(t, slice.Reduce(func(acc, x O) O { return acc.(int) + x.(int) }).O())
+		assert.Nil(t, NewInterSliceV().Reduce(func(acc, x O) O { return acc.(int) + x.(int) }).O())
+		assert.Equal(t, 10, NewInterSliceV().Reduce(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+	}
+
+	// single element
+	assert.Equal(t, 1, NewInterSliceV(1).Reduce(func(acc, x O) O { return acc.(int) + x.(int) }).O())
+
+	// first element is the initial value
+	assert.Equal(t, 15, NewInterSliceV(1, 2, 3, 4, 5).Reduce(func(acc, x O) O { return acc.(int) + x.(int) }).O())
+
+	// initial value
+	assert.Equal(t, 25, NewInterSliceV(1, 2, 3, 4, 5).Reduce(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+}
+
 // Reverse
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Reverse() {
@@ -2754,6 +3299,145 @@ func TestInterSlice_ReverseM(t *testing.T) {
 	}
 }
 
+// Rotate
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Rotate() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Rotate(2).O())
+	// Output: [3 4 5 1 2]
+}
+
+func TestInterSlice_Rotate(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, NewInterSliceV(), slice.Rotate(1))
+		assert.Equal(t, NewInterSliceV(), NewInterSliceV().Rotate(1))
+	}
+
+	// rotate
+	{
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewInterSliceV(1, 2, 3, 4, 5), slice.Rotate(0))
+		assert.Equal(t, NewInterSliceV(2, 3, 4, 5, 1), slice.Rotate(1))
+		assert.Equal(t, NewInterSliceV(3, 4, 5, 1, 2), slice.Rotate(2))
+		assert.Equal(t, NewInterSliceV(3, 4, 5, 1, 2), slice.Rotate(7))
+		assert.Equal(t, NewInterSliceV(1, 2, 3, 4, 5), slice.Rotate(5))
+		assert.Equal(t, NewInterSliceV(1, 2, 3, 4, 5), slice)
+	}
+
+	// negative
+	{
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewInterSliceV(5, 1, 2, 3, 4), slice.Rotate(-1))
+		assert.Equal(t, NewInterSliceV(4, 5, 1, 2, 3), slice.Rotate(-7))
+	}
+}
+
+// RotateM
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_RotateM() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.RotateM(-1).O())
+	// Output: [5 1 2 3 4]
+}
+
+func TestInterSlice_RotateM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, (*InterSlice)(nil), slice.RotateM(1))
+		assert.Equal(t, NewInterSliceV(), NewInterSliceV().RotateM(1))
+	}
+
+	// rotate
+	{
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewInterSliceV(3, 4, 5, 1, 2), slice.RotateM(2))
+		assert.Equal(t, NewInterSliceV(3, 4, 5, 1, 2), slice)
+		assert.Equal(t, NewInterSliceV(2, 3, 4, 5, 1), slice.RotateM(-1))
+		assert.Equal(t, NewInterSliceV(2, 3, 4, 5, 1), slice)
+	}
+}
+
+// Sample
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Sample() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Sample(2, rand.New(rand.NewSource(1))).Len())
+	// Output: 2
+}
+
+func TestInterSlice_Sample(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, 0, slice.Sample(2).Len())
+		assert.Equal(t, 0, NewInterSliceV().Sample(2).Len())
+	}
+
+	// invalid size
+	assert.Equal(t, 0, NewInterSliceV(1, 2).Sample(0).Len())
+	assert.Equal(t, 0, NewInterSliceV(1, 2).Sample(-1).Len())
+
+	// unique elements
+	{
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		sample := slice.Sample(3)
+		assert.Equal(t, 3, sample.Len())
+		sample.Each(func(x O) {
+			assert.Equal(t, 1, sample.CountW(func(y O) bool { return Compare(x, y) == 0 }))
+			assert.True(t, slice.AnyW(func(y O) bool { return Compare(x, y) == 0 }))
+		})
+		assert.Equal(t, NewInterSliceV(1, 2, 3, 4, 5), slice)
+	}
+
+	// all elements
+	{
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		sample := slice.Sample(10)
+		assert.Equal(t, 5, sample.Len())
+		assert.True(t, sample.All(1, 2, 3, 4, 5))
+	}
+
+	// seeded
+	{
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, slice.Sample(3, rand.New(rand.NewSource(2))), slice.Sample(3, rand.New(rand.NewSource(2))))
+	}
+}
+
+// Scan
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Scan() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Scan(func(acc, x O) O { return acc.(int) + x.(int) }))
+	// Output: [1 3 6 10 15]
+}
+
+func TestInterSlice_Scan(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, 0, slice.Scan(func(acc, x O) O { return acc.(int) + x.(int) }).Len())
+		assert.Equal(t, 0, NewInterSliceV().Scan(func(acc, x O) O { return acc.(int) + x.(int) }, 10).Len())
+	}
+
+	// first element is the initial value
+	{
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewIntSliceV(1, 3, 6, 10, 15), slice.Scan(func(acc, x O) O { return acc.(int) + x.(int) }))
+		assert.Equal(t, NewInterSliceV(1, 2, 3, 4, 5), slice)
+	}
+
+	// initial value
+	assert.Equal(t, NewIntSliceV(11, 13, 16, 20, 25), NewInterSliceV(1, 2, 3, 4, 5).Scan(func(acc, x O) O { return acc.(int) + x.(int) }, 10))
+}
+
 // Select
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Select() {
@@ -3018,6 +3702,66 @@ func TestInterSlice_ShiftN(t *testing.T) {
 	}
 }
 
+// Shuffle
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Shuffle() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Shuffle().Len())
+	// Output: 5
+}
+
+func TestInterSlice_Shuffle(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, NewInterSliceV(), slice.Shuffle())
+		assert.Equal(t, NewInterSliceV(), NewInterSliceV().Shuffle())
+	}
+
+	// shuffle
+	{
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		shuffled := slice.Shuffle()
+		assert.Equal(t, 5, shuffled.Len())
+		assert.True(t, shuffled.All(1, 2, 3, 4, 5))
+		assert.Equal(t, NewInterSliceV(1, 2, 3, 4, 5), slice)
+	}
+
+	// seeded
+	{
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, slice.Shuffle(rand.New(rand.NewSource(2))), slice.Shuffle(rand.New(rand.NewSource(2))))
+	}
+}
+
+// ShuffleM
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_ShuffleM() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.ShuffleM().Len())
+	// Output: 5
+}
+
+func TestInterSlice_ShuffleM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, (*InterSlice)(nil), slice.ShuffleM())
+		assert.Equal(t, NewInterSliceV(), NewInterSliceV().ShuffleM())
+	}
+
+	// shuffle
+	{
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		shuffled := slice.ShuffleM(rand.New(rand.NewSource(2)))
+		assert.Equal(t, slice, shuffled)
+		assert.Equal(t, NewInterSliceV(1, 2, 3, 4, 5).ShuffleM(rand.New(rand.NewSource(2))), slice)
+		assert.True(t, slice.All(1, 2, 3, 4, 5))
+	}
+}
+
 // Single
 //--------------------------------------------------------------------------------------------------
 
@@ -3232,6 +3976,116 @@ func TestInterSlice_Sort(t *testing.T) {
 	}
 }
 
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_SortBy() {
+	slice := NewInterSliceV(3, -1, 2, -3)
+	fmt.Println(slice.SortBy(func(x O) O { return x.(int) * x.(int) }).O())
+	// Output: [-1 2 3 -3]
+}
+
+func TestInterSlice_SortBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, NewInterSliceV(), slice.SortBy(func(x O) O { return x.(int) * x.(int) }))
+		assert.Equal(t, NewInterSliceV().O(), NewInterSliceV().SortBy(func(x O) O { return x.(int) * x.(int) }).O())
+	}
+
+	// new Slice
+	{
+		slice := NewInterSliceV(3, -1, 2, -3)
+		sorted := slice.SortBy(func(x O) O { return x.(int) * x.(int) })
+		assert.Equal(t, []interface{}{-1, 2, 3, -3}, sorted.O())
+		assert.Equal(t, NewInterSliceV(3, -1, 2, -3).O(), slice.O())
+	}
+
+	// stable
+	{
+		slice := NewInterSliceV(3, -1, 2, -3)
+		assert.Equal(t, NewInterSliceV(3, -1, 2, -3).O(), slice.SortBy(func(x O) O { return 0 }).O())
+	}
+}
+
+// SortByDesc
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_SortByDesc() {
+	slice := NewInterSliceV(3, -1, 2, -3)
+	fmt.Println(slice.SortByDesc(func(x O) O { return x.(int) * x.(int) }).O())
+	// Output: [3 -3 2 -1]
+}
+
+func TestInterSlice_SortByDesc(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, NewInterSliceV(), slice.SortByDesc(func(x O) O { return x.(int) * x.(int) }))
+		assert.Equal(t, NewInterSliceV().O(), NewInterSliceV().SortByDesc(func(x O) O { return x.(int) * x.(int) }).O())
+	}
+
+	// new Slice
+	{
+		slice := NewInterSliceV(3, -1, 2, -3)
+		sorted := slice.SortByDesc(func(x O) O { return x.(int) * x.(int) })
+		assert.Equal(t, []interface{}{3, -3, 2, -1}, sorted.O())
+		assert.Equal(t, NewInterSliceV(3, -1, 2, -3).O(), slice.O())
+	}
+}
+
+// SortByDescM
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_SortByDescM() {
+	slice := NewInterSliceV(3, -1, 2, -3)
+	fmt.Println(slice.SortByDescM(func(x O) O { return x.(int) * x.(int) }).O())
+	// Output: [3 -3 2 -1]
+}
+
+func TestInterSlice_SortByDescM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, (*InterSlice)(nil), slice.SortByDescM(func(x O) O { return x.(int) * x.(int) }))
+		assert.Equal(t, NewInterSliceV().O(), NewInterSliceV().SortByDescM(func(x O) O { return x.(int) * x.(int) }).O())
+	}
+
+	// modifies this Slice
+	{
+		slice := NewInterSliceV(3, -1, 2, -3)
+		sorted := slice.SortByDescM(func(x O) O { return x.(int) * x.(int) })
+		assert.Equal(t, []interface{}{3, -3, 2, -1}, sorted.O())
+		assert.Equal(t, []interface{}{3, -3, 2, -1}, slice.O())
+	}
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_SortByM() {
+	slice := NewInterSliceV(3, -1, 2, -3)
+	fmt.Println(slice.SortByM(func(x O) O { return x.(int) * x.(int) }).O())
+	// Output: [-1 2 3 -3]
+}
+
+func TestInterSlice_SortByM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, (*InterSlice)(nil), slice.SortByM(func(x O) O { return x.(int) * x.(int) }))
+		assert.Equal(t, NewInterSliceV().O(), NewInterSliceV().SortByM(func(x O) O { return x.(int) * x.(int) }).O())
+	}
+
+	// modifies this Slice
+	{
+		slice := NewInterSliceV(3, -1, 2, -3)
+		sorted := slice.SortByM(func(x O) O { return x.(int) * x.(int) })
+		assert.Equal(t, []interface{}{-1, 2, 3, -3}, sorted.O())
+		assert.Equal(t, []interface{}{-1, 2, 3, -3}, slice.O())
+	}
+}
+
 // SortM
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_SortM() {
@@ -3322,6 +4176,65 @@ func TestInterSlice_SortReverseM(t *testing.T) {
 	}
 }
 
+// SortWith
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_SortWith() {
+	slice := NewInterSliceV(3, -1, 2, -3)
+	fmt.Println(slice.SortWith(func(a, b O) int { return Compare(b, a) }).O())
+	// Output: [3 2 -1 -3]
+}
+
+func TestInterSlice_SortWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, NewInterSliceV(), slice.SortWith(func(a, b O) int { return Compare(b, a) }))
+		assert.Equal(t, NewInterSliceV().O(), NewInterSliceV().SortWith(func(a, b O) int { return Compare(b, a) }).O())
+	}
+
+	// new Slice
+	{
+		slice := NewInterSliceV(3, -1, 2, -3)
+		sorted := slice.SortWith(func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, []interface{}{3, 2, -1, -3}, sorted.O())
+		assert.Equal(t, NewInterSliceV(3, -1, 2, -3).O(), slice.O())
+	}
+
+	// ordering
+	{
+		slice := NewInterSliceV(3, -1, 2, -3)
+		assert.Equal(t, []interface{}{-1, 2, 3, -3}, slice.SortWith(By(func(x O) O { return x.(int) * x.(int) }).Compare).O())
+		assert.Equal(t, []interface{}{3, -3, 2, -1}, slice.SortWith(ByDesc(func(x O) O { return x.(int) * x.(int) }).Compare).O())
+	}
+}
+
+// SortWithM
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_SortWithM() {
+	slice := NewInterSliceV(3, -1, 2, -3)
+	fmt.Println(slice.SortWithM(func(a, b O) int { return Compare(b, a) }).O())
+	// Output: [3 2 -1 -3]
+}
+
+func TestInterSlice_SortWithM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, (*InterSlice)(nil), slice.SortWithM(func(a, b O) int { return Compare(b, a) }))
+		assert.Equal(t, NewInterSliceV().O(), NewInterSliceV().SortWithM(func(a, b O) int { return Compare(b, a) }).O())
+	}
+
+	// modifies this Slice
+	{
+		slice := NewInterSliceV(3, -1, 2, -3)
+		sorted := slice.SortWithM(func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, []interface{}{3, 2, -1, -3}, sorted.O())
+		assert.Equal(t, []interface{}{3, 2, -1, -3}, slice.O())
+	}
+}
+
 // Swap
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Swap() {
@@ -3996,3 +4909,64 @@ func TestInterSlice_TakeW(t *testing.T) {
 // 		assert.Equal(t, []int{1, 2, 3, 4}, uniq.O())
 // 	}
 // }
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Window() {
+	slice := NewInterSliceV(1, 2, 3, 4)
+	fmt.Println(slice.Window(2, 1))
+	// Output: [[1 2] [2 3] [3 4]]
+}
+
+func TestInterSlice_Window(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, []ISlice{}, slice.Window(2, 1))
+		assert.Equal(t, []ISlice{}, NewInterSliceV().Window(2, 1))
+	}
+
+	// invalid size or step
+	assert.Equal(t, []ISlice{}, NewInterSliceV(1, 2).Window(0, 1))
+	assert.Equal(t, []ISlice{}, NewInterSliceV(1, 2).Window(1, 0))
+	assert.Equal(t, []ISlice{}, NewInterSliceV(1, 2).Window(3, 1))
+
+	// windows
+	{
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, []ISlice{NewInterSliceV(1, 2), NewInterSliceV(2, 3), NewInterSliceV(3, 4), NewInterSliceV(4, 5)}, slice.Window(2, 1))
+		assert.Equal(t, []ISlice{NewInterSliceV(1, 2, 3), NewInterSliceV(3, 4, 5)}, slice.Window(3, 2))
+		assert.Equal(t, []ISlice{NewInterSliceV(1, 2), NewInterSliceV(4, 5)}, slice.Window(2, 3))
+		assert.Equal(t, []ISlice{NewInterSliceV(1, 2, 3, 4, 5)}, slice.Window(5, 1))
+	}
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Zip() {
+	slice := NewInterSliceV(1, 2)
+	fmt.Println(slice.Zip([]string{"x", "y"}))
+	// Output: [[1 x] [2 y]]
+}
+
+func TestInterSlice_Zip(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, []ISlice{}, slice.Zip([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewInterSliceV().Zip([]string{"x"}))
+	}
+
+	// no slices
+	assert.Equal(t, []ISlice{NewInterSliceV(1), NewInterSliceV(2)}, NewInterSliceV(1, 2).Zip())
+
+	// stops at the shortest slice
+	{
+		slice := NewInterSliceV(1, 2, 3)
+		assert.Equal(t, []ISlice{NewInterSliceV(1, "x", 7), NewInterSliceV(2, "y", 8)},
+			slice.Zip([]string{"x", "y", "z"}, NewIntSliceV(7, 8)))
+		assert.Equal(t, []ISlice{}, slice.Zip([]int{}))
+	}
+}
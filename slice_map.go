@@ -1,9 +1,14 @@
 package n
 
 import (
+	"math/rand"
 	"sort"
 	"strings"
 
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/phR0ze/n/pkg/enc/msgpack"
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/phR0ze/n/pkg/stats"
 	"github.com/pkg/errors"
 )
 
@@ -144,6 +149,57 @@ func (p *SliceOfMap) At(i int) (elem *Object) {
 	return
 }
 
+// AvgBy returns the average of the values selected from the maps in this Slice or 0 if there
+// are none. See SumBy for the supported selectors.
+func (p *SliceOfMap) AvgBy(sel interface{}) (avg float64) {
+	avg, _ = p.AvgByE(sel)
+	return
+}
+
+// AvgByE returns the average of the values selected from the maps in this Slice. See SumBy for
+// the supported selectors. Returns an error if there are no values or a value can't be
+// converted to a float64.
+func (p *SliceOfMap) AvgByE(sel interface{}) (avg float64, err error) {
+	var vals []float64
+	if vals, err = p.selectFloats(sel); err != nil {
+		return
+	}
+	if avg, err = stats.Mean(vals); err != nil {
+		err = errors.Wrap(err, "failed to average selected values")
+	}
+	return
+}
+
+// BinarySearch searches this Slice, which must be sorted in ascending order according to Compare
+// as maps have no natural ordering, for the given element returning the index it was found at or
+// the index it would be inserted at to keep this Slice sorted and whether it was found.
+func (p *SliceOfMap) BinarySearch(elem interface{}) (i int, found bool) {
+	return p.BinarySearchWith(elem, Compare)
+}
+
+// BinarySearchWith searches this Slice, which must be sorted according to the given comparison, for
+// the given element returning the index it was found at or the index it would be inserted at to
+// keep this Slice sorted and whether it was found. The comparison is given each element of this
+// Slice and the element being searched for, converted to a *StringMap, e.g. SortWith and
+// BinarySearchWith may share an Ordering.
+func (p *SliceOfMap) BinarySearchWith(elem interface{}, cmp func(a, b O) int) (i int, found bool) {
+	return binarySearch(p.Len(), func(i int) O { return (*p)[i] }, ToStringMap(elem), cmp)
+}
+
+// Cartesian returns the cartesian product of this Slice and the given slices as new tuple Slices
+// of every combination of one element from each slice e.g. [1 2].Cartesian([a b]) returns
+// [[1 a] [1 b] [2 a] [2 b]]. Tuples are InterSlices as the slices may be of different types.
+func (p *SliceOfMap) Cartesian(slices ...interface{}) (tuples []ISlice) {
+	return cartesian(p, slices)
+}
+
+// Chunk returns this Slice split into new Slices of n consecutive elements with the last Slice
+// containing the remaining elements e.g. [1 2 3 4 5].Chunk(2) returns [[1 2] [3 4] [5]].
+// Returns no Slices if n is not positive.
+func (p *SliceOfMap) Chunk(n int) (chunks []ISlice) {
+	return chunk(p, n)
+}
+
 // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
 func (p *SliceOfMap) Clear() ISlice {
 	if p == nil {
@@ -154,6 +210,13 @@ func (p *SliceOfMap) Clear() ISlice {
 	return p
 }
 
+// Combinations returns new Slices of all combinations of n elements of this Slice in the order
+// the elements occur e.g. [1 2 3].Combinations(2) returns [[1 2] [1 3] [2 3]]. Returns no Slices
+// if n is negative or larger than this Slice.
+func (p *SliceOfMap) Combinations(n int) (combos []ISlice) {
+	return combinations(p, n)
+}
+
 // Concat returns a new Slice by appending the given Slice to this Slice using variadic expansion.
 // Supports SliceOfMap, *SliceOfMap, []string or *[]string
 func (p *SliceOfMap) Concat(slice interface{}) (new ISlice) {
@@ -216,6 +279,22 @@ func (p *SliceOfMap) CountW(sel func(O) bool) (cnt int) {
 	return
 }
 
+// DeepCopy returns a new Slice with all elements recursively copied from this Slice such that
+// nested maps and slices are no longer shared with this Slice, see DeepCopy.
+func (p *SliceOfMap) DeepCopy() (new ISlice) {
+	if p == nil || len(*p) == 0 {
+		return NewSliceOfMapV()
+	}
+	return DeepCopy(p).(*SliceOfMap)
+}
+
+// DeepEqual tests if this Slice is structurally equal to the given obj, see DeepEqual.
+//
+// Supported options: IgnoreOrderOpt, IgnorePathsOpt and NumericOpt
+func (p *SliceOfMap) DeepEqual(obj interface{}, opts ...*opt.Opt) bool {
+	return DeepEqual(p, obj, opts...)
+}
+
 // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
 // Expects nothing, in which case everything is dropped, or two indices i and j, in which case positive and
 // negative notation is supported and uses an inclusive behavior such that DropAt(0, -1) includes index -1
@@ -398,6 +477,15 @@ func (p *SliceOfMap) EachRIE(action func(int, O) error) (ISlice, error) {
 	return p, err
 }
 
+// EachSlice calls the given lambda once for each new Slice of n consecutive elements of this
+// Slice the same as Chunk and returns a reference to this Slice.
+func (p *SliceOfMap) EachSlice(n int, action func(ISlice)) ISlice {
+	for _, slice := range chunk(p, n) {
+		action(slice)
+	}
+	return p
+}
+
 // Empty tests if this Slice is empty.
 func (p *SliceOfMap) Empty() bool {
 	if p == nil || len(*p) == 0 {
@@ -421,6 +509,12 @@ func (p *SliceOfMap) FirstN(n int) ISlice {
 	return p.Slice(0, abs(n)-1)
 }
 
+// Flatten returns a new Slice with the elements of any nested slices expanded in place. This
+// Slice can't contain nested slices so this is the same as Copy.
+func (p *SliceOfMap) Flatten() (new ISlice) {
+	return p.Copy()
+}
+
 // G returns the underlying data structure as a builtin Go type
 func (p *SliceOfMap) G() []map[string]interface{} {
 	val := []map[string]interface{}{}
@@ -430,6 +524,11 @@ func (p *SliceOfMap) G() []map[string]interface{} {
 	return val
 }
 
+// Hash returns a stable structural hash of this Slice, see Hash.
+func (p *SliceOfMap) Hash() uint64 {
+	return Hash(p)
+}
+
 // Index returns the index of the first element in this Slice where element == elem
 // Returns a -1 if the element was not not found.
 func (p *SliceOfMap) Index(elem interface{}) (loc int) {
@@ -448,6 +547,11 @@ func (p *SliceOfMap) Index(elem interface{}) (loc int) {
 	// return
 }
 
+// Inject is an alias to Reduce
+func (p *SliceOfMap) Inject(reducer func(acc, elem O) O, init ...interface{}) (acc *Object) {
+	return reduce(p, reducer, init)
+}
+
 // Insert modifies this Slice to insert the given elements before the element(s) with the given index.
 // Negative indices count backwards from the end of the slice, where -1 is the last element. If a
 // negative index is used, the given element will be inserted after that element, so using an index
@@ -486,6 +590,17 @@ func (p *SliceOfMap) InterSlice() bool {
 	return false
 }
 
+// IsSorted tests if the elements of this Slice are sorted in ascending order according to Compare
+// as maps have no natural ordering.
+func (p *SliceOfMap) IsSorted() bool {
+	return p.IsSortedWith(Compare)
+}
+
+// IsSortedWith tests if the elements of this Slice are sorted according to the given comparison
+func (p *SliceOfMap) IsSortedWith(cmp func(a, b O) int) bool {
+	return isSorted(p.Len(), func(i int) O { return (*p)[i] }, cmp)
+}
+
 // Join converts each element into a string then joins them together using the given separator or comma by default.
 func (p *SliceOfMap) Join(separator ...string) (str *Object) {
 	if p == nil || len(*p) == 0 {
@@ -553,6 +668,33 @@ func (p *SliceOfMap) Map(mod func(O) O) ISlice {
 	return slice
 }
 
+// MarshalJSON implements the json.Marshaler interface encoding this Slice as a json array of objects.
+// Key order for each map is preserved.
+func (p *SliceOfMap) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		return json.Marshal([]*StringMap{})
+	}
+	return json.Marshal([]*StringMap(*p))
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface encoding this Slice as a msgpack array of maps.
+// Key order for each map is preserved.
+func (p *SliceOfMap) MarshalMsgpack() ([]byte, error) {
+	if p == nil {
+		return msgpack.Marshal([]*StringMap{})
+	}
+	return msgpack.Marshal([]*StringMap(*p))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface encoding this Slice as a yaml sequence of maps.
+// Key order for each map is preserved.
+func (p *SliceOfMap) MarshalYAML() (interface{}, error) {
+	if p == nil {
+		return []*StringMap{}, nil
+	}
+	return []*StringMap(*p), nil
+}
+
 // Nil tests if this Slice is nil
 func (p *SliceOfMap) Nil() bool {
 	return p == nil
@@ -581,6 +723,19 @@ func (p *SliceOfMap) Pair() (first, second *Object) {
 	return
 }
 
+// Partition returns the elements of this Slice that match the lambda selector and the elements
+// that don't as new Slices preserving element order.
+func (p *SliceOfMap) Partition(sel func(O) bool) (match, rest ISlice) {
+	return partition(p, sel)
+}
+
+// Permutations returns new Slices of all ordered arrangements of n elements of this Slice e.g.
+// [1 2 3].Permutations(2) returns [[1 2] [1 3] [2 1] [2 3] [3 1] [3 2]]. Returns no Slices if n
+// is negative or larger than this Slice.
+func (p *SliceOfMap) Permutations(n int) (perms []ISlice) {
+	return permutations(p, n)
+}
+
 // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
 func (p *SliceOfMap) Pop() (elem *Object) {
 	elem = p.Last()
@@ -603,6 +758,13 @@ func (p *SliceOfMap) Prepend(elem interface{}) ISlice {
 	return p.Insert(0, elem)
 }
 
+// Reduce combines the elements of this Slice into a single value by calling the given lambda
+// with the accumulated value and each element in turn, returning the final accumulated value.
+// The first element is used as the initial value if one isn't given.
+func (p *SliceOfMap) Reduce(reducer func(acc, elem O) O, init ...interface{}) (acc *Object) {
+	return reduce(p, reducer, init)
+}
+
 // RefSlice returns true if the underlying implementation is a RefSlice
 func (p *SliceOfMap) RefSlice() bool {
 	return false
@@ -627,11 +789,64 @@ func (p *SliceOfMap) ReverseM() ISlice {
 	return p
 }
 
+// Rotate returns a new Slice with the elements rotated n places to the left or to the right for
+// negative n such that the element at index n becomes the first element.
+func (p *SliceOfMap) Rotate(n int) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().RotateM(n)
+}
+
+// RotateM modifies this Slice rotating the elements n places to the left or to the right for
+// negative n and returns a reference to this Slice. See Rotate.
+func (p *SliceOfMap) RotateM(n int) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	rotateM(p, n)
+	return p
+}
+
 // S is an alias to ToStringSlice
 func (p *SliceOfMap) S() (slice *StringSlice) {
 	return ToStringSlice(p.O())
 }
 
+// SQL runs the given SQL subset query against this Slice returning the resulting rows as a new
+// Slice e.g. `select team, count(*) as total from . group by team`, see ParseQuery. Returns an
+// empty Slice on error.
+func (p *SliceOfMap) SQL(query string) (new *SliceOfMap) {
+	new, err := p.SQLE(query)
+	if err != nil {
+		return NewSliceOfMapV()
+	}
+	return
+}
+
+// SQLE runs the given SQL subset query against this Slice returning the resulting rows as a new
+// Slice, see ParseQuery.
+func (p *SliceOfMap) SQLE(query string) (new *SliceOfMap, err error) {
+	var q *Query
+	if q, err = ParseQuery(query); err != nil {
+		return
+	}
+	return q.RunE(p)
+}
+
+// Sample returns a new Slice of n unique elements chosen at random from this Slice using the
+// optional random number generator e.g. rand.New(rand.NewSource(seed)) for repeatable results.
+// All elements are returned in random order if n is larger than this Slice.
+func (p *SliceOfMap) Sample(n int, rng ...*rand.Rand) (new ISlice) {
+	return sample(p, n, rng)
+}
+
+// Scan returns a new Slice of the accumulated values from each step of reducing this Slice e.g.
+// running totals. The new Slice is converted into an optimized Slice type if possible. See Reduce.
+func (p *SliceOfMap) Scan(reducer func(acc, elem O) O, init ...interface{}) (new ISlice) {
+	return scan(p, reducer, init)
+}
+
 // Select creates a new slice with the elements that match the lambda selector.
 func (p *SliceOfMap) Select(sel func(O) bool) (new ISlice) {
 	slice := NewSliceOfMapV()
@@ -695,6 +910,25 @@ func (p *SliceOfMap) ShiftN(n int) (new ISlice) {
 	return
 }
 
+// Shuffle returns a new Slice with the elements in random order using the optional random
+// number generator e.g. rand.New(rand.NewSource(seed)) for repeatable results.
+func (p *SliceOfMap) Shuffle(rng ...*rand.Rand) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().ShuffleM(rng...)
+}
+
+// ShuffleM modifies this Slice putting the elements in random order using the optional random
+// number generator and returns a reference to this Slice. See Shuffle.
+func (p *SliceOfMap) ShuffleM(rng ...*rand.Rand) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	shuffleM(p, rng)
+	return p
+}
+
 // Single reports true if there is only one element in this Slice.
 func (p *SliceOfMap) Single() bool {
 	return p.Len() == 1
@@ -731,6 +965,47 @@ func (p *SliceOfMap) Sort() (new ISlice) {
 	return p.Copy().SortM()
 }
 
+// SortBy returns a new Slice with the elements sorted in ascending order by the keys the given
+// lambda selects from them compared with Compare. The sort is stable and the lambda is called
+// once per element.
+func (p *SliceOfMap) SortBy(key func(O) O) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByM(key)
+}
+
+// SortByDesc returns a new Slice with the elements sorted in descending order by the keys the
+// given lambda selects from them compared with Compare. The sort is stable.
+func (p *SliceOfMap) SortByDesc(key func(O) O) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByDescM(key)
+}
+
+// SortByDescM modifies this Slice sorting the elements in descending order by the keys the
+// given lambda selects from them compared with Compare and returns a reference to this Slice.
+// The sort is stable.
+func (p *SliceOfMap) SortByDescM(key func(O) O) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return key((*p)[i]) }, func(a, b O) int { return Compare(b, a) })
+	return p
+}
+
+// SortByM modifies this Slice sorting the elements in ascending order by the keys the given
+// lambda selects from them compared with Compare and returns a reference to this Slice. The sort
+// is stable and the lambda is called once per element.
+func (p *SliceOfMap) SortByM(key func(O) O) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return key((*p)[i]) }, Compare)
+	return p
+}
+
 // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
 func (p *SliceOfMap) SortM() ISlice {
 	if p == nil || len(*p) < 2 {
@@ -757,6 +1032,26 @@ func (p *SliceOfMap) SortReverseM() ISlice {
 	return p
 }
 
+// SortWith returns a new Slice with the elements sorted according to the given comparison which
+// returns a negative number when a sorts before b, zero when equal and a positive number when a
+// sorts after b e.g. CompareNatural or an Ordering's Compare. The sort is stable.
+func (p *SliceOfMap) SortWith(cmp func(a, b O) int) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortWithM(cmp)
+}
+
+// SortWithM modifies this Slice sorting the elements according to the given comparison and
+// returns a reference to this Slice. See SortWith. The sort is stable.
+func (p *SliceOfMap) SortWithM(cmp func(a, b O) int) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return (*p)[i] }, cmp)
+	return p
+}
+
 // String returns a string representation of this Slice, implements the Stringer interface
 func (p *SliceOfMap) String() string {
 	var builder strings.Builder
@@ -773,6 +1068,26 @@ func (p *SliceOfMap) String() string {
 	return builder.String()
 }
 
+// SumBy returns the sum of the values selected from the maps in this Slice. The selector is
+// either a key to look up in each map or a func(O) O lambda returning the value for each map.
+// Maps without the key or for which the lambda returns nil are skipped.
+func (p *SliceOfMap) SumBy(sel interface{}) (sum float64) {
+	sum, _ = p.SumByE(sel)
+	return
+}
+
+// SumByE returns the sum of the values selected from the maps in this Slice. The selector is
+// either a key to look up in each map or a func(O) O lambda returning the value for each map.
+// Maps without the key or for which the lambda returns nil are skipped. Returns an error if a
+// value can't be converted to a float64.
+func (p *SliceOfMap) SumByE(sel interface{}) (sum float64, err error) {
+	var vals []float64
+	if vals, err = p.selectFloats(sel); err != nil {
+		return
+	}
+	return stats.Sum(vals), nil
+}
+
 // Swap modifies this Slice swapping the indicated elements.
 func (p *SliceOfMap) Swap(i, j int) {
 	if p == nil || len(*p) < 2 || i < 0 || j < 0 || i >= len(*p) || j >= len(*p) {
@@ -887,3 +1202,78 @@ func (p *SliceOfMap) UniqM() ISlice {
 	}
 	return p
 }
+
+// UnmarshalJSON implements the json.Unmarshaler interface decoding a json array of objects into this Slice.
+// Key order for each map is preserved.
+func (p *SliceOfMap) UnmarshalJSON(data []byte) (err error) {
+	x := []*StringMap{}
+	if err = json.Unmarshal(data, &x); err != nil {
+		return
+	}
+	*p = SliceOfMap(x)
+	return
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface decoding a msgpack array of maps into this Slice.
+// Key order for each map is preserved.
+func (p *SliceOfMap) UnmarshalMsgpack(data []byte) (err error) {
+	x := []*StringMap{}
+	if err = msgpack.Unmarshal(data, &x); err != nil {
+		return
+	}
+	*p = SliceOfMap(x)
+	return
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface decoding a yaml sequence of maps into this Slice.
+// Key order for each map is preserved.
+func (p *SliceOfMap) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	x := []*StringMap{}
+	if err = unmarshal(&x); err != nil {
+		return
+	}
+	*p = SliceOfMap(x)
+	return
+}
+
+// Window returns new Slices of n consecutive elements of this Slice starting every step elements
+// e.g. [1 2 3 4].Window(2, 1) returns [[1 2] [2 3] [3 4]]. Only full windows are returned and no
+// Slices are returned if n or step are not positive.
+func (p *SliceOfMap) Window(n, step int) (windows []ISlice) {
+	return window(p, n, step)
+}
+
+// Zip returns new tuple Slices of the elements at the same index in this Slice and each of the
+// given slices e.g. [1 2].Zip([a b]) returns [[1 a] [2 b]]. Stops at the end of the shortest
+// slice. Tuples are InterSlices as the slices may be of different types.
+func (p *SliceOfMap) Zip(slices ...interface{}) (tuples []ISlice) {
+	return zip(p, slices)
+}
+
+// selectFloats returns the values selected from the maps in this Slice by the given key or
+// lambda selector converted to float64 values skipping nil values.
+func (p *SliceOfMap) selectFloats(sel interface{}) (vals []float64, err error) {
+	vals = []float64{}
+	if p == nil {
+		return
+	}
+	lambda, isLambda := sel.(func(O) O)
+	for i := range *p {
+		var val interface{}
+		if isLambda {
+			val = lambda((*p)[i])
+		} else {
+			val = (*p)[i].Get(sel).O()
+		}
+		if val == nil {
+			continue
+		}
+		var x float64
+		if x, err = ToFloat64E(val); err != nil {
+			err = errors.WithMessagef(err, "failed to convert value selected from map %d", i)
+			return
+		}
+		vals = append(vals, x)
+	}
+	return
+}
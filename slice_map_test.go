@@ -2,8 +2,11 @@ package n
 
 import (
 	"fmt"
+	"math/rand"
 	"testing"
 
+	"github.com/phR0ze/n/pkg/enc/json"
+	yaml "github.com/phR0ze/yaml/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -508,6 +511,195 @@ func TestSliceOfMap_At(t *testing.T) {
 	}
 }
 
+// AvgBy
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_AvgBy() {
+	slice := NewSliceOfMapV(map[string]interface{}{"ms": 10}, map[string]interface{}{"ms": 20})
+	fmt.Println(slice.AvgBy("ms"))
+	// Output: 15
+}
+
+func TestSliceOfMap_AvgBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, 0.0, slice.AvgBy("ms"))
+		_, err := slice.AvgByE("ms")
+		assert.Equal(t, "failed to average selected values: empty data set", err.Error())
+	}
+
+	// maps without the key are skipped
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"ms": 10}, map[string]interface{}{"foo": 1},
+			map[string]interface{}{"ms": "20"})
+		assert.Equal(t, 15.0, slice.AvgBy("ms"))
+		_, err := slice.AvgByE("bar")
+		assert.Equal(t, "failed to average selected values: empty data set", err.Error())
+	}
+
+	// lambda selector
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"a": 1, "b": 2}, map[string]interface{}{"a": 3, "b": 4})
+		assert.Equal(t, 5.0, slice.AvgBy(func(x O) O {
+			m := x.(*StringMap)
+			return m.Get("a").ToInt() + m.Get("b").ToInt()
+		}))
+	}
+
+	// invalid values
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"ms": 10}, map[string]interface{}{"ms": "foo"})
+		_, err := slice.AvgByE("ms")
+		assert.Equal(t, `failed to convert value selected from map 1: failed to convert string to float64: strconv.ParseFloat: parsing "foo": invalid syntax`, err.Error())
+	}
+}
+
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_BinarySearch() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 5})
+	fmt.Println(slice.BinarySearch(map[string]interface{}{"v": 3}))
+	// Output: 1 true
+}
+
+func TestSliceOfMap_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		i, found := slice.BinarySearch(map[string]interface{}{"v": 3})
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = NewSliceOfMapV().BinarySearch(map[string]interface{}{"v": 3})
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 5})
+		i, found := slice.BinarySearch(map[string]interface{}{"v": 1})
+		assert.Equal(t, 0, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearch(map[string]interface{}{"v": 3})
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+	}
+
+	// not found returns the insertion index
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 5})
+		i, found := slice.BinarySearch(map[string]interface{}{"v": 4})
+		assert.Equal(t, 2, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch(map[string]interface{}{"v": 6})
+		assert.Equal(t, 3, i)
+		assert.False(t, found)
+	}
+}
+
+// BinarySearchWith
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_BinarySearchWith() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 5}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 1})
+	fmt.Println(slice.BinarySearchWith(map[string]interface{}{"v": 3}, func(a, b O) int { return Compare(b, a) }))
+	// Output: 1 true
+}
+
+func TestSliceOfMap_BinarySearchWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		i, found := slice.BinarySearchWith(map[string]interface{}{"v": 3}, Compare)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// descending
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 5}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 1})
+		i, found := slice.BinarySearchWith(map[string]interface{}{"v": 3}, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearchWith(map[string]interface{}{"v": 4}, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 1, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearchWith(map[string]interface{}{"v": 6}, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// Cartesian
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Cartesian() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2})
+	fmt.Println(slice.Cartesian([]string{"x", "y"}))
+	// Output: [[&[{v 1}] x] [&[{v 1}] y] [&[{v 2}] x] [&[{v 2}] y]]
+}
+
+func TestSliceOfMap_Cartesian(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, []ISlice{}, slice.Cartesian([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewSliceOfMapV().Cartesian([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewSliceOfMapV(map[string]interface{}{"v": 1}).Cartesian([]string{}))
+	}
+
+	// no slices
+	assert.Equal(t, []ISlice{NewInterSliceV(ToStringMap(map[string]interface{}{"v": 1})), NewInterSliceV(ToStringMap(map[string]interface{}{"v": 2}))}, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Cartesian())
+
+	// multiple slices
+	{
+		tuples := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Cartesian([]string{"x", "y"}, NewIntSliceV(7))
+		assert.Equal(t, []ISlice{NewInterSliceV(ToStringMap(map[string]interface{}{"v": 1}), "x", 7), NewInterSliceV(ToStringMap(map[string]interface{}{"v": 1}), "y", 7),
+			NewInterSliceV(ToStringMap(map[string]interface{}{"v": 2}), "x", 7), NewInterSliceV(ToStringMap(map[string]interface{}{"v": 2}), "y", 7)}, tuples)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Chunk() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+	fmt.Println(slice.Chunk(2))
+	// Output: [[&[{v 1}] &[{v 2}]] [&[{v 3}] &[{v 4}]] [&[{v 5}]]]
+}
+
+func TestSliceOfMap_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, []ISlice{}, slice.Chunk(2))
+		assert.Equal(t, []ISlice{}, NewSliceOfMapV().Chunk(2))
+	}
+
+	// invalid size
+	assert.Equal(t, []ISlice{}, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Chunk(0))
+	assert.Equal(t, []ISlice{}, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Chunk(-1))
+
+	// chunks
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}), NewSliceOfMapV(map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}), NewSliceOfMapV(map[string]interface{}{"v": 5})}, slice.Chunk(2))
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})}, slice.Chunk(5))
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})}, slice.Chunk(10))
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}), NewSliceOfMapV(map[string]interface{}{"v": 2}), NewSliceOfMapV(map[string]interface{}{"v": 3}), NewSliceOfMapV(map[string]interface{}{"v": 4}), NewSliceOfMapV(map[string]interface{}{"v": 5})}, slice.Chunk(1))
+	}
+
+	// chunks are copies
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2})
+		chunks := slice.Chunk(1)
+		chunks[0].Set(0, ToStringMap(map[string]interface{}{"v": 3}))
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}), slice)
+	}
+}
+
 // Clear
 //--------------------------------------------------------------------------------------------------
 func ExampleSliceOfMap_Clear() {
@@ -534,6 +726,39 @@ func TestSliceOfMap_Clear(t *testing.T) {
 	}
 }
 
+// Combinations
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Combinations() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3})
+	fmt.Println(slice.Combinations(2))
+	// Output: [[&[{v 1}] &[{v 2}]] [&[{v 1}] &[{v 3}]] [&[{v 2}] &[{v 3}]]]
+}
+
+func TestSliceOfMap_Combinations(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, []ISlice{}, slice.Combinations(1))
+		assert.Equal(t, []ISlice{}, NewSliceOfMapV().Combinations(1))
+	}
+
+	// out of range
+	assert.Equal(t, []ISlice{}, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Combinations(-1))
+	assert.Equal(t, []ISlice{}, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Combinations(3))
+
+	// combinations
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4})
+		assert.Equal(t, 1, len(slice.Combinations(0)))
+		assert.Equal(t, 0, slice.Combinations(0)[0].Len())
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}), NewSliceOfMapV(map[string]interface{}{"v": 2}), NewSliceOfMapV(map[string]interface{}{"v": 3}), NewSliceOfMapV(map[string]interface{}{"v": 4})}, slice.Combinations(1))
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}), NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}), NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 4}), NewSliceOfMapV(map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}), NewSliceOfMapV(map[string]interface{}{"v": 2}, map[string]interface{}{"v": 4}), NewSliceOfMapV(map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4})}, slice.Combinations(2))
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}), NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 4}), NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}), NewSliceOfMapV(map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4})}, slice.Combinations(3))
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4})}, slice.Combinations(4))
+	}
+}
+
 // Concat
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkSliceOfMap_Concat_Go(t *testing.B) {
@@ -892,6 +1117,68 @@ func TestSliceOfMap_CountW(t *testing.T) {
 	assert.Equal(t, 1, NewSliceOfMapV("1:", "2:", "3:").CountW(func(x O) bool { return ToStringMap(x).Exists("4") || ToStringMap(x).Exists("3") }))
 }
 
+// DeepCopy
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_DeepCopy() {
+	slice := NewSliceOfMapV(map[string]interface{}{"a": []int{1}})
+	copy := slice.DeepCopy()
+	copy.At(0).M().Get("a").O().([]int)[0] = 2
+	fmt.Println(slice, copy)
+	// Output: [&[{a [1]}]] [&[{a [2]}]]
+}
+
+func TestSliceOfMap_DeepCopy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, NewSliceOfMapV(), slice.DeepCopy())
+		assert.Equal(t, NewSliceOfMapV(), NewSliceOfMapV().DeepCopy())
+	}
+
+	// nested maps aren't shared
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"a": map[string]interface{}{"b": 1}})
+		copy := slice.DeepCopy()
+		assert.Equal(t, slice, copy)
+		copy.At(0).M().Update("a.b", 2)
+		copy.At(0).M().Set("c", 3)
+		assert.Equal(t, 1, slice.At(0).M().Query("a.b").O())
+		assert.False(t, slice.At(0).M().Exists("c"))
+		assert.Equal(t, 2, copy.At(0).M().Query("a.b").O())
+	}
+}
+
+// DeepEqual
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_DeepEqual() {
+	slice := NewSliceOfMapV(NewStringMapV(map[string]interface{}{"a": 1}), NewStringMapV(map[string]interface{}{"b": 2}), NewStringMapV(map[string]interface{}{"c": 3}))
+	fmt.Println(slice.DeepEqual([]*StringMap{NewStringMapV(map[string]interface{}{"c": 3}), NewStringMapV(map[string]interface{}{"b": 2}), NewStringMapV(map[string]interface{}{"a": 1})}, IgnoreOrderOpt(true)))
+	// Output: true
+}
+
+func TestSliceOfMap_DeepEqual(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.True(t, slice.DeepEqual(nil))
+		assert.False(t, slice.DeepEqual(NewSliceOfMapV(NewStringMapV(map[string]interface{}{"a": 1}))))
+		assert.True(t, NewSliceOfMapV().DeepEqual([]*StringMap{}))
+	}
+
+	// compare with options
+	{
+		slice := NewSliceOfMapV(NewStringMapV(map[string]interface{}{"a": 1}), NewStringMapV(map[string]interface{}{"b": 2}), NewStringMapV(map[string]interface{}{"c": 3}))
+		assert.True(t, slice.DeepEqual(NewSliceOfMapV(NewStringMapV(map[string]interface{}{"a": 1}), NewStringMapV(map[string]interface{}{"b": 2}), NewStringMapV(map[string]interface{}{"c": 3}))))
+		assert.True(t, slice.DeepEqual([]*StringMap{NewStringMapV(map[string]interface{}{"a": 1}), NewStringMapV(map[string]interface{}{"b": 2}), NewStringMapV(map[string]interface{}{"c": 3})}))
+		assert.False(t, slice.DeepEqual([]*StringMap{NewStringMapV(map[string]interface{}{"c": 3}), NewStringMapV(map[string]interface{}{"b": 2}), NewStringMapV(map[string]interface{}{"a": 1})}))
+		assert.True(t, slice.DeepEqual([]*StringMap{NewStringMapV(map[string]interface{}{"c": 3}), NewStringMapV(map[string]interface{}{"b": 2}), NewStringMapV(map[string]interface{}{"a": 1})}, IgnoreOrderOpt(true)))
+		assert.False(t, slice.DeepEqual([]*StringMap{NewStringMapV(map[string]interface{}{"a": 1}), NewStringMapV(map[string]interface{}{"b": 2}), NewStringMapV(map[string]interface{}{"a": 1})}))
+		assert.True(t, slice.DeepEqual([]*StringMap{NewStringMapV(map[string]interface{}{"a": 1}), NewStringMapV(map[string]interface{}{"b": 2}), NewStringMapV(map[string]interface{}{"a": 1})}, IgnorePathsOpt("[2]")))
+	}
+}
+
 // Drop
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkSliceOfMap_Drop_Go(t *testing.B) {
@@ -1668,6 +1955,36 @@ func TestSliceOfMap_EachI(t *testing.T) {
 // 	}
 // }
 
+// EachSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_EachSlice() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+	slice.EachSlice(2, func(x ISlice) {
+		fmt.Print(x)
+	})
+	// Output: [&[{v 1}] &[{v 2}]][&[{v 3}] &[{v 4}]][&[{v 5}]]
+}
+
+func TestSliceOfMap_EachSlice(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		called := false
+		slice.EachSlice(2, func(x ISlice) { called = true })
+		NewSliceOfMapV().EachSlice(2, func(x ISlice) { called = true })
+		assert.False(t, called)
+	}
+
+	// slices
+	{
+		slices := []ISlice{}
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}), slice.EachSlice(2, func(x ISlice) { slices = append(slices, x) }))
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}), NewSliceOfMapV(map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}), NewSliceOfMapV(map[string]interface{}{"v": 5})}, slices)
+	}
+}
+
 // Empty
 //--------------------------------------------------------------------------------------------------
 func ExampleSliceOfMap_Empty() {
@@ -3807,3 +4124,803 @@ func TestSliceOfMap_Empty(t *testing.T) {
 // 	}
 // 	return
 // }
+
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Flatten() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1})
+	fmt.Println(slice.Flatten())
+	// Output: [&[{v 1}]]
+}
+
+func TestSliceOfMap_Flatten(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, NewSliceOfMapV(), slice.Flatten())
+		assert.Equal(t, NewSliceOfMapV(), NewSliceOfMapV().Flatten())
+	}
+
+	// copy
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1})
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}), slice.Flatten())
+	}
+}
+
+// Hash
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Hash() {
+	slice := NewSliceOfMapV(NewStringMapV(map[string]interface{}{"a": 1}), NewStringMapV(map[string]interface{}{"b": 2}), NewStringMapV(map[string]interface{}{"c": 3}))
+	fmt.Println(slice.Hash() == NewSliceOfMapV(NewStringMapV(map[string]interface{}{"a": 1}), NewStringMapV(map[string]interface{}{"b": 2}), NewStringMapV(map[string]interface{}{"c": 3})).Hash())
+	// Output: true
+}
+
+func TestSliceOfMap_Hash(t *testing.T) {
+	slice := NewSliceOfMapV(NewStringMapV(map[string]interface{}{"a": 1}), NewStringMapV(map[string]interface{}{"b": 2}), NewStringMapV(map[string]interface{}{"c": 3}))
+	assert.Equal(t, slice.Hash(), slice.DeepCopy().Hash())
+	assert.Equal(t, slice.Hash(), Hash([]*StringMap{NewStringMapV(map[string]interface{}{"a": 1}), NewStringMapV(map[string]interface{}{"b": 2}), NewStringMapV(map[string]interface{}{"c": 3})}))
+	assert.NotEqual(t, slice.Hash(), NewSliceOfMapV(NewStringMapV(map[string]interface{}{"c": 3}), NewStringMapV(map[string]interface{}{"b": 2}), NewStringMapV(map[string]interface{}{"a": 1})).Hash())
+}
+
+// Inject
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Inject() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+	fmt.Println(slice.Inject(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }, ToStringMap(map[string]interface{}{"v": 10})))
+	// Output: &[{v 25}]
+}
+
+func TestSliceOfMap_Inject(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, ToStringMap(map[string]interface{}{"v": 10}), slice.Inject(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }, ToStringMap(map[string]interface{}{"v": 10})).O())
+		assert.Equal(t, ToStringMap(map[string]interface{}{"v": 10}), NewSliceOfMapV().Inject(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }, ToStringMap(map[string]interface{}{"v": 10})).O())
+	}
+
+	// inject
+	assert.Equal(t, ToStringMap(map[string]interface{}{"v": 25}), NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}).Inject(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }, ToStringMap(map[string]interface{}{"v": 10})).O())
+}
+
+// IsSorted
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_IsSorted() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 5})
+	fmt.Println(slice.IsSorted())
+	// Output: true
+}
+
+func TestSliceOfMap_IsSorted(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.True(t, slice.IsSorted())
+		assert.True(t, NewSliceOfMapV().IsSorted())
+	}
+
+	// sorted
+	assert.True(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 5}).IsSorted())
+	assert.True(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 3}).IsSorted())
+
+	// not sorted
+	assert.False(t, NewSliceOfMapV(map[string]interface{}{"v": 5}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 1}).IsSorted())
+	assert.False(t, NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20}).IsSorted())
+}
+
+// IsSortedWith
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_IsSortedWith() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 5}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 1})
+	fmt.Println(slice.IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+	// Output: true
+}
+
+func TestSliceOfMap_IsSortedWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.True(t, slice.IsSortedWith(Compare))
+		assert.True(t, NewSliceOfMapV().IsSortedWith(Compare))
+	}
+
+	// sorted
+	assert.True(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 5}).IsSortedWith(Compare))
+	assert.True(t, NewSliceOfMapV(map[string]interface{}{"v": 5}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 1}).IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+	assert.True(t, NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20}).SortBy(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }).IsSortedWith(By(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }).Compare))
+
+	// not sorted
+	assert.False(t, NewSliceOfMapV(map[string]interface{}{"v": 5}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 1}).IsSortedWith(Compare))
+	assert.False(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 5}).IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+}
+
+// MarshalMsgpack
+//--------------------------------------------------------------------------------------------------
+func TestSliceOfMap_MarshalMsgpack(t *testing.T) {
+	slice := NewSliceOfMapV(M().Add("b", 1).Add("a", 2), M().Add("c", "3"))
+	data, err := slice.MarshalMsgpack()
+	assert.Nil(t, err)
+
+	slice2 := NewSliceOfMapV()
+	assert.Nil(t, slice2.UnmarshalMsgpack(data))
+	assert.Equal(t, slice, slice2)
+	assert.Equal(t, []string{"b", "a"}, slice2.First().ToStringMap().Keys().ToStrs())
+}
+
+// MarshalJSON
+//--------------------------------------------------------------------------------------------------
+func TestSliceOfMap_MarshalJSON(t *testing.T) {
+
+	// preserves key order
+	{
+		slice := NewSliceOfMapV(M().Add("b", 1).Add("a", "2"), M().Add("z", []interface{}{1.5}))
+		data, err := slice.MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, `[{"b":1,"a":"2"},{"z":[1.5]}]`, string(data))
+
+		x := NewSliceOfMapV()
+		assert.Nil(t, x.UnmarshalJSON(data))
+		assert.Equal(t, slice, x)
+	}
+
+	// as a field in a struct
+	{
+		type config struct {
+			Items *SliceOfMap `json:"items"`
+		}
+		data, err := json.Marshal(config{Items: NewSliceOfMapV(M().Add("1", "one"))})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"items":[{"1":"one"}]}`, string(data))
+
+		var c config
+		assert.Nil(t, json.Unmarshal(data, &c))
+		assert.Equal(t, NewSliceOfMapV(M().Add("1", "one")), c.Items)
+	}
+
+	// invalid
+	assert.NotNil(t, NewSliceOfMapV().UnmarshalJSON([]byte(`[1]`)))
+}
+
+// MarshalYAML
+//--------------------------------------------------------------------------------------------------
+func TestSliceOfMap_MarshalYAML(t *testing.T) {
+	slice := NewSliceOfMapV(M().Add("b", 1).Add("a", "2"), M().Add("z", 1.5))
+	data, err := yaml.Marshal(slice)
+	assert.Nil(t, err)
+	assert.Equal(t, "- b: 1\n  a: \"2\"\n- z: 1.5\n", string(data))
+
+	x := NewSliceOfMapV()
+	assert.Nil(t, yaml.Unmarshal(data, x))
+	assert.Equal(t, slice, x)
+
+	// invalid
+	assert.NotNil(t, yaml.Unmarshal([]byte("a: 1"), NewSliceOfMapV()))
+}
+
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Partition() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+	fmt.Println(slice.Partition(func(x O) bool { return x.(*StringMap).Get("v").ToInt()%2 == 1 }))
+	// Output: [&[{v 1}] &[{v 3}] &[{v 5}]] [&[{v 2}] &[{v 4}]]
+}
+
+func TestSliceOfMap_Partition(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		match, rest := slice.Partition(func(x O) bool { return x.(*StringMap).Get("v").ToInt()%2 == 1 })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// partition
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+		match, rest := slice.Partition(func(x O) bool { return x.(*StringMap).Get("v").ToInt()%2 == 1 })
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 5}), match)
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 2}, map[string]interface{}{"v": 4}), rest)
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}), slice)
+	}
+
+	// all or nothing
+	{
+		match, rest := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Partition(func(x O) bool { return true })
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}), match)
+		assert.Equal(t, 0, rest.Len())
+	}
+}
+
+// Permutations
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Permutations() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3})
+	fmt.Println(slice.Permutations(2))
+	// Output: [[&[{v 1}] &[{v 2}]] [&[{v 1}] &[{v 3}]] [&[{v 2}] &[{v 1}]] [&[{v 2}] &[{v 3}]] [&[{v 3}] &[{v 1}]] [&[{v 3}] &[{v 2}]]]
+}
+
+func TestSliceOfMap_Permutations(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, []ISlice{}, slice.Permutations(1))
+		assert.Equal(t, []ISlice{}, NewSliceOfMapV().Permutations(1))
+	}
+
+	// out of range
+	assert.Equal(t, []ISlice{}, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Permutations(-1))
+	assert.Equal(t, []ISlice{}, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Permutations(3))
+
+	// permutations
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3})
+		assert.Equal(t, 1, len(slice.Permutations(0)))
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}), NewSliceOfMapV(map[string]interface{}{"v": 2}), NewSliceOfMapV(map[string]interface{}{"v": 3})}, slice.Permutations(1))
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}), NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}), NewSliceOfMapV(map[string]interface{}{"v": 2}, map[string]interface{}{"v": 1}), NewSliceOfMapV(map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}), NewSliceOfMapV(map[string]interface{}{"v": 3}, map[string]interface{}{"v": 1}), NewSliceOfMapV(map[string]interface{}{"v": 3}, map[string]interface{}{"v": 2})}, slice.Permutations(2))
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}), NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 2}), NewSliceOfMapV(map[string]interface{}{"v": 2}, map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}), NewSliceOfMapV(map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 1}), NewSliceOfMapV(map[string]interface{}{"v": 3}, map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}), NewSliceOfMapV(map[string]interface{}{"v": 3}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 1})}, slice.Permutations(3))
+	}
+}
+
+// Reduce
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Reduce() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+	fmt.Println(slice.Reduce(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }))
+	// Output: &[{v 15}]
+}
+
+func TestSliceOfMap_Reduce(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Nil(t, slice.Reduce(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }).O())
+		assert.Nil(t, NewSliceOfMapV().Reduce(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }).O())
+		assert.Equal(t, ToStringMap(map[string]interface{}{"v": 10}), NewSliceOfMapV().Reduce(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }, ToStringMap(map[string]interface{}{"v": 10})).O())
+	}
+
+	// single element
+	assert.Equal(t, ToStringMap(map[string]interface{}{"v": 1}), NewSliceOfMapV(map[string]interface{}{"v": 1}).Reduce(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }).O())
+
+	// first element is the initial value
+	assert.Equal(t, ToStringMap(map[string]interface{}{"v": 15}), NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}).Reduce(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }).O())
+
+	// initial value
+	assert.Equal(t, ToStringMap(map[string]interface{}{"v": 25}), NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}).Reduce(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }, ToStringMap(map[string]interface{}{"v": 10})).O())
+}
+
+// Rotate
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Rotate() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+	fmt.Println(slice.Rotate(2))
+	// Output: [&[{v 3}] &[{v 4}] &[{v 5}] &[{v 1}] &[{v 2}]]
+}
+
+func TestSliceOfMap_Rotate(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, NewSliceOfMapV(), slice.Rotate(1))
+		assert.Equal(t, NewSliceOfMapV(), NewSliceOfMapV().Rotate(1))
+	}
+
+	// rotate
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}), slice.Rotate(0))
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}, map[string]interface{}{"v": 1}), slice.Rotate(1))
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}, map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}), slice.Rotate(2))
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}, map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}), slice.Rotate(7))
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}), slice.Rotate(5))
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}), slice)
+	}
+
+	// negative
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 5}, map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}), slice.Rotate(-1))
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}, map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}), slice.Rotate(-7))
+	}
+}
+
+// RotateM
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_RotateM() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+	fmt.Println(slice.RotateM(-1))
+	// Output: [&[{v 5}] &[{v 1}] &[{v 2}] &[{v 3}] &[{v 4}]]
+}
+
+func TestSliceOfMap_RotateM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, (*SliceOfMap)(nil), slice.RotateM(1))
+		assert.Equal(t, NewSliceOfMapV(), NewSliceOfMapV().RotateM(1))
+	}
+
+	// rotate
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}, map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}), slice.RotateM(2))
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}, map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}), slice)
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}, map[string]interface{}{"v": 1}), slice.RotateM(-1))
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}, map[string]interface{}{"v": 1}), slice)
+	}
+}
+
+// SQL
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_SQL() {
+	slice := NewSliceOfMapV("{name: a, team: red}", "{name: b, team: blue}", "{name: c, team: red}")
+	fmt.Println(slice.SQL("select team, count(*) as total from . group by team order by total"))
+	// Output: [&[{team blue} {total 1}] &[{team red} {total 2}]]
+}
+
+func TestSliceOfMap_SQL(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, NewSliceOfMapV(), slice.SQL("select name"))
+		assert.Equal(t, NewSliceOfMapV(), NewSliceOfMapV().SQL("select name"))
+	}
+
+	// invalid
+	{
+		assert.Equal(t, NewSliceOfMapV(), NewSliceOfMapV("{name: a}").SQL("select"))
+	}
+
+	// valid
+	{
+		slice := NewSliceOfMapV("{name: a, score: 5}", "{name: b, score: 1}")
+		assert.Equal(t, NewSliceOfMapV("{name: b}"), slice.SQL("select name where score < 3"))
+		assert.Equal(t, NewSliceOfMapV("{name: a, score: 5}", "{name: b, score: 1}"), slice)
+	}
+}
+
+// SQLE
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_SQLE() {
+	slice := NewSliceOfMapV("{name: a, score: 5}", "{name: b, score: 1}")
+	fmt.Println(slice.SQLE("select name order by score"))
+	// Output: [&[{name b}] &[{name a}]] <nil>
+}
+
+func TestSliceOfMap_SQLE(t *testing.T) {
+
+	// invalid
+	{
+		result, err := NewSliceOfMapV("{name: a}").SQLE("select name from foo")
+		assert.Nil(t, result)
+		assert.Equal(t, "expected \".\" but found \"foo\" at column 18", err.Error())
+	}
+
+	// valid
+	{
+		result, err := NewSliceOfMapV("{name: a, score: 5}", "{name: b, score: 1}").SQLE("select sum(score) as total")
+		assert.Nil(t, err)
+		assert.Equal(t, []map[string]interface{}{{"total": 6}}, result.G())
+	}
+}
+
+// Sample
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Sample() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+	fmt.Println(slice.Sample(2, rand.New(rand.NewSource(1))).Len())
+	// Output: 2
+}
+
+func TestSliceOfMap_Sample(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, 0, slice.Sample(2).Len())
+		assert.Equal(t, 0, NewSliceOfMapV().Sample(2).Len())
+	}
+
+	// invalid size
+	assert.Equal(t, 0, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Sample(0).Len())
+	assert.Equal(t, 0, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Sample(-1).Len())
+
+	// unique elements
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+		sample := slice.Sample(3)
+		assert.Equal(t, 3, sample.Len())
+		sample.Each(func(x O) {
+			assert.Equal(t, 1, sample.CountW(func(y O) bool { return Compare(x, y) == 0 }))
+			assert.True(t, slice.AnyW(func(y O) bool { return Compare(x, y) == 0 }))
+		})
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}), slice)
+	}
+
+	// all elements
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+		sample := slice.Sample(10)
+		assert.Equal(t, 5, sample.Len())
+		assert.Equal(t, slice, sample.SortBy(func(x O) O { return x.(*StringMap).Get("v") }))
+	}
+
+	// seeded
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+		assert.Equal(t, slice.Sample(3, rand.New(rand.NewSource(2))), slice.Sample(3, rand.New(rand.NewSource(2))))
+	}
+}
+
+// Scan
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Scan() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+	fmt.Println(slice.Scan(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }))
+	// Output: [&[{v 1}] &[{v 3}] &[{v 6}] &[{v 10}] &[{v 15}]]
+}
+
+func TestSliceOfMap_Scan(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, 0, slice.Scan(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }).Len())
+		assert.Equal(t, 0, NewSliceOfMapV().Scan(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }, ToStringMap(map[string]interface{}{"v": 10})).Len())
+	}
+
+	// first element is the initial value
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 6}, map[string]interface{}{"v": 10}, map[string]interface{}{"v": 15}), slice.Scan(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }))
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}), slice)
+	}
+
+	// initial value
+	assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 11}, map[string]interface{}{"v": 13}, map[string]interface{}{"v": 16}, map[string]interface{}{"v": 20}, map[string]interface{}{"v": 25}), NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}).Scan(func(acc, x O) O { return ToStringMap(map[string]interface{}{"v": acc.(*StringMap).Get("v").ToInt() + x.(*StringMap).Get("v").ToInt()}) }, ToStringMap(map[string]interface{}{"v": 10})))
+}
+
+// Shuffle
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Shuffle() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+	fmt.Println(slice.Shuffle().Len())
+	// Output: 5
+}
+
+func TestSliceOfMap_Shuffle(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, NewSliceOfMapV(), slice.Shuffle())
+		assert.Equal(t, NewSliceOfMapV(), NewSliceOfMapV().Shuffle())
+	}
+
+	// shuffle
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+		shuffled := slice.Shuffle()
+		assert.Equal(t, 5, shuffled.Len())
+		assert.Equal(t, slice, shuffled.SortBy(func(x O) O { return x.(*StringMap).Get("v") }))
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}), slice)
+	}
+
+	// seeded
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+		assert.Equal(t, slice.Shuffle(rand.New(rand.NewSource(2))), slice.Shuffle(rand.New(rand.NewSource(2))))
+	}
+}
+
+// ShuffleM
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_ShuffleM() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+	fmt.Println(slice.ShuffleM().Len())
+	// Output: 5
+}
+
+func TestSliceOfMap_ShuffleM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, (*SliceOfMap)(nil), slice.ShuffleM())
+		assert.Equal(t, NewSliceOfMapV(), NewSliceOfMapV().ShuffleM())
+	}
+
+	// shuffle
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+		shuffled := slice.ShuffleM(rand.New(rand.NewSource(2)))
+		assert.Equal(t, slice, shuffled)
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}).ShuffleM(rand.New(rand.NewSource(2))), slice)
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5}), slice.SortBy(func(x O) O { return x.(*StringMap).Get("v") }))
+	}
+}
+
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_SortBy() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20})
+	fmt.Println(slice.SortBy(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }))
+	// Output: [&[{v 25}] &[{v 20}] &[{v 31}] &[{v 30}]]
+}
+
+func TestSliceOfMap_SortBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, NewSliceOfMapV(), slice.SortBy(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }))
+		assert.Equal(t, NewSliceOfMapV(), NewSliceOfMapV().SortBy(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }))
+	}
+
+	// new Slice
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20})
+		sorted := slice.SortBy(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 })
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 25}, map[string]interface{}{"v": 20}, map[string]interface{}{"v": 31}, map[string]interface{}{"v": 30}), sorted)
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20}), slice)
+	}
+
+	// stable
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20})
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20}), slice.SortBy(func(x O) O { return 0 }))
+	}
+}
+
+// SortByDesc
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_SortByDesc() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20})
+	fmt.Println(slice.SortByDesc(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }))
+	// Output: [&[{v 31}] &[{v 30}] &[{v 25}] &[{v 20}]]
+}
+
+func TestSliceOfMap_SortByDesc(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, NewSliceOfMapV(), slice.SortByDesc(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }))
+		assert.Equal(t, NewSliceOfMapV(), NewSliceOfMapV().SortByDesc(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }))
+	}
+
+	// new Slice
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20})
+		sorted := slice.SortByDesc(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 })
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 20}), sorted)
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20}), slice)
+	}
+}
+
+// SortByDescM
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_SortByDescM() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20})
+	fmt.Println(slice.SortByDescM(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }))
+	// Output: [&[{v 31}] &[{v 30}] &[{v 25}] &[{v 20}]]
+}
+
+func TestSliceOfMap_SortByDescM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, (*SliceOfMap)(nil), slice.SortByDescM(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }))
+		assert.Equal(t, NewSliceOfMapV(), NewSliceOfMapV().SortByDescM(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20})
+		sorted := slice.SortByDescM(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 })
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 20}), sorted)
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 20}), slice)
+	}
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_SortByM() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20})
+	fmt.Println(slice.SortByM(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }))
+	// Output: [&[{v 25}] &[{v 20}] &[{v 31}] &[{v 30}]]
+}
+
+func TestSliceOfMap_SortByM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, (*SliceOfMap)(nil), slice.SortByM(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }))
+		assert.Equal(t, NewSliceOfMapV(), NewSliceOfMapV().SortByM(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20})
+		sorted := slice.SortByM(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 })
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 25}, map[string]interface{}{"v": 20}, map[string]interface{}{"v": 31}, map[string]interface{}{"v": 30}), sorted)
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 25}, map[string]interface{}{"v": 20}, map[string]interface{}{"v": 31}, map[string]interface{}{"v": 30}), slice)
+	}
+}
+
+// SortWith
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_SortWith() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20})
+	fmt.Println(slice.SortWith(func(a, b O) int { return Compare(b, a) }))
+	// Output: [&[{v 31}] &[{v 30}] &[{v 25}] &[{v 20}]]
+}
+
+func TestSliceOfMap_SortWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, NewSliceOfMapV(), slice.SortWith(func(a, b O) int { return Compare(b, a) }))
+		assert.Equal(t, NewSliceOfMapV(), NewSliceOfMapV().SortWith(func(a, b O) int { return Compare(b, a) }))
+	}
+
+	// new Slice
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20})
+		sorted := slice.SortWith(func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 20}), sorted)
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20}), slice)
+	}
+
+	// ordering
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20})
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 25}, map[string]interface{}{"v": 20}, map[string]interface{}{"v": 31}, map[string]interface{}{"v": 30}), slice.SortWith(By(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }).Compare))
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 20}), slice.SortWith(ByDesc(func(x O) O { return x.(*StringMap).Get("v").ToInt() / 10 }).Compare))
+	}
+}
+
+// SortWithM
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_SortWithM() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20})
+	fmt.Println(slice.SortWithM(func(a, b O) int { return Compare(b, a) }))
+	// Output: [&[{v 31}] &[{v 30}] &[{v 25}] &[{v 20}]]
+}
+
+func TestSliceOfMap_SortWithM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, (*SliceOfMap)(nil), slice.SortWithM(func(a, b O) int { return Compare(b, a) }))
+		assert.Equal(t, NewSliceOfMapV(), NewSliceOfMapV().SortWithM(func(a, b O) int { return Compare(b, a) }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 20})
+		sorted := slice.SortWithM(func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 20}), sorted)
+		assert.Equal(t, NewSliceOfMapV(map[string]interface{}{"v": 31}, map[string]interface{}{"v": 30}, map[string]interface{}{"v": 25}, map[string]interface{}{"v": 20}), slice)
+	}
+}
+
+// SumBy
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_SumBy() {
+	slice := NewSliceOfMapV(map[string]interface{}{"size": 1.5}, map[string]interface{}{"size": 2})
+	fmt.Println(slice.SumBy("size"))
+	// Output: 3.5
+}
+
+func TestSliceOfMap_SumBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, 0.0, slice.SumBy("size"))
+		assert.Equal(t, 0.0, NewSliceOfMapV().SumBy("size"))
+	}
+
+	// keys
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"size": 1}, map[string]interface{}{"foo": 1},
+			map[string]interface{}{"size": int64(2)}, map[string]interface{}{"size": nil})
+		val, err := slice.SumByE("size")
+		assert.Nil(t, err)
+		assert.Equal(t, 3.0, val)
+		assert.Equal(t, 0.0, slice.SumBy("bar"))
+	}
+
+	// lambda selector
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"size": 1}, map[string]interface{}{"size": 5})
+		assert.Equal(t, 5.0, slice.SumBy(func(x O) O {
+			if v := x.(*StringMap).Get("size").ToInt(); v > 1 {
+				return v
+			}
+			return nil
+		}))
+	}
+
+	// invalid values
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"size": []int{1}})
+		_, err := slice.SumByE("size")
+		assert.Equal(t, "failed to convert value selected from map 0: unable to convert type []int to int", err.Error())
+	}
+}
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Window() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4})
+	fmt.Println(slice.Window(2, 1))
+	// Output: [[&[{v 1}] &[{v 2}]] [&[{v 2}] &[{v 3}]] [&[{v 3}] &[{v 4}]]]
+}
+
+func TestSliceOfMap_Window(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, []ISlice{}, slice.Window(2, 1))
+		assert.Equal(t, []ISlice{}, NewSliceOfMapV().Window(2, 1))
+	}
+
+	// invalid size or step
+	assert.Equal(t, []ISlice{}, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Window(0, 1))
+	assert.Equal(t, []ISlice{}, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Window(1, 0))
+	assert.Equal(t, []ISlice{}, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Window(3, 1))
+
+	// windows
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}), NewSliceOfMapV(map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}), NewSliceOfMapV(map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}), NewSliceOfMapV(map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})}, slice.Window(2, 1))
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}), NewSliceOfMapV(map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})}, slice.Window(3, 2))
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}), NewSliceOfMapV(map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})}, slice.Window(2, 3))
+		assert.Equal(t, []ISlice{NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3}, map[string]interface{}{"v": 4}, map[string]interface{}{"v": 5})}, slice.Window(5, 1))
+	}
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleSliceOfMap_Zip() {
+	slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2})
+	fmt.Println(slice.Zip([]string{"x", "y"}))
+	// Output: [[&[{v 1}] x] [&[{v 2}] y]]
+}
+
+func TestSliceOfMap_Zip(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *SliceOfMap
+		assert.Equal(t, []ISlice{}, slice.Zip([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewSliceOfMapV().Zip([]string{"x"}))
+	}
+
+	// no slices
+	assert.Equal(t, []ISlice{NewInterSliceV(ToStringMap(map[string]interface{}{"v": 1})), NewInterSliceV(ToStringMap(map[string]interface{}{"v": 2}))}, NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}).Zip())
+
+	// stops at the shortest slice
+	{
+		slice := NewSliceOfMapV(map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, map[string]interface{}{"v": 3})
+		assert.Equal(t, []ISlice{NewInterSliceV(ToStringMap(map[string]interface{}{"v": 1}), "x", 7), NewInterSliceV(ToStringMap(map[string]interface{}{"v": 2}), "y", 8)},
+			slice.Zip([]string{"x", "y", "z"}, NewIntSliceV(7, 8)))
+		assert.Equal(t, []ISlice{}, slice.Zip([]int{}))
+	}
+}
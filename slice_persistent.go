@@ -0,0 +1,483 @@
+package n
+
+import (
+	"sort"
+
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/pkg/errors"
+)
+
+// PersistentVector is an immutable slice implementing ISliceView. Rather than modifying the slice
+// in place every modifying method returns a new version of the slice in O(log n), including
+// inserting, dropping, slicing and concatenating, that shares all but O(log n) of its structure
+// with the original making snapshots and undo histories cheap and safe to share between
+// goroutines. Nested maps and slices are converted into PersistentMaps and PersistentVectors so
+// that the whole tree of values is immutable. The zero value is an empty slice.
+type PersistentVector struct {
+	root *pnode
+}
+
+// NewPersistentVector creates a new *PersistentVector from the given Go slice or Slice type
+func NewPersistentVector(slice interface{}) *PersistentVector {
+	if x, ok := slice.(*PersistentVector); ok {
+		return x
+	}
+	return NewPersistentVectorV(*ToInterSlice(slice)...)
+}
+
+// NewPersistentVectorV creates a new *PersistentVector from the given variadic elements
+func NewPersistentVectorV(elems ...interface{}) *PersistentVector {
+	frozen := make([]interface{}, len(elems))
+	for i := range elems {
+		frozen[i] = freeze(elems[i])
+	}
+	return &PersistentVector{root: pfromSlice(frozen)}
+}
+
+// A is an alias to String for brevity
+func (p *PersistentVector) A() string {
+	return p.String()
+}
+
+// All tests if this Slice is not empty or optionally if it contains all of the given variadic
+// elements. Incompatible types will return false.
+func (p *PersistentVector) All(elems ...interface{}) bool {
+	return p.slice().All(elems...)
+}
+
+// AllS tests if this Slice contains all of the given Slice's elements. Incompatible types will
+// return false.
+func (p *PersistentVector) AllS(slice interface{}) bool {
+	return p.slice().AllS(slice)
+}
+
+// Any tests if this Slice is not empty or optionally if it contains any of the given variadic
+// elements. Incompatible types will return false.
+func (p *PersistentVector) Any(elems ...interface{}) bool {
+	return p.slice().Any(elems...)
+}
+
+// AnyS tests if this Slice contains any of the given Slice's elements. Incompatible types will
+// return false.
+func (p *PersistentVector) AnyS(slice interface{}) bool {
+	return p.slice().AnyS(slice)
+}
+
+// AnyW tests if this Slice contains any that match the lambda selector.
+func (p *PersistentVector) AnyW(sel func(O) bool) bool {
+	found := false
+	p.each(func(i int, x O) bool {
+		found = sel(x)
+		return !found
+	})
+	return found
+}
+
+// Append returns a new version of this Slice with the given element added to the end
+func (p *PersistentVector) Append(elem interface{}) *PersistentVector {
+	return p.Insert(p.Len(), elem)
+}
+
+// AppendV returns a new version of this Slice with the given variadic elements added to the end
+func (p *PersistentVector) AppendV(elems ...interface{}) *PersistentVector {
+	return p.Concat(NewPersistentVectorV(elems...))
+}
+
+// At returns the element at the given index location. Allows for negative notation.
+func (p *PersistentVector) At(i int) (elem *Object) {
+	elem = &Object{}
+	if i = absIndex(p.Len(), i); i == -1 {
+		return
+	}
+	elem.o = pat(p.root, i).val
+	return
+}
+
+// BinarySearch searches this Slice, which must be sorted in ascending order, for the given
+// element returning the index it was found at or the index it would be inserted at to keep this
+// Slice sorted and whether it was found, see InterSlice.BinarySearch.
+func (p *PersistentVector) BinarySearch(elem interface{}) (i int, found bool) {
+	return p.slice().BinarySearch(elem)
+}
+
+// BinarySearchWith searches this Slice, which must be sorted according to the given comparison,
+// for the given element returning the index it was found at or the index it would be inserted at
+// to keep this Slice sorted and whether it was found. The comparison returns a negative number
+// when a < b, zero when a == b and a positive number when a > b.
+func (p *PersistentVector) BinarySearchWith(elem interface{}, cmp func(a, b O) int) (i int, found bool) {
+	l := p.Len()
+	i = sort.Search(l, func(j int) bool { return cmp(pat(p.root, j).val, elem) >= 0 })
+	return i, i < l && cmp(pat(p.root, i).val, elem) == 0
+}
+
+// Concat returns a new version of this Slice with the elements of the given Slice added to the
+// end. Concatenating another PersistentVector is O(log n).
+func (p *PersistentVector) Concat(slice interface{}) *PersistentVector {
+	if p == nil {
+		p = &PersistentVector{}
+	}
+	return &PersistentVector{root: pconcat(p.root, NewPersistentVector(slice).root)}
+}
+
+// Copy returns a new mutable *InterSlice with the indicated range of elements copied from this
+// Slice. Expects nothing, in which case everything is copied, or two indices i and j, in which
+// case positive and negative notation is supported and uses an inclusive behavior such that
+// Copy(0, -1) includes index -1.
+func (p *PersistentVector) Copy(indices ...int) (new ISlice) {
+	return NewInterSlice(p.Slice(indices...).ToInterSlice())
+}
+
+// Count the number of elements in this Slice equal to the given element.
+func (p *PersistentVector) Count(elem interface{}) (cnt int) {
+	return p.slice().Count(elem)
+}
+
+// CountW counts the number of elements in this Slice that match the lambda selector.
+func (p *PersistentVector) CountW(sel func(O) bool) (cnt int) {
+	p.each(func(i int, x O) bool {
+		if sel(x) {
+			cnt++
+		}
+		return true
+	})
+	return
+}
+
+// DeepEqual tests if this Slice is structurally equal to the given obj, see DeepEqual.
+//
+// Supported options: IgnoreOrderOpt, IgnorePathsOpt and NumericOpt
+func (p *PersistentVector) DeepEqual(obj interface{}, opts ...*opt.Opt) bool {
+	return DeepEqual(p, obj, opts...)
+}
+
+// Drop returns a new version of this Slice without the indicated range of elements. Expects
+// nothing, in which case everything is dropped, or two indices i and j, in which case positive
+// and negative notation is supported and uses an inclusive behavior such that Drop(0, -1)
+// includes index -1.
+func (p *PersistentVector) Drop(indices ...int) *PersistentVector {
+	if p.Len() == 0 {
+		return &PersistentVector{}
+	}
+	i, j, err := absIndices(p.Len(), indices...)
+	if err != nil {
+		return p
+	}
+	left, rest := psplitAt(p.root, i)
+	_, right := psplitAt(rest, j-i)
+	return &PersistentVector{root: pconcat(left, right)}
+}
+
+// DropAt returns a new version of this Slice without the element at the given index location.
+// Allows for negative notation.
+func (p *PersistentVector) DropAt(i int) *PersistentVector {
+	return p.Drop(i, i)
+}
+
+// DropFirst returns a new version of this Slice without the first element
+func (p *PersistentVector) DropFirst() *PersistentVector {
+	return p.Drop(0, 0)
+}
+
+// DropFirstN returns a new version of this Slice without the first n elements
+func (p *PersistentVector) DropFirstN(n int) *PersistentVector {
+	if n == 0 {
+		return p
+	}
+	return p.Drop(0, abs(n)-1)
+}
+
+// DropLast returns a new version of this Slice without the last element
+func (p *PersistentVector) DropLast() *PersistentVector {
+	return p.Drop(-1, -1)
+}
+
+// DropLastN returns a new version of this Slice without the last n elements
+func (p *PersistentVector) DropLastN(n int) *PersistentVector {
+	if n == 0 {
+		return p
+	}
+	return p.Drop(absNeg(n), -1)
+}
+
+// Each calls the given lambda once for each element in this Slice, passing in that element
+func (p *PersistentVector) Each(action func(O)) *PersistentVector {
+	p.each(func(i int, x O) bool {
+		action(x)
+		return true
+	})
+	return p
+}
+
+// EachI calls the given lambda once for each element in this Slice, passing in the index and
+// element
+func (p *PersistentVector) EachI(action func(int, O)) *PersistentVector {
+	p.each(func(i int, x O) bool {
+		action(i, x)
+		return true
+	})
+	return p
+}
+
+// EachR calls the given lambda once for each element in this Slice in reverse, passing in that
+// element
+func (p *PersistentVector) EachR(action func(O)) *PersistentVector {
+	if p != nil {
+		peachR(p.root, func(n *pnode) bool {
+			action(n.val)
+			return true
+		})
+	}
+	return p
+}
+
+// Empty tests if this Slice is empty.
+func (p *PersistentVector) Empty() bool {
+	return p.Len() == 0
+}
+
+// First returns the first element in this Slice as Object.
+// Object.Nil() == true will be returned when there are no elements in the slice.
+func (p *PersistentVector) First() (elem *Object) {
+	return p.At(0)
+}
+
+// Hash returns a stable structural hash of this Slice, see Hash.
+func (p *PersistentVector) Hash() uint64 {
+	return Hash(p)
+}
+
+// Index returns the index of the first element in this Slice where element == elem
+// Returns a -1 if the element was not not found.
+func (p *PersistentVector) Index(elem interface{}) (loc int) {
+	return p.slice().Index(elem)
+}
+
+// Inject is an alias to Reduce
+func (p *PersistentVector) Inject(reducer func(acc, elem O) O, init ...interface{}) (acc *Object) {
+	return p.Reduce(reducer, init...)
+}
+
+// Insert returns a new version of this Slice with the given element inserted before the element
+// at the given index location when positive or after it when negative e.g. Insert(-1, x) appends.
+func (p *PersistentVector) Insert(i int, elem interface{}) *PersistentVector {
+	if p == nil {
+		p = &PersistentVector{}
+	}
+	j := i
+	if j != p.Len() {
+		if j = absIndex(p.Len(), j); j == -1 {
+			return p
+		}
+		if i < 0 {
+			j++
+		}
+	}
+	left, right := psplitAt(p.root, j)
+	return &PersistentVector{root: pjoin(left, &pnode{val: freeze(elem)}, right)}
+}
+
+// IsSorted tests if the elements of this Slice are sorted in ascending order.
+func (p *PersistentVector) IsSorted() bool {
+	return p.slice().IsSorted()
+}
+
+// IsSortedWith tests if the elements of this Slice are sorted according to the given comparison
+// which returns a negative number when a < b, zero when a == b and a positive number when a > b.
+func (p *PersistentVector) IsSortedWith(cmp func(a, b O) int) bool {
+	return p.slice().IsSortedWith(cmp)
+}
+
+// Join converts each element into a string then joins them together using the given separator
+// or comma by default.
+func (p *PersistentVector) Join(separator ...string) (str *Object) {
+	return p.slice().Join(separator...)
+}
+
+// Last returns the last element in this Slice as an Object.
+// Object.Nil() == true will be returned if there are no elements in the slice.
+func (p *PersistentVector) Last() (elem *Object) {
+	return p.At(-1)
+}
+
+// Len returns the number of elements in this Slice
+func (p *PersistentVector) Len() int {
+	if p == nil {
+		return 0
+	}
+	return plen(p.root)
+}
+
+// Less returns true if the element indexed by i is less than the element indexed by j.
+func (p *PersistentVector) Less(i, j int) bool {
+	l := p.Len()
+	if l < 2 || i < 0 || j < 0 || i >= l || j >= l {
+		return false
+	}
+	return NewInterSliceV(pat(p.root, i).val, pat(p.root, j).val).Less(0, 1)
+}
+
+// Map returns a new Slice with the elements modified by the lambda
+func (p *PersistentVector) Map(mod func(O) O) *PersistentVector {
+	elems := make([]interface{}, 0, p.Len())
+	p.each(func(i int, x O) bool {
+		elems = append(elems, mod(x))
+		return true
+	})
+	return NewPersistentVectorV(elems...)
+}
+
+// Nil tests if this Slice is nil
+func (p *PersistentVector) Nil() bool {
+	return p == nil
+}
+
+// O returns a mutable copy of this Slice as a []interface{}
+func (p *PersistentVector) O() interface{} {
+	return p.ToInterSlice()
+}
+
+// Pair simply returns the first and second Slice elements as Objects
+func (p *PersistentVector) Pair() (first, second *Object) {
+	return p.At(0), p.At(1)
+}
+
+// Prepend returns a new version of this Slice with the given element added at the begining
+func (p *PersistentVector) Prepend(elem interface{}) *PersistentVector {
+	return p.Insert(0, elem)
+}
+
+// Reduce combines the elements of this Slice into a single value by calling the given reducer
+// with the accumulated value and each element. The accumulator starts with the given init value
+// or the first element if not given.
+func (p *PersistentVector) Reduce(reducer func(acc, elem O) O, init ...interface{}) (acc *Object) {
+	return p.slice().Reduce(reducer, init...)
+}
+
+// S is an alias to ToStringSlice
+func (p *PersistentVector) S() (slice *StringSlice) {
+	return p.ToStringSlice()
+}
+
+// Select returns a new Slice with the elements that match the lambda selector
+func (p *PersistentVector) Select(sel func(O) bool) *PersistentVector {
+	elems := []interface{}{}
+	p.each(func(i int, x O) bool {
+		if sel(x) {
+			elems = append(elems, x)
+		}
+		return true
+	})
+	return &PersistentVector{root: pfromSlice(elems)}
+}
+
+// Set returns a new version of this Slice with the element at the given index location set to
+// the given element. Allows for negative notation. Returns this Slice if out of bounds.
+func (p *PersistentVector) Set(i int, elem interface{}) *PersistentVector {
+	if i = absIndex(p.Len(), i); i == -1 {
+		return p
+	}
+	return &PersistentVector{root: psetAt(p.root, i, freeze(elem))}
+}
+
+// Single reports true if there is only one element in this Slice.
+func (p *PersistentVector) Single() bool {
+	return p.Len() == 1
+}
+
+// Slice returns a new version of this Slice with the indicated range of elements. Expects
+// nothing, in which case everything is included, or two indices i and j, in which case an
+// inclusive behavior is used such that Slice(0, -1) includes index -1. Out of bounds indices
+// will be moved within bounds. An empty Slice is returned if the indicies are mutually exclusive.
+func (p *PersistentVector) Slice(indices ...int) *PersistentVector {
+	if p.Len() == 0 {
+		return &PersistentVector{}
+	}
+	i, j, err := absIndices(p.Len(), indices...)
+	if err != nil {
+		return &PersistentVector{}
+	}
+	_, rest := psplitAt(p.root, i)
+	middle, _ := psplitAt(rest, j-i)
+	return &PersistentVector{root: middle}
+}
+
+// String returns a string representation of this Slice, implements the Stringer interface
+func (p *PersistentVector) String() string {
+	return NewInterSlice(p.ToInterSlice()).String()
+}
+
+// ToInts converts the given slice into a native []int type
+func (p *PersistentVector) ToInts() (slice []int) {
+	return ToInts(p.ToInterSlice())
+}
+
+// ToIntSlice converts the given slice into a *IntSlice
+func (p *PersistentVector) ToIntSlice() (slice *IntSlice) {
+	return ToIntSlice(p.ToInterSlice())
+}
+
+// ToInterSlice converts this Slice into a new mutable []interface{} slice with nested
+// PersistentMaps and PersistentVectors converted into yaml.MapSlices and []interface{} slices
+func (p *PersistentVector) ToInterSlice() (slice []interface{}) {
+	slice = make([]interface{}, 0, p.Len())
+	p.each(func(i int, x O) bool {
+		slice = append(slice, thaw(x))
+		return true
+	})
+	return
+}
+
+// ToStrs converts the underlying slice into a []string slice
+func (p *PersistentVector) ToStrs() (slice []string) {
+	return ToStrs(p.ToInterSlice())
+}
+
+// ToStringSlice converts the underlying slice into a *StringSlice
+func (p *PersistentVector) ToStringSlice() (slice *StringSlice) {
+	return ToStringSlice(p.ToInterSlice())
+}
+
+// each calls the given action for each element in order until it returns false
+func (p *PersistentVector) each(action func(i int, x O) bool) {
+	if p == nil {
+		return
+	}
+	i := 0
+	peach(p.root, func(n *pnode) bool {
+		i++
+		return action(i-1, n.val)
+	})
+}
+
+// index returns the index of the element selected by the given jq type array selector e.g.
+// [2], [-1] or [key==val] or -1 for the [] iterator
+func (p *PersistentVector) index(selector string) (i int, err error) {
+	var k, v string
+	if i, k, v, err = IdxFromSelector(selector, p.Len()); err != nil {
+		err = errors.Errorf("invalid array index selector %v", selector)
+		return
+	}
+	if k != "" && v != "" {
+		found := false
+		p.each(func(j int, x O) bool {
+			if m, ok := x.(*PersistentMap); ok && m.Get(k).A() == v {
+				i, found = j, true
+			}
+			return !found
+		})
+		if !found {
+			err = errors.Errorf("invalid array element selection %v", selector)
+		}
+	}
+	return
+}
+
+// slice returns the elements of this Slice as an *InterSlice
+func (p *PersistentVector) slice() *InterSlice {
+	elems := make([]interface{}, 0, p.Len())
+	p.each(func(i int, x O) bool {
+		elems = append(elems, x)
+		return true
+	})
+	return NewInterSliceV(elems...)
+}
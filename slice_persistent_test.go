@@ -0,0 +1,467 @@
+package n
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/phR0ze/yaml/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// NewPersistentVector
+// --------------------------------------------------------------------------------------------------
+func ExampleNewPersistentVector() {
+	fmt.Println(NewPersistentVector([]int{1, 2, 3}))
+	// Output: [1 2 3]
+}
+
+func TestNewPersistentVector(t *testing.T) {
+
+	// empty
+	{
+		assert.Equal(t, 0, NewPersistentVector(nil).Len())
+		assert.Equal(t, 0, NewPersistentVector([]int{}).Len())
+	}
+
+	// Go and Slice types
+	{
+		assert.Equal(t, []int{1, 2, 3}, NewPersistentVector([]int{1, 2, 3}).ToInts())
+		assert.Equal(t, []string{"1", "2"}, NewPersistentVector(NewStringSliceV("1", "2")).ToStrs())
+	}
+
+	// vectors are returned as is
+	{
+		v := NewPersistentVectorV(1, 2)
+		assert.True(t, v == NewPersistentVector(v))
+	}
+
+	// nested values are frozen
+	{
+		v := NewPersistentVector([]interface{}{[]int{1}, map[string]interface{}{"k": "v"}})
+		assert.IsType(t, &PersistentVector{}, v.At(0).O())
+		assert.IsType(t, &PersistentMap{}, v.At(1).O())
+	}
+}
+
+// NewPersistentVectorV
+// --------------------------------------------------------------------------------------------------
+func ExampleNewPersistentVectorV() {
+	fmt.Println(NewPersistentVectorV(1, 2, 3))
+	// Output: [1 2 3]
+}
+
+func TestNewPersistentVectorV(t *testing.T) {
+	assert.Equal(t, &PersistentVector{}, NewPersistentVectorV())
+	assert.Equal(t, []interface{}{1, "2", 3.0}, NewPersistentVectorV(1, "2", 3.0).ToInterSlice())
+}
+
+// Any
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Any() {
+	fmt.Println(NewPersistentVectorV(1, 2, 3).Any(2))
+	// Output: true
+}
+
+func TestPersistentVector_Any(t *testing.T) {
+	assert.False(t, (*PersistentVector)(nil).Any())
+	assert.False(t, NewPersistentVectorV().Any())
+	assert.True(t, NewPersistentVectorV(1).Any())
+	assert.False(t, NewPersistentVectorV(1, 2).Any(3))
+	assert.True(t, NewPersistentVectorV(1, 2).All(1, 2))
+	assert.True(t, NewPersistentVectorV(1, 2).AnyS([]int{3, 2}))
+	assert.True(t, NewPersistentVectorV(1, 2).AnyW(func(x O) bool { return x == 2 }))
+	assert.False(t, NewPersistentVectorV(1, 2).AnyW(func(x O) bool { return x == 3 }))
+}
+
+// Append
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Append() {
+	v := NewPersistentVectorV(1, 2)
+	fmt.Println(v.Append(3), v)
+	// Output: [1 2 3] [1 2]
+}
+
+func TestPersistentVector_Append(t *testing.T) {
+
+	// nil
+	{
+		assert.Equal(t, []int{1}, (*PersistentVector)(nil).Append(1).ToInts())
+	}
+
+	// versions
+	{
+		v := NewPersistentVectorV()
+		versions := []*PersistentVector{v}
+		for i := 0; i < 100; i++ {
+			v = v.Append(i)
+			versions = append(versions, v)
+		}
+		for i, x := range versions {
+			assert.Equal(t, i, x.Len())
+			if i > 0 {
+				assert.Equal(t, i-1, x.Last().O())
+			}
+		}
+	}
+
+	// variadic
+	{
+		assert.Equal(t, []int{1, 2, 3}, NewPersistentVectorV(1).AppendV(2, 3).ToInts())
+	}
+}
+
+// At
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_At() {
+	fmt.Println(NewPersistentVectorV(1, 2, 3).At(-1))
+	// Output: 3
+}
+
+func TestPersistentVector_At(t *testing.T) {
+	v := NewPersistentVectorV(1, 2, 3, 4)
+	assert.Equal(t, &Object{}, (*PersistentVector)(nil).At(0))
+	assert.Equal(t, 1, v.At(0).O())
+	assert.Equal(t, 4, v.At(3).O())
+	assert.Equal(t, 4, v.At(-1).O())
+	assert.Equal(t, 1, v.At(-4).O())
+	assert.Equal(t, &Object{}, v.At(4))
+	assert.Equal(t, &Object{}, v.At(-5))
+	assert.Equal(t, 1, v.First().O())
+	assert.Equal(t, 4, v.Last().O())
+}
+
+// BinarySearch
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_BinarySearch() {
+	fmt.Println(NewPersistentVectorV(1, 3, 5).BinarySearch(3))
+	// Output: 1 true
+}
+
+func TestPersistentVector_BinarySearch(t *testing.T) {
+	v := NewPersistentVectorV(1, 3, 5)
+	i, found := v.BinarySearch(4)
+	assert.Equal(t, 2, i)
+	assert.False(t, found)
+
+	i, found = v.BinarySearchWith(5, func(a, b O) int { return a.(int) - b.(int) })
+	assert.Equal(t, 2, i)
+	assert.True(t, found)
+
+	i, found = v.BinarySearchWith(6, func(a, b O) int { return a.(int) - b.(int) })
+	assert.Equal(t, 3, i)
+	assert.False(t, found)
+}
+
+// Concat
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Concat() {
+	fmt.Println(NewPersistentVectorV(1, 2).Concat([]int{3, 4}))
+	// Output: [1 2 3 4]
+}
+
+func TestPersistentVector_Concat(t *testing.T) {
+
+	// nil
+	{
+		assert.Equal(t, []int{1}, (*PersistentVector)(nil).Concat([]int{1}).ToInts())
+		assert.Equal(t, []int{1}, NewPersistentVectorV(1).Concat(nil).ToInts())
+	}
+
+	// vectors
+	{
+		v1 := NewPersistentVector(Range(0, 99))
+		v2 := NewPersistentVector(Range(100, 109))
+		v3 := v1.Concat(v2)
+		assert.Equal(t, Range(0, 109), v3.ToInts())
+		assert.Equal(t, Range(100, 109), v2.ToInts())
+		assert.Equal(t, 100, v1.Len())
+		_, err := pcheck(v3.root)
+		assert.Nil(t, err)
+	}
+}
+
+// Copy
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Copy() {
+	fmt.Println(NewPersistentVectorV(1, 2, 3).Copy(1, -1))
+	// Output: [2 3]
+}
+
+func TestPersistentVector_Copy(t *testing.T) {
+	v := NewPersistentVectorV(1, []int{2})
+	cp := v.Copy()
+	assert.Equal(t, NewInterSliceV(1, []interface{}{2}), cp)
+
+	// copies are mutable and independent
+	cp.Set(0, 0)
+	assert.Equal(t, 1, v.At(0).O())
+}
+
+// Count
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Count() {
+	fmt.Println(NewPersistentVectorV(1, 2, 2).Count(2))
+	// Output: 2
+}
+
+func TestPersistentVector_Count(t *testing.T) {
+	v := NewPersistentVectorV(1, 2, 2, 3)
+	assert.Equal(t, 0, (*PersistentVector)(nil).Count(1))
+	assert.Equal(t, 0, v.Count(4))
+	assert.Equal(t, 2, v.Count(2))
+	assert.Equal(t, 3, v.CountW(func(x O) bool { return x.(int) > 1 }))
+}
+
+// DeepEqual
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_DeepEqual() {
+	fmt.Println(NewPersistentVectorV(1, 2).DeepEqual([]int{1, 2}))
+	// Output: true
+}
+
+func TestPersistentVector_DeepEqual(t *testing.T) {
+	v := NewPersistentVectorV(1, map[string]interface{}{"k": []int{2}})
+	assert.True(t, v.DeepEqual(v.Set(0, 1)))
+	assert.False(t, v.DeepEqual(v.Set(0, 2)))
+	assert.True(t, v.DeepEqual(v.ToInterSlice()))
+	assert.False(t, v.DeepEqual([]int{1}))
+}
+
+// Drop
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Drop() {
+	fmt.Println(NewPersistentVectorV(1, 2, 3, 4).Drop(1, 2))
+	// Output: [1 4]
+}
+
+func TestPersistentVector_Drop(t *testing.T) {
+
+	// nil and empty
+	{
+		assert.Equal(t, &PersistentVector{}, (*PersistentVector)(nil).Drop())
+		assert.Equal(t, &PersistentVector{}, NewPersistentVectorV().Drop(0, 1))
+	}
+
+	// ranges
+	{
+		v := NewPersistentVectorV(1, 2, 3, 4)
+		assert.Equal(t, []int{}, v.Drop().ToInts())
+		assert.Equal(t, []int{}, v.Drop(0, -1).ToInts())
+		assert.Equal(t, []int{2, 3, 4}, v.Drop(0, 0).ToInts())
+		assert.Equal(t, []int{1, 2}, v.Drop(-2, -1).ToInts())
+		assert.Equal(t, []int{1, 2, 3, 4}, v.ToInts())
+	}
+
+	// invalid ranges return the same slice
+	{
+		v := NewPersistentVectorV(1, 2, 3, 4)
+		assert.Equal(t, v, v.Drop(3, 1))
+		assert.Equal(t, v, v.Drop(0))
+	}
+
+	// helpers
+	{
+		v := NewPersistentVectorV(1, 2, 3, 4)
+		assert.Equal(t, []int{1, 3, 4}, v.DropAt(1).ToInts())
+		assert.Equal(t, []int{1, 2, 3}, v.DropAt(-1).ToInts())
+		assert.Equal(t, []int{2, 3, 4}, v.DropFirst().ToInts())
+		assert.Equal(t, []int{3, 4}, v.DropFirstN(2).ToInts())
+		assert.Equal(t, []int{}, v.DropFirstN(10).ToInts())
+		assert.Equal(t, []int{1, 2, 3}, v.DropLast().ToInts())
+		assert.Equal(t, []int{1, 2}, v.DropLastN(2).ToInts())
+		assert.Equal(t, v, v.DropLastN(0))
+	}
+}
+
+// Each
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Each() {
+	NewPersistentVectorV(1, 2, 3).Each(func(x O) {
+		fmt.Printf("%v", x)
+	})
+	// Output: 123
+}
+
+func TestPersistentVector_Each(t *testing.T) {
+	v := NewPersistentVectorV(1, 2, 3)
+
+	// in order
+	{
+		results := []int{}
+		assert.Equal(t, v, v.Each(func(x O) {
+			results = append(results, x.(int))
+		}))
+		assert.Equal(t, []int{1, 2, 3}, results)
+	}
+
+	// with index
+	{
+		results := []int{}
+		v.EachI(func(i int, x O) {
+			results = append(results, i*x.(int))
+		})
+		assert.Equal(t, []int{0, 2, 6}, results)
+	}
+
+	// in reverse
+	{
+		results := []int{}
+		v.EachR(func(x O) {
+			results = append(results, x.(int))
+		})
+		assert.Equal(t, []int{3, 2, 1}, results)
+	}
+}
+
+// Hash
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Hash() {
+	fmt.Println(NewPersistentVectorV(1, 2).Hash() == Hash([]int{1, 2}))
+	// Output: true
+}
+
+func TestPersistentVector_Hash(t *testing.T) {
+	v := NewPersistentVectorV(1, 2, 3)
+	assert.Equal(t, v.Hash(), NewIntSliceV(1, 2, 3).Hash())
+	assert.Equal(t, v.Hash(), v.DropLast().Append(3).Hash())
+	assert.NotEqual(t, v.Hash(), v.Set(0, 0).Hash())
+}
+
+// Insert
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Insert() {
+	fmt.Println(NewPersistentVectorV(1, 3).Insert(1, 2))
+	// Output: [1 2 3]
+}
+
+func TestPersistentVector_Insert(t *testing.T) {
+
+	// nil
+	{
+		assert.Equal(t, []int{1}, (*PersistentVector)(nil).Insert(0, 1).ToInts())
+	}
+
+	// positive and negative
+	{
+		v := NewPersistentVectorV(1, 3)
+		assert.Equal(t, []int{0, 1, 3}, v.Insert(0, 0).ToInts())
+		assert.Equal(t, []int{1, 3, 4}, v.Insert(2, 4).ToInts())
+		assert.Equal(t, []int{1, 3, 4}, v.Insert(-1, 4).ToInts())
+		assert.Equal(t, []int{1, 2, 3}, v.Insert(-2, 2).ToInts())
+		assert.Equal(t, []int{1, 3}, v.ToInts())
+	}
+
+	// out of bounds returns the same slice
+	{
+		v := NewPersistentVectorV(1, 3)
+		assert.Equal(t, v, v.Insert(3, 4))
+		assert.Equal(t, v, v.Insert(-3, 4))
+	}
+
+	// prepend
+	{
+		assert.Equal(t, []int{0, 1}, NewPersistentVectorV(1).Prepend(0).ToInts())
+	}
+}
+
+// Join
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Join() {
+	fmt.Println(NewPersistentVectorV("1", "2", "3").Join("."))
+	// Output: 1.2.3
+}
+
+func TestPersistentVector_Join(t *testing.T) {
+	assert.Equal(t, "1,2,3", NewPersistentVectorV(1, 2, 3).Join().A())
+	assert.Equal(t, "", NewPersistentVectorV().Join().A())
+}
+
+// Map
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Map() {
+	fmt.Println(NewPersistentVectorV(1, 2).Map(func(x O) O { return x.(int) * 2 }))
+	// Output: [2 4]
+}
+
+func TestPersistentVector_Map(t *testing.T) {
+	v := NewPersistentVectorV(1, 2)
+	assert.Equal(t, []string{"1", "2"}, v.Map(func(x O) O { return ToString(x) }).ToStrs())
+	assert.IsType(t, &PersistentVector{}, v.Map(func(x O) O { return []int{x.(int)} }).At(0).O())
+	assert.Equal(t, 0, (*PersistentVector)(nil).Map(func(x O) O { return x }).Len())
+}
+
+// Reduce
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Reduce() {
+	fmt.Println(NewPersistentVectorV(1, 2, 3).Reduce(func(acc, x O) O { return acc.(int) + x.(int) }))
+	// Output: 6
+}
+
+func TestPersistentVector_Reduce(t *testing.T) {
+	sum := func(acc, x O) O { return acc.(int) + x.(int) }
+	assert.Equal(t, 16, NewPersistentVectorV(1, 2, 3).Reduce(sum, 10).O())
+	assert.Equal(t, 16, NewPersistentVectorV(1, 2, 3).Inject(sum, 10).O())
+}
+
+// Select
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Select() {
+	fmt.Println(NewPersistentVectorV(1, 2, 3).Select(func(x O) bool { return x.(int) > 1 }))
+	// Output: [2 3]
+}
+
+func TestPersistentVector_Select(t *testing.T) {
+	v := NewPersistentVectorV(1, 2, 3)
+	assert.Equal(t, []int{}, v.Select(func(x O) bool { return false }).ToInts())
+	assert.Equal(t, []int{1, 3}, v.Select(func(x O) bool { return x != 2 }).ToInts())
+}
+
+// Set
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Set() {
+	v := NewPersistentVectorV(1, 2, 3)
+	fmt.Println(v.Set(0, 0), v)
+	// Output: [0 2 3] [1 2 3]
+}
+
+func TestPersistentVector_Set(t *testing.T) {
+	v := NewPersistentVectorV(1, 2, 3)
+	assert.Equal(t, []int{1, 2, 0}, v.Set(-1, 0).ToInts())
+	assert.Equal(t, v, v.Set(3, 0))
+	assert.Equal(t, v, (*PersistentVector)(nil).Set(0, 0).Concat(v))
+	assert.IsType(t, &PersistentMap{}, v.Set(0, map[string]interface{}{}).At(0).O())
+}
+
+// Slice
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_Slice() {
+	fmt.Println(NewPersistentVectorV(1, 2, 3, 4).Slice(1, -2))
+	// Output: [2 3]
+}
+
+func TestPersistentVector_Slice(t *testing.T) {
+	v := NewPersistentVectorV(1, 2, 3, 4)
+	assert.Equal(t, &PersistentVector{}, (*PersistentVector)(nil).Slice(0, 1))
+	assert.Equal(t, []int{1, 2, 3, 4}, v.Slice().ToInts())
+	assert.Equal(t, []int{1, 2, 3, 4}, v.Slice(0, -1).ToInts())
+	assert.Equal(t, []int{4}, v.Slice(-1, -1).ToInts())
+	assert.Equal(t, []int{3, 4}, v.Slice(2, 10).ToInts())
+	assert.Equal(t, []int{}, v.Slice(3, 1).ToInts())
+	assert.Equal(t, []int{}, v.Slice(1).ToInts())
+}
+
+// ToInterSlice
+// --------------------------------------------------------------------------------------------------
+func ExamplePersistentVector_ToInterSlice() {
+	fmt.Println(NewPersistentVectorV(1, []int{2}).ToInterSlice())
+	// Output: [1 [2]]
+}
+
+func TestPersistentVector_ToInterSlice(t *testing.T) {
+	v := NewPersistentVectorV(1, map[string]interface{}{"k": []int{2}})
+	assert.Equal(t, []interface{}{1, yaml.MapSlice{{Key: "k", Value: []interface{}{2}}}}, v.ToInterSlice())
+	assert.Equal(t, v.ToInterSlice(), v.O())
+	assert.Equal(t, NewInterSlice(v.ToInterSlice()), ToInterSlice(v))
+	assert.Equal(t, []int{1, 2}, NewPersistentVectorV(1, 2).ToIntSlice().O())
+	assert.Equal(t, []string{"1", "2"}, NewPersistentVectorV(1, 2).ToStringSlice().O())
+}
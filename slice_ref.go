@@ -2,9 +2,13 @@ package n
 
 import (
 	"fmt"
+	"math/rand"
 	"reflect"
 	"strings"
 
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/phR0ze/n/pkg/enc/msgpack"
+	"github.com/phR0ze/n/pkg/opt"
 	"github.com/pkg/errors"
 )
 
@@ -331,6 +335,43 @@ func (p *RefSlice) At(i int) (elem *Object) {
 	return
 }
 
+// BinarySearch searches this Slice, which must be sorted in ascending order e.g. with Sort, for
+// the given element returning the index it was found at or the index it would be inserted at to
+// keep this Slice sorted and whether it was found.
+// Supports optimized Slice types or Go types that can be converted into an optimized Slice type.
+func (p *RefSlice) BinarySearch(elem interface{}) (i int, found bool) {
+	if p.Nil() || p.Len() == 0 {
+		return 0, false
+	}
+	slice := Slice(p.v.Interface())
+	if slice.RefSlice() {
+		panic(fmt.Sprintf("unsupported comparable type '%v'", p.v.Type()))
+	}
+	return slice.BinarySearch(elem)
+}
+
+// BinarySearchWith searches this Slice, which must be sorted according to the given comparison, for
+// the given element returning the index it was found at or the index it would be inserted at to
+// keep this Slice sorted and whether it was found. The comparison is given each element of this
+// Slice and the element being searched for e.g. SortWith and BinarySearchWith may share an Ordering.
+func (p *RefSlice) BinarySearchWith(elem interface{}, cmp func(a, b O) int) (i int, found bool) {
+	return binarySearch(p.Len(), func(i int) O { return p.v.Index(i).Interface() }, elem, cmp)
+}
+
+// Cartesian returns the cartesian product of this Slice and the given slices as new tuple Slices
+// of every combination of one element from each slice e.g. [1 2].Cartesian([a b]) returns
+// [[1 a] [1 b] [2 a] [2 b]]. Tuples are InterSlices as the slices may be of different types.
+func (p *RefSlice) Cartesian(slices ...interface{}) (tuples []ISlice) {
+	return cartesian(p, slices)
+}
+
+// Chunk returns this Slice split into new Slices of n consecutive elements with the last Slice
+// containing the remaining elements e.g. [1 2 3 4 5].Chunk(2) returns [[1 2] [3 4] [5]].
+// Returns no Slices if n is not positive.
+func (p *RefSlice) Chunk(n int) (chunks []ISlice) {
+	return chunk(p, n)
+}
+
 // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
 func (p *RefSlice) Clear() ISlice {
 	if p.Nil() {
@@ -345,6 +386,13 @@ func (p *RefSlice) Clear() ISlice {
 	return p
 }
 
+// Combinations returns new Slices of all combinations of n elements of this Slice in the order
+// the elements occur e.g. [1 2 3].Combinations(2) returns [[1 2] [1 3] [2 3]]. Returns no Slices
+// if n is negative or larger than this Slice.
+func (p *RefSlice) Combinations(n int) (combos []ISlice) {
+	return combinations(p, n)
+}
+
 // Concat returns a new Slice by appending the given Slice to this Slice using variadic expansion.
 // Supports RefSlice, *RefSlice, []int or *[]int
 func (p *RefSlice) Concat(slice interface{}) (new ISlice) {
@@ -450,6 +498,22 @@ func (p *RefSlice) CountW(sel func(O) bool) (cnt int) {
 	return
 }
 
+// DeepCopy returns a new Slice with all elements recursively copied from this Slice such that
+// nested maps and slices are no longer shared with this Slice, see DeepCopy.
+func (p *RefSlice) DeepCopy() (new ISlice) {
+	if p.Nil() {
+		return NewRefSliceV()
+	}
+	return DeepCopy(p).(*RefSlice)
+}
+
+// DeepEqual tests if this Slice is structurally equal to the given obj, see DeepEqual.
+//
+// Supported options: IgnoreOrderOpt, IgnorePathsOpt and NumericOpt
+func (p *RefSlice) DeepEqual(obj interface{}, opts ...*opt.Opt) bool {
+	return DeepEqual(p, obj, opts...)
+}
+
 // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
 // Expects nothing, in which case everything is dropped, or two indices i and j, in which case positive and
 // negative notation is supported and uses an inclusive behavior such that DropAt(0, -1) includes index -1
@@ -634,6 +698,15 @@ func (p *RefSlice) EachRIE(action func(int, O) error) (ISlice, error) {
 	return p, err
 }
 
+// EachSlice calls the given lambda once for each new Slice of n consecutive elements of this
+// Slice the same as Chunk and returns a reference to this Slice.
+func (p *RefSlice) EachSlice(n int, action func(ISlice)) ISlice {
+	for _, slice := range chunk(p, n) {
+		action(slice)
+	}
+	return p
+}
+
 // Empty tests if this Slice is empty.
 func (p *RefSlice) Empty() bool {
 	if p.Nil() || p.Len() == 0 {
@@ -660,6 +733,25 @@ func (p *RefSlice) FirstN(n int) ISlice {
 	return p.Slice(0, abs(n)-1)
 }
 
+// Flatten returns a new Slice with the elements of any nested slices e.g. [][]int expanded in
+// place recursively. Strings, bytes, runes and maps are not expanded. The new Slice is converted
+// into an optimized Slice type if possible e.g. flattening [][]int returns an *IntSlice.
+func (p *RefSlice) Flatten() (new ISlice) {
+	if p.Nil() {
+		return NewRefSliceV()
+	}
+	switch p.v.Type().Elem().Kind() {
+	case reflect.Slice, reflect.Array, reflect.Interface:
+		return NewSliceV(flatten(p, []interface{}{})...)
+	}
+	return p.Copy()
+}
+
+// Hash returns a stable structural hash of this Slice, see Hash.
+func (p *RefSlice) Hash() uint64 {
+	return Hash(p)
+}
+
 // Index returns the index of the first element in this Slice where element == elem
 // Returns a -1 if the element was not not found.
 func (p *RefSlice) Index(elem interface{}) (loc int) {
@@ -676,6 +768,11 @@ func (p *RefSlice) Index(elem interface{}) (loc int) {
 	return
 }
 
+// Inject is an alias to Reduce
+func (p *RefSlice) Inject(reducer func(acc, elem O) O, init ...interface{}) (acc *Object) {
+	return reduce(p, reducer, init)
+}
+
 // Insert modifies this Slice to insert the given element before the element with the given index.
 // Negative indices count backwards from the end of the slice, where -1 is the last element. If a
 // negative index is used, the given element will be inserted after that element, so using an index
@@ -759,6 +856,24 @@ func (p *RefSlice) InterSlice() bool {
 	return false
 }
 
+// IsSorted tests if the elements of this Slice are sorted in ascending order.
+// Supports optimized Slice types or Go types that can be converted into an optimized Slice type.
+func (p *RefSlice) IsSorted() bool {
+	if p.Nil() || p.Len() == 0 {
+		return true
+	}
+	slice := Slice(p.v.Interface())
+	if slice.RefSlice() {
+		panic(fmt.Sprintf("unsupported comparable type '%v'", p.v.Type()))
+	}
+	return slice.IsSorted()
+}
+
+// IsSortedWith tests if the elements of this Slice are sorted according to the given comparison
+func (p *RefSlice) IsSortedWith(cmp func(a, b O) int) bool {
+	return isSorted(p.Len(), func(i int) O { return p.v.Index(i).Interface() }, cmp)
+}
+
 // Join converts each element into a string then joins them together using the given separator or comma by default.
 func (p *RefSlice) Join(separator ...string) (str *Object) {
 	l := p.Len()
@@ -843,6 +958,21 @@ func (p *RefSlice) Map(mod func(O) O) ISlice {
 	return slice
 }
 
+// MarshalJSON implements the json.Marshaler interface encoding this Slice as a json array.
+func (p *RefSlice) MarshalJSON() ([]byte, error) {
+	return json.MarshalOrdered(p.O())
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface encoding this Slice as a msgpack array.
+func (p *RefSlice) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(p.O())
+}
+
+// MarshalYAML implements the yaml.Marshaler interface encoding this Slice as a yaml sequence.
+func (p *RefSlice) MarshalYAML() (interface{}, error) {
+	return p.O(), nil
+}
+
 // Nil tests if this Slice is nil
 func (p *RefSlice) Nil() bool {
 	if p == nil || p.v == nil {
@@ -872,6 +1002,19 @@ func (p *RefSlice) Pair() (first, second *Object) {
 	return
 }
 
+// Partition returns the elements of this Slice that match the lambda selector and the elements
+// that don't as new Slices preserving element order.
+func (p *RefSlice) Partition(sel func(O) bool) (match, rest ISlice) {
+	return partition(p, sel)
+}
+
+// Permutations returns new Slices of all ordered arrangements of n elements of this Slice e.g.
+// [1 2 3].Permutations(2) returns [[1 2] [1 3] [2 1] [2 3] [3 1] [3 2]]. Returns no Slices if n
+// is negative or larger than this Slice.
+func (p *RefSlice) Permutations(n int) (perms []ISlice) {
+	return permutations(p, n)
+}
+
 // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
 func (p *RefSlice) Pop() (elem *Object) {
 	elem = p.Last()
@@ -897,6 +1040,13 @@ func (p *RefSlice) Prepend(elem interface{}) ISlice {
 	return p.Insert(0, elem)
 }
 
+// Reduce combines the elements of this Slice into a single value by calling the given lambda
+// with the accumulated value and each element in turn, returning the final accumulated value.
+// The first element is used as the initial value if one isn't given.
+func (p *RefSlice) Reduce(reducer func(acc, elem O) O, init ...interface{}) (acc *Object) {
+	return reduce(p, reducer, init)
+}
+
 // RefSlice returns true if the underlying implementation is a RefSlice
 func (p *RefSlice) RefSlice() bool {
 	return true
@@ -922,11 +1072,43 @@ func (p *RefSlice) ReverseM() ISlice {
 	return p
 }
 
+// Rotate returns a new Slice with the elements rotated n places to the left or to the right for
+// negative n such that the element at index n becomes the first element.
+func (p *RefSlice) Rotate(n int) (new ISlice) {
+	if p.Nil() || p.Len() < 2 {
+		return p.Copy()
+	}
+	return p.Copy().RotateM(n)
+}
+
+// RotateM modifies this Slice rotating the elements n places to the left or to the right for
+// negative n and returns a reference to this Slice. See Rotate.
+func (p *RefSlice) RotateM(n int) ISlice {
+	if p.Nil() || p.Len() < 2 {
+		return p
+	}
+	rotateM(p, n)
+	return p
+}
+
 // S is an alias to ToStringSlice
 func (p *RefSlice) S() (slice *StringSlice) {
 	return ToStringSlice(p.O())
 }
 
+// Sample returns a new Slice of n unique elements chosen at random from this Slice using the
+// optional random number generator e.g. rand.New(rand.NewSource(seed)) for repeatable results.
+// All elements are returned in random order if n is larger than this Slice.
+func (p *RefSlice) Sample(n int, rng ...*rand.Rand) (new ISlice) {
+	return sample(p, n, rng)
+}
+
+// Scan returns a new Slice of the accumulated values from each step of reducing this Slice e.g.
+// running totals. The new Slice is converted into an optimized Slice type if possible. See Reduce.
+func (p *RefSlice) Scan(reducer func(acc, elem O) O, init ...interface{}) (new ISlice) {
+	return scan(p, reducer, init)
+}
+
 // Select creates a new slice with the elements that match the lambda selector.
 func (p *RefSlice) Select(sel func(O) bool) (new ISlice) {
 	l := p.Len()
@@ -991,6 +1173,25 @@ func (p *RefSlice) ShiftN(n int) (new ISlice) {
 	return
 }
 
+// Shuffle returns a new Slice with the elements in random order using the optional random
+// number generator e.g. rand.New(rand.NewSource(seed)) for repeatable results.
+func (p *RefSlice) Shuffle(rng ...*rand.Rand) (new ISlice) {
+	if p.Nil() || p.Len() < 2 {
+		return p.Copy()
+	}
+	return p.Copy().ShuffleM(rng...)
+}
+
+// ShuffleM modifies this Slice putting the elements in random order using the optional random
+// number generator and returns a reference to this Slice. See Shuffle.
+func (p *RefSlice) ShuffleM(rng ...*rand.Rand) ISlice {
+	if p.Nil() || p.Len() < 2 {
+		return p
+	}
+	shuffleM(p, rng)
+	return p
+}
+
 // Single reports true if there is only one element in this Slice.
 func (p *RefSlice) Single() bool {
 	return p.Len() == 1
@@ -1027,6 +1228,47 @@ func (p *RefSlice) Sort() (new ISlice) {
 	return p.Copy().SortM()
 }
 
+// SortBy returns a new Slice with the elements sorted in ascending order by the keys the given
+// lambda selects from them compared with Compare. The sort is stable and the lambda is called
+// once per element.
+func (p *RefSlice) SortBy(key func(O) O) (new ISlice) {
+	if p.Nil() || p.Len() < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByM(key)
+}
+
+// SortByDesc returns a new Slice with the elements sorted in descending order by the keys the
+// given lambda selects from them compared with Compare. The sort is stable.
+func (p *RefSlice) SortByDesc(key func(O) O) (new ISlice) {
+	if p.Nil() || p.Len() < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByDescM(key)
+}
+
+// SortByDescM modifies this Slice sorting the elements in descending order by the keys the
+// given lambda selects from them compared with Compare and returns a reference to this Slice.
+// The sort is stable.
+func (p *RefSlice) SortByDescM(key func(O) O) ISlice {
+	if p.Nil() || p.Len() < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return key(p.v.Index(i).Interface()) }, func(a, b O) int { return Compare(b, a) })
+	return p
+}
+
+// SortByM modifies this Slice sorting the elements in ascending order by the keys the given
+// lambda selects from them compared with Compare and returns a reference to this Slice. The sort
+// is stable and the lambda is called once per element.
+func (p *RefSlice) SortByM(key func(O) O) ISlice {
+	if p.Nil() || p.Len() < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return key(p.v.Index(i).Interface()) }, Compare)
+	return p
+}
+
 // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
 // Supports optimized Slice types or Go types that can be converted into an optimized Slice type.
 func (p *RefSlice) SortM() ISlice {
@@ -1074,6 +1316,26 @@ func (p *RefSlice) SortReverseM() ISlice {
 	return p
 }
 
+// SortWith returns a new Slice with the elements sorted according to the given comparison which
+// returns a negative number when a sorts before b, zero when equal and a positive number when a
+// sorts after b e.g. CompareNatural or an Ordering's Compare. The sort is stable.
+func (p *RefSlice) SortWith(cmp func(a, b O) int) (new ISlice) {
+	if p.Nil() || p.Len() < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortWithM(cmp)
+}
+
+// SortWithM modifies this Slice sorting the elements according to the given comparison and
+// returns a reference to this Slice. See SortWith. The sort is stable.
+func (p *RefSlice) SortWithM(cmp func(a, b O) int) ISlice {
+	if p.Nil() || p.Len() < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return p.v.Index(i).Interface() }, cmp)
+	return p
+}
+
 // Returns a string representation of this Slice, implements the Stringer interface
 func (p *RefSlice) String() string {
 	l := p.Len()
@@ -1215,3 +1477,17 @@ func (p *RefSlice) UniqM() ISlice {
 	}
 	return p
 }
+
+// Window returns new Slices of n consecutive elements of this Slice starting every step elements
+// e.g. [1 2 3 4].Window(2, 1) returns [[1 2] [2 3] [3 4]]. Only full windows are returned and no
+// Slices are returned if n or step are not positive.
+func (p *RefSlice) Window(n, step int) (windows []ISlice) {
+	return window(p, n, step)
+}
+
+// Zip returns new tuple Slices of the elements at the same index in this Slice and each of the
+// given slices e.g. [1 2].Zip([a b]) returns [[1 a] [2 b]]. Stops at the end of the shortest
+// slice. Tuples are InterSlices as the slices may be of different types.
+func (p *RefSlice) Zip(slices ...interface{}) (tuples []ISlice) {
+	return zip(p, slices)
+}
@@ -2,6 +2,7 @@ package n
 
 import (
 	"fmt"
+	"math/rand"
 	"sort"
 	"strings"
 	"testing"
@@ -757,6 +758,151 @@ func TestRefSlice_At(t *testing.T) {
 	}
 }
 
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_BinarySearch() {
+	slice := NewRefSliceV(1, 3, 5)
+	fmt.Println(slice.BinarySearch(3))
+	// Output: 1 true
+}
+
+func TestRefSlice_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		i, found := slice.BinarySearch(3)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = NewRefSliceV().BinarySearch(3)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		slice := NewRefSliceV(1, 3, 5)
+		i, found := slice.BinarySearch(1)
+		assert.Equal(t, 0, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearch(3)
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+	}
+
+	// not found returns the insertion index
+	{
+		slice := NewRefSliceV(1, 3, 5)
+		i, found := slice.BinarySearch(4)
+		assert.Equal(t, 2, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch(6)
+		assert.Equal(t, 3, i)
+		assert.False(t, found)
+	}
+}
+
+// BinarySearchWith
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_BinarySearchWith() {
+	slice := NewRefSliceV(5, 3, 1)
+	fmt.Println(slice.BinarySearchWith(3, func(a, b O) int { return Compare(b, a) }))
+	// Output: 1 true
+}
+
+func TestRefSlice_BinarySearchWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		i, found := slice.BinarySearchWith(3, Compare)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// descending
+	{
+		slice := NewRefSliceV(5, 3, 1)
+		i, found := slice.BinarySearchWith(3, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearchWith(4, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 1, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearchWith(6, func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// Cartesian
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Cartesian() {
+	slice := NewRefSliceV(1, 2)
+	fmt.Println(slice.Cartesian([]string{"x", "y"}))
+	// Output: [[1 x] [1 y] [2 x] [2 y]]
+}
+
+func TestRefSlice_Cartesian(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, []ISlice{}, slice.Cartesian([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewRefSliceV().Cartesian([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewRefSliceV(1).Cartesian([]string{}))
+	}
+
+	// no slices
+	assert.Equal(t, []ISlice{NewInterSliceV(1), NewInterSliceV(2)}, NewRefSliceV(1, 2).Cartesian())
+
+	// multiple slices
+	{
+		tuples := NewRefSliceV(1, 2).Cartesian([]string{"x", "y"}, NewIntSliceV(7))
+		assert.Equal(t, []ISlice{NewInterSliceV(1, "x", 7), NewInterSliceV(1, "y", 7),
+			NewInterSliceV(2, "x", 7), NewInterSliceV(2, "y", 7)}, tuples)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Chunk() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Chunk(2))
+	// Output: [[1 2] [3 4] [5]]
+}
+
+func TestRefSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, []ISlice{}, slice.Chunk(2))
+		assert.Equal(t, []ISlice{}, NewRefSliceV().Chunk(2))
+	}
+
+	// invalid size
+	assert.Equal(t, []ISlice{}, NewRefSliceV(1, 2).Chunk(0))
+	assert.Equal(t, []ISlice{}, NewRefSliceV(1, 2).Chunk(-1))
+
+	// chunks
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1, 2), NewRefSliceV(3, 4), NewRefSliceV(5)}), sliceO(slice.Chunk(2)))
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1, 2, 3, 4, 5)}), sliceO(slice.Chunk(5)))
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1, 2, 3, 4, 5)}), sliceO(slice.Chunk(10)))
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1), NewRefSliceV(2), NewRefSliceV(3), NewRefSliceV(4), NewRefSliceV(5)}), sliceO(slice.Chunk(1)))
+	}
+
+	// chunks are copies
+	{
+		slice := NewRefSliceV(1, 2)
+		chunks := slice.Chunk(1)
+		chunks[0].Set(0, 3)
+		assert.Equal(t, NewRefSliceV(1, 2).O(), slice.O())
+	}
+}
+
 // Clear
 //--------------------------------------------------------------------------------------------------
 
@@ -819,6 +965,39 @@ func TestRefSlice_Clear(t *testing.T) {
 	}
 }
 
+// Combinations
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Combinations() {
+	slice := NewRefSliceV(1, 2, 3)
+	fmt.Println(slice.Combinations(2))
+	// Output: [[1 2] [1 3] [2 3]]
+}
+
+func TestRefSlice_Combinations(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, []ISlice{}, slice.Combinations(1))
+		assert.Equal(t, []ISlice{}, NewRefSliceV().Combinations(1))
+	}
+
+	// out of range
+	assert.Equal(t, []ISlice{}, NewRefSliceV(1, 2).Combinations(-1))
+	assert.Equal(t, []ISlice{}, NewRefSliceV(1, 2).Combinations(3))
+
+	// combinations
+	{
+		slice := NewRefSliceV(1, 2, 3, 4)
+		assert.Equal(t, 1, len(slice.Combinations(0)))
+		assert.Equal(t, 0, slice.Combinations(0)[0].Len())
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1), NewRefSliceV(2), NewRefSliceV(3), NewRefSliceV(4)}), sliceO(slice.Combinations(1)))
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1, 2), NewRefSliceV(1, 3), NewRefSliceV(1, 4), NewRefSliceV(2, 3), NewRefSliceV(2, 4), NewRefSliceV(3, 4)}), sliceO(slice.Combinations(2)))
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1, 2, 3), NewRefSliceV(1, 2, 4), NewRefSliceV(1, 3, 4), NewRefSliceV(2, 3, 4)}), sliceO(slice.Combinations(3)))
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1, 2, 3, 4)}), sliceO(slice.Combinations(4)))
+	}
+}
+
 // Concat
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_Concat_Go10(t *testing.B) {
@@ -1414,6 +1593,66 @@ func TestRefSlice_CountW(t *testing.T) {
 	assert.Equal(t, 1, NewRefSliceV(1, 2, 3).CountW(func(x O) bool { return ExB(x.(int) == 4 || x.(int) == 3) }))
 }
 
+// DeepCopy
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_DeepCopy() {
+	slice := NewRefSliceV([]int{1}, []int{2})
+	copy := slice.DeepCopy()
+	copy.At(1).O().([]int)[0] = 3
+	fmt.Println(slice.O(), copy.O())
+	// Output: [[1] [2]] [[1] [3]]
+}
+
+func TestRefSlice_DeepCopy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, NewRefSliceV(), slice.DeepCopy())
+	}
+
+	// nested slices and maps aren't shared
+	{
+		slice := NewRefSliceV(map[string][]int{"a": {1}}, map[string][]int{"b": {2}})
+		copy := slice.DeepCopy()
+		assert.Equal(t, slice.O(), copy.O())
+		copy.At(0).O().(map[string][]int)["a"][0] = 3
+		copy.At(1).O().(map[string][]int)["c"] = []int{4}
+		assert.Equal(t, []map[string][]int{{"a": {1}}, {"b": {2}}}, slice.O())
+		assert.Equal(t, []map[string][]int{{"a": {3}}, {"b": {2}, "c": {4}}}, copy.O())
+	}
+}
+
+// DeepEqual
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_DeepEqual() {
+	slice := NewRefSliceV([]int{1}, []int{2}, []int{3})
+	fmt.Println(slice.DeepEqual([][]int{[]int{3}, []int{2}, []int{1}}, IgnoreOrderOpt(true)))
+	// Output: true
+}
+
+func TestRefSlice_DeepEqual(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.True(t, slice.DeepEqual(nil))
+		assert.False(t, slice.DeepEqual(NewRefSliceV([]int{1})))
+		assert.True(t, NewRefSliceV().DeepEqual(nil))
+	}
+
+	// compare with options
+	{
+		slice := NewRefSliceV([]int{1}, []int{2}, []int{3})
+		assert.True(t, slice.DeepEqual(NewRefSliceV([]int{1}, []int{2}, []int{3})))
+		assert.True(t, slice.DeepEqual([][]int{[]int{1}, []int{2}, []int{3}}))
+		assert.False(t, slice.DeepEqual([][]int{[]int{3}, []int{2}, []int{1}}))
+		assert.True(t, slice.DeepEqual([][]int{[]int{3}, []int{2}, []int{1}}, IgnoreOrderOpt(true)))
+		assert.False(t, slice.DeepEqual([][]int{[]int{1}, []int{2}, []int{1}}))
+		assert.True(t, slice.DeepEqual([][]int{[]int{1}, []int{2}, []int{1}}, IgnorePathsOpt("[2]")))
+	}
+}
+
 // Drop
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_Drop_Go(t *testing.B) {
@@ -2606,6 +2845,36 @@ func TestRefSlice_EachRIE(t *testing.T) {
 	}
 }
 
+// EachSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_EachSlice() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	slice.EachSlice(2, func(x ISlice) {
+		fmt.Print(x.O())
+	})
+	// Output: [1 2][3 4][5]
+}
+
+func TestRefSlice_EachSlice(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		called := false
+		slice.EachSlice(2, func(x ISlice) { called = true })
+		NewRefSliceV().EachSlice(2, func(x ISlice) { called = true })
+		assert.False(t, called)
+	}
+
+	// slices
+	{
+		slices := []ISlice{}
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewRefSliceV(1, 2, 3, 4, 5).O(), slice.EachSlice(2, func(x ISlice) { slices = append(slices, x) }).O())
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1, 2), NewRefSliceV(3, 4), NewRefSliceV(5)}), sliceO(slices))
+	}
+}
+
 // Empty
 //--------------------------------------------------------------------------------------------------
 func ExampleRefSlice_Empty() {
@@ -2757,6 +3026,52 @@ func TestRefSlice_FirstN(t *testing.T) {
 	}
 }
 
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Flatten() {
+	slice := NewRefSliceV([]int{1, 2}, []int{3})
+	fmt.Println(slice.Flatten())
+	// Output: [1 2 3]
+}
+
+func TestRefSlice_Flatten(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, NewRefSliceV(), slice.Flatten())
+		assert.Equal(t, NewRefSliceV(), NewRefSliceV().Flatten())
+	}
+
+	// not nested
+	{
+		slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25})
+		assert.Equal(t, []person{{"Ben", 30}, {"Amy", 25}}, slice.Flatten().O())
+	}
+
+	// nested
+	{
+		slice := NewRefSliceV([][]int{{1}, {2, 3}}, [][]int{{4}})
+		assert.Equal(t, NewIntSliceV(1, 2, 3, 4), slice.Flatten())
+		assert.Equal(t, []person{{"Ben", 30}, {"Amy", 25}}, NewRefSliceV([]person{{"Ben", 30}}, []person{{"Amy", 25}}).Flatten().O())
+	}
+}
+
+// Hash
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Hash() {
+	slice := NewRefSliceV([]int{1}, []int{2}, []int{3})
+	fmt.Println(slice.Hash() == NewRefSliceV([]int{1}, []int{2}, []int{3}).Hash())
+	// Output: true
+}
+
+func TestRefSlice_Hash(t *testing.T) {
+	slice := NewRefSliceV([]int{1}, []int{2}, []int{3})
+	assert.Equal(t, slice.Hash(), slice.DeepCopy().Hash())
+	assert.Equal(t, slice.Hash(), Hash([][]int{[]int{1}, []int{2}, []int{3}}))
+	assert.NotEqual(t, slice.Hash(), NewRefSliceV([]int{3}, []int{2}, []int{1}).Hash())
+}
+
 // RefSlicej
 //--------------------------------------------------------------------------------------------------
 func ExampleRefSlice_RefSlice() {
@@ -2806,6 +3121,27 @@ func TestRefSlice_Index(t *testing.T) {
 	assert.Equal(t, -1, NewRefSliceV(1, 2, 3).Index(5))
 }
 
+// Inject
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Inject() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Inject(func(acc, x O) O { return acc.(int) + x.(int) }, 10))
+	// Output: 25
+}
+
+func TestRefSlice_Inject(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, 10, slice.Inject(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+		assert.Equal(t, 10, NewRefSliceV().Inject(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+	}
+
+	// inject
+	assert.Equal(t, 25, NewRefSliceV(1, 2, 3, 4, 5).Inject(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+}
+
 // Insert
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_Insert_Go(t *testing.B) {
@@ -2945,6 +3281,62 @@ func TestRefSlice_Insert_wrongType(t *testing.T) {
 	slice.Insert(0, "2")
 }
 
+// IsSorted
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_IsSorted() {
+	slice := NewRefSliceV(1, 3, 5)
+	fmt.Println(slice.IsSorted())
+	// Output: true
+}
+
+func TestRefSlice_IsSorted(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.True(t, slice.IsSorted())
+		assert.True(t, NewRefSliceV().IsSorted())
+	}
+
+	// sorted
+	assert.True(t, NewRefSliceV(1, 3, 5).IsSorted())
+	assert.True(t, NewRefSliceV(1, 3, 5).SortReverse().Reverse().IsSorted())
+
+	// not sorted
+	assert.False(t, NewRefSliceV(5, 3, 1).IsSorted())
+
+	// unsupported types
+	assert.Panics(t, func() { NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}).IsSorted() })
+	assert.Panics(t, func() { NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}).BinarySearch(person{"Amy", 25}) })
+}
+
+// IsSortedWith
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_IsSortedWith() {
+	slice := NewRefSliceV(5, 3, 1)
+	fmt.Println(slice.IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+	// Output: true
+}
+
+func TestRefSlice_IsSortedWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.True(t, slice.IsSortedWith(Compare))
+		assert.True(t, NewRefSliceV().IsSortedWith(Compare))
+	}
+
+	// sorted
+	assert.True(t, NewRefSliceV(1, 3, 5).IsSortedWith(Compare))
+	assert.True(t, NewRefSliceV(5, 3, 1).IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+	assert.True(t, NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20}).SortBy(personAge).IsSortedWith(By(personAge).Compare))
+
+	// not sorted
+	assert.False(t, NewRefSliceV(5, 3, 1).IsSortedWith(Compare))
+	assert.False(t, NewRefSliceV(1, 3, 5).IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+}
+
 // Join
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_Join_Go(t *testing.B) {
@@ -3276,6 +3668,72 @@ func TestRefSlice_Pair(t *testing.T) {
 	}
 }
 
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Partition() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Partition(func(x O) bool { return x.(int)%2 == 1 }))
+	// Output: [1 3 5] [2 4]
+}
+
+func TestRefSlice_Partition(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		match, rest := slice.Partition(func(x O) bool { return x.(int)%2 == 1 })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// partition
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		match, rest := slice.Partition(func(x O) bool { return x.(int)%2 == 1 })
+		assert.Equal(t, NewRefSliceV(1, 3, 5).O(), match.O())
+		assert.Equal(t, NewRefSliceV(2, 4).O(), rest.O())
+		assert.Equal(t, NewRefSliceV(1, 2, 3, 4, 5).O(), slice.O())
+	}
+
+	// all or nothing
+	{
+		match, rest := NewRefSliceV(1, 2).Partition(func(x O) bool { return true })
+		assert.Equal(t, NewRefSliceV(1, 2).O(), match.O())
+		assert.Equal(t, 0, rest.Len())
+	}
+}
+
+// Permutations
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Permutations() {
+	slice := NewRefSliceV(1, 2, 3)
+	fmt.Println(slice.Permutations(2))
+	// Output: [[1 2] [1 3] [2 1] [2 3] [3 1] [3 2]]
+}
+
+func TestRefSlice_Permutations(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, []ISlice{}, slice.Permutations(1))
+		assert.Equal(t, []ISlice{}, NewRefSliceV().Permutations(1))
+	}
+
+	// out of range
+	assert.Equal(t, []ISlice{}, NewRefSliceV(1, 2).Permutations(-1))
+	assert.Equal(t, []ISlice{}, NewRefSliceV(1, 2).Permutations(3))
+
+	// permutations
+	{
+		slice := NewRefSliceV(1, 2, 3)
+		assert.Equal(t, 1, len(slice.Permutations(0)))
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1), NewRefSliceV(2), NewRefSliceV(3)}), sliceO(slice.Permutations(1)))
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1, 2), NewRefSliceV(1, 3), NewRefSliceV(2, 1), NewRefSliceV(2, 3), NewRefSliceV(3, 1), NewRefSliceV(3, 2)}), sliceO(slice.Permutations(2)))
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1, 2, 3), NewRefSliceV(1, 3, 2), NewRefSliceV(2, 1, 3), NewRefSliceV(2, 3, 1), NewRefSliceV(3, 1, 2), NewRefSliceV(3, 2, 1)}), sliceO(slice.Permutations(3)))
+	}
+}
+
 // Pop
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_Pop_Go(t *testing.B) {
@@ -3470,6 +3928,34 @@ func TestRefSlice_Prepend(t *testing.T) {
 	}
 }
 
+// Reduce
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Reduce() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Reduce(func(acc, x O) O { return acc.(int) + x.(int) }))
+	// Output: 15
+}
+
+func TestRefSlice_Reduce(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Nil(t, slice.Reduce(func(acc, x O) O { return acc.(int) + x.(int) }).O())
+		assert.Nil(t, NewRefSliceV().Reduce(func(acc, x O) O { return acc.(int) + x.(int) }).O())
+		assert.Equal(t, 10, NewRefSliceV().Reduce(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+	}
+
+	// single element
+	assert.Equal(t, 1, NewRefSliceV(1).Reduce(func(acc, x O) O { return acc.(int) + x.(int) }).O())
+
+	// first element is the initial value
+	assert.Equal(t, 15, NewRefSliceV(1, 2, 3, 4, 5).Reduce(func(acc, x O) O { return acc.(int) + x.(int) }).O())
+
+	// initial value
+	assert.Equal(t, 25, NewRefSliceV(1, 2, 3, 4, 5).Reduce(func(acc, x O) O { return acc.(int) + x.(int) }, 10).O())
+}
+
 // Reverse
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_Reverse_Go(t *testing.B) {
@@ -3580,6 +4066,145 @@ func TestRefSlice_ReverseM(t *testing.T) {
 	}
 }
 
+// Rotate
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Rotate() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Rotate(2).O())
+	// Output: [3 4 5 1 2]
+}
+
+func TestRefSlice_Rotate(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, NewRefSliceV().O(), slice.Rotate(1).O())
+		assert.Equal(t, NewRefSliceV().O(), NewRefSliceV().Rotate(1).O())
+	}
+
+	// rotate
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewRefSliceV(1, 2, 3, 4, 5).O(), slice.Rotate(0).O())
+		assert.Equal(t, NewRefSliceV(2, 3, 4, 5, 1).O(), slice.Rotate(1).O())
+		assert.Equal(t, NewRefSliceV(3, 4, 5, 1, 2).O(), slice.Rotate(2).O())
+		assert.Equal(t, NewRefSliceV(3, 4, 5, 1, 2).O(), slice.Rotate(7).O())
+		assert.Equal(t, NewRefSliceV(1, 2, 3, 4, 5).O(), slice.Rotate(5).O())
+		assert.Equal(t, NewRefSliceV(1, 2, 3, 4, 5).O(), slice.O())
+	}
+
+	// negative
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewRefSliceV(5, 1, 2, 3, 4).O(), slice.Rotate(-1).O())
+		assert.Equal(t, NewRefSliceV(4, 5, 1, 2, 3).O(), slice.Rotate(-7).O())
+	}
+}
+
+// RotateM
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_RotateM() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.RotateM(-1).O())
+	// Output: [5 1 2 3 4]
+}
+
+func TestRefSlice_RotateM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, (*RefSlice)(nil), slice.RotateM(1))
+		assert.Equal(t, NewRefSliceV().O(), NewRefSliceV().RotateM(1).O())
+	}
+
+	// rotate
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewRefSliceV(3, 4, 5, 1, 2).O(), slice.RotateM(2).O())
+		assert.Equal(t, NewRefSliceV(3, 4, 5, 1, 2).O(), slice.O())
+		assert.Equal(t, NewRefSliceV(2, 3, 4, 5, 1).O(), slice.RotateM(-1).O())
+		assert.Equal(t, NewRefSliceV(2, 3, 4, 5, 1).O(), slice.O())
+	}
+}
+
+// Sample
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Sample() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Sample(2, rand.New(rand.NewSource(1))).Len())
+	// Output: 2
+}
+
+func TestRefSlice_Sample(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, 0, slice.Sample(2).Len())
+		assert.Equal(t, 0, NewRefSliceV().Sample(2).Len())
+	}
+
+	// invalid size
+	assert.Equal(t, 0, NewRefSliceV(1, 2).Sample(0).Len())
+	assert.Equal(t, 0, NewRefSliceV(1, 2).Sample(-1).Len())
+
+	// unique elements
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		sample := slice.Sample(3)
+		assert.Equal(t, 3, sample.Len())
+		sample.Each(func(x O) {
+			assert.Equal(t, 1, sample.CountW(func(y O) bool { return Compare(x, y) == 0 }))
+			assert.True(t, slice.AnyW(func(y O) bool { return Compare(x, y) == 0 }))
+		})
+		assert.Equal(t, NewRefSliceV(1, 2, 3, 4, 5).O(), slice.O())
+	}
+
+	// all elements
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		sample := slice.Sample(10)
+		assert.Equal(t, 5, sample.Len())
+		assert.True(t, sample.All(1, 2, 3, 4, 5))
+	}
+
+	// seeded
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, slice.Sample(3, rand.New(rand.NewSource(2))).O(), slice.Sample(3, rand.New(rand.NewSource(2))).O())
+	}
+}
+
+// Scan
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Scan() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Scan(func(acc, x O) O { return acc.(int) + x.(int) }))
+	// Output: [1 3 6 10 15]
+}
+
+func TestRefSlice_Scan(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, 0, slice.Scan(func(acc, x O) O { return acc.(int) + x.(int) }).Len())
+		assert.Equal(t, 0, NewRefSliceV().Scan(func(acc, x O) O { return acc.(int) + x.(int) }, 10).Len())
+	}
+
+	// first element is the initial value
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, NewIntSliceV(1, 3, 6, 10, 15), slice.Scan(func(acc, x O) O { return acc.(int) + x.(int) }))
+		assert.Equal(t, NewRefSliceV(1, 2, 3, 4, 5).O(), slice.O())
+	}
+
+	// initial value
+	assert.Equal(t, NewIntSliceV(11, 13, 16, 20, 25), NewRefSliceV(1, 2, 3, 4, 5).Scan(func(acc, x O) O { return acc.(int) + x.(int) }, 10))
+}
+
 // Select
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_Select_Go(t *testing.B) {
@@ -3953,6 +4578,66 @@ func TestRefSlice_ShiftN(t *testing.T) {
 	}
 }
 
+// Shuffle
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Shuffle() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Shuffle().Len())
+	// Output: 5
+}
+
+func TestRefSlice_Shuffle(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, NewRefSliceV().O(), slice.Shuffle().O())
+		assert.Equal(t, NewRefSliceV().O(), NewRefSliceV().Shuffle().O())
+	}
+
+	// shuffle
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		shuffled := slice.Shuffle()
+		assert.Equal(t, 5, shuffled.Len())
+		assert.True(t, shuffled.All(1, 2, 3, 4, 5))
+		assert.Equal(t, NewRefSliceV(1, 2, 3, 4, 5).O(), slice.O())
+	}
+
+	// seeded
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, slice.Shuffle(rand.New(rand.NewSource(2))).O(), slice.Shuffle(rand.New(rand.NewSource(2))).O())
+	}
+}
+
+// ShuffleM
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_ShuffleM() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.ShuffleM().Len())
+	// Output: 5
+}
+
+func TestRefSlice_ShuffleM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, (*RefSlice)(nil), slice.ShuffleM())
+		assert.Equal(t, NewRefSliceV().O(), NewRefSliceV().ShuffleM().O())
+	}
+
+	// shuffle
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		shuffled := slice.ShuffleM(rand.New(rand.NewSource(2)))
+		assert.Equal(t, slice.O(), shuffled.O())
+		assert.Equal(t, NewRefSliceV(1, 2, 3, 4, 5).ShuffleM(rand.New(rand.NewSource(2))).O(), slice.O())
+		assert.True(t, slice.All(1, 2, 3, 4, 5))
+	}
+}
+
 // Single
 //--------------------------------------------------------------------------------------------------
 
@@ -4207,6 +4892,116 @@ func TestRefSlice_Sort(t *testing.T) {
 	}
 }
 
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SortBy() {
+	slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20})
+	fmt.Println(slice.SortBy(personAge).O())
+	// Output: [{Dan 20} {Amy 25} {Ben 30} {Cal 30}]
+}
+
+func TestRefSlice_SortBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, NewRefSliceV(), slice.SortBy(personAge))
+		assert.Equal(t, NewRefSliceV().O(), NewRefSliceV().SortBy(personAge).O())
+	}
+
+	// new Slice
+	{
+		slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20})
+		sorted := slice.SortBy(personAge)
+		assert.Equal(t, []person{{"Dan", 20}, {"Amy", 25}, {"Ben", 30}, {"Cal", 30}}, sorted.O())
+		assert.Equal(t, NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20}).O(), slice.O())
+	}
+
+	// stable
+	{
+		slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20})
+		assert.Equal(t, NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20}).O(), slice.SortBy(func(x O) O { return 0 }).O())
+	}
+}
+
+// SortByDesc
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SortByDesc() {
+	slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20})
+	fmt.Println(slice.SortByDesc(personAge).O())
+	// Output: [{Ben 30} {Cal 30} {Amy 25} {Dan 20}]
+}
+
+func TestRefSlice_SortByDesc(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, NewRefSliceV(), slice.SortByDesc(personAge))
+		assert.Equal(t, NewRefSliceV().O(), NewRefSliceV().SortByDesc(personAge).O())
+	}
+
+	// new Slice
+	{
+		slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20})
+		sorted := slice.SortByDesc(personAge)
+		assert.Equal(t, []person{{"Ben", 30}, {"Cal", 30}, {"Amy", 25}, {"Dan", 20}}, sorted.O())
+		assert.Equal(t, NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20}).O(), slice.O())
+	}
+}
+
+// SortByDescM
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SortByDescM() {
+	slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20})
+	fmt.Println(slice.SortByDescM(personAge).O())
+	// Output: [{Ben 30} {Cal 30} {Amy 25} {Dan 20}]
+}
+
+func TestRefSlice_SortByDescM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, (*RefSlice)(nil), slice.SortByDescM(personAge))
+		assert.Equal(t, NewRefSliceV().O(), NewRefSliceV().SortByDescM(personAge).O())
+	}
+
+	// modifies this Slice
+	{
+		slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20})
+		sorted := slice.SortByDescM(personAge)
+		assert.Equal(t, []person{{"Ben", 30}, {"Cal", 30}, {"Amy", 25}, {"Dan", 20}}, sorted.O())
+		assert.Equal(t, []person{{"Ben", 30}, {"Cal", 30}, {"Amy", 25}, {"Dan", 20}}, slice.O())
+	}
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SortByM() {
+	slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20})
+	fmt.Println(slice.SortByM(personAge).O())
+	// Output: [{Dan 20} {Amy 25} {Ben 30} {Cal 30}]
+}
+
+func TestRefSlice_SortByM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, (*RefSlice)(nil), slice.SortByM(personAge))
+		assert.Equal(t, NewRefSliceV().O(), NewRefSliceV().SortByM(personAge).O())
+	}
+
+	// modifies this Slice
+	{
+		slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20})
+		sorted := slice.SortByM(personAge)
+		assert.Equal(t, []person{{"Dan", 20}, {"Amy", 25}, {"Ben", 30}, {"Cal", 30}}, sorted.O())
+		assert.Equal(t, []person{{"Dan", 20}, {"Amy", 25}, {"Ben", 30}, {"Cal", 30}}, slice.O())
+	}
+}
+
 // SortM
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_SortM_Go(t *testing.B) {
@@ -4327,6 +5122,65 @@ func TestRefSlice_SortReverseM(t *testing.T) {
 	}
 }
 
+// SortWith
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SortWith() {
+	slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20})
+	fmt.Println(slice.SortWith(func(a, b O) int { return Compare(b, a) }).O())
+	// Output: [{Dan 20} {Cal 30} {Ben 30} {Amy 25}]
+}
+
+func TestRefSlice_SortWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, NewRefSliceV(), slice.SortWith(func(a, b O) int { return Compare(b, a) }))
+		assert.Equal(t, NewRefSliceV().O(), NewRefSliceV().SortWith(func(a, b O) int { return Compare(b, a) }).O())
+	}
+
+	// new Slice
+	{
+		slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20})
+		sorted := slice.SortWith(func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, []person{{"Dan", 20}, {"Cal", 30}, {"Ben", 30}, {"Amy", 25}}, sorted.O())
+		assert.Equal(t, NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20}).O(), slice.O())
+	}
+
+	// ordering
+	{
+		slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20})
+		assert.Equal(t, []person{{"Dan", 20}, {"Amy", 25}, {"Ben", 30}, {"Cal", 30}}, slice.SortWith(By(personAge).Compare).O())
+		assert.Equal(t, []person{{"Ben", 30}, {"Cal", 30}, {"Amy", 25}, {"Dan", 20}}, slice.SortWith(ByDesc(personAge).Compare).O())
+	}
+}
+
+// SortWithM
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SortWithM() {
+	slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20})
+	fmt.Println(slice.SortWithM(func(a, b O) int { return Compare(b, a) }).O())
+	// Output: [{Dan 20} {Cal 30} {Ben 30} {Amy 25}]
+}
+
+func TestRefSlice_SortWithM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, (*RefSlice)(nil), slice.SortWithM(func(a, b O) int { return Compare(b, a) }))
+		assert.Equal(t, NewRefSliceV().O(), NewRefSliceV().SortWithM(func(a, b O) int { return Compare(b, a) }).O())
+	}
+
+	// modifies this Slice
+	{
+		slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Cal", 30}, person{"Dan", 20})
+		sorted := slice.SortWithM(func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, []person{{"Dan", 20}, {"Cal", 30}, {"Ben", 30}, {"Amy", 25}}, sorted.O())
+		assert.Equal(t, []person{{"Dan", 20}, {"Cal", 30}, {"Ben", 30}, {"Amy", 25}}, slice.O())
+	}
+}
+
 // Swap
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_Swap_Go(t *testing.B) {
@@ -5155,3 +6009,64 @@ func TestRefSlice_UniqM(t *testing.T) {
 		assert.Equal(t, []int{1, 2, 3, 4}, uniq.O())
 	}
 }
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Window() {
+	slice := NewRefSliceV(1, 2, 3, 4)
+	fmt.Println(slice.Window(2, 1))
+	// Output: [[1 2] [2 3] [3 4]]
+}
+
+func TestRefSlice_Window(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, []ISlice{}, slice.Window(2, 1))
+		assert.Equal(t, []ISlice{}, NewRefSliceV().Window(2, 1))
+	}
+
+	// invalid size or step
+	assert.Equal(t, []ISlice{}, NewRefSliceV(1, 2).Window(0, 1))
+	assert.Equal(t, []ISlice{}, NewRefSliceV(1, 2).Window(1, 0))
+	assert.Equal(t, []ISlice{}, NewRefSliceV(1, 2).Window(3, 1))
+
+	// windows
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1, 2), NewRefSliceV(2, 3), NewRefSliceV(3, 4), NewRefSliceV(4, 5)}), sliceO(slice.Window(2, 1)))
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1, 2, 3), NewRefSliceV(3, 4, 5)}), sliceO(slice.Window(3, 2)))
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1, 2), NewRefSliceV(4, 5)}), sliceO(slice.Window(2, 3)))
+		assert.Equal(t, sliceO([]ISlice{NewRefSliceV(1, 2, 3, 4, 5)}), sliceO(slice.Window(5, 1)))
+	}
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Zip() {
+	slice := NewRefSliceV(1, 2)
+	fmt.Println(slice.Zip([]string{"x", "y"}))
+	// Output: [[1 x] [2 y]]
+}
+
+func TestRefSlice_Zip(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, []ISlice{}, slice.Zip([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewRefSliceV().Zip([]string{"x"}))
+	}
+
+	// no slices
+	assert.Equal(t, []ISlice{NewInterSliceV(1), NewInterSliceV(2)}, NewRefSliceV(1, 2).Zip())
+
+	// stops at the shortest slice
+	{
+		slice := NewRefSliceV(1, 2, 3)
+		assert.Equal(t, []ISlice{NewInterSliceV(1, "x", 7), NewInterSliceV(2, "y", 8)},
+			slice.Zip([]string{"x", "y", "z"}, NewIntSliceV(7, 8)))
+		assert.Equal(t, []ISlice{}, slice.Zip([]int{}))
+	}
+}
@@ -0,0 +1,93 @@
+package n
+
+// StringSlice is generated from the nubgen templates with its string specific extras kept here
+//go:generate go run ./cmd/nubgen -type string -name StringSlice -convert ToString -noto -output slice_string.go
+
+import (
+	"regexp"
+
+	"github.com/phR0ze/n/pkg/fuzzy"
+)
+
+// Closest creates a new StringSlice with up to n of the elements closest to the given query
+// ordered by their Damerau-Levenshtein distance from the query. Useful for "did you mean ...?"
+// suggestions. Ties are kept in their original order.
+func (p *StringSlice) Closest(n int, query string) (new *StringSlice) {
+	if p == nil {
+		return NewStringSliceV()
+	}
+	return ToStringSlice(fuzzy.Closest(query, *p, n))
+}
+
+// FuzzyFilter creates a new StringSlice with the elements that fuzzy match the given query
+// ranked best match first the way fuzzy finders like fzf do. Elements match if they contain
+// all the runes of the query in order with matches at word boundaries and consecutive matches
+// ranking higher. Matching is case insensitive unless the query contains an upper case letter.
+// Use fuzzy.Filter directly for the scores and matched positions.
+func (p *StringSlice) FuzzyFilter(query string) (new *StringSlice) {
+	new = NewStringSliceV()
+	if p == nil {
+		return
+	}
+	for _, x := range fuzzy.Filter(query, *p) {
+		*new = append(*new, x.Text)
+	}
+	return
+}
+
+// Grep creates a new StringSlice with the elements that match the given regex pattern. The
+// pattern may be a string or a compiled *regexp.Regexp. String patterns are compiled once and
+// cached so repeated chained calls don't recompile. Swallows any errors returning an empty
+// slice for invalid patterns, see GrepE.
+func (p *StringSlice) Grep(pattern interface{}) (new *StringSlice) {
+	new, _ = p.grep(pattern, true)
+	return
+}
+
+// GrepE creates a new StringSlice with the elements that match the given regex pattern and
+// returns an error with an empty slice for invalid patterns. See Grep for details.
+func (p *StringSlice) GrepE(pattern interface{}) (new *StringSlice, err error) {
+	return p.grep(pattern, true)
+}
+
+// GrepV creates a new StringSlice with the elements that don't match the given regex pattern
+// in the same way as `grep -v`. See Grep for details.
+func (p *StringSlice) GrepV(pattern interface{}) (new *StringSlice) {
+	new, _ = p.grep(pattern, false)
+	return
+}
+
+// GrepVE creates a new StringSlice with the elements that don't match the given regex pattern
+// and returns an error with an empty slice for invalid patterns. See GrepV for details.
+func (p *StringSlice) GrepVE(pattern interface{}) (new *StringSlice, err error) {
+	return p.grep(pattern, false)
+}
+
+// grep selects the elements that match or don't match the given regex pattern
+func (p *StringSlice) grep(pattern interface{}, match bool) (new *StringSlice, err error) {
+	new = NewStringSliceV()
+	var re *regexp.Regexp
+	if re, err = compileRegex(pattern); err != nil || p == nil {
+		return
+	}
+	for i := range *p {
+		if re.MatchString((*p)[i]) == match {
+			*new = append(*new, (*p)[i])
+		}
+	}
+	return
+}
+
+// S is an alias to ToStringSliceE
+func S(obj interface{}) *StringSlice {
+	x, _ := ToStringSliceE(obj)
+	if x == nil {
+		return &StringSlice{}
+	}
+	return x
+}
+
+// SV is an alias for NewStringSliceV for brevity
+func SV(elems ...interface{}) (new *StringSlice) {
+	return ToStringSlice(elems)
+}
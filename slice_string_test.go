@@ -2,10 +2,14 @@ package n
 
 import (
 	"fmt"
+	"math/rand"
+	"regexp"
 	"sort"
 	"strings"
 	"testing"
 
+	"github.com/phR0ze/n/pkg/enc/json"
+	yaml "github.com/phR0ze/yaml/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -652,6 +656,159 @@ func TestStringSlice_At(t *testing.T) {
 	}
 }
 
+// BinarySearch
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_BinarySearch() {
+	slice := NewStringSliceV("a", "c", "e")
+	fmt.Println(slice.BinarySearch("c"))
+	// Output: 1 true
+}
+
+func TestStringSlice_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		i, found := slice.BinarySearch("c")
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = NewStringSliceV().BinarySearch("c")
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		slice := NewStringSliceV("a", "c", "e")
+		i, found := slice.BinarySearch("a")
+		assert.Equal(t, 0, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearch("c")
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+	}
+
+	// not found returns the insertion index
+	{
+		slice := NewStringSliceV("a", "c", "e")
+		i, found := slice.BinarySearch("d")
+		assert.Equal(t, 2, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch("f")
+		assert.Equal(t, 3, i)
+		assert.False(t, found)
+	}
+
+	// converts the element
+	{
+		slice := NewStringSliceV("1", "3", "5")
+		i, found := slice.BinarySearch(5)
+		assert.Equal(t, 2, i)
+		assert.True(t, found)
+	}
+}
+
+// BinarySearchWith
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_BinarySearchWith() {
+	slice := NewStringSliceV("e", "c", "a")
+	fmt.Println(slice.BinarySearchWith("c", func(a, b O) int { return Compare(b, a) }))
+	// Output: 1 true
+}
+
+func TestStringSlice_BinarySearchWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		i, found := slice.BinarySearchWith("c", Compare)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// descending
+	{
+		slice := NewStringSliceV("e", "c", "a")
+		i, found := slice.BinarySearchWith("c", func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearchWith("d", func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 1, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearchWith("f", func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// Cartesian
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Cartesian() {
+	slice := NewStringSliceV("a", "b")
+	fmt.Println(slice.Cartesian([]string{"x", "y"}))
+	// Output: [[a x] [a y] [b x] [b y]]
+}
+
+func TestStringSlice_Cartesian(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, []ISlice{}, slice.Cartesian([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewStringSliceV().Cartesian([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewStringSliceV("a").Cartesian([]string{}))
+	}
+
+	// no slices
+	assert.Equal(t, []ISlice{NewInterSliceV("a"), NewInterSliceV("b")}, NewStringSliceV("a", "b").Cartesian())
+
+	// multiple slices
+	{
+		tuples := NewStringSliceV("a", "b").Cartesian([]string{"x", "y"}, NewIntSliceV(7))
+		assert.Equal(t, []ISlice{NewInterSliceV("a", "x", 7), NewInterSliceV("a", "y", 7),
+			NewInterSliceV("b", "x", 7), NewInterSliceV("b", "y", 7)}, tuples)
+	}
+}
+
+// Chunk
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Chunk() {
+	slice := NewStringSliceV("a", "b", "c", "d", "e")
+	fmt.Println(slice.Chunk(2))
+	// Output: [[a b] [c d] [e]]
+}
+
+func TestStringSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, []ISlice{}, slice.Chunk(2))
+		assert.Equal(t, []ISlice{}, NewStringSliceV().Chunk(2))
+	}
+
+	// invalid size
+	assert.Equal(t, []ISlice{}, NewStringSliceV("a", "b").Chunk(0))
+	assert.Equal(t, []ISlice{}, NewStringSliceV("a", "b").Chunk(-1))
+
+	// chunks
+	{
+		slice := NewStringSliceV("a", "b", "c", "d", "e")
+		assert.Equal(t, []ISlice{NewStringSliceV("a", "b"), NewStringSliceV("c", "d"), NewStringSliceV("e")}, slice.Chunk(2))
+		assert.Equal(t, []ISlice{NewStringSliceV("a", "b", "c", "d", "e")}, slice.Chunk(5))
+		assert.Equal(t, []ISlice{NewStringSliceV("a", "b", "c", "d", "e")}, slice.Chunk(10))
+		assert.Equal(t, []ISlice{NewStringSliceV("a"), NewStringSliceV("b"), NewStringSliceV("c"), NewStringSliceV("d"), NewStringSliceV("e")}, slice.Chunk(1))
+	}
+
+	// chunks are copies
+	{
+		slice := NewStringSliceV("a", "b")
+		chunks := slice.Chunk(1)
+		chunks[0].Set(0, "c")
+		assert.Equal(t, NewStringSliceV("a", "b"), slice)
+	}
+}
+
 // Clear
 // --------------------------------------------------------------------------------------------------
 func ExampleStringSlice_Clear() {
@@ -678,6 +835,64 @@ func TestStringSlice_Clear(t *testing.T) {
 	}
 }
 
+// Closest
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Closest() {
+	fmt.Println(NewStringSliceV("status", "stash", "commit", "push").Closest(1, "stauts"))
+	// Output: [status]
+}
+
+func TestStringSlice_Closest(t *testing.T) {
+
+	// nil or empty
+	{
+		assert.Equal(t, []string{}, (*StringSlice)(nil).Closest(1, "a").G())
+		assert.Equal(t, []string{}, NewStringSliceV().Closest(1, "a").G())
+		assert.Equal(t, []string{}, NewStringSliceV("a").Closest(0, "a").G())
+	}
+
+	// ranked by distance
+	{
+		slice := NewStringSliceV("status", "stash", "commit", "checkout", "push", "pull")
+		assert.Equal(t, []string{"status", "stash"}, slice.Closest(2, "stats").G())
+		assert.Equal(t, []string{"push", "pull", "stash"}, slice.Closest(3, "puhs").G())
+		assert.Equal(t, 6, slice.Closest(10, "x").Len())
+	}
+}
+
+// Combinations
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Combinations() {
+	slice := NewStringSliceV("a", "b", "c")
+	fmt.Println(slice.Combinations(2))
+	// Output: [[a b] [a c] [b c]]
+}
+
+func TestStringSlice_Combinations(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, []ISlice{}, slice.Combinations(1))
+		assert.Equal(t, []ISlice{}, NewStringSliceV().Combinations(1))
+	}
+
+	// out of range
+	assert.Equal(t, []ISlice{}, NewStringSliceV("a", "b").Combinations(-1))
+	assert.Equal(t, []ISlice{}, NewStringSliceV("a", "b").Combinations(3))
+
+	// combinations
+	{
+		slice := NewStringSliceV("a", "b", "c", "d")
+		assert.Equal(t, 1, len(slice.Combinations(0)))
+		assert.Equal(t, 0, slice.Combinations(0)[0].Len())
+		assert.Equal(t, []ISlice{NewStringSliceV("a"), NewStringSliceV("b"), NewStringSliceV("c"), NewStringSliceV("d")}, slice.Combinations(1))
+		assert.Equal(t, []ISlice{NewStringSliceV("a", "b"), NewStringSliceV("a", "c"), NewStringSliceV("a", "d"), NewStringSliceV("b", "c"), NewStringSliceV("b", "d"), NewStringSliceV("c", "d")}, slice.Combinations(2))
+		assert.Equal(t, []ISlice{NewStringSliceV("a", "b", "c"), NewStringSliceV("a", "b", "d"), NewStringSliceV("a", "c", "d"), NewStringSliceV("b", "c", "d")}, slice.Combinations(3))
+		assert.Equal(t, []ISlice{NewStringSliceV("a", "b", "c", "d")}, slice.Combinations(4))
+	}
+}
+
 // Concat
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Concat_Go(t *testing.B) {
@@ -1056,6 +1271,62 @@ func TestStringSlice_CountW(t *testing.T) {
 	assert.Equal(t, 1, NewStringSliceV("1", "2", "3").CountW(func(x O) bool { return ExB(x.(string) == "4" || x.(string) == "3") }))
 }
 
+// DeepCopy
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_DeepCopy() {
+	slice := NewStringSliceV("1", "2", "3")
+	fmt.Println(slice.DeepCopy())
+	// Output: [1 2 3]
+}
+
+func TestStringSlice_DeepCopy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, NewStringSliceV(), slice.DeepCopy())
+	}
+
+	// copies are independent of the original
+	{
+		slice := NewStringSliceV("1", "2", "3")
+		copy := slice.DeepCopy()
+		assert.Equal(t, slice, copy)
+		copy.Set(0, "3")
+		assert.Equal(t, NewStringSliceV("1", "2", "3"), slice)
+	}
+}
+
+// DeepEqual
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_DeepEqual() {
+	slice := NewStringSliceV("1", "2", "3")
+	fmt.Println(slice.DeepEqual([]string{"3", "2", "1"}, IgnoreOrderOpt(true)))
+	// Output: true
+}
+
+func TestStringSlice_DeepEqual(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.True(t, slice.DeepEqual(nil))
+		assert.False(t, slice.DeepEqual(NewStringSliceV("1")))
+		assert.True(t, NewStringSliceV().DeepEqual([]string{}))
+	}
+
+	// compare with options
+	{
+		slice := NewStringSliceV("1", "2", "3")
+		assert.True(t, slice.DeepEqual(NewStringSliceV("1", "2", "3")))
+		assert.True(t, slice.DeepEqual([]string{"1", "2", "3"}))
+		assert.False(t, slice.DeepEqual([]string{"3", "2", "1"}))
+		assert.True(t, slice.DeepEqual([]string{"3", "2", "1"}, IgnoreOrderOpt(true)))
+		assert.False(t, slice.DeepEqual([]string{"1", "2", "1"}))
+		assert.True(t, slice.DeepEqual([]string{"1", "2", "1"}, IgnorePathsOpt("[2]")))
+	}
+}
+
 // Drop
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Drop_Go(t *testing.B) {
@@ -1851,6 +2122,36 @@ func TestStringSlice_EachRIE(t *testing.T) {
 	}
 }
 
+// EachSlice
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_EachSlice() {
+	slice := NewStringSliceV("a", "b", "c", "d", "e")
+	slice.EachSlice(2, func(x ISlice) {
+		fmt.Print(x)
+	})
+	// Output: [a b][c d][e]
+}
+
+func TestStringSlice_EachSlice(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		called := false
+		slice.EachSlice(2, func(x ISlice) { called = true })
+		NewStringSliceV().EachSlice(2, func(x ISlice) { called = true })
+		assert.False(t, called)
+	}
+
+	// slices
+	{
+		slices := []ISlice{}
+		slice := NewStringSliceV("a", "b", "c", "d", "e")
+		assert.Equal(t, NewStringSliceV("a", "b", "c", "d", "e"), slice.EachSlice(2, func(x ISlice) { slices = append(slices, x) }))
+		assert.Equal(t, []ISlice{NewStringSliceV("a", "b"), NewStringSliceV("c", "d"), NewStringSliceV("e")}, slices)
+	}
+}
+
 // Empty
 // --------------------------------------------------------------------------------------------------
 func ExampleStringSlice_Empty() {
@@ -1970,6 +2271,62 @@ func TestStringSlice_FirstW(t *testing.T) {
 	assert.Equal(t, Obj("1"), NewStringSliceV("2", "1").FirstW(func(o O) bool { return A(o).G() == "1" }))
 }
 
+// Flatten
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Flatten() {
+	slice := NewStringSliceV("a", "b")
+	fmt.Println(slice.Flatten())
+	// Output: [a b]
+}
+
+func TestStringSlice_Flatten(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, NewStringSliceV(), slice.Flatten())
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().Flatten())
+	}
+
+	// copy
+	{
+		slice := NewStringSliceV("a", "b")
+		flat := slice.Flatten()
+		assert.Equal(t, NewStringSliceV("a", "b"), flat)
+		flat.Set(0, "c")
+		assert.Equal(t, NewStringSliceV("a", "b"), slice)
+	}
+}
+
+// FuzzyFilter
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_FuzzyFilter() {
+	fmt.Println(NewStringSliceV("src/main_test.go", "readme.md", "main.go", "cmd/main.go").FuzzyFilter("main"))
+	// Output: [main.go cmd/main.go src/main_test.go]
+}
+
+func TestStringSlice_FuzzyFilter(t *testing.T) {
+
+	// nil or empty
+	{
+		assert.Equal(t, []string{}, (*StringSlice)(nil).FuzzyFilter("a").G())
+		assert.Equal(t, []string{}, NewStringSliceV().FuzzyFilter("a").G())
+		assert.Equal(t, []string{}, NewStringSliceV("abc").FuzzyFilter("x").G())
+	}
+
+	// empty query matches everything in order
+	{
+		assert.Equal(t, []string{"b", "a"}, NewStringSliceV("b", "a").FuzzyFilter("").G())
+	}
+
+	// ranked with smart case
+	{
+		slice := NewStringSliceV("FooBar", "foobar", "fxoxoxbxaxr")
+		assert.Equal(t, []string{"FooBar", "foobar", "fxoxoxbxaxr"}, slice.FuzzyFilter("foobar").G())
+		assert.Equal(t, []string{"FooBar"}, slice.FuzzyFilter("FB").G())
+	}
+}
+
 // G
 // --------------------------------------------------------------------------------------------------
 func ExampleStringSlice_G() {
@@ -1990,6 +2347,100 @@ func ExampleStringSlice_Generic() {
 	// Output: false
 }
 
+// Grep
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Grep() {
+	fmt.Println(NewStringSliceV("foo.go", "foo_test.go", "bar.go").Grep(`_test\.go$`))
+	// Output: [foo_test.go]
+}
+
+func TestStringSlice_Grep(t *testing.T) {
+
+	// nil or empty
+	{
+		assert.Equal(t, []string{}, (*StringSlice)(nil).Grep(`.*`).G())
+		assert.Equal(t, []string{}, NewStringSliceV().Grep(`.*`).G())
+	}
+
+	// string and compiled patterns
+	{
+		slice := NewStringSliceV("a1", "b", "c22")
+		assert.Equal(t, []string{"a1", "c22"}, slice.Grep(`\d`).G())
+		assert.Equal(t, []string{"b"}, slice.Grep(regexp.MustCompile(`^b$`)).G())
+		assert.Equal(t, []string{"a1", "b", "c22"}, slice.G())
+	}
+
+	// chaining reuses the cached pattern
+	{
+		slice := NewStringSliceV("a1", "b", "c22")
+		assert.Equal(t, []string{"c22"}, slice.Grep(`\d`).Grep(`c`).Grep(`\d`).G())
+	}
+
+	// invalid pattern
+	{
+		assert.Equal(t, []string{}, NewStringSliceV("a").Grep(`(`).G())
+	}
+}
+
+func TestStringSlice_GrepE(t *testing.T) {
+	slice, err := NewStringSliceV("a1", "b", "c22").GrepE(`\d`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a1", "c22"}, slice.G())
+
+	// invalid pattern
+	slice, err = NewStringSliceV("a").GrepE(`(`)
+	assert.Equal(t, "failed to compile regex pattern (: error parsing regexp: missing closing ): `(`", err.Error())
+	assert.Equal(t, []string{}, slice.G())
+	_, err = (*StringSlice)(nil).GrepE(`(`)
+	assert.NotNil(t, err)
+}
+
+func BenchmarkStringSlice_Grep(t *testing.B) {
+	slice := NewStringSliceV("foo.go", "foo_test.go", "bar.go")
+	for i := 0; i < t.N; i++ {
+		slice.Grep(`_test\.go$`)
+	}
+}
+
+// GrepV
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_GrepV() {
+	fmt.Println(NewStringSliceV("foo.go", "foo_test.go", "bar.go").GrepV(`_test\.go$`))
+	// Output: [foo.go bar.go]
+}
+
+func TestStringSlice_GrepV(t *testing.T) {
+	assert.Equal(t, []string{}, (*StringSlice)(nil).GrepV(`.*`).G())
+	assert.Equal(t, []string{"b"}, NewStringSliceV("a1", "b", "c22").GrepV(`\d`).G())
+	assert.Equal(t, []string{}, NewStringSliceV("a").GrepV(`(`).G())
+}
+
+func TestStringSlice_GrepVE(t *testing.T) {
+	slice, err := NewStringSliceV("a1", "b", "c22").GrepVE(`\d`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b"}, slice.G())
+
+	// invalid pattern
+	slice, err = NewStringSliceV("a").GrepVE(`(`)
+	assert.Equal(t, "failed to compile regex pattern (: error parsing regexp: missing closing ): `(`", err.Error())
+	assert.Equal(t, []string{}, slice.G())
+}
+
+// Hash
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Hash() {
+	slice := NewStringSliceV("1", "2", "3")
+	fmt.Println(slice.Hash() == NewStringSliceV("1", "2", "3").Hash())
+	// Output: true
+}
+
+func TestStringSlice_Hash(t *testing.T) {
+	slice := NewStringSliceV("1", "2", "3")
+	assert.Equal(t, slice.Hash(), slice.DeepCopy().Hash())
+	assert.Equal(t, slice.Hash(), Hash([]string{"1", "2", "3"}))
+	assert.NotEqual(t, slice.Hash(), NewStringSliceV("3", "2", "1").Hash())
+}
+
 // Index
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Index_Go(t *testing.B) {
@@ -2033,6 +2484,27 @@ func TestStringSlice_Index(t *testing.T) {
 	}
 }
 
+// Inject
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Inject() {
+	slice := NewStringSliceV("a", "b", "c", "d", "e")
+	fmt.Println(slice.Inject(func(acc, x O) O { return acc.(string) + x.(string) }, ">"))
+	// Output: >abcde
+}
+
+func TestStringSlice_Inject(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, ">", slice.Inject(func(acc, x O) O { return acc.(string) + x.(string) }, ">").O())
+		assert.Equal(t, ">", NewStringSliceV().Inject(func(acc, x O) O { return acc.(string) + x.(string) }, ">").O())
+	}
+
+	// inject
+	assert.Equal(t, ">abcde", NewStringSliceV("a", "b", "c", "d", "e").Inject(func(acc, x O) O { return acc.(string) + x.(string) }, ">").O())
+}
+
 // Insert
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Insert_Go(t *testing.B) {
@@ -2160,6 +2632,59 @@ func TestStringSlice_Insert(t *testing.T) {
 	}
 }
 
+// IsSorted
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_IsSorted() {
+	slice := NewStringSliceV("a", "c", "e")
+	fmt.Println(slice.IsSorted())
+	// Output: true
+}
+
+func TestStringSlice_IsSorted(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.True(t, slice.IsSorted())
+		assert.True(t, NewStringSliceV().IsSorted())
+	}
+
+	// sorted
+	assert.True(t, NewStringSliceV("a", "c", "e").IsSorted())
+	assert.True(t, NewStringSliceV("a", "c", "e").SortReverse().Reverse().IsSorted())
+
+	// not sorted
+	assert.False(t, NewStringSliceV("e", "c", "a").IsSorted())
+	assert.False(t, NewStringSliceV("b", "A", "a", "B").IsSorted())
+}
+
+// IsSortedWith
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_IsSortedWith() {
+	slice := NewStringSliceV("e", "c", "a")
+	fmt.Println(slice.IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+	// Output: true
+}
+
+func TestStringSlice_IsSortedWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.True(t, slice.IsSortedWith(Compare))
+		assert.True(t, NewStringSliceV().IsSortedWith(Compare))
+	}
+
+	// sorted
+	assert.True(t, NewStringSliceV("a", "c", "e").IsSortedWith(Compare))
+	assert.True(t, NewStringSliceV("e", "c", "a").IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+	assert.True(t, NewStringSliceV("b", "A", "a", "B").SortBy(func(x O) O { return strings.ToLower(x.(string)) }).IsSortedWith(By(func(x O) O { return strings.ToLower(x.(string)) }).Compare))
+
+	// not sorted
+	assert.False(t, NewStringSliceV("e", "c", "a").IsSortedWith(Compare))
+	assert.False(t, NewStringSliceV("a", "c", "e").IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+}
+
 // Join
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Join_Go(t *testing.B) {
@@ -2374,22 +2899,98 @@ func TestStringSlice_Map(t *testing.T) {
 	}
 }
 
-// Nil
+// MarshalJSON
 // --------------------------------------------------------------------------------------------------
-func ExampleStringSlice_Nil() {
-	var slice *StringSlice
-	fmt.Println(slice.Nil())
-	// Output: true
-}
+func TestStringSlice_MarshalJSON(t *testing.T) {
 
-func TestStringSlice_Nil(t *testing.T) {
-	var slice *StringSlice
-	assert.True(t, slice.Nil())
-	assert.False(t, NewStringSliceV().Nil())
-	assert.False(t, NewStringSliceV("1", "2", "3").Nil())
-}
+	// round trip
+	{
+		data, err := NewStringSliceV("1", "two", "").MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, `["1","two",""]`, string(data))
 
-// O
+		x := NewStringSliceV()
+		assert.Nil(t, x.UnmarshalJSON(data))
+		assert.Equal(t, NewStringSliceV("1", "two", ""), x)
+	}
+
+	// as a field in a struct
+	{
+		type config struct {
+			Data *StringSlice `json:"data"`
+		}
+		data, err := json.Marshal(config{Data: NewStringSliceV("1", "two", "")})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"data":["1","two",""]}`, string(data))
+
+		var c config
+		assert.Nil(t, json.Unmarshal(data, &c))
+		assert.Equal(t, NewStringSliceV("1", "two", ""), c.Data)
+	}
+
+	// invalid
+	assert.NotNil(t, NewStringSliceV().UnmarshalJSON([]byte(`[1]`)))
+}
+
+// MarshalMsgpack
+// --------------------------------------------------------------------------------------------------
+func TestStringSlice_MarshalMsgpack(t *testing.T) {
+	data, err := NewStringSliceV("1", "two", "").MarshalMsgpack()
+	assert.Nil(t, err)
+
+	slice := NewStringSliceV()
+	assert.Nil(t, slice.UnmarshalMsgpack(data))
+	assert.Equal(t, []string{"1", "two", ""}, slice.O())
+}
+
+// MarshalYAML
+// --------------------------------------------------------------------------------------------------
+func TestStringSlice_MarshalYAML(t *testing.T) {
+
+	// round trip
+	{
+		data, err := yaml.Marshal(NewStringSliceV("1", "two", ""))
+		assert.Nil(t, err)
+		assert.Equal(t, "- \"1\"\n- two\n- \"\"\n", string(data))
+
+		x := NewStringSliceV()
+		assert.Nil(t, yaml.Unmarshal(data, x))
+		assert.Equal(t, NewStringSliceV("1", "two", ""), x)
+	}
+
+	// as a field in a struct
+	{
+		type config struct {
+			Data *StringSlice `yaml:"data"`
+		}
+		data, err := yaml.Marshal(config{Data: NewStringSliceV("1", "two", "")})
+		assert.Nil(t, err)
+
+		var c config
+		assert.Nil(t, yaml.Unmarshal(data, &c))
+		assert.Equal(t, NewStringSliceV("1", "two", ""), c.Data)
+	}
+
+	// invalid
+	assert.NotNil(t, yaml.Unmarshal([]byte("a: 1"), NewStringSliceV()))
+}
+
+// Nil
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Nil() {
+	var slice *StringSlice
+	fmt.Println(slice.Nil())
+	// Output: true
+}
+
+func TestStringSlice_Nil(t *testing.T) {
+	var slice *StringSlice
+	assert.True(t, slice.Nil())
+	assert.False(t, NewStringSliceV().Nil())
+	assert.False(t, NewStringSliceV("1", "2", "3").Nil())
+}
+
+// O
 // --------------------------------------------------------------------------------------------------
 func ExampleStringSlice_O() {
 	fmt.Println(NewStringSliceV("1", "2", "3"))
@@ -2443,6 +3044,72 @@ func TestStringSlice_Pair(t *testing.T) {
 	}
 }
 
+// Partition
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Partition() {
+	slice := NewStringSliceV("a", "b", "c", "d", "e")
+	fmt.Println(slice.Partition(func(x O) bool { return x.(string) != "b" && x.(string) != "d" }))
+	// Output: [a c e] [b d]
+}
+
+func TestStringSlice_Partition(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		match, rest := slice.Partition(func(x O) bool { return x.(string) != "b" && x.(string) != "d" })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// partition
+	{
+		slice := NewStringSliceV("a", "b", "c", "d", "e")
+		match, rest := slice.Partition(func(x O) bool { return x.(string) != "b" && x.(string) != "d" })
+		assert.Equal(t, NewStringSliceV("a", "c", "e"), match)
+		assert.Equal(t, NewStringSliceV("b", "d"), rest)
+		assert.Equal(t, NewStringSliceV("a", "b", "c", "d", "e"), slice)
+	}
+
+	// all or nothing
+	{
+		match, rest := NewStringSliceV("a", "b").Partition(func(x O) bool { return true })
+		assert.Equal(t, NewStringSliceV("a", "b"), match)
+		assert.Equal(t, 0, rest.Len())
+	}
+}
+
+// Permutations
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Permutations() {
+	slice := NewStringSliceV("a", "b", "c")
+	fmt.Println(slice.Permutations(2))
+	// Output: [[a b] [a c] [b a] [b c] [c a] [c b]]
+}
+
+func TestStringSlice_Permutations(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, []ISlice{}, slice.Permutations(1))
+		assert.Equal(t, []ISlice{}, NewStringSliceV().Permutations(1))
+	}
+
+	// out of range
+	assert.Equal(t, []ISlice{}, NewStringSliceV("a", "b").Permutations(-1))
+	assert.Equal(t, []ISlice{}, NewStringSliceV("a", "b").Permutations(3))
+
+	// permutations
+	{
+		slice := NewStringSliceV("a", "b", "c")
+		assert.Equal(t, 1, len(slice.Permutations(0)))
+		assert.Equal(t, []ISlice{NewStringSliceV("a"), NewStringSliceV("b"), NewStringSliceV("c")}, slice.Permutations(1))
+		assert.Equal(t, []ISlice{NewStringSliceV("a", "b"), NewStringSliceV("a", "c"), NewStringSliceV("b", "a"), NewStringSliceV("b", "c"), NewStringSliceV("c", "a"), NewStringSliceV("c", "b")}, slice.Permutations(2))
+		assert.Equal(t, []ISlice{NewStringSliceV("a", "b", "c"), NewStringSliceV("a", "c", "b"), NewStringSliceV("b", "a", "c"), NewStringSliceV("b", "c", "a"), NewStringSliceV("c", "a", "b"), NewStringSliceV("c", "b", "a")}, slice.Permutations(3))
+	}
+}
+
 // Pop
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Pop_Go(t *testing.B) {
@@ -2594,6 +3261,34 @@ func TestStringSlice_Prepend(t *testing.T) {
 	}
 }
 
+// Reduce
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Reduce() {
+	slice := NewStringSliceV("a", "b", "c", "d", "e")
+	fmt.Println(slice.Reduce(func(acc, x O) O { return acc.(string) + x.(string) }))
+	// Output: abcde
+}
+
+func TestStringSlice_Reduce(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Nil(t, slice.Reduce(func(acc, x O) O { return acc.(string) + x.(string) }).O())
+		assert.Nil(t, NewStringSliceV().Reduce(func(acc, x O) O { return acc.(string) + x.(string) }).O())
+		assert.Equal(t, ">", NewStringSliceV().Reduce(func(acc, x O) O { return acc.(string) + x.(string) }, ">").O())
+	}
+
+	// single element
+	assert.Equal(t, "a", NewStringSliceV("a").Reduce(func(acc, x O) O { return acc.(string) + x.(string) }).O())
+
+	// first element is the initial value
+	assert.Equal(t, "abcde", NewStringSliceV("a", "b", "c", "d", "e").Reduce(func(acc, x O) O { return acc.(string) + x.(string) }).O())
+
+	// initial value
+	assert.Equal(t, ">abcde", NewStringSliceV("a", "b", "c", "d", "e").Reduce(func(acc, x O) O { return acc.(string) + x.(string) }, ">").O())
+}
+
 // Reverse
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Reverse_Go(t *testing.B) {
@@ -2694,6 +3389,145 @@ func TestStringSlice_ReverseM(t *testing.T) {
 	}
 }
 
+// Rotate
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Rotate() {
+	slice := NewStringSliceV("a", "b", "c", "d", "e")
+	fmt.Println(slice.Rotate(2))
+	// Output: [c d e a b]
+}
+
+func TestStringSlice_Rotate(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, NewStringSliceV(), slice.Rotate(1))
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().Rotate(1))
+	}
+
+	// rotate
+	{
+		slice := NewStringSliceV("a", "b", "c", "d", "e")
+		assert.Equal(t, NewStringSliceV("a", "b", "c", "d", "e"), slice.Rotate(0))
+		assert.Equal(t, NewStringSliceV("b", "c", "d", "e", "a"), slice.Rotate(1))
+		assert.Equal(t, NewStringSliceV("c", "d", "e", "a", "b"), slice.Rotate(2))
+		assert.Equal(t, NewStringSliceV("c", "d", "e", "a", "b"), slice.Rotate(7))
+		assert.Equal(t, NewStringSliceV("a", "b", "c", "d", "e"), slice.Rotate(5))
+		assert.Equal(t, NewStringSliceV("a", "b", "c", "d", "e"), slice)
+	}
+
+	// negative
+	{
+		slice := NewStringSliceV("a", "b", "c", "d", "e")
+		assert.Equal(t, NewStringSliceV("e", "a", "b", "c", "d"), slice.Rotate(-1))
+		assert.Equal(t, NewStringSliceV("d", "e", "a", "b", "c"), slice.Rotate(-7))
+	}
+}
+
+// RotateM
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_RotateM() {
+	slice := NewStringSliceV("a", "b", "c", "d", "e")
+	fmt.Println(slice.RotateM(-1))
+	// Output: [e a b c d]
+}
+
+func TestStringSlice_RotateM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, (*StringSlice)(nil), slice.RotateM(1))
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().RotateM(1))
+	}
+
+	// rotate
+	{
+		slice := NewStringSliceV("a", "b", "c", "d", "e")
+		assert.Equal(t, NewStringSliceV("c", "d", "e", "a", "b"), slice.RotateM(2))
+		assert.Equal(t, NewStringSliceV("c", "d", "e", "a", "b"), slice)
+		assert.Equal(t, NewStringSliceV("b", "c", "d", "e", "a"), slice.RotateM(-1))
+		assert.Equal(t, NewStringSliceV("b", "c", "d", "e", "a"), slice)
+	}
+}
+
+// Sample
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Sample() {
+	slice := NewStringSliceV("a", "b", "c", "d", "e")
+	fmt.Println(slice.Sample(2, rand.New(rand.NewSource(1))).Len())
+	// Output: 2
+}
+
+func TestStringSlice_Sample(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, 0, slice.Sample(2).Len())
+		assert.Equal(t, 0, NewStringSliceV().Sample(2).Len())
+	}
+
+	// invalid size
+	assert.Equal(t, 0, NewStringSliceV("a", "b").Sample(0).Len())
+	assert.Equal(t, 0, NewStringSliceV("a", "b").Sample(-1).Len())
+
+	// unique elements
+	{
+		slice := NewStringSliceV("a", "b", "c", "d", "e")
+		sample := slice.Sample(3)
+		assert.Equal(t, 3, sample.Len())
+		sample.Each(func(x O) {
+			assert.Equal(t, 1, sample.CountW(func(y O) bool { return Compare(x, y) == 0 }))
+			assert.True(t, slice.AnyW(func(y O) bool { return Compare(x, y) == 0 }))
+		})
+		assert.Equal(t, NewStringSliceV("a", "b", "c", "d", "e"), slice)
+	}
+
+	// all elements
+	{
+		slice := NewStringSliceV("a", "b", "c", "d", "e")
+		sample := slice.Sample(10)
+		assert.Equal(t, 5, sample.Len())
+		assert.True(t, sample.All("a", "b", "c", "d", "e"))
+	}
+
+	// seeded
+	{
+		slice := NewStringSliceV("a", "b", "c", "d", "e")
+		assert.Equal(t, slice.Sample(3, rand.New(rand.NewSource(2))), slice.Sample(3, rand.New(rand.NewSource(2))))
+	}
+}
+
+// Scan
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Scan() {
+	slice := NewStringSliceV("a", "b", "c", "d", "e")
+	fmt.Println(slice.Scan(func(acc, x O) O { return acc.(string) + x.(string) }))
+	// Output: [a ab abc abcd abcde]
+}
+
+func TestStringSlice_Scan(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, 0, slice.Scan(func(acc, x O) O { return acc.(string) + x.(string) }).Len())
+		assert.Equal(t, 0, NewStringSliceV().Scan(func(acc, x O) O { return acc.(string) + x.(string) }, ">").Len())
+	}
+
+	// first element is the initial value
+	{
+		slice := NewStringSliceV("a", "b", "c", "d", "e")
+		assert.Equal(t, NewStringSliceV("a", "ab", "abc", "abcd", "abcde"), slice.Scan(func(acc, x O) O { return acc.(string) + x.(string) }))
+		assert.Equal(t, NewStringSliceV("a", "b", "c", "d", "e"), slice)
+	}
+
+	// initial value
+	assert.Equal(t, NewStringSliceV(">a", ">ab", ">abc", ">abcd", ">abcde"), NewStringSliceV("a", "b", "c", "d", "e").Scan(func(acc, x O) O { return acc.(string) + x.(string) }, ">"))
+}
+
 // Select
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Select_Go(t *testing.B) {
@@ -3029,6 +3863,66 @@ func TestStringSlice_ShiftN(t *testing.T) {
 	}
 }
 
+// Shuffle
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Shuffle() {
+	slice := NewStringSliceV("a", "b", "c", "d", "e")
+	fmt.Println(slice.Shuffle().Len())
+	// Output: 5
+}
+
+func TestStringSlice_Shuffle(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, NewStringSliceV(), slice.Shuffle())
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().Shuffle())
+	}
+
+	// shuffle
+	{
+		slice := NewStringSliceV("a", "b", "c", "d", "e")
+		shuffled := slice.Shuffle()
+		assert.Equal(t, 5, shuffled.Len())
+		assert.True(t, shuffled.All("a", "b", "c", "d", "e"))
+		assert.Equal(t, NewStringSliceV("a", "b", "c", "d", "e"), slice)
+	}
+
+	// seeded
+	{
+		slice := NewStringSliceV("a", "b", "c", "d", "e")
+		assert.Equal(t, slice.Shuffle(rand.New(rand.NewSource(2))), slice.Shuffle(rand.New(rand.NewSource(2))))
+	}
+}
+
+// ShuffleM
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_ShuffleM() {
+	slice := NewStringSliceV("a", "b", "c", "d", "e")
+	fmt.Println(slice.ShuffleM().Len())
+	// Output: 5
+}
+
+func TestStringSlice_ShuffleM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, (*StringSlice)(nil), slice.ShuffleM())
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().ShuffleM())
+	}
+
+	// shuffle
+	{
+		slice := NewStringSliceV("a", "b", "c", "d", "e")
+		shuffled := slice.ShuffleM(rand.New(rand.NewSource(2)))
+		assert.Equal(t, slice, shuffled)
+		assert.Equal(t, NewStringSliceV("a", "b", "c", "d", "e").ShuffleM(rand.New(rand.NewSource(2))), slice)
+		assert.True(t, slice.All("a", "b", "c", "d", "e"))
+	}
+}
+
 // Single
 //--------------------------------------------------------------------------------------------------
 
@@ -3192,6 +4086,116 @@ func TestStringSlice_Sort(t *testing.T) {
 	}
 }
 
+// SortBy
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_SortBy() {
+	slice := NewStringSliceV("b", "A", "a", "B")
+	fmt.Println(slice.SortBy(func(x O) O { return strings.ToLower(x.(string)) }))
+	// Output: [A a b B]
+}
+
+func TestStringSlice_SortBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, NewStringSliceV(), slice.SortBy(func(x O) O { return strings.ToLower(x.(string)) }))
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().SortBy(func(x O) O { return strings.ToLower(x.(string)) }))
+	}
+
+	// new Slice
+	{
+		slice := NewStringSliceV("b", "A", "a", "B")
+		sorted := slice.SortBy(func(x O) O { return strings.ToLower(x.(string)) })
+		assert.Equal(t, NewStringSliceV("A", "a", "b", "B"), sorted)
+		assert.Equal(t, NewStringSliceV("b", "A", "a", "B"), slice)
+	}
+
+	// stable
+	{
+		slice := NewStringSliceV("b", "A", "a", "B")
+		assert.Equal(t, NewStringSliceV("b", "A", "a", "B"), slice.SortBy(func(x O) O { return 0 }))
+	}
+}
+
+// SortByDesc
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_SortByDesc() {
+	slice := NewStringSliceV("b", "A", "a", "B")
+	fmt.Println(slice.SortByDesc(func(x O) O { return strings.ToLower(x.(string)) }))
+	// Output: [b B A a]
+}
+
+func TestStringSlice_SortByDesc(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, NewStringSliceV(), slice.SortByDesc(func(x O) O { return strings.ToLower(x.(string)) }))
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().SortByDesc(func(x O) O { return strings.ToLower(x.(string)) }))
+	}
+
+	// new Slice
+	{
+		slice := NewStringSliceV("b", "A", "a", "B")
+		sorted := slice.SortByDesc(func(x O) O { return strings.ToLower(x.(string)) })
+		assert.Equal(t, NewStringSliceV("b", "B", "A", "a"), sorted)
+		assert.Equal(t, NewStringSliceV("b", "A", "a", "B"), slice)
+	}
+}
+
+// SortByDescM
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_SortByDescM() {
+	slice := NewStringSliceV("b", "A", "a", "B")
+	fmt.Println(slice.SortByDescM(func(x O) O { return strings.ToLower(x.(string)) }))
+	// Output: [b B A a]
+}
+
+func TestStringSlice_SortByDescM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, (*StringSlice)(nil), slice.SortByDescM(func(x O) O { return strings.ToLower(x.(string)) }))
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().SortByDescM(func(x O) O { return strings.ToLower(x.(string)) }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewStringSliceV("b", "A", "a", "B")
+		sorted := slice.SortByDescM(func(x O) O { return strings.ToLower(x.(string)) })
+		assert.Equal(t, NewStringSliceV("b", "B", "A", "a"), sorted)
+		assert.Equal(t, NewStringSliceV("b", "B", "A", "a"), slice)
+	}
+}
+
+// SortByM
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_SortByM() {
+	slice := NewStringSliceV("b", "A", "a", "B")
+	fmt.Println(slice.SortByM(func(x O) O { return strings.ToLower(x.(string)) }))
+	// Output: [A a b B]
+}
+
+func TestStringSlice_SortByM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, (*StringSlice)(nil), slice.SortByM(func(x O) O { return strings.ToLower(x.(string)) }))
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().SortByM(func(x O) O { return strings.ToLower(x.(string)) }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewStringSliceV("b", "A", "a", "B")
+		sorted := slice.SortByM(func(x O) O { return strings.ToLower(x.(string)) })
+		assert.Equal(t, NewStringSliceV("A", "a", "b", "B"), sorted)
+		assert.Equal(t, NewStringSliceV("A", "a", "b", "B"), slice)
+	}
+}
+
 // SortM
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_SortM_Go(t *testing.B) {
@@ -3312,6 +4316,65 @@ func TestStringSlice_SortReverseM(t *testing.T) {
 	}
 }
 
+// SortWith
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_SortWith() {
+	slice := NewStringSliceV("b", "A", "a", "B")
+	fmt.Println(slice.SortWith(func(a, b O) int { return Compare(b, a) }))
+	// Output: [b a B A]
+}
+
+func TestStringSlice_SortWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, NewStringSliceV(), slice.SortWith(func(a, b O) int { return Compare(b, a) }))
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().SortWith(func(a, b O) int { return Compare(b, a) }))
+	}
+
+	// new Slice
+	{
+		slice := NewStringSliceV("b", "A", "a", "B")
+		sorted := slice.SortWith(func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, NewStringSliceV("b", "a", "B", "A"), sorted)
+		assert.Equal(t, NewStringSliceV("b", "A", "a", "B"), slice)
+	}
+
+	// ordering
+	{
+		slice := NewStringSliceV("b", "A", "a", "B")
+		assert.Equal(t, NewStringSliceV("A", "a", "b", "B"), slice.SortWith(By(func(x O) O { return strings.ToLower(x.(string)) }).Compare))
+		assert.Equal(t, NewStringSliceV("b", "B", "A", "a"), slice.SortWith(ByDesc(func(x O) O { return strings.ToLower(x.(string)) }).Compare))
+	}
+}
+
+// SortWithM
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_SortWithM() {
+	slice := NewStringSliceV("b", "A", "a", "B")
+	fmt.Println(slice.SortWithM(func(a, b O) int { return Compare(b, a) }))
+	// Output: [b a B A]
+}
+
+func TestStringSlice_SortWithM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, (*StringSlice)(nil), slice.SortWithM(func(a, b O) int { return Compare(b, a) }))
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().SortWithM(func(a, b O) int { return Compare(b, a) }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewStringSliceV("b", "A", "a", "B")
+		sorted := slice.SortWithM(func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, NewStringSliceV("b", "a", "B", "A"), sorted)
+		assert.Equal(t, NewStringSliceV("b", "a", "B", "A"), slice)
+	}
+}
+
 // String
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_String_Go(t *testing.B) {
@@ -4037,3 +5100,64 @@ func RangeString(size int) (new []string) {
 	}
 	return
 }
+
+// Window
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Window() {
+	slice := NewStringSliceV("a", "b", "c", "d")
+	fmt.Println(slice.Window(2, 1))
+	// Output: [[a b] [b c] [c d]]
+}
+
+func TestStringSlice_Window(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, []ISlice{}, slice.Window(2, 1))
+		assert.Equal(t, []ISlice{}, NewStringSliceV().Window(2, 1))
+	}
+
+	// invalid size or step
+	assert.Equal(t, []ISlice{}, NewStringSliceV("a", "b").Window(0, 1))
+	assert.Equal(t, []ISlice{}, NewStringSliceV("a", "b").Window(1, 0))
+	assert.Equal(t, []ISlice{}, NewStringSliceV("a", "b").Window(3, 1))
+
+	// windows
+	{
+		slice := NewStringSliceV("a", "b", "c", "d", "e")
+		assert.Equal(t, []ISlice{NewStringSliceV("a", "b"), NewStringSliceV("b", "c"), NewStringSliceV("c", "d"), NewStringSliceV("d", "e")}, slice.Window(2, 1))
+		assert.Equal(t, []ISlice{NewStringSliceV("a", "b", "c"), NewStringSliceV("c", "d", "e")}, slice.Window(3, 2))
+		assert.Equal(t, []ISlice{NewStringSliceV("a", "b"), NewStringSliceV("d", "e")}, slice.Window(2, 3))
+		assert.Equal(t, []ISlice{NewStringSliceV("a", "b", "c", "d", "e")}, slice.Window(5, 1))
+	}
+}
+
+// Zip
+// --------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Zip() {
+	slice := NewStringSliceV("a", "b")
+	fmt.Println(slice.Zip([]string{"x", "y"}))
+	// Output: [[a x] [b y]]
+}
+
+func TestStringSlice_Zip(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, []ISlice{}, slice.Zip([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewStringSliceV().Zip([]string{"x"}))
+	}
+
+	// no slices
+	assert.Equal(t, []ISlice{NewInterSliceV("a"), NewInterSliceV("b")}, NewStringSliceV("a", "b").Zip())
+
+	// stops at the shortest slice
+	{
+		slice := NewStringSliceV("a", "b", "c")
+		assert.Equal(t, []ISlice{NewInterSliceV("a", "x", 7), NewInterSliceV("b", "y", 8)},
+			slice.Zip([]string{"x", "y", "z"}, NewIntSliceV(7, 8)))
+		assert.Equal(t, []ISlice{}, slice.Zip([]int{}))
+	}
+}
@@ -3,6 +3,7 @@ package n
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -17,6 +18,15 @@ func RangeInteger(min, max int) []Integer {
 	return result
 }
 
+// sliceO returns the underlying data structures of the given slices for comparison
+func sliceO(slices []ISlice) (result []interface{}) {
+	result = []interface{}{}
+	for i := range slices {
+		result = append(result, slices[i].O())
+	}
+	return
+}
+
 func BenchmarkSlice_CustomNative(t *testing.B) {
 	seedData := RangeInteger(0, 999999)
 
@@ -164,6 +174,26 @@ func TestSlice_NewSlice(t *testing.T) {
 		assert.Equal(t, NewStrV("3"), Slice([]byte{'3'}))
 		assert.Equal(t, NewStrV("3"), Slice([]Char{'3'}))
 	}
+
+	// registered converters and text marshalers
+	{
+		assert.True(t, Slice([]color{red}).RefSlice())
+		defer registerTestConverters()()
+		assert.Equal(t, NewStringSliceV("red", "blue"), Slice([]color{red, blue}))
+		assert.Equal(t, NewStringSliceV("red"), Slice(red))
+		assert.Equal(t, NewStringSliceV("01020304"), Slice(id{1, 2, 3, 4}))
+		assert.Equal(t, NewStringSliceV("01020304"), Slice([]*id{{1, 2, 3, 4}}))
+		assert.Equal(t, NewStringSliceV("green"), Slice([]interface{}{green}))
+		assert.Equal(t, NewStringSliceV("10.0.0.1"), Slice([]ip{{10, 0, 0, 1}}))
+		assert.True(t, Slice([]time.Time{{}}).RefSlice())
+
+		RegisterConverter(color(0), 0, func(obj interface{}) (interface{}, error) {
+			return int(obj.(color)), nil
+		})
+		UnregisterConverter(color(0), "")
+		defer UnregisterConverter(color(0), 0)
+		assert.Equal(t, NewIntSliceV(1, 3), Slice([]color{red, blue}))
+	}
 }
 
 func TestSlice_NewSliceV(t *testing.T) {
@@ -195,6 +225,13 @@ func TestSlice_NewSliceV(t *testing.T) {
 		assert.Equal(t, NewStrV("3"), NewSliceV(byte('3')))
 		assert.Equal(t, NewStrV("3"), NewSliceV(Char('3')))
 	}
+
+	// registered converters and text marshalers
+	{
+		defer registerTestConverters()()
+		assert.Equal(t, NewStringSliceV("red", "blue"), NewSliceV(red, blue))
+		assert.Equal(t, NewStringSliceV("10.0.0.1"), NewSliceV(ip{10, 0, 0, 1}))
+	}
 }
 
 func TestSlice_absIndex(t *testing.T) {
@@ -0,0 +1,263 @@
+package n
+
+import (
+	"cmp"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/phR0ze/n/pkg/collate"
+)
+
+// Compare returns -1 if a sorts before b, 0 if they are equal and 1 if a sorts after b using
+// the natural ordering of their types. It is the default comparison used by SortBy and By.
+// nil sorts first, numbers of any type compare numerically with NaN sorting before other
+// numbers, bools sort false before true, strings including Str compare byte wise and times
+// chronologically. Any other combination of types compares their string representations.
+func Compare(a, b O) int {
+	a, b = orderValue(a), orderValue(b)
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	switch x := a.(type) {
+	case bool:
+		if y, ok := b.(bool); ok {
+			return compareBool(x, y)
+		}
+	case int64:
+		switch y := b.(type) {
+		case int64:
+			return cmp.Compare(x, y)
+		case uint64:
+			if x < 0 {
+				return -1
+			}
+			return cmp.Compare(uint64(x), y)
+		case float64:
+			return cmp.Compare(float64(x), y)
+		}
+	case uint64:
+		switch y := b.(type) {
+		case int64:
+			return -Compare(y, x)
+		case uint64:
+			return cmp.Compare(x, y)
+		case float64:
+			return cmp.Compare(float64(x), y)
+		}
+	case float64:
+		switch y := b.(type) {
+		case int64, uint64:
+			return -Compare(y, x)
+		case float64:
+			return cmp.Compare(x, y)
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y)
+		}
+	case time.Time:
+		if y, ok := b.(time.Time); ok {
+			return x.Compare(y)
+		}
+	}
+	return strings.Compare(ToString(a), ToString(b))
+}
+
+// CompareFold compares the string representations of a and b ignoring case using locale
+// independent Unicode simple case folding. Strings that differ only in case are equal.
+func CompareFold(a, b O) int {
+	return collate.Fold(ToString(a), ToString(b))
+}
+
+// CompareNatural compares the string representations of a and b treating embedded numbers
+// numerically such that file2 sorts before file10.
+func CompareNatural(a, b O) int {
+	return collate.Natural(ToString(a), ToString(b))
+}
+
+// CompareNaturalFold compares the string representations of a and b the same as
+// CompareNatural but ignoring case the same as CompareFold.
+func CompareNaturalFold(a, b O) int {
+	return collate.NaturalFold(ToString(a), ToString(b))
+}
+
+// CompareVersion compares the string representations of a and b as versions following
+// semantic versioning precedence such that v1.9.0 < 1.10.0-rc.1 < 1.10.0.
+func CompareVersion(a, b O) int {
+	return collate.Version(ToString(a), ToString(b))
+}
+
+// Ordering provides multi-key ordering for use with SortWith, IsSortedWith and BinarySearchWith.
+// Elements are compared by the first key and only compared by the following keys when equal
+// e.g. slice.SortWith(By(lastName).ThenBy(firstName).ThenByDesc(age).Compare)
+type Ordering struct {
+	keys []orderingKey
+}
+
+// orderingKey is a single key of an Ordering
+type orderingKey struct {
+	key  func(O) O        // selects the key to compare from the element
+	cmp  func(a, b O) int // compares the selected keys
+	desc bool             // reverses the comparison
+}
+
+// By creates a new Ordering by the key the given lambda selects from the elements. Keys are
+// compared with Compare unless the optional comparison e.g. CompareNatural is given. A nil
+// key lambda compares the elements themselves.
+func By(key func(O) O, cmp ...func(a, b O) int) *Ordering {
+	return (&Ordering{}).ThenBy(key, cmp...)
+}
+
+// ByDesc creates a new Ordering the same as By but in descending order.
+func ByDesc(key func(O) O, cmp ...func(a, b O) int) *Ordering {
+	return (&Ordering{}).ThenByDesc(key, cmp...)
+}
+
+// Compare returns -1 if a sorts before b, 0 if they are equal and 1 if a sorts after b
+// according to this Ordering's keys.
+func (p *Ordering) Compare(a, b O) int {
+	if p == nil {
+		return Compare(a, b)
+	}
+	for _, k := range p.keys {
+		x, y := a, b
+		if k.key != nil {
+			x, y = k.key(a), k.key(b)
+		}
+		if k.desc {
+			x, y = y, x
+		}
+		if c := k.cmp(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// ThenBy returns a new Ordering which compares elements that are equal by this Ordering's
+// keys by the key the given lambda selects from them. See By.
+func (p *Ordering) ThenBy(key func(O) O, cmp ...func(a, b O) int) *Ordering {
+	return p.then(key, cmp, false)
+}
+
+// ThenByDesc returns a new Ordering the same as ThenBy but in descending order.
+func (p *Ordering) ThenByDesc(key func(O) O, cmp ...func(a, b O) int) *Ordering {
+	return p.then(key, cmp, true)
+}
+
+// then returns a new Ordering with the given key appended
+func (p *Ordering) then(key func(O) O, cmp []func(a, b O) int, desc bool) *Ordering {
+	k := orderingKey{key: key, cmp: Compare, desc: desc}
+	if len(cmp) > 0 && cmp[0] != nil {
+		k.cmp = cmp[0]
+	}
+	ordering := &Ordering{}
+	if p != nil {
+		ordering.keys = append(ordering.keys, p.keys...)
+	}
+	ordering.keys = append(ordering.keys, k)
+	return ordering
+}
+
+// binarySearch searches the n sorted elements returned by elem for the given target returning
+// the index it was found at or would be inserted at to keep the elements sorted.
+func binarySearch(n int, elem func(i int) O, target interface{}, cmp func(a, b O) int) (i int, found bool) {
+	i = sort.Search(n, func(i int) bool { return cmp(elem(i), target) >= 0 })
+	return i, i < n && cmp(elem(i), target) == 0
+}
+
+// compareBool compares the given bools with false sorting before true
+func compareBool(x, y bool) int {
+	switch {
+	case x == y:
+		return 0
+	case !x:
+		return -1
+	}
+	return 1
+}
+
+// isSorted tests if the n elements returned by elem are sorted according to the given comparison
+func isSorted(n int, elem func(i int) O, cmp func(a, b O) int) bool {
+	for i := 1; i < n; i++ {
+		if cmp(elem(i-1), elem(i)) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// orderValue normalizes the given value for Compare into nil, bool, int64, uint64, float64,
+// string, time.Time or the value as is if it has no natural ordering.
+func orderValue(obj interface{}) interface{} {
+	switch x := obj.(type) {
+	case nil:
+		return nil
+	case bool, string, time.Time:
+		return x
+	case *Object:
+		if x == nil {
+			return nil
+		}
+		return orderValue(x.o)
+	case Str:
+		return string(x)
+	case *Str:
+		if x == nil {
+			return nil
+		}
+		return string(*x)
+	}
+
+	v := reflect.ValueOf(obj)
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return v.String()
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return orderValue(v.Elem().Interface())
+	}
+	return obj
+}
+
+// sortM stably sorts the n elements of the given slice in place according to the given
+// comparison of the elements returned by elem, which are only calculated once per element.
+func sortM(p ISlice, elem func(i int) O, cmp func(a, b O) int) {
+	n := p.Len()
+	elems, order := make([]O, n), make([]int, n)
+	for i := range order {
+		elems[i], order[i] = elem(i), i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return cmp(elems[order[i]], elems[order[j]]) < 0 })
+
+	// Apply the sorted order by following each cycle of the permutation using only Swap so
+	// that it works for any Slice implementation.
+	for i := range order {
+		j := i
+		for order[j] != i {
+			k := order[j]
+			p.Swap(j, k)
+			order[j] = j
+			j = k
+		}
+		order[j] = j
+	}
+}
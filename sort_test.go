@@ -0,0 +1,183 @@
+package n
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// person is a struct type for sorting by properties
+type person struct {
+	Name string
+	Age  int
+}
+
+func personName(x O) O { return x.(person).Name }
+func personAge(x O) O  { return x.(person).Age }
+
+// Compare
+// --------------------------------------------------------------------------------------------------
+func ExampleCompare() {
+	fmt.Println(Compare(1, 2.5), Compare("b", "a"), Compare(nil, 0))
+	// Output: -1 1 -1
+}
+
+func TestCompare(t *testing.T) {
+
+	// nil
+	assert.Equal(t, 0, Compare(nil, nil))
+	assert.Equal(t, -1, Compare(nil, 1))
+	assert.Equal(t, 1, Compare("", nil))
+	assert.Equal(t, -1, Compare((*Str)(nil), ""))
+
+	// numbers of different types
+	assert.Equal(t, 0, Compare(1, int8(1)))
+	assert.Equal(t, -1, Compare(-1, uint(0)))
+	assert.Equal(t, 1, Compare(uint64(math.MaxUint64), int64(math.MaxInt64)))
+	assert.Equal(t, -1, Compare(uint(1), 1.5))
+	assert.Equal(t, 1, Compare(2.5, 2))
+	assert.Equal(t, -1, Compare(math.NaN(), math.Inf(-1)))
+	assert.Equal(t, 0, Compare(math.NaN(), math.NaN()))
+	assert.Equal(t, -1, Compare(Obj(1), Obj(2)))
+	{
+		x := 5
+		assert.Equal(t, 1, Compare(&x, 4))
+	}
+
+	// bools
+	assert.Equal(t, -1, Compare(false, true))
+	assert.Equal(t, 0, Compare(true, true))
+
+	// strings
+	assert.Equal(t, -1, Compare("a", "b"))
+	assert.Equal(t, 0, Compare(A("a"), "a"))
+	assert.Equal(t, 1, Compare(*A("b"), A("a")))
+	assert.Equal(t, -1, Compare("B", "a"))
+	assert.Equal(t, 1, Compare("file2", "file10"))
+
+	// times
+	{
+		now := time.Now()
+		assert.Equal(t, -1, Compare(now, now.Add(time.Second)))
+		assert.Equal(t, 0, Compare(now, now))
+	}
+
+	// mixed types fall back on strings
+	assert.Equal(t, -1, Compare(1, "a"))
+	assert.Equal(t, 1, Compare(true, "false"))
+}
+
+// CompareFold
+// --------------------------------------------------------------------------------------------------
+func ExampleCompareFold() {
+	fmt.Println(CompareFold("Hello", "hELLO"), CompareFold("apple", "Banana"))
+	// Output: 0 -1
+}
+
+func TestCompareFold(t *testing.T) {
+	assert.Equal(t, 0, CompareFold("Straße", "STRAßE"))
+	assert.Equal(t, 0, CompareFold(A("k"), "K"))
+	assert.Equal(t, -1, CompareFold("a", "B"))
+	assert.Equal(t, 1, CompareFold("b", "A"))
+	assert.Equal(t, NewStringSliceV("apple", "Banana", "cherry"),
+		NewStringSliceV("cherry", "Banana", "apple").SortWith(CompareFold))
+}
+
+// CompareNatural
+// --------------------------------------------------------------------------------------------------
+func ExampleCompareNatural() {
+	slice := NewStringSliceV("file10", "file2", "file1")
+	fmt.Println(slice.SortWith(CompareNatural))
+	// Output: [file1 file2 file10]
+}
+
+func TestCompareNatural(t *testing.T) {
+	assert.Equal(t, -1, CompareNatural("file2", "file10"))
+	assert.Equal(t, 1, CompareNatural("file10", "file2"))
+	assert.Equal(t, 0, CompareNatural("file2", A("file2")))
+	assert.Equal(t, -1, CompareNatural("File2", "file1"))
+	assert.Equal(t, -1, CompareNatural(2, 10))
+}
+
+// CompareNaturalFold
+// --------------------------------------------------------------------------------------------------
+func ExampleCompareNaturalFold() {
+	slice := NewStringSliceV("file10", "File2", "file1")
+	fmt.Println(slice.SortWith(CompareNaturalFold))
+	// Output: [file1 File2 file10]
+}
+
+func TestCompareNaturalFold(t *testing.T) {
+	assert.Equal(t, 0, CompareNaturalFold("File2", "file2"))
+	assert.Equal(t, 1, CompareNaturalFold("File2", "file1"))
+	assert.Equal(t, -1, CompareNaturalFold("FILE2", "file10"))
+}
+
+// CompareVersion
+// --------------------------------------------------------------------------------------------------
+func ExampleCompareVersion() {
+	slice := NewStringSliceV("1.10.0", "v1.9.0", "1.10.0-rc.1")
+	fmt.Println(slice.SortWith(CompareVersion))
+	// Output: [v1.9.0 1.10.0-rc.1 1.10.0]
+}
+
+func TestCompareVersion(t *testing.T) {
+	assert.Equal(t, -1, CompareVersion("1.9.0", "1.10.0"))
+	assert.Equal(t, 0, CompareVersion("v1.2", "1.2.0"))
+	assert.Equal(t, -1, CompareVersion("1.0.0-alpha", "1.0.0"))
+	assert.Equal(t, 1, CompareVersion("1.0.0-beta.11", "1.0.0-beta.2"))
+	assert.Equal(t, 0, CompareVersion("1.0.0+build.1", A("1.0.0")))
+}
+
+// Ordering
+// --------------------------------------------------------------------------------------------------
+func ExampleBy() {
+	slice := NewRefSliceV(person{"Ben", 30}, person{"Amy", 25}, person{"Ben", 20})
+	fmt.Println(slice.SortWith(By(personName).ThenByDesc(personAge).Compare).O())
+	// Output: [{Amy 25} {Ben 30} {Ben 20}]
+}
+
+func TestOrdering(t *testing.T) {
+	people := []person{{"Ben", 30}, {"amy", 25}, {"Ben", 20}, {"Cal", 25}}
+
+	// nil ordering
+	assert.Equal(t, -1, (*Ordering)(nil).Compare(1, 2))
+
+	// single key
+	assert.Equal(t, -1, By(personAge).Compare(people[2], people[0]))
+	assert.Equal(t, 1, ByDesc(personAge).Compare(people[2], people[0]))
+	assert.Equal(t, 0, By(personAge).Compare(people[1], people[3]))
+
+	// nil key compares elements
+	assert.Equal(t, -1, By(nil).Compare(1, 2))
+	assert.Equal(t, 1, ByDesc(nil).Compare(1, 2))
+
+	// custom comparison
+	assert.Equal(t, 1, By(personName).Compare(people[1], people[0]))
+	assert.Equal(t, -1, By(personName, CompareFold).Compare(people[1], people[0]))
+
+	// multiple keys
+	{
+		ordering := By(personAge).ThenBy(personName)
+		assert.Equal(t, -1, ordering.Compare(people[3], people[1]))
+		ordering = By(personAge).ThenByDesc(personName, CompareFold)
+		assert.Equal(t, -1, ordering.Compare(people[3], people[1]))
+	}
+
+	// then doesn't modify the original
+	{
+		ordering := By(personAge)
+		ordering.ThenBy(personName)
+		assert.Equal(t, 0, ordering.Compare(people[1], people[3]))
+	}
+
+	// sort
+	{
+		slice := NewRefSlice(people).SortWith(ByDesc(personAge).ThenBy(personName, CompareFold).Compare)
+		assert.Equal(t, []person{{"Ben", 30}, {"amy", 25}, {"Cal", 25}, {"Ben", 20}}, slice.O())
+		assert.True(t, slice.IsSortedWith(ByDesc(personAge).ThenBy(personName, CompareFold).Compare))
+	}
+}
@@ -1,11 +1,20 @@
 package n
 
 import (
+	"math/rand"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"unicode"
 
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/phR0ze/n/pkg/enc/msgpack"
+	"github.com/phR0ze/n/pkg/fuzzy"
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/phR0ze/n/pkg/shell"
+	"github.com/phR0ze/n/pkg/uni"
 	"github.com/pkg/errors"
 )
 
@@ -232,11 +241,57 @@ func (p *Str) B() []byte {
 	return []byte(string(*p))
 }
 
+// BinarySearch searches this Slice, which must be sorted in ascending order e.g. with Sort, for
+// the given element returning the index it was found at or the index it would be inserted at to
+// keep this Slice sorted and whether it was found.
+func (p *Str) BinarySearch(elem interface{}) (i int, found bool) {
+	if p == nil {
+		return 0, false
+	}
+	x := ToChar(elem).G()
+	i = sort.Search(len(*p), func(i int) bool { return (*p)[i] >= x })
+	return i, i < len(*p) && (*p)[i] == x
+}
+
+// BinarySearchWith searches this Slice, which must be sorted according to the given comparison, for
+// the given element returning the index it was found at or the index it would be inserted at to
+// keep this Slice sorted and whether it was found. The comparison is given each element of this
+// Slice and the element being searched for, converted to a rune, e.g. SortWith and
+// BinarySearchWith may share an Ordering.
+func (p *Str) BinarySearchWith(elem interface{}, cmp func(a, b O) int) (i int, found bool) {
+	return binarySearch(p.Len(), func(i int) O { return (*p)[i] }, ToChar(elem).G(), cmp)
+}
+
 // C exports the Str as a Char
 func (p *Str) C() *Char {
 	return NewChar(p)
 }
 
+// Cartesian returns the cartesian product of this Slice and the given slices as new tuple Slices
+// of every combination of one element from each slice e.g. [1 2].Cartesian([a b]) returns
+// [[1 a] [1 b] [2 a] [2 b]]. Tuples are InterSlices as the slices may be of different types.
+func (p *Str) Cartesian(slices ...interface{}) (tuples []ISlice) {
+	return cartesian(p, slices)
+}
+
+// Center returns a new Str centered within the given display width by padding both sides with
+// the optional pad string which defaults to a space. Any odd column of padding goes on the right.
+func (p *Str) Center(width int, pad ...string) (new *Str) {
+	need := width - p.Width()
+	if need <= 0 {
+		return A(p.A())
+	}
+	left := need / 2
+	return A(padding(left, pad...) + p.A() + padding(need-left, pad...))
+}
+
+// Chunk returns this Slice split into new Slices of n consecutive elements with the last Slice
+// containing the remaining elements e.g. [1 2 3 4 5].Chunk(2) returns [[1 2] [3 4] [5]].
+// Returns no Slices if n is not positive.
+func (p *Str) Chunk(n int) (chunks []ISlice) {
+	return chunk(p, n)
+}
+
 // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
 func (p *Str) Clear() ISlice {
 	if p == nil {
@@ -247,6 +302,13 @@ func (p *Str) Clear() ISlice {
 	return p
 }
 
+// Combinations returns new Slices of all combinations of n elements of this Slice in the order
+// the elements occur e.g. [1 2 3].Combinations(2) returns [[1 2] [1 3] [2 3]]. Returns no Slices
+// if n is negative or larger than this Slice.
+func (p *Str) Combinations(n int) (combos []ISlice) {
+	return combinations(p, n)
+}
+
 // Concat returns a new Slice by appending the given Slice to this Slice.
 // Supports Str, *Str, []string or *[]string
 func (p *Str) Concat(slice interface{}) (new ISlice) {
@@ -344,6 +406,26 @@ func (p *Str) CountW(sel func(O) bool) (cnt int) {
 	return
 }
 
+// DamerauLevenshtein returns the Damerau-Levenshtein distance between this Str and the given
+// string i.e. the minimum number of rune insertions, deletions, substitutions and
+// transpositions of adjacent runes needed to change one into the other.
+func (p *Str) DamerauLevenshtein(str interface{}) int {
+	return fuzzy.DamerauLevenshtein(p.A(), ToString(str))
+}
+
+// DeepCopy returns a new Slice with all elements recursively copied from this Slice. As the
+// elements are values this is the same as Copy.
+func (p *Str) DeepCopy() (new ISlice) {
+	return p.Copy()
+}
+
+// DeepEqual tests if this Slice is structurally equal to the given obj, see DeepEqual.
+//
+// Supported options: IgnoreOrderOpt, IgnorePathsOpt and NumericOpt
+func (p *Str) DeepEqual(obj interface{}, opts ...*opt.Opt) bool {
+	return DeepEqual(p, obj, opts...)
+}
+
 // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
 // Expects nothing, in which case everything is dropped, or two indices i and j, in which case positive and
 // negative notation is supported and uses an inclusive behavior such that DropAt(0, -1) includes index -1
@@ -526,6 +608,15 @@ func (p *Str) EachRIE(action func(int, O) error) (ISlice, error) {
 	return p, err
 }
 
+// EachSlice calls the given lambda once for each new Slice of n consecutive elements of this
+// Slice the same as Chunk and returns a reference to this Slice.
+func (p *Str) EachSlice(n int, action func(ISlice)) ISlice {
+	for _, slice := range chunk(p, n) {
+		action(slice)
+	}
+	return p
+}
+
 // Empty tests if this Slice is empty.
 func (p *Str) Empty() bool {
 	if p == nil || len(*p) == 0 {
@@ -578,6 +669,64 @@ func (p *Str) FieldsW(f func(rune) bool) (new *StringSlice) {
 	return
 }
 
+// FindAll returns a new StringSlice of all successive non-overlapping matches of the given
+// regex pattern in this Str. The pattern may be a string or a compiled *regexp.Regexp. String
+// patterns are compiled once and cached. Swallows any errors returning an empty slice for
+// invalid patterns, see FindAllE.
+func (p *Str) FindAll(pattern interface{}) (slice *StringSlice) {
+	slice, _ = p.FindAllE(pattern)
+	return
+}
+
+// FindAllE returns a new StringSlice of all successive non-overlapping matches of the given
+// regex pattern in this Str and an error for invalid patterns. See FindAll for details.
+func (p *Str) FindAllE(pattern interface{}) (slice *StringSlice, err error) {
+	slice = NewStringSliceV()
+	var re *regexp.Regexp
+	if re, err = compileRegex(pattern); err != nil || p == nil {
+		return
+	}
+	for _, match := range re.FindAllString(p.A(), -1) {
+		*slice = append(*slice, match)
+	}
+	return
+}
+
+// FindAllSubmatch returns a new SliceOfMap with a StringMap for every successive
+// non-overlapping match of the given regex pattern in this Str. Each StringMap holds the full
+// match keyed by "0", named captures keyed by their name and unnamed captures keyed by their
+// index e.g. `(?P<key>\w+)=(\w+)` matching "a=1" gives {"0": "a=1", "key": "a", "2": "1"}.
+// Captures that didn't participate in the match are set to an empty string. Swallows any
+// errors returning an empty slice for invalid patterns, see FindAllSubmatchE.
+func (p *Str) FindAllSubmatch(pattern interface{}) (slice *SliceOfMap) {
+	slice, _ = p.FindAllSubmatchE(pattern)
+	return
+}
+
+// FindAllSubmatchE returns a new SliceOfMap with a StringMap for every successive
+// non-overlapping match of the given regex pattern in this Str and an error for invalid
+// patterns. See FindAllSubmatch for details.
+func (p *Str) FindAllSubmatchE(pattern interface{}) (slice *SliceOfMap, err error) {
+	slice = NewSliceOfMapV()
+	var re *regexp.Regexp
+	if re, err = compileRegex(pattern); err != nil || p == nil {
+		return
+	}
+	names := re.SubexpNames()
+	for _, match := range re.FindAllStringSubmatch(p.A(), -1) {
+		m := NewStringMapV()
+		for i := range match {
+			key := names[i]
+			if key == "" {
+				key = strconv.Itoa(i)
+			}
+			m.Set(key, match[i])
+		}
+		*slice = append(*slice, m)
+	}
+	return
+}
+
 // First returns the first element in this Slice as Object.
 // Object.Nil() == true will be returned when there are no elements in the slice.
 func (p *Str) First() (elem *Object) {
@@ -593,11 +742,78 @@ func (p *Str) FirstN(n int) ISlice {
 	return p.Slice(0, abs(n)-1)
 }
 
+// Flatten returns a new Slice with the elements of any nested slices expanded in place. This
+// Slice can't contain nested slices so this is the same as Copy.
+func (p *Str) Flatten() (new ISlice) {
+	return p.Copy()
+}
+
+// FuzzyMatch scores this Str against the given query the way fuzzy finders like fzf do
+// returning nil if this Str doesn't contain all the runes of the query in order. The result
+// includes the rune positions of the matched characters for highlighting. Matching is case
+// insensitive unless the query contains an upper case letter.
+func (p *Str) FuzzyMatch(query string) *fuzzy.Result {
+	return fuzzy.Match(query, p.A())
+}
+
 // G returns the underlying data structure as a builtin Go type
 func (p *Str) G() string {
 	return p.O().(string)
 }
 
+// GraphemeAt returns the user perceived character i.e. extended grapheme cluster at the given
+// index as a new Str. Allows for negative notation. Returns an empty Str if out of bounds.
+func (p *Str) GraphemeAt(i int) (new *Str) {
+	clusters := uni.Graphemes(p.A())
+	if i = absIndex(len(clusters), i); i == -1 {
+		return NewStrV()
+	}
+	return A(clusters[i])
+}
+
+// GraphemeLen returns the number of user perceived characters i.e. extended grapheme clusters
+// in this Str. Unlike Len combining marks, emoji sequences and flags are counted as one.
+func (p *Str) GraphemeLen() int {
+	if p == nil {
+		return 0
+	}
+	return uni.GraphemeCount(p.A())
+}
+
+// GraphemeReverse returns a new Str with the user perceived characters i.e. extended grapheme
+// clusters in reverse order. Unlike Reverse combining marks and emoji sequences are kept intact.
+func (p *Str) GraphemeReverse() (new *Str) {
+	clusters := uni.Graphemes(p.A())
+	for i, j := 0, len(clusters)-1; i < j; i, j = i+1, j-1 {
+		clusters[i], clusters[j] = clusters[j], clusters[i]
+	}
+	return A(strings.Join(clusters, ""))
+}
+
+// GraphemeSlice returns a range of user perceived characters i.e. extended grapheme clusters
+// from this Str as a new Str. Allows for negative notation. Expects nothing, in which case
+// everything is included, or two indices i and j, in which case an inclusive behavior is used.
+// Out of bounds indices will be moved within bounds.
+//
+// An empty Str is returned if indicies are mutually exclusive or nothing can be returned.
+func (p *Str) GraphemeSlice(indices ...int) (new *Str) {
+	clusters := uni.Graphemes(p.A())
+	if len(clusters) == 0 {
+		return NewStrV()
+	}
+	i, j, err := absIndices(len(clusters), indices...)
+	if err != nil {
+		return NewStrV()
+	}
+	return A(strings.Join(clusters[i:j], ""))
+}
+
+// Graphemes splits this Str into its user perceived characters i.e. extended grapheme clusters
+// as defined by UAX #29 such that emoji sequences, flags and combining marks are kept together.
+func (p *Str) Graphemes() (new *StringSlice) {
+	return NewStringSlice(uni.Graphemes(p.A()))
+}
+
 // HasAnyPrefix checks if the string has any of the given prefixes
 func (p *Str) HasAnyPrefix(prefixes interface{}) bool {
 	if p == nil || len(*p) == 0 {
@@ -680,6 +896,11 @@ func (p *Str) HasSuffix(prefix interface{}) bool {
 	return strings.HasSuffix(string(*p), string(*x))
 }
 
+// Hash returns a stable structural hash of this Slice, see Hash.
+func (p *Str) Hash() uint64 {
+	return Hash(p)
+}
+
 // Index returns the index of the first substr in this Str, or -1 if substr is not present.
 // Pass through to strings.Index
 func (p *Str) Index(substr interface{}) (loc int) {
@@ -716,6 +937,11 @@ func (p *Str) IndexChar(char interface{}) (loc int) {
 	return strings.IndexRune(str, x)
 }
 
+// Inject is an alias to Reduce
+func (p *Str) Inject(reducer func(acc, elem O) O, init ...interface{}) (acc *Object) {
+	return reduce(p, reducer, init)
+}
+
 // Insert modifies this Slice to insert the given element before the element with the given index.
 // Negative indices count backwards from the end of the slice, where -1 is the last element. If a
 // negative index is used, the given element will be inserted after that element, so using an index
@@ -752,6 +978,25 @@ func (p *Str) InterSlice() bool {
 	return false
 }
 
+// IsSorted tests if the elements of this Slice are sorted in ascending order
+func (p *Str) IsSorted() bool {
+	if p == nil {
+		return true
+	}
+	return sort.IsSorted(p)
+}
+
+// IsSortedWith tests if the elements of this Slice are sorted according to the given comparison
+func (p *Str) IsSortedWith(cmp func(a, b O) int) bool {
+	return isSorted(p.Len(), func(i int) O { return (*p)[i] }, cmp)
+}
+
+// JaroWinkler returns the Jaro-Winkler similarity between this Str and the given string from
+// 0 for no similarity to 1 for an exact match favoring strings with a common prefix.
+func (p *Str) JaroWinkler(str interface{}) float64 {
+	return fuzzy.JaroWinkler(p.A(), ToString(str))
+}
+
 // Join converts each element into a string then joins them together using the given separator or comma by default.
 func (p *Str) Join(separator ...string) (str *Object) {
 	if p == nil || len(*p) == 0 {
@@ -841,6 +1086,31 @@ func (p *Str) Less(i, j int) bool {
 	return ToChar((*p)[i]).Less((*p)[j])
 }
 
+// Levenshtein returns the Levenshtein distance between this Str and the given string i.e. the
+// minimum number of rune insertions, deletions and substitutions needed to change one into the
+// other.
+func (p *Str) Levenshtein(str interface{}) int {
+	return fuzzy.Levenshtein(p.A(), ToString(str))
+}
+
+// Match reports whether this Str contains any match of the given regex pattern. The pattern
+// may be a string or a compiled *regexp.Regexp. String patterns are compiled once and cached.
+// Swallows any errors returning false for invalid patterns, see MatchE.
+func (p *Str) Match(pattern interface{}) bool {
+	match, _ := p.MatchE(pattern)
+	return match
+}
+
+// MatchE reports whether this Str contains any match of the given regex pattern and returns an
+// error for invalid patterns. See Match for details.
+func (p *Str) MatchE(pattern interface{}) (match bool, err error) {
+	var re *regexp.Regexp
+	if re, err = compileRegex(pattern); err != nil || p == nil {
+		return
+	}
+	return re.MatchString(p.A()), nil
+}
+
 // Map creates a new slice with the modified elements from the lambda.
 func (p *Str) Map(mod func(O) O) ISlice {
 	var slice ISlice
@@ -858,6 +1128,31 @@ func (p *Str) Map(mod func(O) O) ISlice {
 	return slice
 }
 
+// MarshalJSON implements the json.Marshaler interface encoding this Str as a json string.
+func (p *Str) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.A())
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface encoding this Str as a msgpack string.
+func (p *Str) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(p.A())
+}
+
+// MarshalYAML implements the yaml.Marshaler interface encoding this Str as a yaml string.
+func (p *Str) MarshalYAML() (interface{}, error) {
+	return p.A(), nil
+}
+
+// NFC returns a new Str in Unicode Normalization Form C i.e. canonical composition
+func (p *Str) NFC() (new *Str) {
+	return A(uni.NFC(p.A()))
+}
+
+// NFD returns a new Str in Unicode Normalization Form D i.e. canonical decomposition
+func (p *Str) NFD() (new *Str) {
+	return A(uni.NFD(p.A()))
+}
+
 // Nil tests if this Slice is nil
 func (p *Str) Nil() bool {
 	if p == nil {
@@ -874,6 +1169,44 @@ func (p *Str) O() interface{} {
 	return string(*p)
 }
 
+// Ordinalize returns a new Str with the English ordinal suffix appended to this numeric Str
+// e.g. "1" becomes "1st", "12" becomes "12th" and "23" becomes "23rd". A copy of this Str is
+// returned if it isn't an integer.
+func (p *Str) Ordinalize() (new *Str) {
+	str := strings.TrimSpace(p.A())
+	n, err := strconv.Atoi(str)
+	if err != nil {
+		return A(p.A())
+	}
+	if n < 0 {
+		n = -n
+	}
+	suffix := "th"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return A(str + suffix)
+}
+
+// PadLeft returns a new Str right aligned within the given display width by padding the left
+// side with the optional pad string which defaults to a space.
+func (p *Str) PadLeft(width int, pad ...string) (new *Str) {
+	return A(padding(width-p.Width(), pad...) + p.A())
+}
+
+// PadRight returns a new Str left aligned within the given display width by padding the right
+// side with the optional pad string which defaults to a space.
+func (p *Str) PadRight(width int, pad ...string) (new *Str) {
+	return A(p.A() + padding(width-p.Width(), pad...))
+}
+
 // Pair simply returns the first and second Slice elements as Objects
 func (p *Str) Pair() (first, second *Object) {
 	first, second = &Object{}, &Object{}
@@ -889,6 +1222,27 @@ func (p *Str) Pair() (first, second *Object) {
 	return
 }
 
+// Partition returns the elements of this Slice that match the lambda selector and the elements
+// that don't as new Slices preserving element order.
+func (p *Str) Partition(sel func(O) bool) (match, rest ISlice) {
+	return partition(p, sel)
+}
+
+// Permutations returns new Slices of all ordered arrangements of n elements of this Slice e.g.
+// [1 2 3].Permutations(2) returns [[1 2] [1 3] [2 1] [2 3] [3 1] [3 2]]. Returns no Slices if n
+// is negative or larger than this Slice.
+func (p *Str) Permutations(n int) (perms []ISlice) {
+	return permutations(p, n)
+}
+
+// Pluralize returns a new Str with the last word of this Str in its English plural form e.g.
+// "box" becomes "boxes", "person" becomes "people" and "user_category" becomes "user_categories".
+// Irregular and uncountable words can be extended with AddIrregular and AddUncountable and the
+// rules with AddPluralRule. The case of the word is preserved.
+func (p *Str) Pluralize() (new *Str) {
+	return A(inflect(p.A(), true))
+}
+
 // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
 func (p *Str) Pop() (elem *Object) {
 	elem = p.Last()
@@ -911,6 +1265,13 @@ func (p *Str) Prepend(elem interface{}) ISlice {
 	return p.Insert(0, elem)
 }
 
+// Reduce combines the elements of this Slice into a single value by calling the given lambda
+// with the accumulated value and each element in turn, returning the final accumulated value.
+// The first element is used as the initial value if one isn't given.
+func (p *Str) Reduce(reducer func(acc, elem O) O, init ...interface{}) (acc *Object) {
+	return reduce(p, reducer, init)
+}
+
 // RefSlice returns true if the underlying implementation is a RefSlice
 func (p *Str) RefSlice() bool {
 	return false
@@ -944,6 +1305,55 @@ func (p *Str) ReplaceAll(old, new interface{}) *Str {
 	return ToStr(strings.ReplaceAll(str, x, y))
 }
 
+// ReplaceFunc returns a new Str with all matches of the given regex pattern replaced by the
+// return value of the lambda which is given the matched text. The pattern may be a string or
+// a compiled *regexp.Regexp. Swallows any errors returning a copy of this Str for invalid
+// patterns, see ReplaceFuncE.
+func (p *Str) ReplaceFunc(pattern interface{}, repl func(string) string) (new *Str) {
+	var err error
+	if new, err = p.ReplaceFuncE(pattern, repl); err != nil {
+		new = p.Copy().(*Str)
+	}
+	return
+}
+
+// ReplaceFuncE returns a new Str with all matches of the given regex pattern replaced by the
+// return value of the lambda and an error with an empty Str for invalid patterns. See
+// ReplaceFunc for details.
+func (p *Str) ReplaceFuncE(pattern interface{}, repl func(string) string) (new *Str, err error) {
+	new = NewStrV()
+	var re *regexp.Regexp
+	if re, err = compileRegex(pattern); err != nil || p == nil || len(*p) == 0 {
+		return
+	}
+	return ToStr(re.ReplaceAllStringFunc(p.A(), repl)), nil
+}
+
+// ReplaceRegex returns a new Str with all matches of the given regex pattern replaced by the
+// replacement string. Inside the replacement $ signs are expanded as with regexp.Expand such
+// that $1 or ${1} is the first submatch and ${name} is the named submatch. The pattern may be a
+// string or a compiled *regexp.Regexp. Swallows any errors returning a copy of this Str for
+// invalid patterns, see ReplaceRegexE.
+func (p *Str) ReplaceRegex(pattern interface{}, repl string) (new *Str) {
+	var err error
+	if new, err = p.ReplaceRegexE(pattern, repl); err != nil {
+		new = p.Copy().(*Str)
+	}
+	return
+}
+
+// ReplaceRegexE returns a new Str with all matches of the given regex pattern replaced by the
+// replacement string and an error with an empty Str for invalid patterns. See ReplaceRegex for
+// details.
+func (p *Str) ReplaceRegexE(pattern interface{}, repl string) (new *Str, err error) {
+	new = NewStrV()
+	var re *regexp.Regexp
+	if re, err = compileRegex(pattern); err != nil || p == nil || len(*p) == 0 {
+		return
+	}
+	return ToStr(re.ReplaceAllString(p.A(), repl)), nil
+}
+
 // Reverse returns a new Slice with the order of the elements reversed.
 func (p *Str) Reverse() (new ISlice) {
 	if p == nil || len(*p) < 2 {
@@ -963,11 +1373,43 @@ func (p *Str) ReverseM() ISlice {
 	return p
 }
 
+// Rotate returns a new Slice with the elements rotated n places to the left or to the right for
+// negative n such that the element at index n becomes the first element.
+func (p *Str) Rotate(n int) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().RotateM(n)
+}
+
+// RotateM modifies this Slice rotating the elements n places to the left or to the right for
+// negative n and returns a reference to this Slice. See Rotate.
+func (p *Str) RotateM(n int) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	rotateM(p, n)
+	return p
+}
+
 // S is an alias to ToStringSlice
 func (p *Str) S() (slice *StringSlice) {
 	return ToStringSlice(p.O())
 }
 
+// Sample returns a new Slice of n unique elements chosen at random from this Slice using the
+// optional random number generator e.g. rand.New(rand.NewSource(seed)) for repeatable results.
+// All elements are returned in random order if n is larger than this Slice.
+func (p *Str) Sample(n int, rng ...*rand.Rand) (new ISlice) {
+	return sample(p, n, rng)
+}
+
+// Scan returns a new Slice of the accumulated values from each step of reducing this Slice e.g.
+// running totals. The new Slice is converted into an optimized Slice type if possible. See Reduce.
+func (p *Str) Scan(reducer func(acc, elem O) O, init ...interface{}) (new ISlice) {
+	return scan(p, reducer, init)
+}
+
 // Select creates a new slice with the elements that match the lambda selector.
 func (p *Str) Select(sel func(O) bool) (new ISlice) {
 	slice := NewStrV()
@@ -1026,11 +1468,38 @@ func (p *Str) ShiftN(n int) (new ISlice) {
 	return
 }
 
+// Shuffle returns a new Slice with the elements in random order using the optional random
+// number generator e.g. rand.New(rand.NewSource(seed)) for repeatable results.
+func (p *Str) Shuffle(rng ...*rand.Rand) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().ShuffleM(rng...)
+}
+
+// ShuffleM modifies this Slice putting the elements in random order using the optional random
+// number generator and returns a reference to this Slice. See Shuffle.
+func (p *Str) ShuffleM(rng ...*rand.Rand) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	shuffleM(p, rng)
+	return p
+}
+
 // Single reports true if there is only one element in this Slice.
 func (p *Str) Single() bool {
 	return p.Len() == 1
 }
 
+// Singularize returns a new Str with the last word of this Str in its English singular form e.g.
+// "boxes" becomes "box", "people" becomes "person" and "user_categories" becomes "user_category".
+// Irregular and uncountable words can be extended with AddIrregular and AddUncountable and the
+// rules with AddSingularRule. The case of the word is preserved.
+func (p *Str) Singularize() (new *Str) {
+	return A(inflect(p.A(), false))
+}
+
 // Slice returns a range of elements from this Slice as a Slice reference to the original. Allows for negative notation.
 // Expects nothing, in which case everything is included, or two indices i and j, in which case an inclusive behavior
 // is used such that Slice(0, -1) includes index -1 as opposed to Go's exclusive behavior. Out of bounds indices will
@@ -1061,6 +1530,47 @@ func (p *Str) Sort() (new ISlice) {
 	return p.Copy().SortM()
 }
 
+// SortBy returns a new Slice with the elements sorted in ascending order by the keys the given
+// lambda selects from them compared with Compare. The sort is stable and the lambda is called
+// once per element.
+func (p *Str) SortBy(key func(O) O) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByM(key)
+}
+
+// SortByDesc returns a new Slice with the elements sorted in descending order by the keys the
+// given lambda selects from them compared with Compare. The sort is stable.
+func (p *Str) SortByDesc(key func(O) O) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByDescM(key)
+}
+
+// SortByDescM modifies this Slice sorting the elements in descending order by the keys the
+// given lambda selects from them compared with Compare and returns a reference to this Slice.
+// The sort is stable.
+func (p *Str) SortByDescM(key func(O) O) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return key((*p)[i]) }, func(a, b O) int { return Compare(b, a) })
+	return p
+}
+
+// SortByM modifies this Slice sorting the elements in ascending order by the keys the given
+// lambda selects from them compared with Compare and returns a reference to this Slice. The sort
+// is stable and the lambda is called once per element.
+func (p *Str) SortByM(key func(O) O) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return key((*p)[i]) }, Compare)
+	return p
+}
+
 // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
 func (p *Str) SortM() ISlice {
 	if p == nil || len(*p) < 2 {
@@ -1087,6 +1597,26 @@ func (p *Str) SortReverseM() ISlice {
 	return p
 }
 
+// SortWith returns a new Slice with the elements sorted according to the given comparison which
+// returns a negative number when a sorts before b, zero when equal and a positive number when a
+// sorts after b e.g. CompareNatural or an Ordering's Compare. The sort is stable.
+func (p *Str) SortWith(cmp func(a, b O) int) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortWithM(cmp)
+}
+
+// SortWithM modifies this Slice sorting the elements according to the given comparison and
+// returns a reference to this Slice. See SortWith. The sort is stable.
+func (p *Str) SortWithM(cmp func(a, b O) int) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sortM(p, func(i int) O { return (*p)[i] }, cmp)
+	return p
+}
+
 // Split this Str into all substrings delimited by separator and returns a slice of the
 // substrings. If Str does not contain separator, Split returns a slice of length 1 whose
 // only element is Str. If Str is empty, Split returns an empty slice. separator defaults
@@ -1154,61 +1684,47 @@ func (p *Str) SplitEscape(separator string, escape ...string) (slice *StringSlic
 // SplitQuotes splits this Str into substrings starting and ending with double quotes and
 // returns a slice of the substrings. If Str does not contain quotes, Split returns
 // a slice of length 1 whose only element is Str. If Str is empty, Split returns an empty
-// slice. Unmatched quotes throw and error and empty quotes are removed.
+// slice. Unmatched quotes throw and error and empty quotes are removed. Quoting follows the POSIX
+// shell rules for double quotes and backslash escapes using shell.Segments while keeping the
+// quotes, escapes and whitespace as is in the substrings for KeysFromSelector.
 func (p *Str) SplitQuotes() (slice *StringSlice, err error) {
-	sep := '"'
 	if p == nil || len(*p) == 0 {
 		slice = NewStringSliceV()
 		return
 	}
 
-	// Loop through and create slice
-	var quote bool
-	piece := []rune{}
-	pieces := []string{}
-	for _, r := range *p {
-		if r == sep {
-
-			// Complete current
-			if len(piece) > 0 {
-				if quote {
-					piece = append(piece, r)
-				}
-				if !ToStr(piece).Equal([]rune{sep, sep}) {
-					pieces = append(pieces, string(piece))
-				}
-				piece = []rune{}
-			}
-
-			// Start new quote
-			if !quote {
-				piece = append(piece, r)
-				quote = true
-			} else {
-				quote = false
-			}
-			continue
-		}
-		piece = append(piece, r)
-	}
-
-	// Ensure we capture the last piece
-	if len(piece) > 0 && !ToStr(piece).Equal([]rune{sep, sep}) {
-		pieces = append(pieces, string(piece))
+	var pieces []string
+	if pieces, err = shell.Segments(string(*p)); err != nil {
+		err = errors.Wrap(err, "imbalanced quotes")
 	}
 	slice = ToStringSlice(pieces)
+	return
+}
 
-	// Check for unbalanced quotes and remove empty quotes
-	if slice.AnyW(func(x O) bool {
-		cnt := strings.Count(x.(string), string(sep))
-		return ExB(cnt != 0 && cnt != 2)
-	}) {
-		err = errors.Errorf("imbalanced quotes")
+// SplitRegex splits this Str into all substrings delimited by matches of the given regex
+// pattern and returns a slice of the substrings. The pattern may be a string or a compiled
+// *regexp.Regexp. If Str is empty an empty slice is returned. Swallows any errors returning a
+// slice containing only this Str for invalid patterns, see SplitRegexE.
+func (p *Str) SplitRegex(pattern interface{}) (slice *StringSlice) {
+	var err error
+	if slice, err = p.SplitRegexE(pattern); err != nil && p != nil && len(*p) > 0 {
+		slice = NewStringSliceV(p.A())
 	}
-
 	return
 }
 
+// SplitRegexE splits this Str into all substrings delimited by matches of the given regex
+// pattern and returns a slice of the substrings and an error with an empty slice for invalid
+// patterns. See SplitRegex for details.
+func (p *Str) SplitRegexE(pattern interface{}) (slice *StringSlice, err error) {
+	slice = NewStringSliceV()
+	var re *regexp.Regexp
+	if re, err = compileRegex(pattern); err != nil || p == nil || len(*p) == 0 {
+		return
+	}
+	return ToStringSlice(re.Split(p.A(), -1)), nil
+}
+
 // String returns a string representation of this Slice, implements the Stringer interface
 func (p *Str) String() string {
 	if p == nil {
@@ -1261,6 +1777,21 @@ func (p *Str) TakeW(sel func(O) bool) (new ISlice) {
 	return slice
 }
 
+// ToCamel returns a new Str converted to camelCase e.g. "http_server" and "HTTPServer" both
+// become "httpServer". Words are split on case changes, acronyms, digits and any non
+// alphanumeric characters. See Words for details.
+func (p *Str) ToCamel() (new *Str) {
+	words := p.Words()
+	for i := range words {
+		if i == 0 {
+			words[i] = strings.ToLower(words[i])
+		} else {
+			words[i] = capitalize(words[i])
+		}
+	}
+	return A(strings.Join(words, ""))
+}
+
 // ToInts converts the underlying slice into a []int
 func (p *Str) ToInts() (slice []int) {
 	return ToIntSlice(p.O()).G()
@@ -1296,6 +1827,12 @@ func (p *Str) Title() (new *Str) {
 	return NewStr(strings.Title(p.A()))
 }
 
+// ToKebab returns a new Str converted to kebab-case e.g. "HTTPServer" becomes "http-server".
+// Words are split on case changes, acronyms, digits and any non alphanumeric characters.
+func (p *Str) ToKebab() (new *Str) {
+	return A(strings.ToLower(strings.Join(p.Words(), "-")))
+}
+
 // ToLower returns a copy of the Str with all Unicode letters mapped to their lower case.
 func (p *Str) ToLower() (new *Str) {
 	if p == nil {
@@ -1304,6 +1841,42 @@ func (p *Str) ToLower() (new *Str) {
 	return NewStr(strings.ToLower(p.A()))
 }
 
+// ToPascal returns a new Str converted to PascalCase e.g. "http_server" and "HTTPServer" both
+// become "HttpServer". Words are split on case changes, acronyms, digits and any non
+// alphanumeric characters.
+func (p *Str) ToPascal() (new *Str) {
+	words := p.Words()
+	for i := range words {
+		words[i] = capitalize(words[i])
+	}
+	return A(strings.Join(words, ""))
+}
+
+// ToScreamingSnake returns a new Str converted to SCREAMING_SNAKE_CASE e.g. "HTTPServer"
+// becomes "HTTP_SERVER" which is commonly used for environment variables and constants.
+func (p *Str) ToScreamingSnake() (new *Str) {
+	return A(strings.ToUpper(strings.Join(p.Words(), "_")))
+}
+
+// ToSnake returns a new Str converted to snake_case e.g. "HTTPServer" becomes "http_server".
+// Words are split on case changes, acronyms, digits and any non alphanumeric characters.
+func (p *Str) ToSnake() (new *Str) {
+	return A(strings.ToLower(strings.Join(p.Words(), "_")))
+}
+
+// ToTitleWords returns a new Str converted to space separated title cased words e.g.
+// "http_server_url" becomes "Http Server Url". Acronyms already in upper case are kept as is
+// such that "HTTPServer" becomes "HTTP Server".
+func (p *Str) ToTitleWords() (new *Str) {
+	words := p.Words()
+	for i := range words {
+		if !isAcronym(words[i]) {
+			words[i] = capitalize(words[i])
+		}
+	}
+	return A(strings.Join(words, " "))
+}
+
 // ToUpper returns a copy of the Str with all Unicode letters mapped to their upper case.
 func (p *Str) ToUpper() (new *Str) {
 	if p == nil {
@@ -1395,6 +1968,40 @@ func (p *Str) TrimSuffix(suffix interface{}) (new *Str) {
 	return NewStr(strings.TrimSuffix(p.A(), x))
 }
 
+// Truncate returns a new Str that fits within the given display width. If this Str is wider
+// than the width it is cut at a grapheme cluster boundary and the optional ellipsis, which
+// defaults to "...", is appended such that the result including the ellipsis fits the width.
+// A negative width is treated as zero.
+func (p *Str) Truncate(width int, ellipsis ...string) (new *Str) {
+	if width < 0 {
+		width = 0
+	}
+	if p.Width() <= width {
+		return A(p.A())
+	}
+	e := "..."
+	if len(ellipsis) > 0 {
+		e = ellipsis[0]
+	}
+	if uni.Width(e) > width {
+		return A(e).Truncate(width, "")
+	}
+
+	// Take as many clusters as will fit with the ellipsis
+	var b strings.Builder
+	remaining := width - uni.Width(e)
+	for _, cluster := range uni.Graphemes(p.A()) {
+		w := uni.GraphemeWidth(cluster)
+		if w > remaining {
+			break
+		}
+		b.WriteString(cluster)
+		remaining -= w
+	}
+	b.WriteString(e)
+	return A(b.String())
+}
+
 // Union returns a new Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
 // Supports Str, *Str, []string or *[]string
 func (p *Str) Union(slice interface{}) (new ISlice) {
@@ -1440,3 +2047,432 @@ func (p *Str) UniqM() ISlice {
 	}
 	return p
 }
+
+// UnmarshalJSON implements the json.Unmarshaler interface decoding a json string into this Str.
+func (p *Str) UnmarshalJSON(data []byte) (err error) {
+	var x string
+	if err = json.Unmarshal(data, &x); err != nil {
+		return
+	}
+	*p = Str(x)
+	return
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface decoding a msgpack string into this Str.
+func (p *Str) UnmarshalMsgpack(data []byte) (err error) {
+	var x string
+	if err = msgpack.Unmarshal(data, &x); err != nil {
+		return
+	}
+	*p = Str(x)
+	return
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface decoding a yaml string into this Str.
+func (p *Str) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	var x string
+	if err = unmarshal(&x); err != nil {
+		return
+	}
+	*p = Str(x)
+	return
+}
+
+// Width returns the number of terminal display columns this Str occupies. Each extended grapheme
+// cluster is measured as a whole using its East Asian Width such that wide CJK characters and
+// emoji occupy two columns while combining marks, zero width joiners and controls occupy none.
+func (p *Str) Width() int {
+	if p == nil {
+		return 0
+	}
+	return uni.Width(p.A())
+}
+
+// Window returns new Slices of n consecutive elements of this Slice starting every step elements
+// e.g. [1 2 3 4].Window(2, 1) returns [[1 2] [2 3] [3 4]]. Only full windows are returned and no
+// Slices are returned if n or step are not positive.
+func (p *Str) Window(n, step int) (windows []ISlice) {
+	return window(p, n, step)
+}
+
+// Words splits this Str into the words of an identifier for case conversion. Words are split on
+// any non alphanumeric characters, a lower case letter followed by an upper case letter, the
+// last letter of an acronym followed by a word e.g. "HTTPServer" becomes "HTTP", "Server" and
+// an upper case letter following digits e.g. "Base64Encode" becomes "Base64", "Encode".
+// Digits stay attached to the word preceding them.
+func (p *Str) Words() (words []string) {
+	words = []string{}
+	runes := []rune(p.A())
+	start := -1
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start != -1 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start == -1 {
+			start = i
+			continue
+		}
+
+		// Split on case changes
+		prev := runes[i-1]
+		if unicode.IsUpper(r) {
+			next := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && next) {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+		}
+	}
+	if start != -1 {
+		words = append(words, string(runes[start:]))
+	}
+	return
+}
+
+// Wrap returns a new Str with lines wrapped to fit within the given display width. Lines are
+// broken at spaces where possible with words wider than the width broken at grapheme cluster
+// boundaries. Existing line breaks are preserved and runs of spaces at wrap points are dropped.
+func (p *Str) Wrap(width int) (new *Str) {
+	if p == nil || width <= 0 {
+		return A(p.A())
+	}
+	lines := []string{}
+	for _, line := range strings.Split(p.A(), "\n") {
+		lines = append(lines, wrapLine(line, width)...)
+	}
+	return A(strings.Join(lines, "\n"))
+}
+
+// wrapLine wraps the single line of text to the given display width
+func wrapLine(line string, width int) (lines []string) {
+	var cur strings.Builder
+	curWidth := 0
+	flush := func() {
+		lines = append(lines, cur.String())
+		cur.Reset()
+		curWidth = 0
+	}
+
+	words := strings.FieldsFunc(line, func(r rune) bool { return r == ' ' })
+	if len(words) == 0 {
+		return []string{line}
+	}
+	for _, word := range words {
+		w := uni.Width(word)
+
+		// Word fits on the current line
+		sep := 0
+		if curWidth > 0 {
+			sep = 1
+		}
+		if curWidth+sep+w <= width {
+			if sep == 1 {
+				cur.WriteByte(' ')
+			}
+			cur.WriteString(word)
+			curWidth += sep + w
+			continue
+		}
+
+		// Start a new line and break the word if it is too wide on its own
+		if curWidth > 0 {
+			flush()
+		}
+		for _, cluster := range uni.Graphemes(word) {
+			cw := uni.GraphemeWidth(cluster)
+			if curWidth+cw > width && curWidth > 0 {
+				flush()
+			}
+			cur.WriteString(cluster)
+			curWidth += cw
+		}
+	}
+	flush()
+	return
+}
+
+// padding returns a string of the given display width made by repeating the pad string's
+// grapheme clusters and filling any remaining columns with spaces
+func padding(width int, pad ...string) string {
+	if width <= 0 {
+		return ""
+	}
+	fill := " "
+	if len(pad) > 0 && pad[0] != "" {
+		fill = pad[0]
+	}
+	clusters := uni.Graphemes(fill)
+
+	var b strings.Builder
+	for i := 0; width > 0; i++ {
+		cluster := clusters[i%len(clusters)]
+		w := uni.GraphemeWidth(cluster)
+		if w == 0 || w > width {
+			b.WriteString(strings.Repeat(" ", width))
+			break
+		}
+		b.WriteString(cluster)
+		width -= w
+	}
+	return b.String()
+}
+
+// capitalize returns the word with the first letter upper case and the rest lower case
+func capitalize(word string) string {
+	runes := []rune(strings.ToLower(word))
+	if len(runes) > 0 {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+	return string(runes)
+}
+
+// isAcronym tests if the word is more than one letter and all upper case
+func isAcronym(word string) bool {
+	letters := 0
+	for _, r := range word {
+		if unicode.IsLower(r) {
+			return false
+		}
+		if unicode.IsLetter(r) {
+			letters++
+		}
+	}
+	return letters > 1
+}
+
+// Inflection
+//--------------------------------------------------------------------------------------------------
+
+// inflectionRule is a regex and replacement used to inflect a word
+type inflectionRule struct {
+	re   *regexp.Regexp
+	repl string
+}
+
+var (
+	inflectionsLock sync.RWMutex
+	pluralRules     []inflectionRule
+	singularRules   []inflectionRule
+	irregulars      = map[string]string{} // singular to plural
+	irregularsRev   = map[string]string{} // plural to singular
+	uncountables    = map[string]bool{}
+)
+
+func init() {
+	for _, rule := range [][2]string{
+		{`$`, `s`},
+		{`s$`, `s`},
+		{`^(ax|test)is$`, `${1}es`},
+		{`(octop|vir)us$`, `${1}i`},
+		{`(octop|vir)i$`, `${1}i`},
+		{`(alias|status|campus)$`, `${1}es`},
+		{`(bu)s$`, `${1}ses`},
+		{`(buffal|tomat|potat|her|ech)o$`, `${1}oes`},
+		{`([ti])um$`, `${1}a`},
+		{`([ti])a$`, `${1}a`},
+		{`sis$`, `ses`},
+		{`(?:([^f])fe|([lr])f)$`, `${1}${2}ves`},
+		{`(hive)$`, `${1}s`},
+		{`([^aeiouy]|qu)y$`, `${1}ies`},
+		{`(x|ch|ss|sh|z)$`, `${1}es`},
+		{`(matr|vert|ind)(?:ix|ex)$`, `${1}ices`},
+		{`^(m|l)ouse$`, `${1}ice`},
+		{`^(m|l)ice$`, `${1}ice`},
+		{`^(ox)$`, `${1}en`},
+		{`^(oxen)$`, `${1}`},
+		{`(quiz)$`, `${1}zes`},
+	} {
+		AddPluralRule(rule[0], rule[1])
+	}
+	for _, rule := range [][2]string{
+		{`s$`, ``},
+		{`(ss)$`, `${1}`},
+		{`(n)ews$`, `${1}ews`},
+		{`([ti])a$`, `${1}um`},
+		{`((a)naly|(b)a|(d)iagno|(p)arenthe|(p)rogno|(s)ynop|(t)he)(sis|ses)$`, `${1}sis`},
+		{`(^analy)(sis|ses)$`, `${1}sis`},
+		{`([^f])ves$`, `${1}fe`},
+		{`(hive)s$`, `${1}`},
+		{`(tive)s$`, `${1}`},
+		{`([lr])ves$`, `${1}f`},
+		{`([^aeiouy]|qu)ies$`, `${1}y`},
+		{`(s)eries$`, `${1}eries`},
+		{`(m)ovies$`, `${1}ovie`},
+		{`(x|ch|ss|sh|z)es$`, `${1}`},
+		{`^(m|l)ice$`, `${1}ouse`},
+		{`(bus|campus)(es)?$`, `${1}`},
+		{`(o)es$`, `${1}`},
+		{`(shoe)s$`, `${1}`},
+		{`(cris|test)(is|es)$`, `${1}is`},
+		{`^(a)x[ie]s$`, `${1}xis`},
+		{`(octop|vir)(us|i)$`, `${1}us`},
+		{`(alias|status)(es)?$`, `${1}`},
+		{`^(ox)en`, `${1}`},
+		{`(vert|ind)ices$`, `${1}ex`},
+		{`(matr)ices$`, `${1}ix`},
+		{`(quiz)zes$`, `${1}`},
+		{`(database)s$`, `${1}`},
+	} {
+		AddSingularRule(rule[0], rule[1])
+	}
+	for _, x := range [][2]string{
+		{"person", "people"}, {"man", "men"}, {"woman", "women"}, {"child", "children"},
+		{"tooth", "teeth"}, {"foot", "feet"}, {"goose", "geese"}, {"sex", "sexes"},
+		{"move", "moves"}, {"zombie", "zombies"}, {"cactus", "cacti"}, {"die", "dice"},
+		{"datum", "data"},
+	} {
+		AddIrregular(x[0], x[1])
+	}
+	AddUncountable("equipment", "information", "rice", "money", "species", "series", "fish",
+		"sheep", "jeans", "police", "news", "deer", "metadata", "software", "feedback")
+}
+
+// AddIrregular adds the given irregular singular and plural word pair to the inflection tables
+// used by Str.Pluralize and Str.Singularize taking precedence over the rules.
+func AddIrregular(singular, plural string) {
+	inflectionsLock.Lock()
+	defer inflectionsLock.Unlock()
+	irregulars[strings.ToLower(singular)] = strings.ToLower(plural)
+	irregularsRev[strings.ToLower(plural)] = strings.ToLower(singular)
+}
+
+// AddUncountable adds the given words to the inflection tables as words that have the same
+// singular and plural form e.g. "sheep" such that they are left as is.
+func AddUncountable(words ...string) {
+	inflectionsLock.Lock()
+	defer inflectionsLock.Unlock()
+	for _, word := range words {
+		uncountables[strings.ToLower(word)] = true
+	}
+}
+
+// AddPluralRule adds a case insensitive regex rule used by Str.Pluralize. Rules added later take
+// precedence over those added earlier. The replacement supports regexp expansion e.g. ${1}es.
+func AddPluralRule(pattern, replacement string) (err error) {
+	return addInflectionRule(&pluralRules, pattern, replacement)
+}
+
+// AddSingularRule adds a case insensitive regex rule used by Str.Singularize. Rules added later
+// take precedence over those added earlier. The replacement supports regexp expansion e.g. ${1}.
+func AddSingularRule(pattern, replacement string) (err error) {
+	return addInflectionRule(&singularRules, pattern, replacement)
+}
+
+// addInflectionRule compiles and prepends the rule to the given rules
+func addInflectionRule(rules *[]inflectionRule, pattern, replacement string) (err error) {
+	var re *regexp.Regexp
+	if re, err = regexp.Compile("(?i)" + pattern); err != nil {
+		err = errors.Wrapf(err, "failed to compile inflection rule %s", pattern)
+		return
+	}
+	inflectionsLock.Lock()
+	defer inflectionsLock.Unlock()
+	*rules = append([]inflectionRule{{re: re, repl: replacement}}, *rules...)
+	return
+}
+
+// inflect converts the last word of the given string to its plural or singular form
+func inflect(str string, plural bool) string {
+
+	// Split off the last word to inflect
+	runes := []rune(str)
+	i := len(runes)
+	for i > 0 && unicode.IsLetter(runes[i-1]) {
+		i--
+		if unicode.IsUpper(runes[i]) && i > 0 && unicode.IsLower(runes[i-1]) {
+			break
+		}
+	}
+	prefix, word := string(runes[:i]), string(runes[i:])
+	if word == "" {
+		return str
+	}
+	lower := strings.ToLower(word)
+
+	inflectionsLock.RLock()
+	defer inflectionsLock.RUnlock()
+	result := ""
+	if uncountables[lower] {
+		return str
+	}
+	table, rules := irregularsRev, singularRules
+	if plural {
+		table, rules = irregulars, pluralRules
+	}
+	if x, ok := table[lower]; ok {
+		result = x
+	} else {
+		result = lower
+		for _, rule := range rules {
+			if rule.re.MatchString(lower) {
+				result = rule.re.ReplaceAllString(lower, rule.repl)
+				break
+			}
+		}
+	}
+
+	// Restore the original case
+	switch {
+	case isAcronym(word):
+		result = strings.ToUpper(result)
+	case unicode.IsUpper([]rune(word)[0]):
+		result = capitalize(result)
+	}
+	return prefix + result
+}
+
+// Regex
+//--------------------------------------------------------------------------------------------------
+
+// regexCacheSize is the maximum number of compiled patterns kept in the regex cache
+const regexCacheSize = 256
+
+var (
+	regexCacheLock sync.RWMutex
+	regexCache     = map[string]*regexp.Regexp{}
+)
+
+// compileRegex returns the compiled regex for the given pattern which may be a string, Str or
+// *regexp.Regexp. String patterns are cached so that repeated calls don't recompile them.
+func compileRegex(pattern interface{}) (re *regexp.Regexp, err error) {
+	var str string
+	switch x := pattern.(type) {
+	case *regexp.Regexp:
+		if x == nil {
+			err = errors.New("regex pattern is nil")
+		}
+		return x, err
+	default:
+		str = ToString(pattern)
+	}
+
+	regexCacheLock.RLock()
+	re = regexCache[str]
+	regexCacheLock.RUnlock()
+	if re != nil {
+		return
+	}
+
+	if re, err = regexp.Compile(str); err != nil {
+		err = errors.Wrapf(err, "failed to compile regex pattern %s", str)
+		return
+	}
+	regexCacheLock.Lock()
+	if len(regexCache) >= regexCacheSize {
+		regexCache = map[string]*regexp.Regexp{}
+	}
+	regexCache[str] = re
+	regexCacheLock.Unlock()
+	return
+}
+
+// Zip returns new tuple Slices of the elements at the same index in this Slice and each of the
+// given slices e.g. [1 2].Zip([a b]) returns [[1 a] [2 b]]. Stops at the end of the shortest
+// slice. Tuples are InterSlices as the slices may be of different types.
+func (p *Str) Zip(slices ...interface{}) (tuples []ISlice) {
+	return zip(p, slices)
+}
@@ -2,9 +2,14 @@ package n
 
 import (
 	"fmt"
+	"math/rand"
+	"regexp"
 	"strings"
 	"testing"
+	"unicode"
 
+	"github.com/phR0ze/n/pkg/enc/json"
+	yaml "github.com/phR0ze/yaml/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -770,6 +775,169 @@ func TestStr_B(t *testing.T) {
 	}
 }
 
+// BinarySearch
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_BinarySearch() {
+	slice := NewStrV("ace")
+	fmt.Println(slice.BinarySearch('c'))
+	// Output: 1 true
+}
+
+func TestStr_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		i, found := slice.BinarySearch('c')
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = NewStrV().BinarySearch('c')
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		slice := NewStrV("ace")
+		i, found := slice.BinarySearch("a")
+		assert.Equal(t, 0, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearch('c')
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+	}
+
+	// not found returns the insertion index
+	{
+		slice := NewStrV("ace")
+		i, found := slice.BinarySearch("d")
+		assert.Equal(t, 2, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch("f")
+		assert.Equal(t, 3, i)
+		assert.False(t, found)
+	}
+}
+
+// BinarySearchWith
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_BinarySearchWith() {
+	slice := NewStrV("eca")
+	fmt.Println(slice.BinarySearchWith('c', func(a, b O) int { return Compare(b, a) }))
+	// Output: 1 true
+}
+
+func TestStr_BinarySearchWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		i, found := slice.BinarySearchWith('c', Compare)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// descending
+	{
+		slice := NewStrV("eca")
+		i, found := slice.BinarySearchWith('c', func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+		i, found = slice.BinarySearchWith("d", func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 1, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearchWith("f", func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// Cartesian
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Cartesian() {
+	slice := NewStrV("ab")
+	fmt.Println(slice.Cartesian([]string{"x", "y"}))
+	// Output: [[a x] [a y] [b x] [b y]]
+}
+
+func TestStr_Cartesian(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, []ISlice{}, slice.Cartesian([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewStrV("").Cartesian([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewStrV("a").Cartesian([]string{}))
+	}
+
+	// no slices
+	assert.Equal(t, []ISlice{NewInterSliceV(NewChar('a')), NewInterSliceV(NewChar('b'))}, NewStrV("ab").Cartesian())
+
+	// multiple slices
+	{
+		tuples := NewStrV("ab").Cartesian([]string{"x", "y"}, NewIntSliceV(7))
+		assert.Equal(t, []ISlice{NewInterSliceV(NewChar('a'), "x", 7), NewInterSliceV(NewChar('a'), "y", 7),
+			NewInterSliceV(NewChar('b'), "x", 7), NewInterSliceV(NewChar('b'), "y", 7)}, tuples)
+	}
+}
+
+// Center
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Center() {
+	fmt.Printf("[%s]", A("日本").Center(8, "-"))
+	// Output: [--日本--]
+}
+
+func TestStr_Center(t *testing.T) {
+	assert.Equal(t, "  abc  ", A("abc").Center(7).A())
+	assert.Equal(t, " abc  ", A("abc").Center(6).A())
+	assert.Equal(t, "abc", A("abc").Center(2).A())
+	assert.Equal(t, " 日本 ", A("日本").Center(6).A())
+	assert.Equal(t, "   ", (*Str)(nil).Center(3).A())
+
+	// wide pad strings that don't fit are filled with spaces
+	assert.Equal(t, "日 a日 ", A("a").Center(7, "日").A())
+}
+
+// Chunk
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Chunk() {
+	slice := NewStrV("abcde")
+	fmt.Println(slice.Chunk(2))
+	// Output: [ab cd e]
+}
+
+func TestStr_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, []ISlice{}, slice.Chunk(2))
+		assert.Equal(t, []ISlice{}, NewStrV("").Chunk(2))
+	}
+
+	// invalid size
+	assert.Equal(t, []ISlice{}, NewStrV("ab").Chunk(0))
+	assert.Equal(t, []ISlice{}, NewStrV("ab").Chunk(-1))
+
+	// chunks
+	{
+		slice := NewStrV("abcde")
+		assert.Equal(t, []ISlice{NewStrV("ab"), NewStrV("cd"), NewStrV("e")}, slice.Chunk(2))
+		assert.Equal(t, []ISlice{NewStrV("abcde")}, slice.Chunk(5))
+		assert.Equal(t, []ISlice{NewStrV("abcde")}, slice.Chunk(10))
+		assert.Equal(t, []ISlice{NewStrV("a"), NewStrV("b"), NewStrV("c"), NewStrV("d"), NewStrV("e")}, slice.Chunk(1))
+	}
+
+	// chunks are copies
+	{
+		slice := NewStrV("ab")
+		chunks := slice.Chunk(1)
+		chunks[0].Set(0, 'c')
+		assert.Equal(t, NewStrV("ab"), slice)
+	}
+}
+
 // Clear
 // --------------------------------------------------------------------------------------------------
 func ExampleStr_Clear() {
@@ -798,6 +966,39 @@ func TestStr_Clear(t *testing.T) {
 	}
 }
 
+// Combinations
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Combinations() {
+	slice := NewStrV("abc")
+	fmt.Println(slice.Combinations(2))
+	// Output: [ab ac bc]
+}
+
+func TestStr_Combinations(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, []ISlice{}, slice.Combinations(1))
+		assert.Equal(t, []ISlice{}, NewStrV("").Combinations(1))
+	}
+
+	// out of range
+	assert.Equal(t, []ISlice{}, NewStrV("ab").Combinations(-1))
+	assert.Equal(t, []ISlice{}, NewStrV("ab").Combinations(3))
+
+	// combinations
+	{
+		slice := NewStrV("abcd")
+		assert.Equal(t, 1, len(slice.Combinations(0)))
+		assert.Equal(t, 0, slice.Combinations(0)[0].Len())
+		assert.Equal(t, []ISlice{NewStrV("a"), NewStrV("b"), NewStrV("c"), NewStrV("d")}, slice.Combinations(1))
+		assert.Equal(t, []ISlice{NewStrV("ab"), NewStrV("ac"), NewStrV("ad"), NewStrV("bc"), NewStrV("bd"), NewStrV("cd")}, slice.Combinations(2))
+		assert.Equal(t, []ISlice{NewStrV("abc"), NewStrV("abd"), NewStrV("acd"), NewStrV("bcd")}, slice.Combinations(3))
+		assert.Equal(t, []ISlice{NewStrV("abcd")}, slice.Combinations(4))
+	}
+}
+
 // Concat
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_Concat_Go(t *testing.B) {
@@ -1215,6 +1416,77 @@ func TestStr_CountW(t *testing.T) {
 	assert.Equal(t, 1, NewStrV("1", "2", "3").CountW(func(x O) bool { return ExB(x.(Char) == '4' || x.(Char) == '3') }))
 }
 
+// DamerauLevenshtein
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_DamerauLevenshtein() {
+	fmt.Println(A("stauts").DamerauLevenshtein("status"))
+	// Output: 1
+}
+
+func TestStr_DamerauLevenshtein(t *testing.T) {
+	assert.Equal(t, 0, (*Str)(nil).DamerauLevenshtein(""))
+	assert.Equal(t, 3, (*Str)(nil).DamerauLevenshtein("abc"))
+	assert.Equal(t, 1, A("ab").DamerauLevenshtein("ba"))
+	assert.Equal(t, 1, A("ab").DamerauLevenshtein(A("ba")))
+	assert.Equal(t, 3, A("kitten").DamerauLevenshtein("sitting"))
+}
+
+// DeepCopy
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_DeepCopy() {
+	slice := NewStrV('1', '2', '3')
+	fmt.Println(slice.DeepCopy())
+	// Output: 123
+}
+
+func TestStr_DeepCopy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, NewStrV(), slice.DeepCopy())
+	}
+
+	// copies are independent of the original
+	{
+		slice := NewStrV('1', '2', '3')
+		copy := slice.DeepCopy()
+		assert.Equal(t, slice, copy)
+		copy.Set(0, '3')
+		assert.Equal(t, NewStrV('1', '2', '3'), slice)
+	}
+}
+
+// DeepEqual
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_DeepEqual() {
+	slice := NewStrV('1', '2', '3')
+	fmt.Println(slice.DeepEqual([]rune{'3', '2', '1'}, IgnoreOrderOpt(true)))
+	// Output: true
+}
+
+func TestStr_DeepEqual(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.True(t, slice.DeepEqual(nil))
+		assert.False(t, slice.DeepEqual(NewStrV('1')))
+		assert.True(t, NewStrV().DeepEqual([]rune{}))
+	}
+
+	// compare with options
+	{
+		slice := NewStrV('1', '2', '3')
+		assert.True(t, slice.DeepEqual(NewStrV('1', '2', '3')))
+		assert.True(t, slice.DeepEqual([]rune{'1', '2', '3'}))
+		assert.False(t, slice.DeepEqual([]rune{'3', '2', '1'}))
+		assert.True(t, slice.DeepEqual([]rune{'3', '2', '1'}, IgnoreOrderOpt(true)))
+		assert.False(t, slice.DeepEqual([]rune{'1', '2', '1'}))
+		assert.True(t, slice.DeepEqual([]rune{'1', '2', '1'}, IgnorePathsOpt("[2]")))
+	}
+}
+
 // Drop
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_Drop_Go(t *testing.B) {
@@ -1989,6 +2261,36 @@ func TestStr_EachRIE(t *testing.T) {
 	}
 }
 
+// EachSlice
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_EachSlice() {
+	slice := NewStrV("abcde")
+	slice.EachSlice(2, func(x ISlice) {
+		fmt.Print(x)
+	})
+	// Output: abcde
+}
+
+func TestStr_EachSlice(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		called := false
+		slice.EachSlice(2, func(x ISlice) { called = true })
+		NewStrV("").EachSlice(2, func(x ISlice) { called = true })
+		assert.False(t, called)
+	}
+
+	// slices
+	{
+		slices := []ISlice{}
+		slice := NewStrV("abcde")
+		assert.Equal(t, NewStrV("abcde"), slice.EachSlice(2, func(x ISlice) { slices = append(slices, x) }))
+		assert.Equal(t, []ISlice{NewStrV("ab"), NewStrV("cd"), NewStrV("e")}, slices)
+	}
+}
+
 // Empty
 // --------------------------------------------------------------------------------------------------
 func ExampleStr_Empty() {
@@ -2091,6 +2393,98 @@ func TestStr_FieldsW(t *testing.T) {
 	assert.Equal(t, []string{"1", "2", "3"}, NewStr("1.2.3").FieldsW(func(x rune) bool { return ExB(x == '.') }).O())
 }
 
+// FindAll
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_FindAll() {
+	fmt.Println(A("a1b22c333").FindAll(`\d+`))
+	// Output: [1 22 333]
+}
+
+func TestStr_FindAll(t *testing.T) {
+
+	// nil or empty
+	{
+		assert.Equal(t, []string{}, (*Str)(nil).FindAll(`\d+`).G())
+		assert.Equal(t, []string{}, A("").FindAll(`\d+`).G())
+	}
+
+	// no matches
+	{
+		assert.Equal(t, []string{}, A("abc").FindAll(`\d+`).G())
+	}
+
+	// string and compiled patterns
+	{
+		assert.Equal(t, []string{"1", "22"}, A("a1b22").FindAll(`\d+`).G())
+		assert.Equal(t, []string{"1", "22"}, A("a1b22").FindAll(regexp.MustCompile(`\d+`)).G())
+	}
+
+	// invalid pattern
+	{
+		assert.Equal(t, []string{}, A("a1b22").FindAll(`(`).G())
+	}
+}
+
+func TestStr_FindAllE(t *testing.T) {
+	slice, err := A("a1b22").FindAllE(`\d+`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "22"}, slice.G())
+	slice, err = (*Str)(nil).FindAllE(`\d+`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, slice.G())
+
+	// invalid pattern
+	slice, err = A("a1b22").FindAllE(`(`)
+	assert.Equal(t, "failed to compile regex pattern (: error parsing regexp: missing closing ): `(`", err.Error())
+	assert.Equal(t, []string{}, slice.G())
+}
+
+// FindAllSubmatch
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_FindAllSubmatch() {
+	matches := A("a=1 b=2").FindAllSubmatch(`(?P<key>\w+)=(?P<val>\w+)`)
+	fmt.Println(matches.At(1).ToStringMap().Get("key"), matches.At(1).ToStringMap().Get("val"))
+	// Output: b 2
+}
+
+func TestStr_FindAllSubmatch(t *testing.T) {
+
+	// nil or empty
+	{
+		assert.Equal(t, 0, (*Str)(nil).FindAllSubmatch(`\w`).Len())
+		assert.Equal(t, 0, A("").FindAllSubmatch(`\w`).Len())
+		assert.Equal(t, 0, A("abc").FindAllSubmatch(`(`).Len())
+	}
+
+	// named and unnamed captures
+	{
+		matches := A("a=1, b=2").FindAllSubmatch(`(?P<key>\w+)=(\w+)`)
+		assert.Equal(t, 2, matches.Len())
+		assert.Equal(t, &StringMap{{Key: "0", Value: "a=1"}, {Key: "key", Value: "a"}, {Key: "2", Value: "1"}},
+			matches.At(0).ToStringMap())
+		assert.Equal(t, &StringMap{{Key: "0", Value: "b=2"}, {Key: "key", Value: "b"}, {Key: "2", Value: "2"}},
+			matches.At(1).ToStringMap())
+	}
+
+	// optional captures that didn't participate
+	{
+		matches := A("a").FindAllSubmatch(`(?P<x>a)(?P<y>b)?`)
+		assert.Equal(t, "", matches.At(0).ToStringMap().Get("y").A())
+	}
+}
+
+func TestStr_FindAllSubmatchE(t *testing.T) {
+	matches, err := A("a=1").FindAllSubmatchE(`(?P<key>\w+)=(\w+)`)
+	assert.Nil(t, err)
+	assert.Equal(t, &StringMap{{Key: "0", Value: "a=1"}, {Key: "key", Value: "a"}, {Key: "2", Value: "1"}},
+		matches.At(0).ToStringMap())
+
+	// invalid pattern
+	matches, err = A("abc").FindAllSubmatchE(`(`)
+	assert.Equal(t, "failed to compile regex pattern (: error parsing regexp: missing closing ): `(`", err.Error())
+	assert.Equal(t, 0, matches.Len())
+}
+
 // First
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_First_Go(t *testing.B) {
@@ -2174,6 +2568,47 @@ func TestStr_FirstN(t *testing.T) {
 	assert.Equal(t, NewStrV("1", "2"), NewStrV("1", "2", "3").FirstN(2))
 }
 
+// Flatten
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Flatten() {
+	slice := NewStrV("abc")
+	fmt.Println(slice.Flatten())
+	// Output: abc
+}
+
+func TestStr_Flatten(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, NewStrV(), slice.Flatten())
+		assert.Equal(t, NewStrV(), NewStrV().Flatten())
+	}
+
+	// copy
+	{
+		slice := NewStrV("abc")
+		flat := slice.Flatten()
+		assert.Equal(t, NewStrV("abc"), flat)
+		flat.Set(0, 'z')
+		assert.Equal(t, NewStrV("abc"), slice)
+	}
+}
+
+// FuzzyMatch
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_FuzzyMatch() {
+	fmt.Println(A("cmd/main.go").FuzzyMatch("main").Positions)
+	// Output: [4 5 6 7]
+}
+
+func TestStr_FuzzyMatch(t *testing.T) {
+	assert.Nil(t, (*Str)(nil).FuzzyMatch("a"))
+	assert.Nil(t, A("abc").FuzzyMatch("ca"))
+	assert.Equal(t, []int{0, 4}, A("foo_bar").FuzzyMatch("fb").Positions)
+	assert.Greater(t, A("foo_bar").FuzzyMatch("fb").Score, A("foobar").FuzzyMatch("fb").Score)
+}
+
 // G
 // --------------------------------------------------------------------------------------------------
 func ExampleStr_G() {
@@ -2194,6 +2629,81 @@ func ExampleStr_Generic() {
 	// Output: false
 }
 
+// GraphemeAt
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_GraphemeAt() {
+	fmt.Println(A("👍🏽ok").GraphemeAt(0))
+	// Output: 👍🏽
+}
+
+func TestStr_GraphemeAt(t *testing.T) {
+	str := A("e\u0301🇺🇸x")
+	assert.Equal(t, "e\u0301", str.GraphemeAt(0).A())
+	assert.Equal(t, "🇺🇸", str.GraphemeAt(1).A())
+	assert.Equal(t, "x", str.GraphemeAt(-1).A())
+	assert.Equal(t, "", str.GraphemeAt(3).A())
+	assert.Equal(t, "", (*Str)(nil).GraphemeAt(0).A())
+}
+
+// GraphemeLen
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_GraphemeLen() {
+	fmt.Println(A("👨\u200d👩\u200d👧").GraphemeLen())
+	// Output: 1
+}
+
+func TestStr_GraphemeLen(t *testing.T) {
+	assert.Equal(t, 0, (*Str)(nil).GraphemeLen())
+	assert.Equal(t, 3, A("abc").GraphemeLen())
+	assert.Equal(t, 2, A("e\u0301x").Len()-1)
+	assert.Equal(t, 2, A("e\u0301x").GraphemeLen())
+	assert.Equal(t, 2, A("🇺🇸🇫🇷").GraphemeLen())
+}
+
+// GraphemeReverse
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_GraphemeReverse() {
+	fmt.Println(A("ab👍🏽").GraphemeReverse())
+	// Output: 👍🏽ba
+}
+
+func TestStr_GraphemeReverse(t *testing.T) {
+	assert.Equal(t, "", (*Str)(nil).GraphemeReverse().A())
+	assert.Equal(t, "cba", A("abc").GraphemeReverse().A())
+	assert.Equal(t, "xe\u0301", A("e\u0301x").GraphemeReverse().A())
+	assert.Equal(t, "🇫🇷🇺🇸", A("🇺🇸🇫🇷").GraphemeReverse().A())
+}
+
+// GraphemeSlice
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_GraphemeSlice() {
+	fmt.Println(A("🇺🇸🇫🇷🇩🇪").GraphemeSlice(1, -1))
+	// Output: 🇫🇷🇩🇪
+}
+
+func TestStr_GraphemeSlice(t *testing.T) {
+	str := A("e\u0301日👍🏽x")
+	assert.Equal(t, str.A(), str.GraphemeSlice().A())
+	assert.Equal(t, "e\u0301日", str.GraphemeSlice(0, 1).A())
+	assert.Equal(t, "👍🏽x", str.GraphemeSlice(-2, -1).A())
+	assert.Equal(t, "x", str.GraphemeSlice(3, 10).A())
+	assert.Equal(t, "", str.GraphemeSlice(2, 1).A())
+	assert.Equal(t, "", str.GraphemeSlice(1).A())
+	assert.Equal(t, "", (*Str)(nil).GraphemeSlice(0, 1).A())
+}
+
+// Graphemes
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Graphemes() {
+	fmt.Println(A("a👍🏽🇺🇸").Graphemes())
+	// Output: [a 👍🏽 🇺🇸]
+}
+
+func TestStr_Graphemes(t *testing.T) {
+	assert.Equal(t, NewStringSliceV(), (*Str)(nil).Graphemes())
+	assert.Equal(t, NewStringSliceV("e\u0301", "\r\n", "👨\u200d👩"), A("e\u0301\r\n👨\u200d👩").Graphemes())
+}
+
 // HasAnyPrefix
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_HasAnyPrefix_Go(t *testing.B) {
@@ -2449,6 +2959,21 @@ func TestStr_HasSuffix(t *testing.T) {
 	assert.Equal(t, false, NewStr("123").HasSuffix(2))
 }
 
+// Hash
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Hash() {
+	slice := NewStrV('1', '2', '3')
+	fmt.Println(slice.Hash() == NewStrV('1', '2', '3').Hash())
+	// Output: true
+}
+
+func TestStr_Hash(t *testing.T) {
+	slice := NewStrV('1', '2', '3')
+	assert.Equal(t, slice.Hash(), slice.DeepCopy().Hash())
+	assert.Equal(t, slice.Hash(), Hash([]rune{'1', '2', '3'}))
+	assert.NotEqual(t, slice.Hash(), NewStrV('3', '2', '1').Hash())
+}
+
 // Index
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_Index_Go(t *testing.B) {
@@ -2585,6 +3110,27 @@ func TestStr_IndexChar(t *testing.T) {
 	}
 }
 
+// Inject
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Inject() {
+	slice := NewStrV("abcde")
+	fmt.Println(slice.Inject(func(acc, x O) O { return ToString(acc) + ToString(x) }, ">"))
+	// Output: >abcde
+}
+
+func TestStr_Inject(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, ">", slice.Inject(func(acc, x O) O { return ToString(acc) + ToString(x) }, ">").O())
+		assert.Equal(t, ">", NewStrV("").Inject(func(acc, x O) O { return ToString(acc) + ToString(x) }, ">").O())
+	}
+
+	// inject
+	assert.Equal(t, ">abcde", NewStrV("abcde").Inject(func(acc, x O) O { return ToString(acc) + ToString(x) }, ">").O())
+}
+
 // Insert
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_Insert_Go(t *testing.B) {
@@ -2712,44 +3258,112 @@ func TestStr_Insert(t *testing.T) {
 	}
 }
 
-// Join
+// IsSorted
 // --------------------------------------------------------------------------------------------------
-func BenchmarkStr_Join_Go(t *testing.B) {
-	// src := RangeString(nines4)
-	// strings.Join(src, ",")
-}
-
-func BenchmarkStr_Join_Slice(t *testing.B) {
-	// slice := NewStr(RangeString(nines4))
-	// slice.Join()
+func ExampleStr_IsSorted() {
+	slice := NewStrV("ace")
+	fmt.Println(slice.IsSorted())
+	// Output: true
 }
 
-func ExampleStr_Join() {
-	slice := NewStrV("1", "2", "3")
-	fmt.Println(slice.Join())
-	// Output: 1,2,3
-}
+func TestStr_IsSorted(t *testing.T) {
 
-func TestStr_Join(t *testing.T) {
-	// nil
+	// nil or empty
 	{
 		var slice *Str
-		assert.Equal(t, Obj(""), slice.Join())
+		assert.True(t, slice.IsSorted())
+		assert.True(t, NewStrV().IsSorted())
 	}
 
-	// empty
-	{
-		assert.Equal(t, Obj(""), NewStrV().Join())
-	}
+	// sorted
+	assert.True(t, NewStrV("ace").IsSorted())
+	assert.True(t, NewStrV("ace").SortReverse().Reverse().IsSorted())
 
-	assert.Equal(t, "1,2,3", NewStrV("1", "2", "3").Join().O())
-	assert.Equal(t, "1.2.3", NewStrV("1", "2", "3").Join(".").O())
+	// not sorted
+	assert.False(t, NewStrV("eca").IsSorted())
+	assert.False(t, NewStrV("bAaB").IsSorted())
 }
 
-// Last
+// IsSortedWith
 // --------------------------------------------------------------------------------------------------
-func BenchmarkStr_Last_Go(t *testing.B) {
-	// src := RangeString(nines7)
+func ExampleStr_IsSortedWith() {
+	slice := NewStrV("eca")
+	fmt.Println(slice.IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+	// Output: true
+}
+
+func TestStr_IsSortedWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.True(t, slice.IsSortedWith(Compare))
+		assert.True(t, NewStrV().IsSortedWith(Compare))
+	}
+
+	// sorted
+	assert.True(t, NewStrV("ace").IsSortedWith(Compare))
+	assert.True(t, NewStrV("eca").IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+	assert.True(t, NewStrV("bAaB").SortBy(func(x O) O { return unicode.ToLower(x.(rune)) }).IsSortedWith(By(func(x O) O { return unicode.ToLower(x.(rune)) }).Compare))
+
+	// not sorted
+	assert.False(t, NewStrV("eca").IsSortedWith(Compare))
+	assert.False(t, NewStrV("ace").IsSortedWith(func(a, b O) int { return Compare(b, a) }))
+}
+
+// JaroWinkler
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_JaroWinkler() {
+	fmt.Printf("%.3f", A("MARTHA").JaroWinkler("MARHTA"))
+	// Output: 0.961
+}
+
+func TestStr_JaroWinkler(t *testing.T) {
+	assert.Equal(t, 1.0, (*Str)(nil).JaroWinkler(""))
+	assert.Equal(t, 0.0, (*Str)(nil).JaroWinkler("abc"))
+	assert.Equal(t, 1.0, A("abc").JaroWinkler("abc"))
+	assert.Equal(t, 0.0, A("abc").JaroWinkler("xyz"))
+	assert.Greater(t, A("status").JaroWinkler("statsu"), A("status").JaroWinkler("tsatus"))
+}
+
+// Join
+// --------------------------------------------------------------------------------------------------
+func BenchmarkStr_Join_Go(t *testing.B) {
+	// src := RangeString(nines4)
+	// strings.Join(src, ",")
+}
+
+func BenchmarkStr_Join_Slice(t *testing.B) {
+	// slice := NewStr(RangeString(nines4))
+	// slice.Join()
+}
+
+func ExampleStr_Join() {
+	slice := NewStrV("1", "2", "3")
+	fmt.Println(slice.Join())
+	// Output: 1,2,3
+}
+
+func TestStr_Join(t *testing.T) {
+	// nil
+	{
+		var slice *Str
+		assert.Equal(t, Obj(""), slice.Join())
+	}
+
+	// empty
+	{
+		assert.Equal(t, Obj(""), NewStrV().Join())
+	}
+
+	assert.Equal(t, "1,2,3", NewStrV("1", "2", "3").Join().O())
+	assert.Equal(t, "1.2.3", NewStrV("1", "2", "3").Join(".").O())
+}
+
+// Last
+// --------------------------------------------------------------------------------------------------
+func BenchmarkStr_Last_Go(t *testing.B) {
+	// src := RangeString(nines7)
 	// for len(src) > 1 {
 	// 	_ = src[len(src)-1]
 	// 	src = src[:len(src)-1]
@@ -3013,6 +3627,56 @@ func TestStr_Less(t *testing.T) {
 	assert.Equal(t, true, NewStrV("0", "1", "2").Less(1, 2))
 }
 
+// Levenshtein
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Levenshtein() {
+	fmt.Println(A("kitten").Levenshtein("sitting"))
+	// Output: 3
+}
+
+func TestStr_Levenshtein(t *testing.T) {
+	assert.Equal(t, 0, (*Str)(nil).Levenshtein(""))
+	assert.Equal(t, 3, (*Str)(nil).Levenshtein("abc"))
+	assert.Equal(t, 2, A("ab").Levenshtein("ba"))
+	assert.Equal(t, 1, A("日本語").Levenshtein(A("日本")))
+}
+
+// Match
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Match() {
+	fmt.Println(A("v1.2.3").Match(`^v\d+\.\d+\.\d+$`))
+	// Output: true
+}
+
+func TestStr_Match(t *testing.T) {
+	assert.False(t, (*Str)(nil).Match(`.*`))
+	assert.True(t, A("").Match(`.*`))
+	assert.True(t, A("foobar").Match(`o+b`))
+	assert.False(t, A("foobar").Match(`^bar`))
+	assert.True(t, A("foobar").Match(regexp.MustCompile(`bar$`)))
+	assert.True(t, A("foobar").Match(A("oob")))
+
+	// invalid patterns
+	assert.False(t, A("foobar").Match(`(`))
+	assert.False(t, A("foobar").Match((*regexp.Regexp)(nil)))
+}
+
+func TestStr_MatchE(t *testing.T) {
+	match, err := A("foobar").MatchE(`o+b`)
+	assert.Nil(t, err)
+	assert.True(t, match)
+	match, err = (*Str)(nil).MatchE(`.*`)
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	// invalid patterns
+	match, err = A("foobar").MatchE(`(`)
+	assert.Equal(t, "failed to compile regex pattern (: error parsing regexp: missing closing ): `(`", err.Error())
+	assert.False(t, match)
+	_, err = A("foobar").MatchE((*regexp.Regexp)(nil))
+	assert.Equal(t, "regex pattern is nil", err.Error())
+}
+
 // Map
 // --------------------------------------------------------------------------------------------------
 func ExampleStr_Map() {
@@ -3033,6 +3697,108 @@ func TestStr_Map(t *testing.T) {
 	}
 }
 
+// MarshalJSON
+// --------------------------------------------------------------------------------------------------
+func TestStr_MarshalJSON(t *testing.T) {
+
+	// round trip
+	{
+		data, err := A("test").MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, `"test"`, string(data))
+
+		x := A("")
+		assert.Nil(t, x.UnmarshalJSON(data))
+		assert.Equal(t, A("test"), x)
+	}
+
+	// as a field in a struct
+	{
+		type config struct {
+			Data *Str `json:"data"`
+		}
+		data, err := json.Marshal(config{Data: A("test")})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"data":"test"}`, string(data))
+
+		var c config
+		assert.Nil(t, json.Unmarshal(data, &c))
+		assert.Equal(t, A("test"), c.Data)
+	}
+
+	// invalid
+	assert.NotNil(t, A("").UnmarshalJSON([]byte(`[1]`)))
+}
+
+// MarshalMsgpack
+// --------------------------------------------------------------------------------------------------
+func TestStr_MarshalMsgpack(t *testing.T) {
+	data, err := A("test").MarshalMsgpack()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0xa4, 't', 'e', 's', 't'}, data)
+
+	str := A("")
+	assert.Nil(t, str.UnmarshalMsgpack(data))
+	assert.Equal(t, "test", str.A())
+}
+
+// MarshalYAML
+// --------------------------------------------------------------------------------------------------
+func TestStr_MarshalYAML(t *testing.T) {
+
+	// round trip
+	{
+		data, err := yaml.Marshal(A("test"))
+		assert.Nil(t, err)
+		assert.Equal(t, "test\n", string(data))
+
+		x := A("")
+		assert.Nil(t, yaml.Unmarshal(data, x))
+		assert.Equal(t, A("test"), x)
+	}
+
+	// as a field in a struct
+	{
+		type config struct {
+			Data *Str `yaml:"data"`
+		}
+		data, err := yaml.Marshal(config{Data: A("test")})
+		assert.Nil(t, err)
+
+		var c config
+		assert.Nil(t, yaml.Unmarshal(data, &c))
+		assert.Equal(t, A("test"), c.Data)
+	}
+
+	// invalid
+	assert.NotNil(t, yaml.Unmarshal([]byte("[1]"), A("")))
+}
+
+// NFC
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_NFC() {
+	fmt.Println(A("e\u0301").NFC().Len())
+	// Output: 1
+}
+
+func TestStr_NFC(t *testing.T) {
+	assert.Equal(t, "", (*Str)(nil).NFC().A())
+	assert.Equal(t, "\u00e9t\u00e9", A("e\u0301t\u00e9").NFC().A())
+	assert.True(t, A("e\u0301").NFC().Equal(A("\u00e9")))
+}
+
+// NFD
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_NFD() {
+	fmt.Println(A("\u00e9").NFD().Len())
+	// Output: 2
+}
+
+func TestStr_NFD(t *testing.T) {
+	assert.Equal(t, "", (*Str)(nil).NFD().A())
+	assert.Equal(t, "e\u0301te\u0301", A("e\u0301t\u00e9").NFD().A())
+}
+
 // Nil
 // --------------------------------------------------------------------------------------------------
 func ExampleStr_Nil() {
@@ -3061,6 +3827,57 @@ func TestStr_O(t *testing.T) {
 	assert.Equal(t, NewStrV("1", "2", "3"), NewStrV("1", "2", "3"))
 }
 
+// Ordinalize
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Ordinalize() {
+	fmt.Println(A("22").Ordinalize())
+	// Output: 22nd
+}
+
+func TestStr_Ordinalize(t *testing.T) {
+	for k, v := range map[string]string{
+		"0": "0th", "1": "1st", "2": "2nd", "3": "3rd", "4": "4th", "11": "11th", "12": "12th",
+		"13": "13th", "21": "21st", "102": "102nd", "111": "111th", "-1": "-1st", "-11": "-11th",
+	} {
+		assert.Equal(t, v, A(k).Ordinalize().A())
+	}
+
+	// not a number
+	{
+		assert.Equal(t, "foo", A("foo").Ordinalize().A())
+		assert.Equal(t, "", (*Str)(nil).Ordinalize().A())
+	}
+}
+
+// PadLeft
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_PadLeft() {
+	fmt.Printf("[%s]", A("日本").PadLeft(6))
+	// Output: [  日本]
+}
+
+func TestStr_PadLeft(t *testing.T) {
+	assert.Equal(t, "  abc", A("abc").PadLeft(5).A())
+	assert.Equal(t, "00042", A("42").PadLeft(5, "0").A())
+	assert.Equal(t, "abc", A("abc").PadLeft(2).A())
+	assert.Equal(t, "-=-abc", A("abc").PadLeft(6, "-=").A())
+	assert.Equal(t, "  ", (*Str)(nil).PadLeft(2).A())
+}
+
+// PadRight
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_PadRight() {
+	fmt.Printf("[%s]", A("日本").PadRight(6))
+	// Output: [日本  ]
+}
+
+func TestStr_PadRight(t *testing.T) {
+	assert.Equal(t, "abc  ", A("abc").PadRight(5).A())
+	assert.Equal(t, "👍🏽..", A("👍🏽").PadRight(4, ".").A())
+	assert.Equal(t, "abc", A("abc").PadRight(3).A())
+	assert.Equal(t, "日本 ", A("日本").PadRight(5).A())
+}
+
 // Pair
 //--------------------------------------------------------------------------------------------------
 
@@ -3102,6 +3919,113 @@ func TestStr_Pair(t *testing.T) {
 	}
 }
 
+// Partition
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Partition() {
+	slice := NewStrV("abcde")
+	fmt.Println(slice.Partition(func(x O) bool { return ToChar(x).G() != 'b' && ToChar(x).G() != 'd' }))
+	// Output: ace bd
+}
+
+func TestStr_Partition(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		match, rest := slice.Partition(func(x O) bool { return ToChar(x).G() != 'b' && ToChar(x).G() != 'd' })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// partition
+	{
+		slice := NewStrV("abcde")
+		match, rest := slice.Partition(func(x O) bool { return ToChar(x).G() != 'b' && ToChar(x).G() != 'd' })
+		assert.Equal(t, NewStrV("ace"), match)
+		assert.Equal(t, NewStrV("bd"), rest)
+		assert.Equal(t, NewStrV("abcde"), slice)
+	}
+
+	// all or nothing
+	{
+		match, rest := NewStrV("ab").Partition(func(x O) bool { return true })
+		assert.Equal(t, NewStrV("ab"), match)
+		assert.Equal(t, 0, rest.Len())
+	}
+}
+
+// Permutations
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Permutations() {
+	slice := NewStrV("abc")
+	fmt.Println(slice.Permutations(2))
+	// Output: [ab ac ba bc ca cb]
+}
+
+func TestStr_Permutations(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, []ISlice{}, slice.Permutations(1))
+		assert.Equal(t, []ISlice{}, NewStrV("").Permutations(1))
+	}
+
+	// out of range
+	assert.Equal(t, []ISlice{}, NewStrV("ab").Permutations(-1))
+	assert.Equal(t, []ISlice{}, NewStrV("ab").Permutations(3))
+
+	// permutations
+	{
+		slice := NewStrV("abc")
+		assert.Equal(t, 1, len(slice.Permutations(0)))
+		assert.Equal(t, []ISlice{NewStrV("a"), NewStrV("b"), NewStrV("c")}, slice.Permutations(1))
+		assert.Equal(t, []ISlice{NewStrV("ab"), NewStrV("ac"), NewStrV("ba"), NewStrV("bc"), NewStrV("ca"), NewStrV("cb")}, slice.Permutations(2))
+		assert.Equal(t, []ISlice{NewStrV("abc"), NewStrV("acb"), NewStrV("bac"), NewStrV("bca"), NewStrV("cab"), NewStrV("cba")}, slice.Permutations(3))
+	}
+}
+
+// Pluralize
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Pluralize() {
+	fmt.Println(A("person").Pluralize())
+	// Output: people
+}
+
+func TestStr_Pluralize(t *testing.T) {
+	for k, v := range map[string]string{
+		"box": "boxes", "category": "categories", "knife": "knives", "wolf": "wolves", "sheep": "sheep",
+		"person": "people", "child": "children", "user": "users", "bus": "buses", "matrix": "matrices",
+		"analysis": "analyses", "mouse": "mice", "quiz": "quizzes", "day": "days", "boxes": "boxes",
+	} {
+		assert.Equal(t, v, A(k).Pluralize().A())
+	}
+
+	// preserve case and only inflect the last word
+	{
+		assert.Equal(t, "People", A("Person").Pluralize().A())
+		assert.Equal(t, "PEOPLE", A("PERSON").Pluralize().A())
+		assert.Equal(t, "user_categories", A("user_category").Pluralize().A())
+		assert.Equal(t, "UserCategories", A("UserCategory").Pluralize().A())
+		assert.Equal(t, "", (*Str)(nil).Pluralize().A())
+	}
+
+	// extend the inflections
+	{
+		assert.Equal(t, "octopi", A("octopus").Pluralize().A())
+		AddIrregular("octopus", "octopuses")
+		assert.Equal(t, "octopuses", A("octopus").Pluralize().A())
+		assert.Equal(t, "octopus", A("octopuses").Singularize().A())
+
+		AddUncountable("pokemon")
+		assert.Equal(t, "pokemon", A("pokemon").Pluralize().A())
+
+		assert.Nil(t, AddPluralRule(`(gal)$`, `${1}z`))
+		assert.Equal(t, "galz", A("gal").Pluralize().A())
+		assert.NotNil(t, AddPluralRule(`(`, ``))
+	}
+}
+
 // Pop
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_Pop_Go(t *testing.B) {
@@ -3253,6 +4177,34 @@ func TestStr_Prepend(t *testing.T) {
 	}
 }
 
+// Reduce
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Reduce() {
+	slice := NewStrV("abcde")
+	fmt.Println(slice.Reduce(func(acc, x O) O { return ToString(acc) + ToString(x) }))
+	// Output: abcde
+}
+
+func TestStr_Reduce(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Nil(t, slice.Reduce(func(acc, x O) O { return ToString(acc) + ToString(x) }).O())
+		assert.Nil(t, NewStrV("").Reduce(func(acc, x O) O { return ToString(acc) + ToString(x) }).O())
+		assert.Equal(t, ">", NewStrV("").Reduce(func(acc, x O) O { return ToString(acc) + ToString(x) }, ">").O())
+	}
+
+	// single element
+	assert.Equal(t, NewChar('a'), NewStrV("a").Reduce(func(acc, x O) O { return ToString(acc) + ToString(x) }).O())
+
+	// first element is the initial value
+	assert.Equal(t, "abcde", NewStrV("abcde").Reduce(func(acc, x O) O { return ToString(acc) + ToString(x) }).O())
+
+	// initial value
+	assert.Equal(t, ">abcde", NewStrV("abcde").Reduce(func(acc, x O) O { return ToString(acc) + ToString(x) }, ">").O())
+}
+
 // Replace
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_Replace_Go(t *testing.B) {
@@ -3319,6 +4271,70 @@ func TestStr_ReplaceAll(t *testing.T) {
 	assert.Equal(t, "1255", NewStr("1233").ReplaceAll("3", "5").A())
 }
 
+// ReplaceFunc
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_ReplaceFunc() {
+	fmt.Println(A("foo bar").ReplaceFunc(`\w+`, strings.ToUpper))
+	// Output: FOO BAR
+}
+
+func TestStr_ReplaceFunc(t *testing.T) {
+	assert.Equal(t, "", (*Str)(nil).ReplaceFunc(`\w`, strings.ToUpper).A())
+	assert.Equal(t, "", A("").ReplaceFunc(`\w`, strings.ToUpper).A())
+	assert.Equal(t, "a[1]b[22]", A("a1b22").ReplaceFunc(`\d+`, func(x string) string { return "[" + x + "]" }).A())
+
+	// invalid pattern returns a copy
+	{
+		str := A("foo")
+		new := str.ReplaceFunc(`(`, strings.ToUpper)
+		assert.Equal(t, "foo", new.A())
+		str.Set(0, 'b')
+		assert.Equal(t, "foo", new.A())
+	}
+}
+
+func TestStr_ReplaceFuncE(t *testing.T) {
+	new, err := A("a1b22").ReplaceFuncE(`\d+`, func(x string) string { return "[" + x + "]" })
+	assert.Nil(t, err)
+	assert.Equal(t, "a[1]b[22]", new.A())
+
+	// invalid pattern
+	new, err = A("foo").ReplaceFuncE(`(`, strings.ToUpper)
+	assert.Equal(t, "failed to compile regex pattern (: error parsing regexp: missing closing ): `(`", err.Error())
+	assert.Equal(t, "", new.A())
+	_, err = A("").ReplaceFuncE(`(`, strings.ToUpper)
+	assert.NotNil(t, err)
+}
+
+// ReplaceRegex
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_ReplaceRegex() {
+	fmt.Println(A("John Smith").ReplaceRegex(`(\w+) (\w+)`, "$2, $1"))
+	// Output: Smith, John
+}
+
+func TestStr_ReplaceRegex(t *testing.T) {
+	assert.Equal(t, "", (*Str)(nil).ReplaceRegex(`\w`, "x").A())
+	assert.Equal(t, "", A("").ReplaceRegex(`\w`, "x").A())
+	assert.Equal(t, "foo", A("foo").ReplaceRegex(`\d`, "x").A())
+	assert.Equal(t, "a#b#", A("a1b22").ReplaceRegex(`\d+`, "#").A())
+	assert.Equal(t, "a=1 b=2", A("1:a 2:b").ReplaceRegex(`(\d):(?P<key>\w)`, "${key}=${1}").A())
+	assert.Equal(t, "foo", A("foo").ReplaceRegex(`(`, "x").A())
+}
+
+func TestStr_ReplaceRegexE(t *testing.T) {
+	new, err := A("1:a 2:b").ReplaceRegexE(`(\d):(?P<key>\w)`, "${key}=${1}")
+	assert.Nil(t, err)
+	assert.Equal(t, "a=1 b=2", new.A())
+
+	// invalid pattern
+	new, err = A("foo").ReplaceRegexE(`(`, "x")
+	assert.Equal(t, "failed to compile regex pattern (: error parsing regexp: missing closing ): `(`", err.Error())
+	assert.Equal(t, "", new.A())
+	_, err = (*Str)(nil).ReplaceRegexE(`(`, "x")
+	assert.NotNil(t, err)
+}
+
 // Reverse
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_Reverse_Go(t *testing.B) {
@@ -3393,30 +4409,169 @@ func TestStr_ReverseM(t *testing.T) {
 
 	// nil
 	{
-		var slice *Str
-		assert.Equal(t, (*Str)(nil), slice.ReverseM())
+		var slice *Str
+		assert.Equal(t, (*Str)(nil), slice.ReverseM())
+	}
+
+	// empty
+	{
+		assert.Equal(t, NewStrV(), NewStrV().ReverseM())
+	}
+
+	// pos
+	{
+		slice := NewStrV("3", "2", "1")
+		reversed := slice.ReverseM()
+		assert.Equal(t, NewStrV("1", "2", "3", "4"), slice.Append("4"))
+		assert.Equal(t, NewStrV("1", "2", "3", "4"), reversed)
+	}
+
+	// neg
+	{
+		slice := NewStrV("2", "3", "-2", "-3")
+		reversed := slice.ReverseM()
+		assert.Equal(t, "3-2-324", slice.Append('4').A())
+		assert.Equal(t, "3-2-324", reversed.A())
+	}
+}
+
+// Rotate
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Rotate() {
+	slice := NewStrV("abcde")
+	fmt.Println(slice.Rotate(2))
+	// Output: cdeab
+}
+
+func TestStr_Rotate(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, NewStrV(""), slice.Rotate(1))
+		assert.Equal(t, NewStrV(""), NewStrV("").Rotate(1))
+	}
+
+	// rotate
+	{
+		slice := NewStrV("abcde")
+		assert.Equal(t, NewStrV("abcde"), slice.Rotate(0))
+		assert.Equal(t, NewStrV("bcdea"), slice.Rotate(1))
+		assert.Equal(t, NewStrV("cdeab"), slice.Rotate(2))
+		assert.Equal(t, NewStrV("cdeab"), slice.Rotate(7))
+		assert.Equal(t, NewStrV("abcde"), slice.Rotate(5))
+		assert.Equal(t, NewStrV("abcde"), slice)
+	}
+
+	// negative
+	{
+		slice := NewStrV("abcde")
+		assert.Equal(t, NewStrV("eabcd"), slice.Rotate(-1))
+		assert.Equal(t, NewStrV("deabc"), slice.Rotate(-7))
+	}
+}
+
+// RotateM
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_RotateM() {
+	slice := NewStrV("abcde")
+	fmt.Println(slice.RotateM(-1))
+	// Output: eabcd
+}
+
+func TestStr_RotateM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, (*Str)(nil), slice.RotateM(1))
+		assert.Equal(t, NewStrV(""), NewStrV("").RotateM(1))
+	}
+
+	// rotate
+	{
+		slice := NewStrV("abcde")
+		assert.Equal(t, NewStrV("cdeab"), slice.RotateM(2))
+		assert.Equal(t, NewStrV("cdeab"), slice)
+		assert.Equal(t, NewStrV("bcdea"), slice.RotateM(-1))
+		assert.Equal(t, NewStrV("bcdea"), slice)
+	}
+}
+
+// Sample
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Sample() {
+	slice := NewStrV("abcde")
+	fmt.Println(slice.Sample(2, rand.New(rand.NewSource(1))).Len())
+	// Output: 2
+}
+
+func TestStr_Sample(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, 0, slice.Sample(2).Len())
+		assert.Equal(t, 0, NewStrV("").Sample(2).Len())
+	}
+
+	// invalid size
+	assert.Equal(t, 0, NewStrV("ab").Sample(0).Len())
+	assert.Equal(t, 0, NewStrV("ab").Sample(-1).Len())
+
+	// unique elements
+	{
+		slice := NewStrV("abcde")
+		sample := slice.Sample(3)
+		assert.Equal(t, 3, sample.Len())
+		sample.Each(func(x O) {
+			assert.Equal(t, 1, sample.CountW(func(y O) bool { return Compare(x, y) == 0 }))
+			assert.True(t, slice.AnyW(func(y O) bool { return Compare(x, y) == 0 }))
+		})
+		assert.Equal(t, NewStrV("abcde"), slice)
+	}
+
+	// all elements
+	{
+		slice := NewStrV("abcde")
+		sample := slice.Sample(10)
+		assert.Equal(t, 5, sample.Len())
+		assert.True(t, sample.All('a', 'b', 'c', 'd', 'e'))
 	}
 
-	// empty
+	// seeded
 	{
-		assert.Equal(t, NewStrV(), NewStrV().ReverseM())
+		slice := NewStrV("abcde")
+		assert.Equal(t, slice.Sample(3, rand.New(rand.NewSource(2))), slice.Sample(3, rand.New(rand.NewSource(2))))
 	}
+}
 
-	// pos
+// Scan
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Scan() {
+	slice := NewStrV("abcde")
+	fmt.Println(slice.Scan(func(acc, x O) O { return ToString(acc) + ToString(x) }))
+	// Output: [a ab abc abcd abcde]
+}
+
+func TestStr_Scan(t *testing.T) {
+
+	// nil or empty
 	{
-		slice := NewStrV("3", "2", "1")
-		reversed := slice.ReverseM()
-		assert.Equal(t, NewStrV("1", "2", "3", "4"), slice.Append("4"))
-		assert.Equal(t, NewStrV("1", "2", "3", "4"), reversed)
+		var slice *Str
+		assert.Equal(t, 0, slice.Scan(func(acc, x O) O { return ToString(acc) + ToString(x) }).Len())
+		assert.Equal(t, 0, NewStrV("").Scan(func(acc, x O) O { return ToString(acc) + ToString(x) }, ">").Len())
 	}
 
-	// neg
+	// first element is the initial value
 	{
-		slice := NewStrV("2", "3", "-2", "-3")
-		reversed := slice.ReverseM()
-		assert.Equal(t, "3-2-324", slice.Append('4').A())
-		assert.Equal(t, "3-2-324", reversed.A())
+		slice := NewStrV("abcde")
+		assert.Equal(t, []string{"a", "ab", "abc", "abcd", "abcde"}, slice.Scan(func(acc, x O) O { return ToString(acc) + ToString(x) }).ToStrs())
+		assert.Equal(t, NewStrV("abcde"), slice)
 	}
+
+	// initial value
+	assert.Equal(t, []string{">a", ">ab", ">abc", ">abcd", ">abcde"}, NewStrV("abcde").Scan(func(acc, x O) O { return ToString(acc) + ToString(x) }, ">").ToStrs())
 }
 
 // Select
@@ -3760,6 +4915,66 @@ func TestStr_ShiftN(t *testing.T) {
 	}
 }
 
+// Shuffle
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Shuffle() {
+	slice := NewStrV("abcde")
+	fmt.Println(slice.Shuffle().Len())
+	// Output: 5
+}
+
+func TestStr_Shuffle(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, NewStrV(""), slice.Shuffle())
+		assert.Equal(t, NewStrV(""), NewStrV("").Shuffle())
+	}
+
+	// shuffle
+	{
+		slice := NewStrV("abcde")
+		shuffled := slice.Shuffle()
+		assert.Equal(t, 5, shuffled.Len())
+		assert.True(t, shuffled.All('a', 'b', 'c', 'd', 'e'))
+		assert.Equal(t, NewStrV("abcde"), slice)
+	}
+
+	// seeded
+	{
+		slice := NewStrV("abcde")
+		assert.Equal(t, slice.Shuffle(rand.New(rand.NewSource(2))), slice.Shuffle(rand.New(rand.NewSource(2))))
+	}
+}
+
+// ShuffleM
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_ShuffleM() {
+	slice := NewStrV("abcde")
+	fmt.Println(slice.ShuffleM().Len())
+	// Output: 5
+}
+
+func TestStr_ShuffleM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, (*Str)(nil), slice.ShuffleM())
+		assert.Equal(t, NewStrV(""), NewStrV("").ShuffleM())
+	}
+
+	// shuffle
+	{
+		slice := NewStrV("abcde")
+		shuffled := slice.ShuffleM(rand.New(rand.NewSource(2)))
+		assert.Equal(t, slice, shuffled)
+		assert.Equal(t, NewStrV("abcde").ShuffleM(rand.New(rand.NewSource(2))), slice)
+		assert.True(t, slice.All('a', 'b', 'c', 'd', 'e'))
+	}
+}
+
 // Single
 //--------------------------------------------------------------------------------------------------
 
@@ -3776,6 +4991,39 @@ func TestStr_Single(t *testing.T) {
 	assert.Equal(t, false, NewStrV("1", "2").Single())
 }
 
+// Singularize
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Singularize() {
+	fmt.Println(A("categories").Singularize())
+	// Output: category
+}
+
+func TestStr_Singularize(t *testing.T) {
+	for k, v := range map[string]string{
+		"boxes": "box", "categories": "category", "knives": "knife", "wolves": "wolf", "sheep": "sheep",
+		"people": "person", "children": "child", "users": "user", "buses": "bus", "matrices": "matrix",
+		"analyses": "analysis", "mice": "mouse", "quizzes": "quiz", "news": "news", "status": "status",
+	} {
+		assert.Equal(t, v, A(k).Singularize().A())
+	}
+
+	// preserve case and only inflect the last word
+	{
+		assert.Equal(t, "Person", A("People").Singularize().A())
+		assert.Equal(t, "user_category", A("user_categories").Singularize().A())
+		assert.Equal(t, "", (*Str)(nil).Singularize().A())
+	}
+
+	// round trip
+	{
+		for _, x := range []string{"datum", "person", "category", "analysis", "sheep"} {
+			assert.Equal(t, x, A(x).Pluralize().Singularize().A())
+		}
+		assert.Equal(t, "data", A("data").Pluralize().A())
+		assert.Equal(t, "metadata", A("metadata").Singularize().A())
+	}
+}
+
 // Slice
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_Slice_Go(t *testing.B) {
@@ -3923,6 +5171,116 @@ func TestStr_Sort(t *testing.T) {
 	}
 }
 
+// SortBy
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_SortBy() {
+	slice := NewStrV("bAaB")
+	fmt.Println(slice.SortBy(func(x O) O { return unicode.ToLower(x.(rune)) }))
+	// Output: AabB
+}
+
+func TestStr_SortBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, NewStrV(), slice.SortBy(func(x O) O { return unicode.ToLower(x.(rune)) }))
+		assert.Equal(t, NewStrV(), NewStrV().SortBy(func(x O) O { return unicode.ToLower(x.(rune)) }))
+	}
+
+	// new Slice
+	{
+		slice := NewStrV("bAaB")
+		sorted := slice.SortBy(func(x O) O { return unicode.ToLower(x.(rune)) })
+		assert.Equal(t, NewStrV("AabB"), sorted)
+		assert.Equal(t, NewStrV("bAaB"), slice)
+	}
+
+	// stable
+	{
+		slice := NewStrV("bAaB")
+		assert.Equal(t, NewStrV("bAaB"), slice.SortBy(func(x O) O { return 0 }))
+	}
+}
+
+// SortByDesc
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_SortByDesc() {
+	slice := NewStrV("bAaB")
+	fmt.Println(slice.SortByDesc(func(x O) O { return unicode.ToLower(x.(rune)) }))
+	// Output: bBAa
+}
+
+func TestStr_SortByDesc(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, NewStrV(), slice.SortByDesc(func(x O) O { return unicode.ToLower(x.(rune)) }))
+		assert.Equal(t, NewStrV(), NewStrV().SortByDesc(func(x O) O { return unicode.ToLower(x.(rune)) }))
+	}
+
+	// new Slice
+	{
+		slice := NewStrV("bAaB")
+		sorted := slice.SortByDesc(func(x O) O { return unicode.ToLower(x.(rune)) })
+		assert.Equal(t, NewStrV("bBAa"), sorted)
+		assert.Equal(t, NewStrV("bAaB"), slice)
+	}
+}
+
+// SortByDescM
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_SortByDescM() {
+	slice := NewStrV("bAaB")
+	fmt.Println(slice.SortByDescM(func(x O) O { return unicode.ToLower(x.(rune)) }))
+	// Output: bBAa
+}
+
+func TestStr_SortByDescM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, (*Str)(nil), slice.SortByDescM(func(x O) O { return unicode.ToLower(x.(rune)) }))
+		assert.Equal(t, NewStrV(), NewStrV().SortByDescM(func(x O) O { return unicode.ToLower(x.(rune)) }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewStrV("bAaB")
+		sorted := slice.SortByDescM(func(x O) O { return unicode.ToLower(x.(rune)) })
+		assert.Equal(t, NewStrV("bBAa"), sorted)
+		assert.Equal(t, NewStrV("bBAa"), slice)
+	}
+}
+
+// SortByM
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_SortByM() {
+	slice := NewStrV("bAaB")
+	fmt.Println(slice.SortByM(func(x O) O { return unicode.ToLower(x.(rune)) }))
+	// Output: AabB
+}
+
+func TestStr_SortByM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, (*Str)(nil), slice.SortByM(func(x O) O { return unicode.ToLower(x.(rune)) }))
+		assert.Equal(t, NewStrV(), NewStrV().SortByM(func(x O) O { return unicode.ToLower(x.(rune)) }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewStrV("bAaB")
+		sorted := slice.SortByM(func(x O) O { return unicode.ToLower(x.(rune)) })
+		assert.Equal(t, NewStrV("AabB"), sorted)
+		assert.Equal(t, NewStrV("AabB"), slice)
+	}
+}
+
 // SortM
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_SortM_Go(t *testing.B) {
@@ -4043,6 +5401,65 @@ func TestStr_SortReverseM(t *testing.T) {
 	}
 }
 
+// SortWith
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_SortWith() {
+	slice := NewStrV("bAaB")
+	fmt.Println(slice.SortWith(func(a, b O) int { return Compare(b, a) }))
+	// Output: baBA
+}
+
+func TestStr_SortWith(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, NewStrV(), slice.SortWith(func(a, b O) int { return Compare(b, a) }))
+		assert.Equal(t, NewStrV(), NewStrV().SortWith(func(a, b O) int { return Compare(b, a) }))
+	}
+
+	// new Slice
+	{
+		slice := NewStrV("bAaB")
+		sorted := slice.SortWith(func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, NewStrV("baBA"), sorted)
+		assert.Equal(t, NewStrV("bAaB"), slice)
+	}
+
+	// ordering
+	{
+		slice := NewStrV("bAaB")
+		assert.Equal(t, NewStrV("AabB"), slice.SortWith(By(func(x O) O { return unicode.ToLower(x.(rune)) }).Compare))
+		assert.Equal(t, NewStrV("bBAa"), slice.SortWith(ByDesc(func(x O) O { return unicode.ToLower(x.(rune)) }).Compare))
+	}
+}
+
+// SortWithM
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_SortWithM() {
+	slice := NewStrV("bAaB")
+	fmt.Println(slice.SortWithM(func(a, b O) int { return Compare(b, a) }))
+	// Output: baBA
+}
+
+func TestStr_SortWithM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, (*Str)(nil), slice.SortWithM(func(a, b O) int { return Compare(b, a) }))
+		assert.Equal(t, NewStrV(), NewStrV().SortWithM(func(a, b O) int { return Compare(b, a) }))
+	}
+
+	// modifies this Slice
+	{
+		slice := NewStrV("bAaB")
+		sorted := slice.SortWithM(func(a, b O) int { return Compare(b, a) })
+		assert.Equal(t, NewStrV("baBA"), sorted)
+		assert.Equal(t, NewStrV("baBA"), slice)
+	}
+}
+
 // Split
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_Split_Go(t *testing.B) {
@@ -4220,6 +5637,65 @@ func TestStr_SplitQuotes(t *testing.T) {
 		pieces, err = NewStrV(`foo"bar`).SplitQuotes()
 		assert.NotNil(t, err)
 	}
+
+	// escapes
+	{
+		pieces, err := NewStrV(`."foo\"bar".blah`).SplitQuotes()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`.`, `"foo\"bar"`, `.blah`}, pieces.O())
+
+		pieces, err = NewStrV(`foo\"bar`).SplitQuotes()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`foo\"bar`}, pieces.O())
+
+		pieces, err = NewStrV(`foo\\"bar"`).SplitQuotes()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`foo\\`, `"bar"`}, pieces.O())
+
+		pieces, err = NewStrV(`foo\.bar`).SplitQuotes()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`foo\.bar`}, pieces.O())
+
+		pieces, err = NewStrV(`"foo\"`).SplitQuotes()
+		assert.Equal(t, "imbalanced quotes: unterminated double quote at offset 0", err.Error())
+		assert.Equal(t, 0, pieces.Len())
+	}
+
+	// quotes and whitespace are kept unlike shell.Split
+	{
+		pieces, err := NewStrV(`foo "bar baz" qux`).SplitQuotes()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`foo `, `"bar baz"`, ` qux`}, pieces.O())
+	}
+}
+
+// SplitRegex
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_SplitRegex() {
+	fmt.Println(A("a, b;c").SplitRegex(`[,;]\s*`))
+	// Output: [a b c]
+}
+
+func TestStr_SplitRegex(t *testing.T) {
+	assert.Equal(t, []string{}, (*Str)(nil).SplitRegex(`,`).G())
+	assert.Equal(t, []string{}, A("").SplitRegex(`,`).G())
+	assert.Equal(t, []string{"abc"}, A("abc").SplitRegex(`,`).G())
+	assert.Equal(t, []string{"a", "b", "c"}, A("a1b22c").SplitRegex(`\d+`).G())
+	assert.Equal(t, []string{"a", "b", ""}, A("a  b ").SplitRegex(regexp.MustCompile(`\s+`)).G())
+	assert.Equal(t, []string{"a,b"}, A("a,b").SplitRegex(`(`).G())
+}
+
+func TestStr_SplitRegexE(t *testing.T) {
+	slice, err := A("a1b22c").SplitRegexE(`\d+`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, slice.G())
+
+	// invalid pattern
+	slice, err = A("a,b").SplitRegexE(`(`)
+	assert.Equal(t, "failed to compile regex pattern (: error parsing regexp: missing closing ): `(`", err.Error())
+	assert.Equal(t, []string{}, slice.G())
+	_, err = A("").SplitRegexE(`(`)
+	assert.NotNil(t, err)
 }
 
 // String
@@ -4696,6 +6172,36 @@ func TestStr_Title(t *testing.T) {
 	assert.Equal(t, "This   Is   A   Test", NewStr("this   is   a   test").Title().A())
 }
 
+// ToCamel
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_ToCamel() {
+	fmt.Println(A("http_server_id").ToCamel())
+	// Output: httpServerId
+}
+
+func TestStr_ToCamel(t *testing.T) {
+	assert.Equal(t, "", (*Str)(nil).ToCamel().A())
+	assert.Equal(t, "fooBar", A("foo_bar").ToCamel().A())
+	assert.Equal(t, "fooBar", A("Foo Bar").ToCamel().A())
+	assert.Equal(t, "httpServer", A("HTTPServer").ToCamel().A())
+	assert.Equal(t, "userId", A("user-id").ToCamel().A())
+	assert.Equal(t, "base64Encode", A("base64_encode").ToCamel().A())
+}
+
+// ToKebab
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_ToKebab() {
+	fmt.Println(A("HTTPServer").ToKebab())
+	// Output: http-server
+}
+
+func TestStr_ToKebab(t *testing.T) {
+	assert.Equal(t, "", (*Str)(nil).ToKebab().A())
+	assert.Equal(t, "foo-bar", A("fooBar").ToKebab().A())
+	assert.Equal(t, "user-id", A("userID").ToKebab().A())
+	assert.Equal(t, "foo-bar", A("  foo__bar  ").ToKebab().A())
+}
+
 // ToLower
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_ToLower_Go(t *testing.B) {
@@ -4725,6 +6231,64 @@ func TestStr_ToLower(t *testing.T) {
 	assert.Equal(t, "this   is   a   test", NewStr("This   Is   A   Test").ToLower().A())
 }
 
+// ToPascal
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_ToPascal() {
+	fmt.Println(A("user_id").ToPascal())
+	// Output: UserId
+}
+
+func TestStr_ToPascal(t *testing.T) {
+	assert.Equal(t, "", (*Str)(nil).ToPascal().A())
+	assert.Equal(t, "FooBar", A("foo_bar").ToPascal().A())
+	assert.Equal(t, "HttpServer", A("HTTPServer").ToPascal().A())
+	assert.Equal(t, "Base64Encode", A("base64 encode").ToPascal().A())
+}
+
+// ToScreamingSnake
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_ToScreamingSnake() {
+	fmt.Println(A("fooBar").ToScreamingSnake())
+	// Output: FOO_BAR
+}
+
+func TestStr_ToScreamingSnake(t *testing.T) {
+	assert.Equal(t, "", (*Str)(nil).ToScreamingSnake().A())
+	assert.Equal(t, "HTTP_SERVER", A("HTTPServer").ToScreamingSnake().A())
+	assert.Equal(t, "USER_ID", A("user-id").ToScreamingSnake().A())
+}
+
+// ToSnake
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_ToSnake() {
+	fmt.Println(A("HTTPServer").ToSnake())
+	// Output: http_server
+}
+
+func TestStr_ToSnake(t *testing.T) {
+	assert.Equal(t, "", (*Str)(nil).ToSnake().A())
+	assert.Equal(t, "http_server", A("HTTPServer").ToSnake().A())
+	assert.Equal(t, "user_id", A("userID").ToSnake().A())
+	assert.Equal(t, "base64_encode", A("Base64Encode").ToSnake().A())
+	assert.Equal(t, "http2_server", A("HTTP2Server").ToSnake().A())
+	assert.Equal(t, "foo_bar", A("foo-bar").ToSnake().A())
+	assert.Equal(t, "foo_bar", A("FOO_BAR").ToSnake().A())
+}
+
+// ToTitleWords
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_ToTitleWords() {
+	fmt.Println(A("http_server_status").ToTitleWords())
+	// Output: Http Server Status
+}
+
+func TestStr_ToTitleWords(t *testing.T) {
+	assert.Equal(t, "", (*Str)(nil).ToTitleWords().A())
+	assert.Equal(t, "Foo Bar", A("fooBar").ToTitleWords().A())
+	assert.Equal(t, "HTTP Server", A("HTTPServer").ToTitleWords().A())
+	assert.Equal(t, "User ID", A("userID").ToTitleWords().A())
+}
+
 // ToUpper
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_ToUpper_Go(t *testing.B) {
@@ -4992,6 +6556,36 @@ func TestStr_TrimSuffix(t *testing.T) {
 	assert.Equal(t, " This   Is   A", NewStr(" This   Is   A   Test  ").TrimSuffix("   Test  ").A())
 }
 
+// Truncate
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Truncate() {
+	fmt.Println(A("日本語のテキスト").Truncate(9))
+	// Output: 日本語...
+}
+
+func TestStr_Truncate(t *testing.T) {
+	assert.Equal(t, "", (*Str)(nil).Truncate(3).A())
+	assert.Equal(t, "abc", A("abc").Truncate(3).A())
+	assert.Equal(t, "ab...", A("abcdef").Truncate(5).A())
+	assert.Equal(t, "abcd…", A("abcdef").Truncate(5, "…").A())
+	assert.Equal(t, "abcde", A("abcdef").Truncate(5, "").A())
+
+	// wide characters are not split
+	assert.Equal(t, "...", A("日本語").Truncate(4).A())
+	assert.Equal(t, "日...", A("日本語").Truncate(5).A())
+	assert.Equal(t, "👍🏽…", A("👍🏽👍🏽👍🏽").Truncate(4, "…").A())
+
+	// ellipsis wider than width
+	assert.Equal(t, "..", A("abcdef").Truncate(2).A())
+
+	// zero and negative widths
+	assert.Equal(t, "", A("abcdef").Truncate(0).A())
+	assert.Equal(t, "", A("abcdef").Truncate(0, "…").A())
+	assert.Equal(t, "", A("abcdef").Truncate(-1).A())
+	assert.Equal(t, "", A("abcdef").Truncate(-5, "").A())
+	assert.Equal(t, "", A("").Truncate(-1).A())
+}
+
 // Union
 // --------------------------------------------------------------------------------------------------
 func BenchmarkStr_Union_Go(t *testing.B) {
@@ -5265,3 +6859,122 @@ func TestStr_UniqM(t *testing.T) {
 		assert.Equal(t, NewStrV("1", "2", "3", "4"), uniq)
 	}
 }
+
+// Width
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Width() {
+	fmt.Println(A("日本語").Width())
+	// Output: 6
+}
+
+func TestStr_Width(t *testing.T) {
+	assert.Equal(t, 0, (*Str)(nil).Width())
+	assert.Equal(t, 3, A("abc").Width())
+	assert.Equal(t, 2, A("e\u0301x").Width())
+	assert.Equal(t, 4, A("👨\u200d👩🇺🇸").Width())
+	assert.Equal(t, 5, A("a日本").Width())
+}
+
+// Window
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Window() {
+	slice := NewStrV("abcd")
+	fmt.Println(slice.Window(2, 1))
+	// Output: [ab bc cd]
+}
+
+func TestStr_Window(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, []ISlice{}, slice.Window(2, 1))
+		assert.Equal(t, []ISlice{}, NewStrV("").Window(2, 1))
+	}
+
+	// invalid size or step
+	assert.Equal(t, []ISlice{}, NewStrV("ab").Window(0, 1))
+	assert.Equal(t, []ISlice{}, NewStrV("ab").Window(1, 0))
+	assert.Equal(t, []ISlice{}, NewStrV("ab").Window(3, 1))
+
+	// windows
+	{
+		slice := NewStrV("abcde")
+		assert.Equal(t, []ISlice{NewStrV("ab"), NewStrV("bc"), NewStrV("cd"), NewStrV("de")}, slice.Window(2, 1))
+		assert.Equal(t, []ISlice{NewStrV("abc"), NewStrV("cde")}, slice.Window(3, 2))
+		assert.Equal(t, []ISlice{NewStrV("ab"), NewStrV("de")}, slice.Window(2, 3))
+		assert.Equal(t, []ISlice{NewStrV("abcde")}, slice.Window(5, 1))
+	}
+}
+
+// Words
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Words() {
+	fmt.Println(A("HTTPServer_userID").Words())
+	// Output: [HTTP Server user ID]
+}
+
+func TestStr_Words(t *testing.T) {
+	assert.Equal(t, []string{}, (*Str)(nil).Words())
+	assert.Equal(t, []string{}, A(" _-").Words())
+	assert.Equal(t, []string{"foo", "Bar"}, A("fooBar").Words())
+	assert.Equal(t, []string{"HTTP", "Server"}, A("HTTPServer").Words())
+	assert.Equal(t, []string{"Base64", "Encode"}, A("Base64Encode").Words())
+	assert.Equal(t, []string{"HTTP2", "Server"}, A("HTTP2Server").Words())
+	assert.Equal(t, []string{"v2api"}, A("v2api").Words())
+	assert.Equal(t, []string{"foo", "bar", "baz"}, A("foo_bar-baz").Words())
+}
+
+// Wrap
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Wrap() {
+	fmt.Println(A("the quick brown fox").Wrap(10))
+	// Output:
+	// the quick
+	// brown fox
+}
+
+func TestStr_Wrap(t *testing.T) {
+	assert.Equal(t, "", (*Str)(nil).Wrap(5).A())
+	assert.Equal(t, "abc", A("abc").Wrap(0).A())
+	assert.Equal(t, "the\nquick\nbrown\nfox", A("the quick brown fox").Wrap(5).A())
+
+	// existing line breaks and empty lines are preserved
+	assert.Equal(t, "one\ntwo\n\nthree", A("one two\n\nthree").Wrap(5).A())
+
+	// long words are broken
+	assert.Equal(t, "abcd\nefgh\nij k", A("abcdefghij k").Wrap(4).A())
+
+	// wide characters are measured by display width
+	assert.Equal(t, "日本\n語の\nテキ\nスト", A("日本語のテキスト").Wrap(5).A())
+	assert.Equal(t, "日本 語\nです", A("日本 語 です").Wrap(7).A())
+}
+
+// Zip
+// --------------------------------------------------------------------------------------------------
+func ExampleStr_Zip() {
+	slice := NewStrV("ab")
+	fmt.Println(slice.Zip([]string{"x", "y"}))
+	// Output: [[a x] [b y]]
+}
+
+func TestStr_Zip(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, []ISlice{}, slice.Zip([]string{"x"}))
+		assert.Equal(t, []ISlice{}, NewStrV("").Zip([]string{"x"}))
+	}
+
+	// no slices
+	assert.Equal(t, []ISlice{NewInterSliceV(NewChar('a')), NewInterSliceV(NewChar('b'))}, NewStrV("ab").Zip())
+
+	// stops at the shortest slice
+	{
+		slice := NewStrV("abc")
+		assert.Equal(t, []ISlice{NewInterSliceV(NewChar('a'), "x", 7), NewInterSliceV(NewChar('b'), "y", 8)},
+			slice.Zip([]string{"x", "y", "z"}, NewIntSliceV(7, 8)))
+		assert.Equal(t, []ISlice{}, slice.Zip([]int{}))
+	}
+}